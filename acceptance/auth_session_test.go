@@ -42,7 +42,7 @@ var _ = Describe("Auth Session", func() {
 					Name: "job-name",
 				},
 			},
-		}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+		}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		teamDB := teamDBFactory.GetTeamDB(atc.DefaultTeamName)
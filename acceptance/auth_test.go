@@ -25,7 +25,7 @@ var _ = Describe("Auth", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(found).To(BeTrue()) // created by postgresRunner
 
-		_, _, err = defaultTeam.SavePipeline(atc.DefaultPipelineName, atc.Config{}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+		_, _, err = defaultTeam.SavePipeline(atc.DefaultPipelineName, atc.Config{}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 		Expect(err).NotTo(HaveOccurred())
 	})
 
@@ -39,7 +39,7 @@ var _ = Describe("Resource Pagination", func() {
 			Resources: atc.ResourceConfigs{
 				{Name: "resource-name"},
 			},
-		}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+		}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		teamDB := teamDBFactory.GetTeamDB(atc.DefaultTeamName)
@@ -22,6 +22,7 @@ import (
 	"github.com/concourse/atc/api/pipes/pipesfakes"
 	"github.com/concourse/atc/api/resourceserver/resourceserverfakes"
 	"github.com/concourse/atc/auth/authfakes"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
 	"github.com/concourse/atc/db/dbfakes"
 	"github.com/concourse/atc/engine/enginefakes"
 	"github.com/concourse/atc/worker/workerfakes"
@@ -41,6 +42,7 @@ var (
 	providerFactory               *authfakes.FakeProviderFactory
 	fakeEngine                    *enginefakes.FakeEngine
 	fakeWorkerClient              *workerfakes.FakeClient
+	fakeArtifactStore             *blobstorefakes.FakeStore
 	fakeVolumeFactory             *dbngfakes.FakeVolumeFactory
 	fakeContainerFactory          *dbngfakes.FakeContainerFactory
 	pipeDB                        *pipesfakes.FakePipeDB
@@ -123,6 +125,7 @@ var _ = BeforeEach(func() {
 
 	fakeEngine = new(enginefakes.FakeEngine)
 	fakeWorkerClient = new(workerfakes.FakeClient)
+	fakeArtifactStore = new(blobstorefakes.FakeStore)
 
 	fakeSchedulerFactory = new(jobserverfakes.FakeSchedulerFactory)
 	fakeScannerFactory = new(resourceserverfakes.FakeScannerFactory)
@@ -192,6 +195,7 @@ var _ = BeforeEach(func() {
 
 		fakeEngine,
 		fakeWorkerClient,
+		fakeArtifactStore,
 
 		fakeSchedulerFactory,
 		fakeScannerFactory,
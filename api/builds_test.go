@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -16,9 +17,13 @@ import (
 	"github.com/onsi/gomega/ghttp"
 
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/engine"
 	"github.com/concourse/atc/engine/enginefakes"
+	"github.com/concourse/atc/event"
+	"github.com/vito/go-sse/sse"
 )
 
 var _ = Describe("Builds API", func() {
@@ -134,6 +139,21 @@ var _ = Describe("Builds API", func() {
 					})
 				})
 
+				Context("and the ATC is draining", func() {
+					BeforeEach(func() {
+						fakeEngine.CreateBuildReturns(nil, engine.ErrDraining)
+					})
+
+					It("returns 503 Service Unavailable", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusServiceUnavailable))
+					})
+
+					It("aborts the build, as nothing else would start it", func() {
+						Expect(build.FinishCallCount()).To(Equal(1))
+						Expect(build.FinishArgsForCall(0)).To(Equal(dbng.BuildStatusAborted))
+					})
+				})
+
 				Context("and building fails", func() {
 					BeforeEach(func() {
 						fakeEngine.CreateBuildReturns(nil, errors.New("oh no!"))
@@ -323,6 +343,56 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 					})
+
+					It("does not look up annotations for a requester outside the build's team", func() {
+						Expect(build.AnnotationsCallCount()).To(BeZero())
+					})
+
+					Context("when the requester belongs to the build's team", func() {
+						BeforeEach(func() {
+							userContextReader.GetTeamReturns("some-team", false, true)
+						})
+
+						Context("when the build has annotations", func() {
+							BeforeEach(func() {
+								build.AnnotationsReturns([]atc.BuildAnnotation{
+									{Step: "unit", Name: "coverage", Value: "87.5"},
+								}, nil)
+							})
+
+							It("includes them", func() {
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(body).To(MatchJSON(`{
+						"id": 1,
+						"name": "1",
+						"status": "succeeded",
+						"job_name": "job1",
+						"pipeline_name": "pipeline1",
+						"team_name": "some-team",
+						"url": "/teams/some-team/pipelines/pipeline1/jobs/job1/builds/1",
+						"api_url": "/api/v1/builds/1",
+						"start_time": 1,
+						"end_time": 100,
+						"reap_time": 200,
+						"annotations": [
+							{"step": "unit", "name": "coverage", "value": "87.5"}
+						]
+					}`))
+							})
+						})
+
+						Context("when getting the annotations fails", func() {
+							BeforeEach(func() {
+								build.AnnotationsReturns(nil, errors.New("nope"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
 				})
 			})
 		})
@@ -1498,4 +1568,859 @@ var _ = Describe("Builds API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/builds/:build_id/artifacts", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/42/artifacts")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the job is private", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(true)
+					fakePipeline.ConfigReturns(atc.Config{
+						Jobs: atc.JobConfigs{
+							{Name: "job1", Public: false},
+						},
+					})
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not list the artifacts", func() {
+					Expect(fakeArtifactStore.ListCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(true)
+					userContextReader.GetTeamReturns("some-team", false, true)
+
+					fakeArtifactStore.ListReturns([]blobstore.Artifact{
+						{
+							BuildID:   42,
+							Name:      "some-artifact",
+							Size:      1024,
+							CreatedAt: time.Unix(1, 0),
+						},
+						{
+							BuildID:   42,
+							Name:      "other-artifact",
+							Size:      2048,
+							CreatedAt: time.Unix(2, 0),
+						},
+					}, nil)
+				})
+
+				It("lists the artifacts of the build", func() {
+					Expect(fakeArtifactStore.ListCallCount()).To(Equal(1))
+					Expect(fakeArtifactStore.ListArgsForCall(0)).To(Equal(42))
+				})
+
+				It("returns 200 with the artifacts", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-artifact",
+							"size_in_bytes": 1024,
+							"created_at": 1,
+							"url": "/api/v1/builds/42/artifacts/some-artifact"
+						},
+						{
+							"name": "other-artifact",
+							"size_in_bytes": 2048,
+							"created_at": 2,
+							"url": "/api/v1/builds/42/artifacts/other-artifact"
+						}
+					]`))
+				})
+
+				Context("when listing the artifacts fails", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.ListReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/artifacts/:artifact_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/42/artifacts/some-artifact")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the pipeline is private", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not open the artifact", func() {
+					Expect(fakeArtifactStore.OpenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(true)
+					userContextReader.GetTeamReturns("some-team", false, true)
+				})
+
+				Context("when the artifact exists", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.OpenReturns(ioutil.NopCloser(strings.NewReader("some-tgz")), true, nil)
+					})
+
+					It("opens the artifact of the build", func() {
+						Expect(fakeArtifactStore.OpenCallCount()).To(Equal(1))
+
+						buildID, name := fakeArtifactStore.OpenArgsForCall(0)
+						Expect(buildID).To(Equal(42))
+						Expect(name).To(Equal("some-artifact"))
+					})
+
+					It("streams the artifact as a gzipped tarball", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/gzip"))
+						Expect(response.Header.Get("Content-Disposition")).To(Equal(`attachment; filename="some-artifact.tgz"`))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("some-tgz"))
+					})
+				})
+
+				Context("when the artifact does not exist", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.OpenReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when opening the artifact fails", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.OpenReturns(nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/steps/:plan_id/approval", func() {
+		var (
+			decision atc.ApprovalDecision
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			decision = atc.ApprovalDecision{
+				Approved: true,
+				Comment:  "ship it",
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(decision)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/steps/some-plan-id/approval", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not decide the step", func() {
+				Expect(build.DecideApprovalCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				build.TeamNameReturns("some-team")
+				build.IsRunningReturns(true)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when accessing other team's build", func() {
+				BeforeEach(func() {
+					userContextReader.GetTeamReturns("some-other-team", false, true)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not decide the step", func() {
+					Expect(build.DecideApprovalCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when accessing same team's build", func() {
+				BeforeEach(func() {
+					userContextReader.GetTeamReturns("some-team", false, true)
+					build.DecideApprovalReturns(true, nil)
+				})
+
+				It("returns 204", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				})
+
+				It("decides the step, recording the approving team", func() {
+					Expect(build.DecideApprovalCallCount()).To(Equal(1))
+
+					planID, approved, approver, comment := build.DecideApprovalArgsForCall(0)
+					Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+					Expect(approved).To(BeTrue())
+					Expect(approver).To(Equal("some-team"))
+					Expect(comment).To(Equal("ship it"))
+				})
+
+				Context("when the step is rejected", func() {
+					BeforeEach(func() {
+						decision.Approved = false
+					})
+
+					It("records the rejection", func() {
+						_, approved, _, _ := build.DecideApprovalArgsForCall(0)
+						Expect(approved).To(BeFalse())
+					})
+				})
+
+				Context("when the step is not awaiting approval", func() {
+					BeforeEach(func() {
+						build.DecideApprovalReturns(false, nil)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+				})
+
+				Context("when the build is not running", func() {
+					BeforeEach(func() {
+						build.IsRunningReturns(false)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not decide the step", func() {
+						Expect(build.DecideApprovalCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when deciding fails", func() {
+					BeforeEach(func() {
+						build.DecideApprovalReturns(false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/annotations", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/42/annotations")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the job is private", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(true)
+					fakePipeline.ConfigReturns(atc.Config{
+						Jobs: atc.JobConfigs{
+							{Name: "job1", Public: false},
+						},
+					})
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not look up the annotations", func() {
+					Expect(build.AnnotationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(true)
+					userContextReader.GetTeamReturns("some-team", false, true)
+
+					build.AnnotationsReturns([]atc.BuildAnnotation{
+						{Step: "unit", Name: "coverage", Value: "87.5"},
+						{Step: "unit", Name: "tests", Value: "120"},
+					}, nil)
+				})
+
+				It("returns 200 with the annotations", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{"step": "unit", "name": "coverage", "value": "87.5"},
+						{"step": "unit", "name": "tests", "value": "120"}
+					]`))
+				})
+
+				Context("when getting the annotations fails", func() {
+					BeforeEach(func() {
+						build.AnnotationsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/builds/search", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = "?q=connection+refused"
+
+			dbTeam.SearchBuildLogsReturns([]dbng.BuildLogMatch{
+				{
+					BuildID:      42,
+					BuildName:    "7",
+					JobName:      "some-job",
+					PipelineName: "some-pipeline",
+					TeamName:     "some-team",
+					Origin:       "some-step",
+					Snippets:     []string{"dial tcp: connection refused"},
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/builds/search" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as the requested team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+			})
+
+			It("returns 200 with the matches", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"build_id": 42,
+						"build_name": "7",
+						"job_name": "some-job",
+						"pipeline_name": "some-pipeline",
+						"team_name": "some-team",
+						"origin": "some-step",
+						"snippets": ["dial tcp: connection refused"]
+					}
+				]`))
+			})
+
+			It("searches all of the team's builds with the default limit", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+
+				Expect(dbTeam.SearchBuildLogsCallCount()).To(Equal(1))
+				searched, publicOnly, limit := dbTeam.SearchBuildLogsArgsForCall(0)
+				Expect(searched).To(Equal("connection refused"))
+				Expect(publicOnly).To(BeFalse())
+				Expect(limit).To(Equal(atc.PaginationAPIDefaultLimit))
+			})
+
+			Context("when a limit is given", func() {
+				BeforeEach(func() {
+					query += "&limit=5"
+				})
+
+				It("uses it", func() {
+					_, _, limit := dbTeam.SearchBuildLogsArgsForCall(0)
+					Expect(limit).To(Equal(5))
+				})
+			})
+
+			Context("when no query is given", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not search", func() {
+					Expect(dbTeam.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when searching fails", func() {
+				BeforeEach(func() {
+					dbTeam.SearchBuildLogsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-other-team", false, true)
+			})
+
+			It("only searches public jobs", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				_, publicOnly, _ := dbTeam.SearchBuildLogsArgsForCall(0)
+				Expect(publicOnly).To(BeTrue())
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("only searches public jobs", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				_, publicOnly, _ := dbTeam.SearchBuildLogsArgsForCall(0)
+				Expect(publicOnly).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/notifications", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/42/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when accessing other team's build", func() {
+				BeforeEach(func() {
+					userContextReader.GetTeamReturns("some-other-team", false, true)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+
+				It("does not look up the deliveries", func() {
+					Expect(build.NotificationDeliveriesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when accessing same team's build", func() {
+				BeforeEach(func() {
+					userContextReader.GetTeamReturns("some-team", false, true)
+
+					build.NotificationDeliveriesReturns([]dbng.NotificationDelivery{
+						{
+							Notification: "some-hook",
+							Attempt:      1,
+							Delivered:    false,
+							Error:        "connection refused",
+							CreatedAt:    time.Unix(1, 0),
+						},
+						{
+							Notification: "some-hook",
+							Attempt:      2,
+							Delivered:    true,
+							CreatedAt:    time.Unix(6, 0),
+						},
+					}, nil)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the delivery log", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"notification": "some-hook",
+							"attempt": 1,
+							"delivered": false,
+							"error": "connection refused",
+							"time": 1
+						},
+						{
+							"notification": "some-hook",
+							"attempt": 2,
+							"delivered": true,
+							"time": 6
+						}
+					]`))
+				})
+
+				Context("when getting the deliveries fails", func() {
+					BeforeEach(func() {
+						build.NotificationDeliveriesReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/events", func() {
+		var (
+			request  *http.Request
+			response *http.Response
+
+			fakeEventSource *dbngfakes.FakeTeamEventSource
+			sourceClosed    chan struct{}
+		)
+
+		teamEvent := func(id uint, payload string) dbng.TeamEvent {
+			data := json.RawMessage(payload)
+			return dbng.TeamEvent{
+				ID: id,
+				Envelope: event.Envelope{
+					Data:    &data,
+					Event:   "build-status-changed",
+					Version: "1.0",
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest("GET", server.URL+"/api/v1/teams/some-team/events", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			returnedEvents := []dbng.TeamEvent{
+				teamEvent(42, `{"build_id":1,"status":"started"}`),
+				teamEvent(43, `{"build_id":1,"status":"succeeded"}`),
+			}
+
+			sourceClosed = make(chan struct{})
+
+			fakeEventSource = new(dbngfakes.FakeTeamEventSource)
+			fakeEventSource.NextStub = func() (dbng.TeamEvent, error) {
+				if len(returnedEvents) > 0 {
+					ev := returnedEvents[0]
+					returnedEvents = returnedEvents[1:]
+					return ev, nil
+				}
+
+				<-sourceClosed
+				return dbng.TeamEvent{}, dbng.ErrTeamEventStreamClosed
+			}
+
+			var closeOnce sync.Once
+			fakeEventSource.CloseStub = func() error {
+				closeOnce.Do(func() { close(sourceClosed) })
+				return nil
+			}
+
+			dbTeam.LatestEventIDReturns(41, nil)
+			dbTeam.EventsReturns(fakeEventSource, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			response.Body.Close()
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not subscribe to the team's events", func() {
+				Expect(dbTeam.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-other-team", false, true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated as the requested team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+			})
+
+			It("streams events that happen from now on", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+				Expect(dbTeam.EventsCallCount()).To(Equal(1))
+				Expect(dbTeam.EventsArgsForCall(0)).To(Equal(uint(41)))
+
+				reader := sse.NewReadCloser(response.Body)
+
+				Expect(reader.Next()).To(Equal(sse.Event{
+					ID:   "42",
+					Name: "event",
+					Data: []byte(`{"data":{"build_id":1,"status":"started"},"event":"build-status-changed","version":"1.0"}`),
+				}))
+
+				Expect(reader.Next()).To(Equal(sse.Event{
+					ID:   "43",
+					Name: "event",
+					Data: []byte(`{"data":{"build_id":1,"status":"succeeded"},"event":"build-status-changed","version":"1.0"}`),
+				}))
+			})
+
+			It("closes the event source when the client goes away", func() {
+				response.Body.Close()
+				Eventually(fakeEventSource.CloseCallCount).ShouldNot(BeZero())
+			})
+
+			It("ends the stream when draining", func() {
+				close(drain)
+
+				_, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeEventSource.CloseCallCount()).ToNot(BeZero())
+			})
+
+			Context("when resuming from a Last-Event-ID", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "12")
+				})
+
+				It("streams events after it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbTeam.EventsArgsForCall(0)).To(Equal(uint(12)))
+					Expect(dbTeam.LatestEventIDCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when resuming from an event older than those retained", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "12")
+					dbTeam.EventsReturnsOnCall(0, nil, dbng.ErrTeamEventsReaped)
+					dbTeam.EventsReturnsOnCall(1, fakeEventSource, nil)
+				})
+
+				It("tells the client to reset, and streams what happens from now on", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					Expect(dbTeam.EventsCallCount()).To(Equal(2))
+					Expect(dbTeam.EventsArgsForCall(0)).To(Equal(uint(12)))
+					Expect(dbTeam.EventsArgsForCall(1)).To(Equal(uint(41)))
+
+					reader := sse.NewReadCloser(response.Body)
+
+					Expect(reader.Next()).To(Equal(sse.Event{
+						ID:   "41",
+						Name: "reset",
+						Data: []byte{},
+					}))
+
+					ev, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ev.ID).To(Equal("42"))
+				})
+			})
+
+			Context("when the Last-Event-ID is malformed", func() {
+				BeforeEach(func() {
+					request.Header.Set("Last-Event-ID", "nope")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the latest event ID fails", func() {
+				BeforeEach(func() {
+					dbTeam.LatestEventIDReturns(0, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when subscribing to the team's events fails", func() {
+				BeforeEach(func() {
+					dbTeam.EventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,26 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) ListBuildAnnotations(build dbng.Build) http.Handler {
+	log := s.logger.Session("list-build-annotations", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		annotations, err := build.Annotations()
+		if err != nil {
+			log.Error("failed-to-get-annotations", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(annotations)
+	})
+}
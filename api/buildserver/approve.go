@@ -0,0 +1,61 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/auth"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) ApproveBuildStep(build dbng.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		planID := atc.PlanID(r.FormValue(":plan_id"))
+
+		aLog := s.logger.Session("approve-build-step", lager.Data{
+			"build":   build.ID(),
+			"plan-id": planID,
+		})
+
+		var decision atc.ApprovalDecision
+		err := json.NewDecoder(r.Body).Decode(&decision)
+		if err != nil {
+			aLog.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		authTeam, found := auth.GetTeam(r)
+		if !found {
+			aLog.Error("failed-to-get-team-from-auth", errors.New("failed-to-get-team-from-auth"))
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !build.IsRunning() {
+			aLog.Info("build-not-running")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		decided, err := build.DecideApproval(planID, decision.Approved, authTeam.Name(), decision.Comment)
+		if err != nil {
+			aLog.Error("failed-to-decide-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !decided {
+			aLog.Info("step-not-awaiting-approval")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		aLog.Info("decided", lager.Data{"approved": decision.Approved, "approver": authTeam.Name()})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,78 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) ListBuildArtifacts(build dbng.Build) http.Handler {
+	log := s.logger.Session("list-build-artifacts", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.artifactStore == nil {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode([]atc.BuildArtifact{})
+			return
+		}
+
+		artifacts, err := s.artifactStore.List(build.ID())
+		if err != nil {
+			log.Error("failed-to-list-artifacts", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.BuildArtifact, len(artifacts))
+		for i, artifact := range artifacts {
+			presented[i] = present.BuildArtifact(artifact)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(presented)
+	})
+}
+
+func (s *Server) DownloadBuildArtifact(build dbng.Build) http.Handler {
+	log := s.logger.Session("download-build-artifact", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.artifactStore == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		name := r.FormValue(":artifact_name")
+
+		reader, found, err := s.artifactStore.Open(build.ID(), name)
+		if err != nil {
+			log.Error("failed-to-open-artifact", err, lager.Data{"artifact": name})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		defer reader.Close()
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".tgz"))
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			log.Error("failed-to-stream-artifact", err, lager.Data{"artifact": name})
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/atc/api/present"
 	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/engine"
 )
 
 func (s *Server) CreateBuild(teamDB db.TeamDB, team dbng.Team) http.Handler {
@@ -30,7 +31,22 @@ func (s *Server) CreateBuild(teamDB db.TeamDB, team dbng.Team) http.Handler {
 			return
 		}
 
+		// one-off builds are not held back by the build queue, as nothing
+		// would start them once admitted; they do count against its capacity
 		engineBuild, err := s.engine.CreateBuild(hLog, build, plan)
+		if err == engine.ErrDraining {
+			hLog.Info("draining")
+
+			// nothing else will pick up a pending one-off build
+			err := build.Finish(dbng.BuildStatusAborted)
+			if err != nil {
+				hLog.Error("failed-to-abort-build", err)
+			}
+
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
 		if err != nil {
 			hLog.Error("failed-to-start-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -33,27 +33,8 @@ func NewEventHandler(logger lager.Logger, build dbng.Build) http.Handler {
 			eventID++
 		}
 
-		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
-		w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
-		w.Header().Add("X-Accel-Buffering", "no")
-		w.Header().Add(ProtocolVersionHeader, CurrentProtocolVersion)
-
-		writer := eventWriter{
-			responseWriter:  w,
-			writeFlusher:    nil,
-			responseFlusher: w.(http.Flusher),
-		}
-
-		w.Header().Add("Vary", "Accept-Encoding")
-		if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
-			w.Header().Set("Content-Encoding", "gzip")
-
-			gz := gzip.NewWriter(w)
-			defer gz.Close()
-
-			writer.responseWriter = gz
-			writer.writeFlusher = gz
-		}
+		writer, closeWriter := newEventWriter(w, r)
+		defer closeWriter()
 
 		events, err := build.Events(eventID)
 		if err != nil {
@@ -96,6 +77,36 @@ func NewEventHandler(logger lager.Logger, build dbng.Build) http.Handler {
 	})
 }
 
+// newEventWriter sets up the response for streaming server-sent events,
+// compressing them if the client accepts gzip. The returned func must be
+// called once the stream is done.
+func newEventWriter(w http.ResponseWriter, r *http.Request) (eventWriter, func()) {
+	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+	w.Header().Add("X-Accel-Buffering", "no")
+	w.Header().Add(ProtocolVersionHeader, CurrentProtocolVersion)
+
+	writer := eventWriter{
+		responseWriter:  w,
+		writeFlusher:    nil,
+		responseFlusher: w.(http.Flusher),
+	}
+
+	w.Header().Add("Vary", "Accept-Encoding")
+	if strings.Contains(r.Header.Get("Accept-Encoding"), "gzip") {
+		w.Header().Set("Content-Encoding", "gzip")
+
+		gz := gzip.NewWriter(w)
+
+		writer.responseWriter = gz
+		writer.writeFlusher = gz
+
+		return writer, func() { gz.Close() }
+	}
+
+	return writer, func() {}
+}
+
 type flusher interface {
 	Flush() error
 }
@@ -133,6 +144,17 @@ func (writer eventWriter) WriteEnd(id uint) error {
 	return writer.flush()
 }
 
+// WriteReset tells the subscriber that events it has not seen are gone, and
+// that the stream continues from the given event.
+func (writer eventWriter) WriteReset(id uint) error {
+	err := sse.Event{ID: fmt.Sprintf("%d", id), Name: "reset"}.Write(writer.responseWriter)
+	if err != nil {
+		return err
+	}
+
+	return writer.flush()
+}
+
 func (writer eventWriter) flush() error {
 	if writer.writeFlusher != nil {
 		err := writer.writeFlusher.Flush()
@@ -4,14 +4,34 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/auth"
 	"github.com/concourse/atc/dbng"
 )
 
 func (s *Server) GetBuild(build dbng.Build) http.Handler {
+	log := s.logger.Session("get-build", lager.Data{"build-id": build.ID()})
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented := present.Build(build)
+
+		// annotations may come from private jobs, so only the owning team sees
+		// them here; everyone else goes through ListBuildAnnotations
+		authTeam, authTeamFound := auth.GetTeam(r)
+		if authTeamFound && authTeam.IsAuthorized(build.TeamName()) {
+			annotations, err := build.Annotations()
+			if err != nil {
+				log.Error("failed-to-get-annotations", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			presented.Annotations = annotations
+		}
+
 		w.WriteHeader(http.StatusOK)
 
-		json.NewEncoder(w).Encode(present.Build(build))
+		json.NewEncoder(w).Encode(presented)
 	})
 }
@@ -0,0 +1,33 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) ListBuildNotifications(build dbng.Build) http.Handler {
+	log := s.logger.Session("list-build-notifications", lager.Data{"build-id": build.ID()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deliveries, err := build.NotificationDeliveries()
+		if err != nil {
+			log.Error("failed-to-get-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.NotificationDelivery, len(deliveries))
+		for i, delivery := range deliveries {
+			presented[i] = present.NotificationDelivery(delivery)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(presented)
+	})
+}
@@ -0,0 +1,59 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/auth"
+)
+
+func (s *Server) SearchBuildLogs(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("search-build-logs")
+
+	query := r.FormValue("q")
+	if query == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if limit <= 0 {
+		limit = atc.PaginationAPIDefaultLimit
+	}
+
+	requestTeamName := r.FormValue(":team_name")
+	team, found, err := s.teamFactory.FindTeam(requestTeamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	authTeam, authTeamFound := auth.GetTeam(r)
+	publicOnly := !authTeamFound || !authTeam.IsAuthorized(requestTeamName)
+
+	matches, err := team.SearchBuildLogs(query, publicOnly, limit)
+	if err != nil {
+		logger.Error("failed-to-search-build-logs", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.BuildLogMatch, len(matches))
+	for i, match := range matches {
+		presented[i] = present.BuildLogMatch(match)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(presented)
+}
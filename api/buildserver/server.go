@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc/auth"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/engine"
 	"github.com/concourse/atc/worker"
@@ -24,6 +25,7 @@ type Server struct {
 	buildFactory        dbng.BuildFactory
 	eventHandlerFactory EventHandlerFactory
 	drain               <-chan struct{}
+	artifactStore       blobstore.Store
 	rejector            auth.Rejector
 
 	httpClient *http.Client
@@ -38,6 +40,7 @@ func NewServer(
 	buildFactory dbng.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
 	drain <-chan struct{},
+	artifactStore blobstore.Store,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -50,6 +53,7 @@ func NewServer(
 		buildFactory:        buildFactory,
 		eventHandlerFactory: eventHandlerFactory,
 		drain:               drain,
+		artifactStore:       artifactStore,
 
 		rejector: auth.UnauthorizedRejector{},
 
@@ -0,0 +1,121 @@
+package buildserver
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) TeamEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("team-events")
+
+	teamName := r.FormValue(":team_name")
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	var after uint
+	if startString := r.Header.Get("Last-Event-ID"); startString != "" {
+		_, err := fmt.Sscanf(startString, "%d", &after)
+		if err != nil {
+			logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": startString})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+	} else {
+		// new subscribers only care about what happens from now on
+		after, err = team.LatestEventID()
+		if err != nil {
+			logger.Error("failed-to-get-latest-event-id", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	events, err := team.Events(after)
+	reset := err == dbng.ErrTeamEventsReaped
+	if reset {
+		// the subscriber has missed events that are no longer retained, so it
+		// has to start over from what happens from now on
+		logger.Info("resetting-stream", lager.Data{"after": after})
+
+		after, err = team.LatestEventID()
+		if err != nil {
+			logger.Error("failed-to-get-latest-event-id", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		events, err = team.Events(after)
+	}
+	if err != nil {
+		logger.Error("failed-to-get-team-events", err, lager.Data{"after": after})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	defer events.Close()
+
+	writer, closeWriter := newEventWriter(w, r)
+	defer closeWriter()
+
+	w.WriteHeader(http.StatusOK)
+
+	err = writer.flush()
+	if err != nil {
+		logger.Info("failed-to-flush-headers", lager.Data{"error": err.Error()})
+		return
+	}
+
+	if reset {
+		err = writer.WriteReset(after)
+		if err != nil {
+			logger.Info("failed-to-write-reset", lager.Data{"error": err.Error()})
+			return
+		}
+	}
+
+	streamDone := make(chan struct{})
+
+	go func() {
+		defer close(streamDone)
+
+		for {
+			ev, err := events.Next()
+			if err != nil {
+				if err != dbng.ErrTeamEventStreamClosed {
+					logger.Error("failed-to-get-next-team-event", err)
+				}
+
+				return
+			}
+
+			err = writer.WriteEvent(ev.ID, ev.Envelope)
+			if err != nil {
+				logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-streamDone:
+	case <-w.(http.CloseNotifier).CloseNotify():
+	case <-s.drain:
+	}
+
+	events.Close()
+
+	<-streamDone
+}
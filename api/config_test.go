@@ -8,8 +8,11 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/textproto"
+	"time"
 
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/configserver"
+	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/dbng/dbngfakes"
 	"github.com/onsi/gomega/gbytes"
@@ -317,11 +320,12 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							name, savedConfig, id, pipelineState, author := dbTeam.SavePipelineArgsForCall(0)
 							Expect(name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(dbng.ConfigVersion(42)))
 							Expect(pipelineState).To(Equal(dbng.PipelineNoChange))
+							Expect(author).To(Equal("a-team"))
 						})
 
 						Context("and saving it fails", func() {
@@ -349,6 +353,98 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when doing a dry run", func() {
+							BeforeEach(func() {
+								request.URL.RawQuery = "dry_run=true"
+
+								fakePipeline.ConfigReturns(atc.Config{
+									ResourceTypes: atc.ResourceTypes{
+										{
+											Name:   "custom-resource",
+											Type:   "custom-type",
+											Source: atc.Source{"custom": "old-source"},
+										},
+									},
+									Jobs: atc.JobConfigs{
+										pipelineConfig.Jobs[0],
+										{Name: "old-job"},
+									},
+								})
+
+								oldJobBuild := new(dbngfakes.FakeBuild)
+								oldJobBuild.IDReturns(12)
+								oldJobBuild.NameReturns("3")
+								oldJobBuild.JobNameReturns("old-job")
+								oldJobBuild.PipelineNameReturns("a-pipeline")
+								oldJobBuild.TeamNameReturns("a-team")
+								oldJobBuild.StatusReturns(dbng.BuildStatusStarted)
+
+								keptJobBuild := new(dbngfakes.FakeBuild)
+								keptJobBuild.IDReturns(13)
+								keptJobBuild.JobNameReturns("some-job")
+
+								fakePipeline.InFlightBuildsReturns([]dbng.Build{oldJobBuild, keptJobBuild}, nil)
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("does not save anything", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+							})
+
+							It("returns what would change", func() {
+								var saveResponse configserver.SaveConfigResponse
+								err := json.NewDecoder(response.Body).Decode(&saveResponse)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(dbTeam.PipelineArgsForCall(0)).To(Equal("a-pipeline"))
+
+								impact := saveResponse.Impact
+								Expect(impact).NotTo(BeNil())
+
+								Expect(impact.Groups.Added).To(Equal([]string{"some-group"}))
+								Expect(impact.Resources.Added).To(Equal([]string{"some-resource"}))
+								Expect(impact.ResourceTypes.Changed).To(Equal([]string{"custom-resource"}))
+								Expect(impact.Jobs.Removed).To(Equal([]string{"old-job"}))
+								Expect(impact.Jobs.Changed).To(BeEmpty())
+
+								Expect(impact.RecheckedResourceTypes).To(Equal([]string{"custom-resource"}))
+
+								Expect(impact.OrphanedBuilds).To(HaveLen(1))
+								Expect(impact.OrphanedBuilds[0].ID).To(Equal(12))
+								Expect(impact.OrphanedBuilds[0].JobName).To(Equal("old-job"))
+								Expect(impact.OrphanedBuilds[0].Status).To(Equal("started"))
+							})
+
+							Context("when the pipeline does not exist yet", func() {
+								BeforeEach(func() {
+									dbTeam.PipelineReturns(nil, false, nil)
+								})
+
+								It("reports everything as added", func() {
+									var saveResponse configserver.SaveConfigResponse
+									err := json.NewDecoder(response.Body).Decode(&saveResponse)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(saveResponse.Impact.Jobs.Added).To(Equal([]string{"some-job"}))
+									Expect(saveResponse.Impact.RecheckedResourceTypes).To(Equal([]string{"custom-resource"}))
+									Expect(saveResponse.Impact.OrphanedBuilds).To(BeEmpty())
+								})
+							})
+
+							Context("when looking up in-flight builds fails", func() {
+								BeforeEach(func() {
+									fakePipeline.InFlightBuildsReturns(nil, errors.New("nope"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
 						Context("when the config is invalid", func() {
 							BeforeEach(func() {
 								pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
@@ -393,7 +489,7 @@ var _ = Describe("Config API", func() {
 						It("saves it", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+							name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(dbng.ConfigVersion(42)))
@@ -403,7 +499,7 @@ var _ = Describe("Config API", func() {
 						It("does not give the DB a map of empty interfaces to empty interfaces", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+							_, savedConfig, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(savedConfig).To(Equal(pipelineConfig))
 
 							_, err := json.Marshal(pipelineConfig)
@@ -441,7 +537,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
@@ -578,7 +674,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, pipelineState := dbTeam.SavePipelineArgsForCall(0)
+								name, savedConfig, id, pipelineState, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(pipelineConfig))
 								Expect(id).To(Equal(dbng.ConfigVersion(42)))
@@ -914,4 +1010,285 @@ jobs:
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			teamDB.GetPipelineByNameReturns(db.SavedPipeline{}, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/config/versions")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+
+				fakePipeline.ConfigVersionsReturns([]dbng.PipelineConfigVersion{
+					{
+						Version:   dbng.ConfigVersion(7),
+						Config:    pipelineConfig,
+						Author:    "a-team",
+						CreatedAt: time.Unix(200, 0),
+					},
+					{
+						Version:   dbng.ConfigVersion(3),
+						Config:    atc.Config{},
+						CreatedAt: time.Unix(100, 0),
+					},
+				}, nil)
+			})
+
+			It("returns 200 with every version, newest first", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{"version": 7, "author": "a-team", "created_at": 200},
+					{"version": 3, "created_at": 100}
+				]`))
+			})
+
+			It("looks up the pipeline", func() {
+				Expect(dbTeam.PipelineArgsForCall(0)).To(Equal("a-pipeline"))
+			})
+
+			Context("when getting the versions fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigVersionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/diff", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = "?from=3&to=7"
+
+			teamDB.GetPipelineByNameReturns(db.SavedPipeline{}, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/config/diff" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+
+				fakePipeline.ConfigAtVersionStub = func(version dbng.ConfigVersion) (dbng.PipelineConfigVersion, bool, error) {
+					switch version {
+					case 3:
+						return dbng.PipelineConfigVersion{
+							Version: version,
+							Config: atc.Config{
+								Jobs: atc.JobConfigs{
+									{Name: "some-job", Serial: false},
+									{Name: "old-job"},
+								},
+							},
+						}, true, nil
+					case 7:
+						return dbng.PipelineConfigVersion{
+							Version: version,
+							Config:  pipelineConfig,
+						}, true, nil
+					default:
+						return dbng.PipelineConfigVersion{}, false, nil
+					}
+				}
+			})
+
+			It("returns 200 with what changed between the versions", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+					"from": 3,
+					"to": 7,
+					"groups": {"added": ["some-group"], "removed": [], "changed": []},
+					"resources": {"added": ["some-resource"], "removed": [], "changed": []},
+					"resource_types": {"added": ["custom-resource"], "removed": [], "changed": []},
+					"jobs": {"added": [], "removed": ["old-job"], "changed": ["some-job"]}
+				}`))
+			})
+
+			Context("when a version does not exist", func() {
+				BeforeEach(func() {
+					query = "?from=3&to=8"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when a version is missing", func() {
+				BeforeEach(func() {
+					query = "?from=3"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when looking up a version fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigAtVersionStub = nil
+					fakePipeline.ConfigAtVersionReturns(dbng.PipelineConfigVersion{}, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version/rollback", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			teamDB.GetPipelineByNameReturns(db.SavedPipeline{}, true, nil)
+
+			fakePipeline.ConfigVersionReturns(dbng.ConfigVersion(42))
+			fakePipeline.ConfigAtVersionReturns(dbng.PipelineConfigVersion{
+				Version: dbng.ConfigVersion(3),
+				Config:  pipelineConfig,
+			}, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.RollbackPipelineConfig, rata.Params{
+				"team_name":      "a-team",
+				"pipeline_name":  "a-pipeline",
+				"config_version": "3",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("saves the old config on top of the current one", func() {
+				Expect(fakePipeline.ConfigAtVersionArgsForCall(0)).To(Equal(dbng.ConfigVersion(3)))
+
+				Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+				name, savedConfig, from, pausedState, author := dbTeam.SavePipelineArgsForCall(0)
+				Expect(name).To(Equal("a-pipeline"))
+				Expect(savedConfig).To(Equal(pipelineConfig))
+				Expect(from).To(Equal(dbng.ConfigVersion(42)))
+				Expect(pausedState).To(Equal(dbng.PipelineNoChange))
+				Expect(author).To(Equal("a-team"))
+			})
+
+			Context("when the old config is no longer valid", func() {
+				BeforeEach(func() {
+					pipelineConfig.Groups[0].Resources = []string{"missing-resource"}
+
+					fakePipeline.ConfigAtVersionReturns(dbng.PipelineConfigVersion{
+						Version: dbng.ConfigVersion(3),
+						Config:  pipelineConfig,
+					}, true, nil)
+				})
+
+				It("returns 400 with the errors", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"errors": [
+							"invalid groups:\n\tgroup 'some-group' has unknown resource 'missing-resource'\n"
+						]
+					}`))
+				})
+
+				It("does not save it", func() {
+					Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the version does not exist", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigAtVersionReturns(dbng.PipelineConfigVersion{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authorized as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("another-team", false, true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save anything", func() {
+				Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+			})
+		})
+	})
 })
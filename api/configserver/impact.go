@@ -0,0 +1,57 @@
+package configserver
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/dbng"
+)
+
+func configImpact(team dbng.Team, pipelineName string, config atc.Config) (atc.ConfigImpact, error) {
+	pipeline, found, err := team.Pipeline(pipelineName)
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	var current atc.Config
+	if found {
+		current = pipeline.Config()
+	}
+
+	diff := atc.DiffConfigs(current, config)
+
+	impact := atc.ConfigImpact{
+		Groups:        diff.Groups,
+		Resources:     diff.Resources,
+		ResourceTypes: diff.ResourceTypes,
+		Jobs:          diff.Jobs,
+
+		RecheckedResourceTypes: append(
+			append([]string{}, diff.ResourceTypes.Added...),
+			diff.ResourceTypes.Changed...,
+		),
+
+		OrphanedBuilds: []atc.Build{},
+	}
+
+	if !found || len(diff.Jobs.Removed) == 0 {
+		return impact, nil
+	}
+
+	removedJobs := map[string]bool{}
+	for _, name := range diff.Jobs.Removed {
+		removedJobs[name] = true
+	}
+
+	builds, err := pipeline.InFlightBuilds()
+	if err != nil {
+		return atc.ConfigImpact{}, err
+	}
+
+	for _, build := range builds {
+		if removedJobs[build.JobName()] {
+			impact.OrphanedBuilds = append(impact.OrphanedBuilds, present.Build(build))
+		}
+	}
+
+	return impact, nil
+}
@@ -44,8 +44,9 @@ func (eke ExtraKeysError) Error() string {
 }
 
 type SaveConfigResponse struct {
-	Errors   []string      `json:"errors,omitempty"`
-	Warnings []atc.Warning `json:"warnings,omitempty"`
+	Errors   []string          `json:"errors,omitempty"`
+	Warnings []atc.Warning     `json:"warnings,omitempty"`
+	Impact   *atc.ConfigImpact `json:"impact,omitempty"`
 }
 
 func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
@@ -102,9 +103,23 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	s.saveConfig(w, r, session, config, version, pausedState)
+}
+
+// saveConfig validates and saves the config for the pipeline named in the
+// request, crediting whoever made the request with the change. With
+// ?dry_run=true, it instead reports what saving it would do.
+func (s *Server) saveConfig(
+	w http.ResponseWriter,
+	r *http.Request,
+	session lager.Logger,
+	config atc.Config,
+	version dbng.ConfigVersion,
+	pausedState dbng.PipelinePausedState,
+) {
 	warnings, errorMessages := config.Validate()
 	if len(errorMessages) > 0 {
-		session.Error("ignoring-invalid-config", err)
+		session.Info("ignoring-invalid-config")
 		s.handleBadRequest(w, errorMessages, session)
 		return
 	}
@@ -127,7 +142,20 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState)
+	if r.FormValue("dry_run") == "true" {
+		impact, err := configImpact(team, pipelineName, config)
+		if err != nil {
+			session.Error("failed-to-determine-config-impact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		s.writeSaveConfigResponse(w, SaveConfigResponse{Warnings: warnings, Impact: &impact}, session)
+		return
+	}
+
+	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState, requestAuthor(r))
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -239,6 +267,15 @@ func saveConfigRequestUnmarshaler(r *http.Request) (atc.Config, dbng.PipelinePau
 		return atc.Config{}, dbng.PipelineNoChange, err
 	}
 
+	config, err := decodeConfig(configStructure)
+	if err != nil {
+		return atc.Config{}, dbng.PipelineNoChange, err
+	}
+
+	return config, pausedState, nil
+}
+
+func decodeConfig(configStructure interface{}) (atc.Config, error) {
 	var config atc.Config
 	var md mapstructure.Metadata
 	msConfig := &mapstructure.DecoderConfig{
@@ -248,21 +285,23 @@ func saveConfigRequestUnmarshaler(r *http.Request) (atc.Config, dbng.PipelinePau
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			atc.SanitizeDecodeHook,
 			atc.VersionConfigDecodeHook,
+			atc.AttemptsConfigDecodeHook,
+			atc.InParallelConfigDecodeHook,
 		),
 	}
 
 	decoder, err := mapstructure.NewDecoder(msConfig)
 	if err != nil {
-		return atc.Config{}, dbng.PipelineNoChange, ErrFailedToConstructDecoder
+		return atc.Config{}, ErrFailedToConstructDecoder
 	}
 
 	if err := decoder.Decode(configStructure); err != nil {
-		return atc.Config{}, dbng.PipelineNoChange, ErrCouldNotDecode
+		return atc.Config{}, ErrCouldNotDecode
 	}
 
 	if len(md.Unused) != 0 {
-		return atc.Config{}, dbng.PipelineNoChange, ExtraKeysError{extraKeys: md.Unused}
+		return atc.Config{}, ExtraKeysError{extraKeys: md.Unused}
 	}
 
-	return config, pausedState, nil
+	return config, nil
 }
@@ -0,0 +1,275 @@
+package configserver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/auth"
+	"github.com/concourse/atc/config"
+	"github.com/concourse/atc/dbng"
+	"github.com/tedsuo/rata"
+	"gopkg.in/yaml.v2"
+)
+
+func (s *Server) ListPipelineTemplates(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-pipeline-templates")
+
+	team, found := s.findTeam(w, r, logger)
+	if !found {
+		return
+	}
+
+	templates, err := team.PipelineTemplates()
+	if err != nil {
+		logger.Error("failed-to-get-pipeline-templates", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.PipelineTemplate, len(templates))
+	for i, template := range templates {
+		instances, err := template.Instances()
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-template-instances", err, lager.Data{"template": template.Name()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented[i] = present.PipelineTemplate(template, instances)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(presented)
+}
+
+func (s *Server) GetPipelineTemplate(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-pipeline-template")
+
+	team, found := s.findTeam(w, r, logger)
+	if !found {
+		return
+	}
+
+	template, found := s.findPipelineTemplate(w, r, logger, team)
+	if !found {
+		return
+	}
+
+	instances, err := template.Instances()
+	if err != nil {
+		logger.Error("failed-to-get-pipeline-template-instances", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(present.PipelineTemplate(template, instances))
+}
+
+// SavePipelineTemplate saves the YAML template in the request body and then
+// re-renders and re-saves every pipeline created from it. A pipeline whose
+// params no longer fit the template is left as it was and reported in the
+// response; it does not stop the others from being updated.
+func (s *Server) SavePipelineTemplate(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("save-pipeline-template")
+
+	team, found := s.findTeam(w, r, logger)
+	if !found {
+		return
+	}
+
+	templateName := rata.Param(r, "template_name")
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		logger.Error("failed-to-read-body", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	var structure interface{}
+	err = yaml.Unmarshal(body, &structure)
+	if err != nil {
+		s.handleBadRequest(w, []string{"malformed template: " + err.Error()}, logger)
+		return
+	}
+
+	template, err := team.SavePipelineTemplate(templateName, body)
+	if err != nil {
+		logger.Error("failed-to-save-pipeline-template", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	instances, err := template.Instances()
+	if err != nil {
+		logger.Error("failed-to-get-pipeline-template-instances", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	author := requestAuthor(r)
+
+	results := []atc.PipelineTemplateInstanceResult{}
+	for _, instance := range instances {
+		warnings, errorMessages, _, err := saveTemplatedConfig(team, template, instance.PipelineName, instance.Params, author)
+		if err != nil {
+			logger.Error("failed-to-save-pipeline-template-instance", err, lager.Data{"pipeline": instance.PipelineName})
+			errorMessages = []string{"failed to save config: " + err.Error()}
+		}
+
+		results = append(results, atc.PipelineTemplateInstanceResult{
+			PipelineName: instance.PipelineName,
+			Errors:       errorMessages,
+			Warnings:     warnings,
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(results)
+}
+
+// SavePipelineTemplateInstance renders the template with the params in the
+// request body and saves the result as the named pipeline, remembering the
+// params so the pipeline can be re-rendered when the template changes.
+func (s *Server) SavePipelineTemplateInstance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("save-pipeline-template-instance")
+
+	team, found := s.findTeam(w, r, logger)
+	if !found {
+		return
+	}
+
+	template, found := s.findPipelineTemplate(w, r, logger, team)
+	if !found {
+		return
+	}
+
+	var params map[string]interface{}
+	err := json.NewDecoder(r.Body).Decode(&params)
+	if err != nil {
+		s.handleBadRequest(w, []string{"malformed params"}, logger)
+		return
+	}
+
+	pipelineName := rata.Param(r, "pipeline_name")
+
+	warnings, errorMessages, created, err := saveTemplatedConfig(team, template, pipelineName, params, requestAuthor(r))
+	if err != nil {
+		logger.Error("failed-to-save-pipeline-template-instance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if len(errorMessages) > 0 {
+		logger.Info("ignoring-invalid-config")
+		s.handleBadRequest(w, errorMessages, logger)
+		return
+	}
+
+	if created {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	s.writeSaveConfigResponse(w, SaveConfigResponse{Warnings: warnings}, logger)
+}
+
+// saveTemplatedConfig renders the template with the given params and saves it
+// over whatever config the pipeline currently has, along with the params.
+// Problems with the rendered config are returned as error messages rather
+// than as an error, as with SaveConfig.
+func saveTemplatedConfig(
+	team dbng.Team,
+	template dbng.PipelineTemplate,
+	pipelineName string,
+	params map[string]interface{},
+	author string,
+) ([]atc.Warning, []string, bool, error) {
+	configStructure, err := config.RenderTemplate(template.Template(), params)
+	if err != nil {
+		return nil, []string{err.Error()}, false, nil
+	}
+
+	renderedConfig, err := decodeConfig(configStructure)
+	if err != nil {
+		return nil, []string{err.Error()}, false, nil
+	}
+
+	warnings, errorMessages := renderedConfig.Validate()
+	if len(errorMessages) > 0 {
+		return warnings, errorMessages, false, nil
+	}
+
+	var version dbng.ConfigVersion
+
+	pipeline, found, err := team.Pipeline(pipelineName)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if found {
+		version = pipeline.ConfigVersion()
+	}
+
+	_, created, err := template.SaveInstance(pipelineName, renderedConfig, version, author, params)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return warnings, nil, created, nil
+}
+
+func (s *Server) findTeam(w http.ResponseWriter, r *http.Request, logger lager.Logger) (dbng.Team, bool) {
+	teamName := rata.Param(r, "team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-find-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Info("team-not-found", lager.Data{"team": teamName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return team, true
+}
+
+func (s *Server) findPipelineTemplate(w http.ResponseWriter, r *http.Request, logger lager.Logger, team dbng.Team) (dbng.PipelineTemplate, bool) {
+	templateName := rata.Param(r, "template_name")
+
+	template, found, err := team.PipelineTemplate(templateName)
+	if err != nil {
+		logger.Error("failed-to-get-pipeline-template", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return nil, false
+	}
+
+	if !found {
+		logger.Info("pipeline-template-not-found", lager.Data{"template": templateName})
+		w.WriteHeader(http.StatusNotFound)
+		return nil, false
+	}
+
+	return template, true
+}
+
+func requestAuthor(r *http.Request) string {
+	if authTeam, found := auth.GetTeam(r); found {
+		return authTeam.Name()
+	}
+
+	return ""
+}
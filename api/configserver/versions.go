@@ -0,0 +1,113 @@
+package configserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/api/present"
+	"github.com/concourse/atc/db"
+	"github.com/concourse/atc/dbng"
+)
+
+func (s *Server) ListConfigVersions(_ db.PipelineDB, pipeline dbng.Pipeline) http.Handler {
+	logger := s.logger.Session("list-config-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		versions, err := pipeline.ConfigVersions()
+		if err != nil {
+			logger.Error("failed-to-get-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.PipelineConfigVersion, len(versions))
+		for i, version := range versions {
+			presented[i] = present.PipelineConfigVersion(version)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(presented)
+	})
+}
+
+func (s *Server) GetConfigDiff(_ db.PipelineDB, pipeline dbng.Pipeline) http.Handler {
+	logger := s.logger.Session("get-config-diff")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from, err := strconv.Atoi(r.FormValue("from"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		to, err := strconv.Atoi(r.FormValue("to"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fromVersion, found, err := pipeline.ConfigAtVersion(dbng.ConfigVersion(from))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err, lager.Data{"version": from})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		toVersion, found, err := pipeline.ConfigAtVersion(dbng.ConfigVersion(to))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err, lager.Data{"version": to})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		diff := atc.DiffConfigs(fromVersion.Config, toVersion.Config)
+		diff.From = from
+		diff.To = to
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(diff)
+	})
+}
+
+func (s *Server) RollbackConfig(_ db.PipelineDB, pipeline dbng.Pipeline) http.Handler {
+	logger := s.logger.Session("rollback-config")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, err := strconv.Atoi(r.FormValue(":config_version"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		oldVersion, found, err := pipeline.ConfigAtVersion(dbng.ConfigVersion(version))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err, lager.Data{"version": version})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// the old config is saved as a new version on top of the current one,
+		// so it's checked against today's rules like any other config
+		s.saveConfig(w, r, logger, oldVersion.Config, pipeline.ConfigVersion(), dbng.PipelineNoChange)
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/concourse/atc/api/volumeserver"
 	"github.com/concourse/atc/api/workerserver"
 	"github.com/concourse/atc/auth"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/engine"
@@ -62,6 +63,7 @@ func NewHandler(
 
 	engine engine.Engine,
 	workerClient worker.Client,
+	artifactStore blobstore.Store,
 
 	schedulerFactory jobserver.SchedulerFactory,
 	scannerFactory resourceserver.ScannerFactory,
@@ -105,6 +107,7 @@ func NewHandler(
 		dbBuildFactory,
 		eventHandlerFactory,
 		drain,
+		artifactStore,
 	)
 
 	jobServer := jobserver.NewServer(logger, schedulerFactory, externalURL)
@@ -137,15 +140,37 @@ func NewHandler(
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
 		atc.SaveConfig: http.HandlerFunc(configServer.SaveConfig),
 
+		atc.ListPipelineConfigVersions: pipelineHandlerFactory.HandlerFor(configServer.ListConfigVersions),
+		atc.GetPipelineConfigDiff:      pipelineHandlerFactory.HandlerFor(configServer.GetConfigDiff),
+		atc.RollbackPipelineConfig:     pipelineHandlerFactory.HandlerFor(configServer.RollbackConfig),
+
+		atc.ListPipelineTemplates:        http.HandlerFunc(configServer.ListPipelineTemplates),
+		atc.GetPipelineTemplate:          http.HandlerFunc(configServer.GetPipelineTemplate),
+		atc.SavePipelineTemplate:         http.HandlerFunc(configServer.SavePipelineTemplate),
+		atc.SavePipelineTemplateInstance: http.HandlerFunc(configServer.SavePipelineTemplateInstance),
+
 		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
 		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
 		atc.CreateBuild:         teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.ApproveBuildStep:    buildHandlerFactory.HandlerFor(buildServer.ApproveBuildStep),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 
+		atc.ListBuildArtifacts:    buildHandlerFactory.HandlerFor(buildServer.ListBuildArtifacts),
+		atc.DownloadBuildArtifact: buildHandlerFactory.HandlerFor(buildServer.DownloadBuildArtifact),
+
+		atc.ListBuildNotifications: buildHandlerFactory.HandlerFor(buildServer.ListBuildNotifications),
+
+		atc.ListBuildAnnotations: buildHandlerFactory.HandlerFor(buildServer.ListBuildAnnotations),
+		atc.ListJobAnnotations:   pipelineHandlerFactory.HandlerFor(jobServer.ListJobAnnotations),
+
+		atc.SearchBuildLogs: http.HandlerFunc(buildServer.SearchBuildLogs),
+
+		atc.TeamEvents: http.HandlerFunc(buildServer.TeamEvents),
+
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
 		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
 		atc.ListJobBuilds:  pipelineHandlerFactory.HandlerFor(jobServer.ListJobBuilds),
@@ -190,6 +215,7 @@ func NewHandler(
 		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
 		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
 		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
+		atc.DrainWorker:     http.HandlerFunc(workerServer.DrainWorker),
 		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
 		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
 		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
@@ -210,6 +236,15 @@ func NewHandler(
 		atc.ListTeams:   http.HandlerFunc(teamServer.ListTeams),
 		atc.SetTeam:     http.HandlerFunc(teamServer.SetTeam),
 		atc.DestroyTeam: http.HandlerFunc(teamServer.DestroyTeam),
+
+		atc.GetTeamNotifications: http.HandlerFunc(teamServer.GetNotifications),
+		atc.SetTeamNotifications: http.HandlerFunc(teamServer.SetNotifications),
+
+		atc.GetTeamRedactionRules: http.HandlerFunc(teamServer.GetRedactionRules),
+		atc.SetTeamRedactionRules: http.HandlerFunc(teamServer.SetRedactionRules),
+
+		atc.GetTeamScheduling: http.HandlerFunc(teamServer.GetScheduling),
+		atc.SetTeamScheduling: http.HandlerFunc(teamServer.SetScheduling),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
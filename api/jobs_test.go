@@ -1512,6 +1512,118 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/annotations/:annotation_name", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/annotations/coverage" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", true, true)
+			})
+
+			Context("when the job exists", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.AnnotationsReturns([]atc.BuildAnnotation{
+						{BuildID: 3, BuildName: "3", Step: "unit", Name: "coverage", Value: "82.5"},
+						{BuildID: 1, BuildName: "1", Step: "unit", Name: "coverage", Value: "80.0"},
+					}, nil)
+				})
+
+				It("looks up the job", func() {
+					Expect(fakePipeline.JobCallCount()).To(Equal(1))
+					Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				})
+
+				It("looks up the annotation with the default limit", func() {
+					Expect(fakeJob.AnnotationsCallCount()).To(Equal(1))
+
+					name, limit := fakeJob.AnnotationsArgsForCall(0)
+					Expect(name).To(Equal("coverage"))
+					Expect(limit).To(Equal(atc.PaginationAPIDefaultLimit))
+				})
+
+				It("returns 200 with the annotation across builds", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{"build_id": 3, "build_name": "3", "step": "unit", "name": "coverage", "value": "82.5"},
+						{"build_id": 1, "build_name": "1", "step": "unit", "name": "coverage", "value": "80.0"}
+					]`))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("uses it", func() {
+						_, limit := fakeJob.AnnotationsArgsForCall(0)
+						Expect(limit).To(Equal(5))
+					})
+				})
+
+				Context("when getting the annotations fails", func() {
+					BeforeEach(func() {
+						fakeJob.AnnotationsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job does not exist", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when looking up the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
@@ -0,0 +1,398 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline Templates API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+
+		fakeTemplate *dbngfakes.FakePipelineTemplate
+	)
+
+	const template = `
+resources:
+- name: repo
+  type: git
+  source:
+    uri: ((uri))
+
+jobs:
+- name: build
+  plan:
+  - get: repo
+`
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+
+		fakeTemplate = new(dbngfakes.FakePipelineTemplate)
+		fakeTemplate.NameReturns("some-template")
+		fakeTemplate.TemplateReturns([]byte(template))
+		fakeTemplate.InstancesReturns([]dbng.PipelineTemplateInstance{
+			{PipelineName: "some-pipeline", Params: map[string]interface{}{"uri": "some-uri"}},
+		}, nil)
+
+		dbTeam.PipelineTemplateReturns(fakeTemplate, true, nil)
+		dbTeam.SavePipelineTemplateReturns(fakeTemplate, nil)
+
+		fakePipeline.ConfigVersionReturns(dbng.ConfigVersion(42))
+	})
+
+	Describe("GET /api/v1/teams/:team_name/templates", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			dbTeam.PipelineTemplatesReturns([]dbng.PipelineTemplate{fakeTemplate}, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.ListPipelineTemplates, rata.Params{
+				"team_name": "a-team",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+			})
+
+			It("returns the templates with their instances", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				var templates []atc.PipelineTemplate
+				err := json.NewDecoder(response.Body).Decode(&templates)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(templates).To(Equal([]atc.PipelineTemplate{
+					{
+						Name:     "some-template",
+						Template: template,
+						Instances: []atc.PipelineTemplateInstance{
+							{PipelineName: "some-pipeline", Params: map[string]interface{}{"uri": "some-uri"}},
+						},
+					},
+				}))
+			})
+
+			Context("when getting the templates fails", func() {
+				BeforeEach(func() {
+					dbTeam.PipelineTemplatesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/templates/:template_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.GetPipelineTemplate, rata.Params{
+				"team_name":     "a-team",
+				"template_name": "some-template",
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+			})
+
+			It("returns the template", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbTeam.PipelineTemplateArgsForCall(0)).To(Equal("some-template"))
+
+				var presented atc.PipelineTemplate
+				err := json.NewDecoder(response.Body).Decode(&presented)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(presented.Name).To(Equal("some-template"))
+				Expect(presented.Instances).To(HaveLen(1))
+			})
+
+			Context("when the template does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.PipelineTemplateReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/templates/:template_name", func() {
+		var (
+			body     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			body = template
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.SavePipelineTemplate, rata.Params{
+				"team_name":     "a-team",
+				"template_name": "some-template",
+			}, bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", "application/x-yaml")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+			})
+
+			It("saves the template", func() {
+				Expect(dbTeam.SavePipelineTemplateCallCount()).To(Equal(1))
+
+				name, savedTemplate := dbTeam.SavePipelineTemplateArgsForCall(0)
+				Expect(name).To(Equal("some-template"))
+				Expect(string(savedTemplate)).To(Equal(template))
+			})
+
+			It("re-renders and saves each instance", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeTemplate.SaveInstanceCallCount()).To(Equal(1))
+
+				name, savedConfig, from, author, params := fakeTemplate.SaveInstanceArgsForCall(0)
+				Expect(name).To(Equal("some-pipeline"))
+				Expect(savedConfig.Resources[0].Source).To(Equal(atc.Source{"uri": "some-uri"}))
+				Expect(savedConfig.Jobs[0].Name).To(Equal("build"))
+				Expect(from).To(Equal(dbng.ConfigVersion(42)))
+				Expect(author).To(Equal("a-team"))
+				Expect(params).To(Equal(map[string]interface{}{"uri": "some-uri"}))
+
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+					{"pipeline_name": "some-pipeline"}
+				]`))
+			})
+
+			Context("when some instances no longer render", func() {
+				BeforeEach(func() {
+					fakeTemplate.InstancesReturns([]dbng.PipelineTemplateInstance{
+						{PipelineName: "broken-pipeline", Params: map[string]interface{}{}},
+						{PipelineName: "some-pipeline", Params: map[string]interface{}{"uri": "some-uri"}},
+					}, nil)
+				})
+
+				It("reports them and saves the rest", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					Expect(fakeTemplate.SaveInstanceCallCount()).To(Equal(1))
+
+					name, _, _, _, _ := fakeTemplate.SaveInstanceArgsForCall(0)
+					Expect(name).To(Equal("some-pipeline"))
+
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"pipeline_name": "broken-pipeline", "errors": ["undefined params: uri"]},
+						{"pipeline_name": "some-pipeline"}
+					]`))
+				})
+			})
+
+			Context("when saving an instance fails", func() {
+				BeforeEach(func() {
+					fakeTemplate.SaveInstanceReturns(nil, false, errors.New("oh no!"))
+				})
+
+				It("reports it as an error for that instance", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`[
+						{"pipeline_name": "some-pipeline", "errors": ["failed to save config: oh no!"]}
+					]`))
+				})
+			})
+
+			Context("when the template is not valid YAML", func() {
+				BeforeEach(func() {
+					body = "{"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not save it", func() {
+					Expect(dbTeam.SavePipelineTemplateCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving the template fails", func() {
+				BeforeEach(func() {
+					dbTeam.SavePipelineTemplateReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authorized as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("another-team", false, true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save anything", func() {
+				Expect(dbTeam.SavePipelineTemplateCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/templates/:template_name/instances/:pipeline_name", func() {
+		var (
+			body     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			body = `{"uri": "some-uri"}`
+		})
+
+		JustBeforeEach(func() {
+			req, err := requestGenerator.CreateRequest(atc.SavePipelineTemplateInstance, rata.Params{
+				"team_name":     "a-team",
+				"template_name": "some-template",
+				"pipeline_name": "a-pipeline",
+			}, bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Content-Type", "application/json")
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("a-team", true, true)
+			})
+
+			It("saves the rendered config along with the params", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(fakeTemplate.SaveInstanceCallCount()).To(Equal(1))
+
+				name, savedConfig, _, _, params := fakeTemplate.SaveInstanceArgsForCall(0)
+				Expect(name).To(Equal("a-pipeline"))
+				Expect(savedConfig.Resources[0].Source).To(Equal(atc.Source{"uri": "some-uri"}))
+				Expect(params).To(Equal(map[string]interface{}{"uri": "some-uri"}))
+
+				Expect(dbTeam.SavePipelineCallCount()).To(BeZero())
+			})
+
+			Context("when the pipeline is new", func() {
+				BeforeEach(func() {
+					dbTeam.PipelineReturns(nil, false, nil)
+					fakeTemplate.SaveInstanceReturns(fakePipeline, true, nil)
+				})
+
+				It("returns 201", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					_, _, from, _, _ := fakeTemplate.SaveInstanceArgsForCall(0)
+					Expect(from).To(BeZero())
+				})
+			})
+
+			Context("when a param is missing", func() {
+				BeforeEach(func() {
+					body = `{}`
+				})
+
+				It("returns 400 with the error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+						"errors": ["undefined params: uri"]
+					}`))
+				})
+
+				It("saves nothing", func() {
+					Expect(fakeTemplate.SaveInstanceCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the params are malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the template does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.PipelineTemplateReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when saving the pipeline fails", func() {
+				BeforeEach(func() {
+					fakeTemplate.SaveInstanceReturns(nil, false, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
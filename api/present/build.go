@@ -67,6 +67,9 @@ func Build(build dbng.Build) atc.Build {
 		atcBuild.ReapTime = build.ReapTime().Unix()
 	}
 
+	atcBuild.AbortReason = build.AbortReason()
+	atcBuild.QueuePosition = build.QueuePosition()
+
 	return atcBuild
 }
 
@@ -0,0 +1,26 @@
+package present
+
+import (
+	"strconv"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
+	"github.com/tedsuo/rata"
+)
+
+func BuildArtifact(artifact blobstore.Artifact) atc.BuildArtifact {
+	downloadURL, err := atc.Routes.CreatePathForRoute(atc.DownloadBuildArtifact, rata.Params{
+		"build_id":      strconv.Itoa(artifact.BuildID),
+		"artifact_name": artifact.Name,
+	})
+	if err != nil {
+		panic("failed to generate url: " + err.Error())
+	}
+
+	return atc.BuildArtifact{
+		Name:        artifact.Name,
+		SizeInBytes: artifact.Size,
+		CreatedAt:   artifact.CreatedAt.Unix(),
+		URL:         downloadURL,
+	}
+}
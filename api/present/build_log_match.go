@@ -0,0 +1,18 @@
+package present
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+)
+
+func BuildLogMatch(match dbng.BuildLogMatch) atc.BuildLogMatch {
+	return atc.BuildLogMatch{
+		BuildID:      match.BuildID,
+		BuildName:    match.BuildName,
+		JobName:      match.JobName,
+		PipelineName: match.PipelineName,
+		TeamName:     match.TeamName,
+		Origin:       match.Origin,
+		Snippets:     match.Snippets,
+	}
+}
@@ -0,0 +1,16 @@
+package present
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+)
+
+func NotificationDelivery(delivery dbng.NotificationDelivery) atc.NotificationDelivery {
+	return atc.NotificationDelivery{
+		Notification: delivery.Notification,
+		Attempt:      delivery.Attempt,
+		Delivered:    delivery.Delivered,
+		Error:        delivery.Error,
+		Time:         delivery.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,22 @@
+package present
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+)
+
+func PipelineTemplate(template dbng.PipelineTemplate, instances []dbng.PipelineTemplateInstance) atc.PipelineTemplate {
+	presentedInstances := make([]atc.PipelineTemplateInstance, len(instances))
+	for i, instance := range instances {
+		presentedInstances[i] = atc.PipelineTemplateInstance{
+			PipelineName: instance.PipelineName,
+			Params:       instance.Params,
+		}
+	}
+
+	return atc.PipelineTemplate{
+		Name:      template.Name(),
+		Template:  string(template.Template()),
+		Instances: presentedInstances,
+	}
+}
@@ -1,6 +1,8 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/dbng"
 )
@@ -28,5 +30,16 @@ func Worker(workerInfo dbng.Worker) atc.Worker {
 		Name:             workerInfo.Name(),
 		Team:             workerInfo.TeamName(),
 		State:            string(workerInfo.State()),
+
+		Labels:          workerInfo.Labels(),
+		StreamEncodings: workerInfo.StreamEncodings(),
+	}
+}
+
+func WorkerDrain(deadline time.Time, progress dbng.WorkerDrainProgress) *atc.WorkerDrain {
+	return &atc.WorkerDrain{
+		Deadline:            deadline.Unix(),
+		RemainingContainers: progress.RemainingContainers,
+		RemainingBuilds:     progress.RemainingBuilds,
 	}
 }
@@ -499,4 +499,468 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("other-team", false, true)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.NotificationsReturns([]atc.NotificationConfig{
+						{
+							Name:    "some-hook",
+							Webhook: &atc.WebhookNotification{URL: "https://hooks.example.com"},
+							Filter: atc.NotificationFilter{
+								Transitions: []atc.BuildTransition{atc.TransitionBroken},
+							},
+						},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("looks up the requested team", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+				})
+
+				It("returns the team's notifications", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-hook",
+							"webhook": {"url": "https://hooks.example.com"},
+							"filter": {"transitions": ["broken"]}
+						}
+					]`))
+				})
+
+				Context("when getting the notifications fails", func() {
+					BeforeEach(func() {
+						fakeTeam.NotificationsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/notifications", func() {
+		var (
+			notifications []atc.NotificationConfig
+			response      *http.Response
+		)
+
+		BeforeEach(func() {
+			notifications = []atc.NotificationConfig{
+				{
+					Name:  "some-email",
+					Email: &atc.EmailNotification{To: []string{"dev@example.com"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/notifications", jsonEncode(notifications))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not update the notifications", func() {
+				Expect(fakeTeam.UpdateNotificationsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the notifications", func() {
+				Expect(fakeTeam.UpdateNotificationsCallCount()).To(Equal(1))
+				Expect(fakeTeam.UpdateNotificationsArgsForCall(0)).To(Equal(notifications))
+			})
+
+			Context("when the notifications are invalid", func() {
+				BeforeEach(func() {
+					notifications[0].Email = nil
+				})
+
+				It("returns 400 Bad Request with the validation error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("notifications.some-email specifies neither a webhook nor an email"))
+				})
+
+				It("does not update the notifications", func() {
+					Expect(fakeTeam.UpdateNotificationsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeTeam.UpdateNotificationsReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/redaction-rules", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/redaction-rules")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("other-team", false, true)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.RedactionRulesReturns(atc.RedactionRules{
+					MinLength:  6,
+					IgnoreKeys: []string{"uri"},
+				}, nil)
+			})
+
+			It("returns the team's redaction rules", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{"min_length": 6, "ignore_keys": ["uri"]}`))
+			})
+
+			Context("when getting the rules fails", func() {
+				BeforeEach(func() {
+					fakeTeam.RedactionRulesReturns(atc.RedactionRules{}, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/redaction-rules", func() {
+		var (
+			rules    atc.RedactionRules
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			rules = atc.RedactionRules{
+				IgnoreKeys:  []string{"uri", "branch"},
+				Placeholder: "***",
+			}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/redaction-rules", jsonEncode(rules))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not update the rules", func() {
+				Expect(fakeTeam.UpdateRedactionRulesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the rules", func() {
+				Expect(fakeTeam.UpdateRedactionRulesCallCount()).To(Equal(1))
+				Expect(fakeTeam.UpdateRedactionRulesArgsForCall(0)).To(Equal(rules))
+			})
+
+			Context("when the rules are invalid", func() {
+				BeforeEach(func() {
+					rules.MinLength = -1
+				})
+
+				It("returns 400 Bad Request with the validation error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("min_length must not be negative"))
+				})
+
+				It("does not update the rules", func() {
+					Expect(fakeTeam.UpdateRedactionRulesCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeTeam.UpdateRedactionRulesReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/scheduling", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/scheduling")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as another team", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("other-team", false, true)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.SchedulingReturns(atc.TeamScheduling{Weight: 2, Priority: 5}, nil)
+			})
+
+			It("returns the team's scheduling", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{"weight": 2, "priority": 5}`))
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/scheduling", func() {
+		var (
+			scheduling atc.TeamScheduling
+			response   *http.Response
+		)
+
+		BeforeEach(func() {
+			scheduling = atc.TeamScheduling{Weight: 3, Priority: -1}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/scheduling", jsonEncode(scheduling))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized as the team but not an admin", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("some-team", false, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not update the scheduling", func() {
+				Expect(fakeTeam.UpdateSchedulingCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(true)
+				userContextReader.GetTeamReturns("main", true, true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the scheduling for the requested team", func() {
+				Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+				Expect(fakeTeam.UpdateSchedulingCallCount()).To(Equal(1))
+				Expect(fakeTeam.UpdateSchedulingArgsForCall(0)).To(Equal(scheduling))
+			})
+
+			Context("when the weight is invalid", func() {
+				BeforeEach(func() {
+					scheduling.Weight = 0
+				})
+
+				It("returns 400 Bad Request with the validation error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("weight must be at least 1"))
+				})
+
+				It("does not update the scheduling", func() {
+					Expect(fakeTeam.UpdateSchedulingCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving fails", func() {
+				BeforeEach(func() {
+					fakeTeam.UpdateSchedulingReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,82 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+)
+
+func (s *Server) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("get-notifications")
+
+	teamName := r.FormValue(":team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	notifications, err := team.Notifications()
+	if err != nil {
+		hLog.Error("failed-to-get-notifications", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(notifications)
+}
+
+func (s *Server) SetNotifications(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("set-notifications")
+
+	teamName := r.FormValue(":team_name")
+
+	var notifications []atc.NotificationConfig
+	err := json.NewDecoder(r.Body).Decode(&notifications)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = atc.ValidateNotifications(notifications)
+	if err != nil {
+		hLog.Info("invalid-notifications", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.UpdateNotifications(notifications)
+	if err != nil {
+		hLog.Error("failed-to-update-notifications", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
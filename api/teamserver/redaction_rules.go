@@ -0,0 +1,82 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+)
+
+func (s *Server) GetRedactionRules(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("get-redaction-rules")
+
+	teamName := r.FormValue(":team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	rules, err := team.RedactionRules()
+	if err != nil {
+		hLog.Error("failed-to-get-redaction-rules", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(rules)
+}
+
+func (s *Server) SetRedactionRules(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("set-redaction-rules")
+
+	teamName := r.FormValue(":team_name")
+
+	var rules atc.RedactionRules
+	err := json.NewDecoder(r.Body).Decode(&rules)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = atc.ValidateRedactionRules(rules)
+	if err != nil {
+		hLog.Info("invalid-redaction-rules", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.UpdateRedactionRules(rules)
+	if err != nil {
+		hLog.Error("failed-to-update-redaction-rules", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,82 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+)
+
+func (s *Server) GetScheduling(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("get-scheduling")
+
+	teamName := r.FormValue(":team_name")
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	scheduling, err := team.Scheduling()
+	if err != nil {
+		hLog.Error("failed-to-get-scheduling", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	json.NewEncoder(w).Encode(scheduling)
+}
+
+func (s *Server) SetScheduling(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("set-scheduling")
+
+	teamName := r.FormValue(":team_name")
+
+	var scheduling atc.TeamScheduling
+	err := json.NewDecoder(r.Body).Decode(&scheduling)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = atc.ValidateTeamScheduling(scheduling)
+	if err != nil {
+		hLog.Info("invalid-scheduling", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.UpdateScheduling(scheduling)
+	if err != nil {
+		hLog.Error("failed-to-update-scheduling", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
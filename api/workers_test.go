@@ -48,6 +48,8 @@ var _ = Describe("Workers API", func() {
 				var (
 					teamWorker1 *dbngfakes.FakeWorker
 					teamWorker2 *dbngfakes.FakeWorker
+
+					drainDeadline = time.Unix(1234567890, 0)
 				)
 
 				BeforeEach(func() {
@@ -91,6 +93,39 @@ var _ = Describe("Workers API", func() {
 					}))
 
 				})
+
+				Context("when a worker is being drained", func() {
+					BeforeEach(func() {
+						teamWorker2.DrainDeadlineReturns(&drainDeadline)
+						teamWorker2.DrainProgressReturns(dbng.WorkerDrainProgress{
+							RemainingContainers: 3,
+							RemainingBuilds:     2,
+						}, nil)
+					})
+
+					It("returns the drain progress", func() {
+						var returnedWorkers []atc.Worker
+						err := json.NewDecoder(response.Body).Decode(&returnedWorkers)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(returnedWorkers[0].Drain).To(BeNil())
+						Expect(returnedWorkers[1].Drain).To(Equal(&atc.WorkerDrain{
+							Deadline:            drainDeadline.Unix(),
+							RemainingContainers: 3,
+							RemainingBuilds:     2,
+						}))
+					})
+
+					Context("when getting the drain progress fails", func() {
+						BeforeEach(func() {
+							teamWorker2.DrainProgressReturns(dbng.WorkerDrainProgress{}, errors.New("oh no!"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
 			})
 
 			Context("when getting the workers fails", func() {
@@ -554,6 +589,148 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/workers/:worker_name/drain", func() {
+		var (
+			response   *http.Response
+			workerName string
+			query      string
+			fakeWorker *dbngfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/drain"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbngfakes.FakeWorker)
+			workerName = "some-worker"
+			query = "?timeout=30m"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeWorker.DrainReturns(nil)
+
+			authValidator.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authenticated as system", func() {
+			BeforeEach(func() {
+				userContextReader.GetSystemReturns(true, true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("lands the worker with the given timeout", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+				Expect(fakeWorker.DrainCallCount()).To(Equal(1))
+
+				retire, timeout := fakeWorker.DrainArgsForCall(0)
+				Expect(retire).To(BeFalse())
+				Expect(timeout).To(Equal(30 * time.Minute))
+			})
+
+			Context("when asked to retire the worker", func() {
+				BeforeEach(func() {
+					query = "?timeout=30m&retire=true"
+				})
+
+				It("retires the worker", func() {
+					Expect(fakeWorker.DrainCallCount()).To(Equal(1))
+
+					retire, _ := fakeWorker.DrainArgsForCall(0)
+					Expect(retire).To(BeTrue())
+				})
+			})
+
+			Context("when the timeout is missing", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("missing timeout")))
+				})
+
+				It("does not drain the worker", func() {
+					Expect(fakeWorker.DrainCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the timeout is malformed", func() {
+				BeforeEach(func() {
+					query = "?timeout=invalid-duration"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("malformed timeout")))
+				})
+			})
+
+			Context("when draining the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.DrainReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authenticated as the worker's owner", func() {
+			BeforeEach(func() {
+				userContextReader.GetTeamReturns("some-team", false, true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the request is authenticated as the wrong team", func() {
+			BeforeEach(func() {
+				userContextReader.GetTeamReturns("some-other-team", false, true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				authValidator.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not attempt to find the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/workers/:worker_name/prune", func() {
 		var (
 			response   *http.Response
@@ -0,0 +1,50 @@
+package workerserver
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+func (s *Server) DrainWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("draining-worker")
+	workerName := r.FormValue(":worker_name")
+
+	timeoutStr := r.URL.Query().Get("timeout")
+	if len(timeoutStr) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "missing timeout")
+		return
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil || timeout < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "malformed timeout")
+		return
+	}
+
+	retire := r.URL.Query().Get("retire") == "true"
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-drain", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Drain(retire, timeout)
+	if err != nil {
+		logger.Error("failed-to-drain-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -33,6 +33,17 @@ func (s *Server) ListWorkers(teamDB db.TeamDB, team dbng.Team) http.Handler {
 		workers := make([]atc.Worker, len(savedWorkers))
 		for i, savedWorker := range savedWorkers {
 			workers[i] = present.Worker(savedWorker)
+
+			if savedWorker.DrainDeadline() != nil {
+				progress, err := savedWorker.DrainProgress()
+				if err != nil {
+					logger.Error("failed-to-get-worker-drain-progress", err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+
+				workers[i].Drain = present.WorkerDrain(*savedWorker.DrainDeadline(), progress)
+			}
 		}
 
 		json.NewEncoder(w).Encode(workers)
@@ -0,0 +1,6 @@
+package atc
+
+type ApprovalDecision struct {
+	Approved bool   `json:"approved"`
+	Comment  string `json:"comment,omitempty"`
+}
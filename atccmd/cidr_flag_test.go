@@ -0,0 +1,26 @@
+package atccmd_test
+
+import (
+	"github.com/concourse/atc/atccmd"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CIDRFlag", func() {
+	It("parses a network", func() {
+		flag := atccmd.CIDRFlag{}
+
+		err := flag.UnmarshalFlag("169.254.169.254/32")
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(flag.String()).To(Equal("169.254.169.254/32"))
+		Expect(flag.IPNet().Contains([]byte{169, 254, 169, 254})).To(BeTrue())
+	})
+
+	It("returns an error when the value is not a CIDR", func() {
+		flag := atccmd.CIDRFlag{}
+
+		err := flag.UnmarshalFlag("169.254.169.254")
+		Expect(err).To(MatchError("invalid CIDR: '169.254.169.254'"))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"net/url"
@@ -22,6 +23,7 @@ import (
 	"github.com/concourse/atc/api"
 	"github.com/concourse/atc/api/buildserver"
 	"github.com/concourse/atc/auth"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/builds"
 	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/db/lock"
@@ -33,15 +35,19 @@ import (
 	"github.com/concourse/atc/gcng"
 	"github.com/concourse/atc/lockrunner"
 	"github.com/concourse/atc/metric"
+	"github.com/concourse/atc/notifications"
 	"github.com/concourse/atc/pipelines"
 	"github.com/concourse/atc/radar"
 	"github.com/concourse/atc/resource"
 	"github.com/concourse/atc/scheduler"
+	"github.com/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/atc/web"
 	"github.com/concourse/atc/web/publichandler"
 	"github.com/concourse/atc/web/robotstxt"
 	"github.com/concourse/atc/worker"
 	"github.com/concourse/atc/worker/image"
+	"github.com/concourse/atc/workerdrain"
+	"github.com/concourse/atc/workerhealth"
 	"github.com/concourse/atc/wrappa"
 	"github.com/concourse/retryhttp"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -94,6 +100,21 @@ type ATCCommand struct {
 
 	CLIArtifactsDir DirFlag `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
 
+	BuildArtifactsDir DirFlag `long:"build-artifacts-dir" description:"Directory in which to retain the artifacts declared by task steps. If not specified, artifacts are not retained."`
+
+	ResourceCacheDir DirFlag `long:"resource-cache-dir" description:"Directory, shared between ATCs, in which to keep the contents of fetched resources so that a version fetched on one worker is imported rather than fetched again on another. If not specified, each worker fetches versions itself."`
+
+	Notifications struct {
+		SMTPAddress   string        `long:"notifications-smtp-address"   default:"127.0.0.1:25"        description:"Address of the SMTP relay used to deliver email notifications."`
+		EmailFrom     string        `long:"notifications-email-from"     default:"concourse@localhost" description:"Sender address for email notifications which do not specify one."`
+		Attempts      int           `long:"notifications-attempts"       default:"3"                   description:"Number of times to attempt delivering each notification."`
+		RetryInterval time.Duration `long:"notifications-retry-interval" default:"5s"                  description:"Interval to wait before retrying a failed notification. Doubles with each attempt."`
+		Timeout       time.Duration `long:"notifications-timeout"        default:"1m"                  description:"Length of time to wait for a single webhook or email delivery before considering it failed."`
+		Workers       int           `long:"notifications-workers"        default:"4"                   description:"Number of finished builds whose notifications are delivered concurrently."`
+
+		DeniedWebhookNetworks []CIDRFlag `long:"notifications-deny-webhook-network" description:"Network in CIDR notation that webhooks may not be delivered to, e.g. 169.254.0.0/16. Can be specified multiple times."`
+	} `group:"Notifications"`
+
 	Developer struct {
 		Noop bool `short:"n" long:"noop"              description:"Don't actually do any automatic scheduling or checking."`
 	} `group:"Developer Options"`
@@ -127,7 +148,30 @@ type ATCCommand struct {
 
 	GCInterval time.Duration `long:"gc-interval" default:"30s" description:"Interval on which to perform garbage collection."`
 
+	TeamEventRetention time.Duration `long:"team-event-retention" default:"24h" description:"Length of time to keep team events for subscribers resuming their stream. Subscribers resuming from an older event are told to reset."`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
+
+	BuildDrainTimeout time.Duration `long:"build-drain-timeout" default:"0" description:"When shutting down, how long to wait for running builds to finish before handing them off to another ATC. By default they are handed off immediately."`
+
+	WorkerProbeInterval  time.Duration `long:"worker-probe-interval" default:"0" description:"Interval on which to probe each worker by creating and destroying a container and volume. Disabled by default."`
+	WorkerProbeTimeout   time.Duration `long:"worker-probe-timeout" default:"1m" description:"Length of time after which a probe that has not completed counts as failed."`
+	WorkerProbeWindow    int           `long:"worker-probe-window" default:"5" description:"Number of recent probes to consider when deciding whether to quarantine a worker."`
+	WorkerProbeThreshold float64       `long:"worker-probe-threshold" default:"0.6" description:"Proportion of failed probes in the window at which a worker is quarantined. A quarantined worker is released once every probe in the window succeeds."`
+
+	P2PStreamingKey    string        `long:"p2p-streaming-key"     description:"Secret shared with the workers' Baggageclaim servers, used to sign URLs from which they stream volumes directly from one another. If not specified, volumes are streamed between workers through the ATC."`
+	P2PStreamingURLTTL time.Duration `long:"p2p-streaming-url-ttl" default:"5m" description:"Length of time for which a signed volume streaming URL is valid."`
+
+	VolumeStreamingEncodings []string `long:"volume-streaming-encoding" description:"Compression encoding to use when relaying volumes between workers, if both workers support it. Can be specified multiple times, in order of preference. Defaults to zstd, falling back to gzip." value-name:"ENCODING"`
+
+	ImagePrewarmingImages          int           `long:"image-prewarming-images"            default:"0"   description:"Number of the most used images to fetch onto each worker that has recently registered or is idle. Disabled by default."`
+	ImagePrewarmingDiskMB          int64         `long:"image-prewarming-disk-mb"           default:"0"   description:"Disk space, in megabytes, that pre-warmed images may take up on each worker. Unbounded by default."`
+	ImagePrewarmingInterval        time.Duration `long:"image-prewarming-interval"          default:"1m"  description:"Interval on which to pre-warm images on workers."`
+	ImagePrewarmingNewWorkerWindow time.Duration `long:"image-prewarming-new-worker-window" default:"10m" description:"Length of time after registering for which a worker is pre-warmed regardless of how busy it is."`
+	ImagePrewarmingIdleContainers  int           `long:"image-prewarming-idle-containers"   default:"0"   description:"Number of containers at or below which a worker is considered idle, and pre-warmed."`
+
+	MaxActiveBuilds    int           `long:"max-active-builds"    default:"0"  description:"Maximum number of builds running across the cluster. Pending builds of jobs beyond it are queued by priority and shared fairly between teams by weight. One-off builds are never queued, but count towards it. Unbounded by default."`
+	BuildQueueInterval time.Duration `long:"build-queue-interval" default:"5s" description:"Interval on which to admit queued builds."`
 }
 
 func (cmd *ATCCommand) Execute(args []string) error {
@@ -172,7 +216,8 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 	bus := db.NewNotificationsBus(listener, dbConn)
 
 	sqlDB := db.NewSQL(dbConn, bus, lockFactory)
-	resourceFetcherFactory := resource.NewFetcherFactory(sqlDB, clock.NewClock())
+	cacheStore := cmd.constructCacheStore()
+	resourceFetcherFactory := resource.NewFetcherFactory(sqlDB, clock.NewClock(), cacheStore)
 	resourceFactoryFactory := resource.NewResourceFactoryFactory()
 	pipelineDBFactory := db.NewPipelineDBFactory(dbConn, bus, lockFactory)
 	dbBuildFactory := dbng.NewBuildFactory(dbngConn, lockFactory)
@@ -185,6 +230,8 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 	dbResourceCacheFactory := dbng.NewResourceCacheFactory(dbngConn, lockFactory)
 	dbResourceConfigFactory := dbng.NewResourceConfigFactory(dbngConn, lockFactory)
 	dbWorkerBaseResourceTypeFactory := dbng.NewWorkerBaseResourceTypeFactory(dbngConn)
+	dbImageResourceFetchFactory := dbng.NewImageResourceFetchFactory(dbngConn)
+	dbBuildQueue := dbng.NewBuildQueue(dbngConn)
 	workerClient := cmd.constructWorkerPool(
 		logger,
 		sqlDB,
@@ -196,17 +243,22 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 		dbVolumeFactory,
 		dbWorkerFactory,
 		dbTeamFactory,
+		dbImageResourceFetchFactory,
 	)
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resourceFactoryFactory.FactoryFor(workerClient)
 	teamDBFactory := db.NewTeamDBFactory(dbConn, bus, lockFactory)
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, teamDBFactory)
+	artifactStore := cmd.constructArtifactStore()
+	notifier := cmd.constructNotifier(logger, dbTeamFactory, dbng.NewNotificationQueue(dbngConn))
+
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, teamDBFactory, dbTeamFactory, artifactStore, notifier)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
 		resourceFactory,
 		cmd.ResourceCheckingInterval,
 		engine,
+		buildqueue.NewQueue(dbBuildQueue, cmd.MaxActiveBuilds),
 	)
 
 	radarScannerFactory := radar.NewScannerFactory(
@@ -258,6 +310,7 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 		pipelineDBFactory,
 		engine,
 		workerClient,
+		artifactStore,
 		drain,
 		radarSchedulerFactory,
 		radarScannerFactory,
@@ -377,6 +430,8 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 			Logger:    logger.Session("tracker-runner"),
 		}},
 
+		{"notifier", notifier},
+
 		{"collector", lockrunner.NewRunner(
 			logger.Session("collector-runner"),
 			gcng.NewCollector(
@@ -416,6 +471,16 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 					dbWorkerFactory,
 					gcng.NewGardenClientFactory(),
 				),
+				gcng.NewCacheStoreCollector(
+					logger.Session("cache-store-collector"),
+					dbResourceCacheFactory,
+					cacheStore,
+				),
+				gcng.NewTeamEventCollector(
+					logger.Session("team-event-collector"),
+					dbTeamFactory,
+					cmd.TeamEventRetention,
+				),
 			),
 			"collector",
 			sqlDB,
@@ -429,6 +494,7 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 				logger.Session("build-reaper"),
 				sqlDB,
 				pipelineDBFactory,
+				artifactStore,
 				500,
 			),
 			"build-reaper",
@@ -436,6 +502,81 @@ func (cmd *ATCCommand) Runner(args []string) (ifrit.Runner, error) {
 			clock.NewClock(),
 			30*time.Second,
 		)},
+
+		{"worker-drainer", lockrunner.NewRunner(
+			logger.Session("worker-drainer-runner"),
+			workerdrain.NewDrainer(
+				logger.Session("worker-drainer"),
+				dbWorkerFactory,
+				dbBuildFactory,
+				engine,
+				gcng.NewGardenClientFactory(),
+				clock.NewClock(),
+			),
+			"worker-drainer",
+			sqlDB,
+			clock.NewClock(),
+			10*time.Second,
+		)},
+	}
+
+	if cmd.WorkerProbeInterval != 0 {
+		members = append(members, grouper.Member{"worker-prober", lockrunner.NewRunner(
+			logger.Session("worker-prober-runner"),
+			workerhealth.NewProber(
+				logger.Session("worker-prober"),
+				dbWorkerFactory,
+				gcng.NewGardenClientFactory(),
+				gcng.NewBaggageclaimClientFactory(dbWorkerFactory),
+				clock.NewClock(),
+				cmd.WorkerProbeTimeout,
+				cmd.WorkerProbeWindow,
+				cmd.WorkerProbeThreshold,
+			),
+			"worker-prober",
+			sqlDB,
+			clock.NewClock(),
+			cmd.WorkerProbeInterval,
+		)})
+	}
+
+	if cmd.ImagePrewarmingImages > 0 {
+		members = append(members, grouper.Member{"image-prewarmer", lockrunner.NewRunner(
+			logger.Session("image-prewarmer-runner"),
+			image.NewPrewarmer(
+				logger.Session("image-prewarmer"),
+				workerClient,
+				resourceFetcherFactory,
+				dbResourceCacheFactory,
+				dbImageResourceFetchFactory,
+				clock.NewClock(),
+				image.PrewarmBudget{
+					Images: cmd.ImagePrewarmingImages,
+					Disk:   cmd.ImagePrewarmingDiskMB * 1024 * 1024,
+				},
+				cmd.ImagePrewarmingNewWorkerWindow,
+				cmd.ImagePrewarmingIdleContainers,
+			),
+			"image-prewarmer",
+			sqlDB,
+			clock.NewClock(),
+			cmd.ImagePrewarmingInterval,
+		)})
+	}
+
+	if cmd.MaxActiveBuilds > 0 {
+		members = append(members, grouper.Member{"build-queue-planner", lockrunner.NewRunner(
+			logger.Session("build-queue-planner-runner"),
+			buildqueue.NewPlanner(
+				logger.Session("build-queue-planner"),
+				dbBuildQueue,
+				cmd.MaxActiveBuilds,
+			),
+			"build-queue-planner",
+			sqlDB,
+			clock.NewClock(),
+			cmd.BuildQueueInterval,
+		)})
 	}
 
 	if cmd.Worker.GardenURL.URL() != nil {
@@ -564,6 +705,20 @@ func (cmd *ATCCommand) validate() error {
 		)
 	}
 
+	if cmd.WorkerProbeWindow < 1 {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify a --worker-probe-window of at least 1"),
+		)
+	}
+
+	if cmd.WorkerProbeThreshold <= 0 || cmd.WorkerProbeThreshold > 1 {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify a --worker-probe-threshold greater than 0 and at most 1"),
+		)
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -662,30 +817,61 @@ func (cmd *ATCCommand) constructWorkerPool(
 	dbVolumeFactory dbng.VolumeFactory,
 	dbWorkerFactory dbng.WorkerFactory,
 	dbTeamFactory dbng.TeamFactory,
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory,
 ) worker.Client {
 	imageResourceFetcherFactory := image.NewImageResourceFetcherFactory(
 		resourceFetcherFactory,
 		resourceFactoryFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		dbImageResourceFetchFactory,
 		clock.NewClock(),
 	)
+	volumeStreamer := cmd.constructVolumeStreamer(dbWorkerFactory)
+
 	return worker.NewPool(
 		worker.NewDBWorkerProvider(
 			logger,
 			sqlDB,
 			retryhttp.NewExponentialBackOffFactory(5*time.Minute),
-			image.NewImageFactory(imageResourceFetcherFactory),
+			image.NewImageFactory(imageResourceFetcherFactory, volumeStreamer),
 			dbResourceCacheFactory,
 			dbResourceConfigFactory,
 			dbWorkerBaseResourceTypeFactory,
 			dbVolumeFactory,
 			dbTeamFactory,
 			dbWorkerFactory,
+			cmd.constructP2PStreamer(dbWorkerFactory),
+			volumeStreamer,
 		),
 	)
 }
 
+func (cmd *ATCCommand) constructVolumeStreamer(dbWorkerFactory dbng.WorkerFactory) worker.VolumeStreamer {
+	encodings := worker.DefaultStreamEncodings
+	if len(cmd.VolumeStreamingEncodings) > 0 {
+		encodings = []worker.StreamEncoding{}
+		for _, encoding := range cmd.VolumeStreamingEncodings {
+			encodings = append(encodings, worker.StreamEncoding(encoding))
+		}
+	}
+
+	return worker.NewVolumeStreamer(dbWorkerFactory, encodings)
+}
+
+func (cmd *ATCCommand) constructP2PStreamer(dbWorkerFactory dbng.WorkerFactory) worker.P2PStreamer {
+	if cmd.P2PStreamingKey == "" {
+		return nil
+	}
+
+	return worker.NewP2PStreamer(
+		dbWorkerFactory,
+		[]byte(cmd.P2PStreamingKey),
+		cmd.P2PStreamingURLTTL,
+		clock.NewClock(),
+	)
+}
+
 func (cmd *ATCCommand) loadOrGenerateSigningKey() (*rsa.PrivateKey, error) {
 	var signingKey *rsa.PrivateKey
 
@@ -749,12 +935,56 @@ func (cmd *ATCCommand) configureAuthForDefaultTeam(teamFactory dbng.TeamFactory)
 	return nil
 }
 
+func (cmd *ATCCommand) constructArtifactStore() blobstore.Store {
+	if cmd.BuildArtifactsDir == "" {
+		return nil
+	}
+
+	return blobstore.NewFileStore(cmd.BuildArtifactsDir.Path())
+}
+
+func (cmd *ATCCommand) constructCacheStore() blobstore.CacheStore {
+	if cmd.ResourceCacheDir == "" {
+		return nil
+	}
+
+	return blobstore.NewFileCacheStore(cmd.ResourceCacheDir.Path())
+}
+
+func (cmd *ATCCommand) constructNotifier(logger lager.Logger, dbTeamFactory dbng.TeamFactory, dbNotificationQueue dbng.NotificationQueue) notifications.Notifier {
+	deniedNetworks := []*net.IPNet{}
+	for _, network := range cmd.Notifications.DeniedWebhookNetworks {
+		deniedNetworks = append(deniedNetworks, network.IPNet())
+	}
+
+	sender := notifications.NewSender(
+		notifications.NewWebhookSender(cmd.Notifications.Timeout, deniedNetworks),
+		notifications.NewEmailSender(cmd.Notifications.SMTPAddress, cmd.Notifications.EmailFrom, cmd.Notifications.Timeout),
+	)
+
+	return notifications.NewNotifier(
+		logger.Session("notifier"),
+		dbTeamFactory,
+		dbNotificationQueue,
+		sender,
+		clock.NewClock(),
+		cmd.ExternalURL.String(),
+		cmd.Notifications.Attempts,
+		cmd.Notifications.RetryInterval,
+		cmd.Notifications.Workers,
+		2*cmd.Notifications.Timeout,
+	)
+}
+
 func (cmd *ATCCommand) constructEngine(
 	workerClient worker.Client,
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	dbResourceCacheFactory dbng.ResourceCacheFactory,
 	teamDBFactory db.TeamDBFactory,
+	dbTeamFactory dbng.TeamFactory,
+	artifactStore blobstore.Store,
+	notifier notifications.Notifier,
 ) engine.Engine {
 	gardenFactory := exec.NewGardenFactory(
 		workerClient,
@@ -765,14 +995,15 @@ func (cmd *ATCCommand) constructEngine(
 
 	execV2Engine := engine.NewExecEngine(
 		gardenFactory,
-		engine.NewBuildDelegateFactory(),
+		engine.NewBuildDelegateFactory(notifier, dbTeamFactory),
 		teamDBFactory,
 		cmd.ExternalURL.String(),
+		artifactStore,
 	)
 
 	execV1Engine := engine.NewExecV1DummyEngine()
 
-	return engine.NewDBEngine(engine.Engines{execV2Engine, execV1Engine})
+	return engine.NewDBEngine(engine.Engines{execV2Engine, execV1Engine}, cmd.BuildDrainTimeout)
 }
 
 func (cmd *ATCCommand) constructHTTPHandler(
@@ -822,6 +1053,7 @@ func (cmd *ATCCommand) constructAPIHandler(
 	pipelineDBFactory db.PipelineDBFactory,
 	engine engine.Engine,
 	workerClient worker.Client,
+	artifactStore blobstore.Store,
 	drain <-chan struct{},
 	radarSchedulerFactory pipelines.RadarSchedulerFactory,
 	radarScannerFactory radar.ScannerFactory,
@@ -883,6 +1115,7 @@ func (cmd *ATCCommand) constructAPIHandler(
 
 		engine,
 		workerClient,
+		artifactStore,
 		radarSchedulerFactory,
 		radarScannerFactory,
 
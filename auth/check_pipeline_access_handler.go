@@ -9,6 +9,7 @@ import (
 
 type CheckPipelineAccessHandlerFactory interface {
 	HandlerFor(pipelineScopedHandler http.Handler, rejector Rejector) http.Handler
+	CheckIfPrivateJobHandler(jobScopedHandler http.Handler, rejector Rejector) http.Handler
 }
 
 type checkPipelineAccessHandlerFactory struct {
@@ -34,10 +35,23 @@ func (f *checkPipelineAccessHandlerFactory) HandlerFor(
 	}
 }
 
+func (f *checkPipelineAccessHandlerFactory) CheckIfPrivateJobHandler(
+	delegateHandler http.Handler,
+	rejector Rejector,
+) http.Handler {
+	return checkPipelineAccessHandler{
+		rejector:        rejector,
+		teamFactory:     f.teamFactory,
+		delegateHandler: delegateHandler,
+		checkPrivateJob: true,
+	}
+}
+
 type checkPipelineAccessHandler struct {
 	rejector        Rejector
 	teamFactory     dbng.TeamFactory
 	delegateHandler http.Handler
+	checkPrivateJob bool
 }
 
 func (h checkPipelineAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -65,7 +79,16 @@ func (h checkPipelineAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if IsAuthorized(r) || pipeline.Public() {
+	isPublic := pipeline.Public()
+	if isPublic && h.checkPrivateJob {
+		isPublic, err = pipeline.Config().JobIsPublic(r.FormValue(":job_name"))
+		if err != nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+	}
+
+	if IsAuthorized(r) || isPublic {
 		ctx := context.WithValue(r.Context(), PipelineContextKey, pipeline)
 		h.delegateHandler.ServeHTTP(w, r.WithContext(ctx))
 		return
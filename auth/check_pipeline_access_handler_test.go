@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/concourse/atc"
 	"github.com/concourse/atc/auth"
 	"github.com/concourse/atc/auth/authfakes"
 	"github.com/concourse/atc/dbng"
@@ -154,6 +155,137 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 	})
 })
 
+var _ = Describe("CheckPipelineAccessHandler for a private job", func() {
+	var (
+		response    *http.Response
+		server      *httptest.Server
+		delegate    *pipelineDelegateHandler
+		teamFactory *dbngfakes.FakeTeamFactory
+		team        *dbngfakes.FakeTeam
+		pipeline    *dbngfakes.FakePipeline
+		handler     http.Handler
+
+		authValidator     *authfakes.FakeValidator
+		userContextReader *authfakes.FakeUserContextReader
+	)
+
+	BeforeEach(func() {
+		teamFactory = new(dbngfakes.FakeTeamFactory)
+		team = new(dbngfakes.FakeTeam)
+		teamFactory.FindTeamReturns(team, true, nil)
+
+		pipeline = new(dbngfakes.FakePipeline)
+		pipeline.NameReturns("some-pipeline")
+		pipeline.ConfigReturns(atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "public-job", Public: true},
+				{Name: "private-job", Public: false},
+			},
+		})
+		team.PipelineReturns(pipeline, true, nil)
+
+		handlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
+
+		authValidator = new(authfakes.FakeValidator)
+		userContextReader = new(authfakes.FakeUserContextReader)
+
+		delegate = &pipelineDelegateHandler{}
+		checkPipelineAccessHandler := handlerFactory.CheckIfPrivateJobHandler(delegate, auth.UnauthorizedRejector{})
+		handler = auth.WrapHandler(checkPipelineAccessHandler, authValidator, userContextReader)
+	})
+
+	requestJob := func(jobName string) {
+		server = httptest.NewServer(handler)
+
+		request, err := http.NewRequest("GET", server.URL+"?:team_name=some-team&:pipeline_name=some-pipeline&:job_name="+jobName, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err = new(http.Client).Do(request)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("when the pipeline is public", func() {
+		BeforeEach(func() {
+			pipeline.PublicReturns(true)
+		})
+
+		Context("and the job is public", func() {
+			JustBeforeEach(func() {
+				requestJob("public-job")
+			})
+
+			It("calls the scoped handler with the pipeline in context", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(delegate.IsCalled).To(BeTrue())
+				Expect(delegate.ContextPipelineDB).To(BeIdenticalTo(pipeline))
+			})
+		})
+
+		Context("and the job is private", func() {
+			JustBeforeEach(func() {
+				requestJob("private-job")
+			})
+
+			Context("and authorized", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(true)
+					userContextReader.GetTeamReturns("some-team", true, true)
+				})
+
+				It("calls the scoped handler", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(delegate.IsCalled).To(BeTrue())
+				})
+			})
+
+			Context("and authenticated as another team", func() {
+				BeforeEach(func() {
+					authValidator.IsAuthenticatedReturns(true)
+					userContextReader.GetTeamReturns("some-other-team", true, true)
+				})
+
+				It("returns 403 forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(delegate.IsCalled).To(BeFalse())
+				})
+			})
+
+			Context("and not authenticated", func() {
+				It("returns 401 unauthorized", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(delegate.IsCalled).To(BeFalse())
+				})
+			})
+		})
+
+		Context("and the job does not exist", func() {
+			JustBeforeEach(func() {
+				requestJob("bogus-job")
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(delegate.IsCalled).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the pipeline is private and the job is public", func() {
+		JustBeforeEach(func() {
+			requestJob("public-job")
+		})
+
+		It("returns 401 unauthorized", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(delegate.IsCalled).To(BeFalse())
+		})
+	})
+})
+
 type pipelineDelegateHandler struct {
 	IsCalled          bool
 	ContextPipelineDB dbng.Pipeline
@@ -0,0 +1,290 @@
+// This file was generated by counterfeiter
+package blobstorefakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/concourse/atc/blobstore"
+)
+
+type FakeCacheStore struct {
+	SaveStub        func(resourceCacheID int, tarStream io.Reader) error
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		resourceCacheID int
+		tarStream       io.Reader
+	}
+	saveReturns struct {
+		result1 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	OpenStub        func(resourceCacheID int) (io.ReadCloser, bool, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		resourceCacheID int
+	}
+	openReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	ListStub        func() ([]int, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct{}
+	listReturns     struct {
+		result1 []int
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
+	DeleteStub        func(resourceCacheIDs []int) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		resourceCacheIDs []int
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCacheStore) Save(resourceCacheID int, tarStream io.Reader) error {
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		resourceCacheID int
+		tarStream       io.Reader
+	}{resourceCacheID, tarStream})
+	fake.recordInvocation("Save", []interface{}{resourceCacheID, tarStream})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(resourceCacheID, tarStream)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveReturns.result1
+}
+
+func (fake *FakeCacheStore) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeCacheStore) SaveArgsForCall(i int) (int, io.Reader) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return fake.saveArgsForCall[i].resourceCacheID, fake.saveArgsForCall[i].tarStream
+}
+
+func (fake *FakeCacheStore) SaveReturns(result1 error) {
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheStore) SaveReturnsOnCall(i int, result1 error) {
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheStore) Open(resourceCacheID int) (io.ReadCloser, bool, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		resourceCacheID int
+	}{resourceCacheID})
+	fake.recordInvocation("Open", []interface{}{resourceCacheID})
+	fake.openMutex.Unlock()
+	if fake.OpenStub != nil {
+		return fake.OpenStub(resourceCacheID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.openReturns.result1, fake.openReturns.result2, fake.openReturns.result3
+}
+
+func (fake *FakeCacheStore) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeCacheStore) OpenArgsForCall(i int) int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return fake.openArgsForCall[i].resourceCacheID
+}
+
+func (fake *FakeCacheStore) OpenReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCacheStore) OpenReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCacheStore) List() ([]int, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct{}{})
+	fake.recordInvocation("List", []interface{}{})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeCacheStore) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeCacheStore) ListReturns(result1 []int, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCacheStore) ListReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCacheStore) Delete(resourceCacheIDs []int) error {
+	var resourceCacheIDsCopy []int
+	if resourceCacheIDs != nil {
+		resourceCacheIDsCopy = make([]int, len(resourceCacheIDs))
+		copy(resourceCacheIDsCopy, resourceCacheIDs)
+	}
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		resourceCacheIDs []int
+	}{resourceCacheIDsCopy})
+	fake.recordInvocation("Delete", []interface{}{resourceCacheIDsCopy})
+	fake.deleteMutex.Unlock()
+	if fake.DeleteStub != nil {
+		return fake.DeleteStub(resourceCacheIDs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteReturns.result1
+}
+
+func (fake *FakeCacheStore) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeCacheStore) DeleteArgsForCall(i int) []int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.deleteArgsForCall[i].resourceCacheIDs
+}
+
+func (fake *FakeCacheStore) DeleteReturns(result1 error) {
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheStore) DeleteReturnsOnCall(i int, result1 error) {
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCacheStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeCacheStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ blobstore.CacheStore = new(FakeCacheStore)
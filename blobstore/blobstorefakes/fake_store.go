@@ -0,0 +1,304 @@
+// This file was generated by counterfeiter
+package blobstorefakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/concourse/atc/blobstore"
+)
+
+type FakeStore struct {
+	SaveStub        func(buildID int, name string, tarStream io.Reader) error
+	saveMutex       sync.RWMutex
+	saveArgsForCall []struct {
+		buildID   int
+		name      string
+		tarStream io.Reader
+	}
+	saveReturns struct {
+		result1 error
+	}
+	saveReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListStub        func(buildID int) ([]blobstore.Artifact, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		buildID int
+	}
+	listReturns struct {
+		result1 []blobstore.Artifact
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 []blobstore.Artifact
+		result2 error
+	}
+	OpenStub        func(buildID int, name string) (io.ReadCloser, bool, error)
+	openMutex       sync.RWMutex
+	openArgsForCall []struct {
+		buildID int
+		name    string
+	}
+	openReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	openReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	DeleteBuildsStub        func(buildIDs []int) error
+	deleteBuildsMutex       sync.RWMutex
+	deleteBuildsArgsForCall []struct {
+		buildIDs []int
+	}
+	deleteBuildsReturns struct {
+		result1 error
+	}
+	deleteBuildsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStore) Save(buildID int, name string, tarStream io.Reader) error {
+	fake.saveMutex.Lock()
+	ret, specificReturn := fake.saveReturnsOnCall[len(fake.saveArgsForCall)]
+	fake.saveArgsForCall = append(fake.saveArgsForCall, struct {
+		buildID   int
+		name      string
+		tarStream io.Reader
+	}{buildID, name, tarStream})
+	fake.recordInvocation("Save", []interface{}{buildID, name, tarStream})
+	fake.saveMutex.Unlock()
+	if fake.SaveStub != nil {
+		return fake.SaveStub(buildID, name, tarStream)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveReturns.result1
+}
+
+func (fake *FakeStore) SaveCallCount() int {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return len(fake.saveArgsForCall)
+}
+
+func (fake *FakeStore) SaveArgsForCall(i int) (int, string, io.Reader) {
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	return fake.saveArgsForCall[i].buildID, fake.saveArgsForCall[i].name, fake.saveArgsForCall[i].tarStream
+}
+
+func (fake *FakeStore) SaveReturns(result1 error) {
+	fake.SaveStub = nil
+	fake.saveReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) SaveReturnsOnCall(i int, result1 error) {
+	fake.SaveStub = nil
+	if fake.saveReturnsOnCall == nil {
+		fake.saveReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) List(buildID int) ([]blobstore.Artifact, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		buildID int
+	}{buildID})
+	fake.recordInvocation("List", []interface{}{buildID})
+	fake.listMutex.Unlock()
+	if fake.ListStub != nil {
+		return fake.ListStub(buildID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listReturns.result1, fake.listReturns.result2
+}
+
+func (fake *FakeStore) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeStore) ListArgsForCall(i int) int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return fake.listArgsForCall[i].buildID
+}
+
+func (fake *FakeStore) ListReturns(result1 []blobstore.Artifact, result2 error) {
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []blobstore.Artifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) ListReturnsOnCall(i int, result1 []blobstore.Artifact, result2 error) {
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []blobstore.Artifact
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []blobstore.Artifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStore) Open(buildID int, name string) (io.ReadCloser, bool, error) {
+	fake.openMutex.Lock()
+	ret, specificReturn := fake.openReturnsOnCall[len(fake.openArgsForCall)]
+	fake.openArgsForCall = append(fake.openArgsForCall, struct {
+		buildID int
+		name    string
+	}{buildID, name})
+	fake.recordInvocation("Open", []interface{}{buildID, name})
+	fake.openMutex.Unlock()
+	if fake.OpenStub != nil {
+		return fake.OpenStub(buildID, name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.openReturns.result1, fake.openReturns.result2, fake.openReturns.result3
+}
+
+func (fake *FakeStore) OpenCallCount() int {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return len(fake.openArgsForCall)
+}
+
+func (fake *FakeStore) OpenArgsForCall(i int) (int, string) {
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	return fake.openArgsForCall[i].buildID, fake.openArgsForCall[i].name
+}
+
+func (fake *FakeStore) OpenReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.OpenStub = nil
+	fake.openReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) OpenReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.OpenStub = nil
+	if fake.openReturnsOnCall == nil {
+		fake.openReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.openReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStore) DeleteBuilds(buildIDs []int) error {
+	var buildIDsCopy []int
+	if buildIDs != nil {
+		buildIDsCopy = make([]int, len(buildIDs))
+		copy(buildIDsCopy, buildIDs)
+	}
+	fake.deleteBuildsMutex.Lock()
+	ret, specificReturn := fake.deleteBuildsReturnsOnCall[len(fake.deleteBuildsArgsForCall)]
+	fake.deleteBuildsArgsForCall = append(fake.deleteBuildsArgsForCall, struct {
+		buildIDs []int
+	}{buildIDsCopy})
+	fake.recordInvocation("DeleteBuilds", []interface{}{buildIDsCopy})
+	fake.deleteBuildsMutex.Unlock()
+	if fake.DeleteBuildsStub != nil {
+		return fake.DeleteBuildsStub(buildIDs)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.deleteBuildsReturns.result1
+}
+
+func (fake *FakeStore) DeleteBuildsCallCount() int {
+	fake.deleteBuildsMutex.RLock()
+	defer fake.deleteBuildsMutex.RUnlock()
+	return len(fake.deleteBuildsArgsForCall)
+}
+
+func (fake *FakeStore) DeleteBuildsArgsForCall(i int) []int {
+	fake.deleteBuildsMutex.RLock()
+	defer fake.deleteBuildsMutex.RUnlock()
+	return fake.deleteBuildsArgsForCall[i].buildIDs
+}
+
+func (fake *FakeStore) DeleteBuildsReturns(result1 error) {
+	fake.DeleteBuildsStub = nil
+	fake.deleteBuildsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) DeleteBuildsReturnsOnCall(i int, result1 error) {
+	fake.DeleteBuildsStub = nil
+	if fake.deleteBuildsReturnsOnCall == nil {
+		fake.deleteBuildsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteBuildsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStore) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.saveMutex.RLock()
+	defer fake.saveMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.openMutex.RLock()
+	defer fake.openMutex.RUnlock()
+	fake.deleteBuildsMutex.RLock()
+	defer fake.deleteBuildsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeStore) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ blobstore.Store = new(FakeStore)
@@ -0,0 +1,23 @@
+package blobstore
+
+import "io"
+
+//go:generate counterfeiter . CacheStore
+
+// CacheStore retains the contents of resource caches independently of any
+// worker, so that a version fetched on one worker can be imported on another
+// rather than fetched again.
+type CacheStore interface {
+	// Save persists the given tar stream as the contents of the resource
+	// cache, replacing any contents previously saved for it.
+	Save(resourceCacheID int, tarStream io.Reader) error
+
+	// Open returns the tar stream of the contents of the resource cache.
+	Open(resourceCacheID int) (io.ReadCloser, bool, error)
+
+	// List returns the IDs of the resource caches whose contents are saved.
+	List() ([]int, error)
+
+	// Delete removes the contents saved for the given resource caches.
+	Delete(resourceCacheIDs []int) error
+}
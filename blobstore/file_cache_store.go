@@ -0,0 +1,133 @@
+package blobstore
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type fileCacheStore struct {
+	dir string
+}
+
+// NewFileCacheStore returns a CacheStore which keeps the contents of each
+// resource cache as a gzipped tarball within dir. The directory may be shared
+// between ATCs.
+func NewFileCacheStore(dir string) CacheStore {
+	return &fileCacheStore{dir: dir}
+}
+
+func (store *fileCacheStore) Save(resourceCacheID int, tarStream io.Reader) error {
+	err := os.MkdirAll(store.dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(store.dir, "."+strconv.Itoa(resourceCacheID))
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	gz := gzip.NewWriter(tmp)
+
+	_, err = io.Copy(gz, tarStream)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = gz.Close()
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), store.cachePath(resourceCacheID))
+}
+
+func (store *fileCacheStore) Open(resourceCacheID int) (io.ReadCloser, bool, error) {
+	file, err := os.Open(store.cachePath(resourceCacheID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, false, err
+	}
+
+	return gzipFile{Reader: gz, file: file}, true, nil
+}
+
+func (store *fileCacheStore) List() ([]int, error) {
+	infos, err := ioutil.ReadDir(store.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []int{}, nil
+		}
+
+		return nil, err
+	}
+
+	ids := []int{}
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, artifactExtension) {
+			continue
+		}
+
+		id, err := strconv.Atoi(strings.TrimSuffix(name, artifactExtension))
+		if err != nil {
+			continue
+		}
+
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	return ids, nil
+}
+
+func (store *fileCacheStore) Delete(resourceCacheIDs []int) error {
+	for _, id := range resourceCacheIDs {
+		err := os.Remove(store.cachePath(id))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *fileCacheStore) cachePath(resourceCacheID int) string {
+	return filepath.Join(store.dir, strconv.Itoa(resourceCacheID)+artifactExtension)
+}
+
+type gzipFile struct {
+	*gzip.Reader
+
+	file *os.File
+}
+
+func (f gzipFile) Close() error {
+	f.Reader.Close()
+	return f.file.Close()
+}
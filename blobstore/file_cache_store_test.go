@@ -0,0 +1,103 @@
+package blobstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/atc/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileCacheStore", func() {
+	var (
+		dir   string
+		store blobstore.CacheStore
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cachestore")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = blobstore.NewFileCacheStore(filepath.Join(dir, "caches"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readCache := func(resourceCacheID int) string {
+		reader, found, err := store.Open(resourceCacheID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		defer reader.Close()
+
+		contents, err := ioutil.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(contents)
+	}
+
+	Describe("Save", func() {
+		It("stores the stream compressed, and opens it decompressed", func() {
+			err := store.Save(42, strings.NewReader("some-tar-stream"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filepath.Join(dir, "caches", "42.tgz")).To(BeAnExistingFile())
+			Expect(readCache(42)).To(Equal("some-tar-stream"))
+		})
+
+		It("replaces contents previously saved for the resource cache", func() {
+			Expect(store.Save(42, strings.NewReader("first"))).To(Succeed())
+			Expect(store.Save(42, strings.NewReader("second"))).To(Succeed())
+
+			Expect(readCache(42)).To(Equal("second"))
+		})
+	})
+
+	Describe("Open", func() {
+		It("returns false when nothing is saved for the resource cache", func() {
+			_, found, err := store.Open(42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("List", func() {
+		It("returns an empty list when nothing has been saved", func() {
+			ids, err := store.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(BeEmpty())
+		})
+
+		It("returns the saved resource caches in order", func() {
+			Expect(store.Save(43, strings.NewReader("b"))).To(Succeed())
+			Expect(store.Save(42, strings.NewReader("a"))).To(Succeed())
+
+			ids, err := store.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ids).To(Equal([]int{42, 43}))
+		})
+	})
+
+	Describe("Delete", func() {
+		It("removes the given resource caches only", func() {
+			Expect(store.Save(42, strings.NewReader("a"))).To(Succeed())
+			Expect(store.Save(43, strings.NewReader("b"))).To(Succeed())
+
+			err := store.Delete([]int{42, 44})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := store.Open(42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			Expect(readCache(43)).To(Equal("b"))
+		})
+	})
+})
@@ -0,0 +1,146 @@
+package blobstore
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const artifactExtension = ".tgz"
+
+var ErrInvalidArtifactName = errors.New("invalid artifact name")
+
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a Store which keeps each artifact as a gzipped tarball
+// under a per-build directory within dir.
+func NewFileStore(dir string) Store {
+	return &fileStore{dir: dir}
+}
+
+func (store *fileStore) Save(buildID int, name string, tarStream io.Reader) error {
+	if !validName(name) {
+		return ErrInvalidArtifactName
+	}
+
+	buildDir := store.buildDir(buildID)
+
+	err := os.MkdirAll(buildDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(buildDir, "."+name)
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	gz := gzip.NewWriter(tmp)
+
+	_, err = io.Copy(gz, tarStream)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = gz.Close()
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), store.artifactPath(buildID, name))
+}
+
+func (store *fileStore) List(buildID int) ([]Artifact, error) {
+	infos, err := ioutil.ReadDir(store.buildDir(buildID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Artifact{}, nil
+		}
+
+		return nil, err
+	}
+
+	artifacts := []Artifact{}
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, artifactExtension) {
+			continue
+		}
+
+		artifacts = append(artifacts, Artifact{
+			BuildID:   buildID,
+			Name:      strings.TrimSuffix(name, artifactExtension),
+			Size:      info.Size(),
+			CreatedAt: info.ModTime(),
+		})
+	}
+
+	sort.Sort(artifactsByName(artifacts))
+
+	return artifacts, nil
+}
+
+func (store *fileStore) Open(buildID int, name string) (io.ReadCloser, bool, error) {
+	if !validName(name) {
+		return nil, false, nil
+	}
+
+	file, err := os.Open(store.artifactPath(buildID, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return file, true, nil
+}
+
+func (store *fileStore) DeleteBuilds(buildIDs []int) error {
+	for _, buildID := range buildIDs {
+		err := os.RemoveAll(store.buildDir(buildID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *fileStore) buildDir(buildID int) string {
+	return filepath.Join(store.dir, strconv.Itoa(buildID))
+}
+
+func (store *fileStore) artifactPath(buildID int, name string) string {
+	return filepath.Join(store.buildDir(buildID), name+artifactExtension)
+}
+
+func validName(name string) bool {
+	return name != "" &&
+		!strings.HasPrefix(name, ".") &&
+		!strings.ContainsAny(name, `/\`)
+}
+
+type artifactsByName []Artifact
+
+func (as artifactsByName) Len() int           { return len(as) }
+func (as artifactsByName) Swap(i, j int)      { as[i], as[j] = as[j], as[i] }
+func (as artifactsByName) Less(i, j int) bool { return as[i].Name < as[j].Name }
@@ -0,0 +1,134 @@
+package blobstore_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/atc/blobstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FileStore", func() {
+	var (
+		dir   string
+		store blobstore.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "blobstore")
+		Expect(err).NotTo(HaveOccurred())
+
+		store = blobstore.NewFileStore(dir)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	readArtifact := func(buildID int, name string) string {
+		reader, found, err := store.Open(buildID, name)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		defer reader.Close()
+
+		gz, err := gzip.NewReader(reader)
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadAll(gz)
+		Expect(err).NotTo(HaveOccurred())
+
+		return string(contents)
+	}
+
+	Describe("Save", func() {
+		It("stores the stream compressed under the build", func() {
+			err := store.Save(42, "some-artifact", strings.NewReader("some-tar-stream"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filepath.Join(dir, "42", "some-artifact.tgz")).To(BeAnExistingFile())
+			Expect(readArtifact(42, "some-artifact")).To(Equal("some-tar-stream"))
+		})
+
+		It("replaces an artifact saved under the same name", func() {
+			err := store.Save(42, "some-artifact", strings.NewReader("first"))
+			Expect(err).NotTo(HaveOccurred())
+
+			err = store.Save(42, "some-artifact", strings.NewReader("second"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(readArtifact(42, "some-artifact")).To(Equal("second"))
+		})
+
+		It("rejects names that would escape the build directory", func() {
+			err := store.Save(42, "../some-artifact", strings.NewReader("some-tar-stream"))
+			Expect(err).To(Equal(blobstore.ErrInvalidArtifactName))
+		})
+	})
+
+	Describe("List", func() {
+		It("returns an empty list for a build with no artifacts", func() {
+			artifacts, err := store.List(42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(BeEmpty())
+		})
+
+		It("returns the artifacts of the build ordered by name", func() {
+			Expect(store.Save(42, "b-artifact", strings.NewReader("b"))).To(Succeed())
+			Expect(store.Save(42, "a-artifact", strings.NewReader("a"))).To(Succeed())
+			Expect(store.Save(43, "other-artifact", strings.NewReader("c"))).To(Succeed())
+
+			artifacts, err := store.List(42)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(artifacts).To(HaveLen(2))
+
+			Expect(artifacts[0].BuildID).To(Equal(42))
+			Expect(artifacts[0].Name).To(Equal("a-artifact"))
+			Expect(artifacts[0].Size).To(BeNumerically(">", 0))
+			Expect(artifacts[0].CreatedAt).NotTo(BeZero())
+
+			Expect(artifacts[1].Name).To(Equal("b-artifact"))
+		})
+	})
+
+	Describe("Open", func() {
+		It("returns false when the artifact does not exist", func() {
+			_, found, err := store.Open(42, "bogus")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false for names that would escape the build directory", func() {
+			_, found, err := store.Open(42, "../../etc/passwd")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("DeleteBuilds", func() {
+		It("removes the artifacts of the given builds only", func() {
+			Expect(store.Save(42, "some-artifact", strings.NewReader("a"))).To(Succeed())
+			Expect(store.Save(43, "some-artifact", strings.NewReader("b"))).To(Succeed())
+			Expect(store.Save(44, "some-artifact", strings.NewReader("c"))).To(Succeed())
+
+			err := store.DeleteBuilds([]int{42, 43})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := store.Open(42, "some-artifact")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = store.Open(43, "some-artifact")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			Expect(readArtifact(44, "some-artifact")).To(Equal("c"))
+		})
+	})
+})
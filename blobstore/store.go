@@ -0,0 +1,32 @@
+package blobstore
+
+import (
+	"io"
+	"time"
+)
+
+//go:generate counterfeiter . Store
+
+// Store retains the artifacts produced by builds beyond the lifetime of the
+// worker volumes they were produced on.
+type Store interface {
+	// Save persists the given tar stream as the named artifact of the build,
+	// replacing any artifact previously saved under the same name.
+	Save(buildID int, name string, tarStream io.Reader) error
+
+	// List returns the artifacts saved for the build, ordered by name.
+	List(buildID int) ([]Artifact, error)
+
+	// Open returns the gzipped tar stream of the named artifact of the build.
+	Open(buildID int, name string) (io.ReadCloser, bool, error)
+
+	// DeleteBuilds removes all artifacts saved for the given builds.
+	DeleteBuilds(buildIDs []int) error
+}
+
+type Artifact struct {
+	BuildID   int
+	Name      string
+	Size      int64
+	CreatedAt time.Time
+}
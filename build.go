@@ -23,6 +23,13 @@ type Build struct {
 	StartTime    int64  `json:"start_time,omitempty"`
 	EndTime      int64  `json:"end_time,omitempty"`
 	ReapTime     int64  `json:"reap_time,omitempty"`
+	AbortReason  string `json:"abort_reason,omitempty"`
+
+	// QueuePosition is set while a pending build waits for capacity, starting
+	// from 1 for the build that will start next.
+	QueuePosition int `json:"queue_position,omitempty"`
+
+	Annotations []BuildAnnotation `json:"annotations,omitempty"`
 }
 
 func (b Build) IsRunning() bool {
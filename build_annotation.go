@@ -0,0 +1,9 @@
+package atc
+
+type BuildAnnotation struct {
+	BuildID   int    `json:"build_id,omitempty"`
+	BuildName string `json:"build_name,omitempty"`
+	Step      string `json:"step"`
+	Name      string `json:"name"`
+	Value     string `json:"value"`
+}
@@ -0,0 +1,8 @@
+package atc
+
+type BuildArtifact struct {
+	Name        string `json:"name"`
+	SizeInBytes int64  `json:"size_in_bytes"`
+	CreatedAt   int64  `json:"created_at"`
+	URL         string `json:"url"`
+}
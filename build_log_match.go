@@ -0,0 +1,11 @@
+package atc
+
+type BuildLogMatch struct {
+	BuildID      int      `json:"build_id"`
+	BuildName    string   `json:"build_name"`
+	JobName      string   `json:"job_name,omitempty"`
+	PipelineName string   `json:"pipeline_name,omitempty"`
+	TeamName     string   `json:"team_name"`
+	Origin       string   `json:"origin"`
+	Snippets     []string `json:"snippets"`
+}
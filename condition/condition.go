@@ -0,0 +1,333 @@
+// Package condition parses and evaluates the expressions given to a step's
+// `if:` modifier.
+//
+// An expression compares variables and quoted strings with `==`, `!=`, `=~`
+// and `!~` (the latter two matching a regular expression), and combines
+// comparisons with `!`, `&&`, `||` and parentheses. A variable is a dotted
+// path into the variables the expression is evaluated against, e.g.
+// `inputs.repo.metadata.tag`. Variables which are not set evaluate to the
+// empty string.
+//
+// A value on its own is true unless it is `false` or the empty string.
+package condition
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Vars are the values an expression's variables refer to, nested by the
+// segments of their paths.
+type Vars map[string]interface{}
+
+// A Condition is a parsed expression.
+type Condition interface {
+	Eval(Vars) (bool, error)
+}
+
+// Parse parses the expression, checking that any regular expressions given
+// as literals compile.
+func Parse(expression string) (Condition, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if !p.done() {
+		return nil, fmt.Errorf("unexpected '%s'", p.peek().text)
+	}
+
+	return condition{node}, nil
+}
+
+type condition struct {
+	node node
+}
+
+func (c condition) Eval(vars Vars) (bool, error) {
+	val, err := c.node.eval(vars)
+	if err != nil {
+		return false, err
+	}
+
+	return truthy(val), nil
+}
+
+type node interface {
+	eval(Vars) (string, error)
+}
+
+type literal string
+
+func (n literal) eval(Vars) (string, error) {
+	return string(n), nil
+}
+
+type variable []string
+
+func (n variable) eval(vars Vars) (string, error) {
+	var val interface{} = map[string]interface{}(vars)
+
+	for _, segment := range n {
+		nested, ok := val.(map[string]interface{})
+		if !ok {
+			return "", nil
+		}
+
+		val, ok = nested[segment]
+		if !ok {
+			return "", nil
+		}
+	}
+
+	switch v := val.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	default:
+		return fmt.Sprintf("%v", v), nil
+	}
+}
+
+type not struct {
+	operand node
+}
+
+func (n not) eval(vars Vars) (string, error) {
+	val, err := n.operand.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	return boolean(!truthy(val)), nil
+}
+
+type logical struct {
+	operator    string
+	left, right node
+}
+
+func (n logical) eval(vars Vars) (string, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	if n.operator == "&&" && !truthy(left) {
+		return boolean(false), nil
+	}
+
+	if n.operator == "||" && truthy(left) {
+		return boolean(true), nil
+	}
+
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	return boolean(truthy(right)), nil
+}
+
+type comparison struct {
+	operator    string
+	left, right node
+}
+
+func (n comparison) eval(vars Vars) (string, error) {
+	left, err := n.left.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	right, err := n.right.eval(vars)
+	if err != nil {
+		return "", err
+	}
+
+	switch n.operator {
+	case "==":
+		return boolean(left == right), nil
+	case "!=":
+		return boolean(left != right), nil
+	}
+
+	re, err := regexp.Compile(right)
+	if err != nil {
+		return "", fmt.Errorf("invalid regular expression '%s': %s", right, err)
+	}
+
+	matched := re.MatchString(left)
+	if n.operator == "!~" {
+		matched = !matched
+	}
+
+	return boolean(matched), nil
+}
+
+func truthy(val string) bool {
+	return val != "" && val != "false"
+}
+
+func boolean(b bool) string {
+	if b {
+		return "true"
+	}
+
+	return "false"
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *parser) peek() token {
+	if p.done() {
+		return token{}
+	}
+
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOperator && p.peek().text == "||" {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logical{operator: "||", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOperator && p.peek().text == "&&" {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = logical{operator: "&&", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind == tokenOperator && p.peek().text == "!" {
+		p.next()
+
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return not{operand}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return left, nil
+	}
+
+	switch t.text {
+	case "==", "!=", "=~", "!~":
+	default:
+		return left, nil
+	}
+
+	p.next()
+
+	right, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if pattern, isLiteral := right.(literal); isLiteral && (t.text == "=~" || t.text == "!~") {
+		_, err := regexp.Compile(string(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression '%s': %s", pattern, err)
+		}
+	}
+
+	return comparison{operator: t.text, left: left, right: right}, nil
+}
+
+func (p *parser) parseOperand() (node, error) {
+	t := p.next()
+
+	switch t.kind {
+	case tokenString:
+		return literal(t.text), nil
+
+	case tokenIdent:
+		switch t.text {
+		case "true", "false":
+			return literal(t.text), nil
+		}
+
+		return variable(strings.Split(t.text, ".")), nil
+
+	case tokenOpen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.next().kind != tokenClose {
+			return nil, fmt.Errorf("missing ')'")
+		}
+
+		return inner, nil
+
+	case tokenEOF:
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+
+	return nil, fmt.Errorf("unexpected '%s'", t.text)
+}
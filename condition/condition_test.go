@@ -0,0 +1,69 @@
+package condition_test
+
+import (
+	"github.com/concourse/atc/condition"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Condition", func() {
+	vars := condition.Vars{
+		"build": map[string]interface{}{
+			"job_name": "deploy",
+			"trigger":  "manual",
+			"id":       42,
+		},
+		"inputs": map[string]interface{}{
+			"repo": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"tag": "v1.2.3",
+				},
+			},
+		},
+		"steps": map[string]interface{}{
+			"unit": map[string]interface{}{
+				"status": "failed",
+			},
+		},
+	}
+
+	DescribeTable("evaluating expressions",
+		func(expression string, expected bool) {
+			cond, err := condition.Parse(expression)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := cond.Eval(vars)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(expected))
+		},
+		Entry("equality", `build.job_name == "deploy"`, true),
+		Entry("inequality", `build.job_name != 'deploy'`, false),
+		Entry("non-string variables", `build.id == "42"`, true),
+		Entry("regular expression matches", `inputs.repo.metadata.tag =~ "^v[0-9]+"`, true),
+		Entry("regular expression mismatches", `inputs.repo.metadata.tag !~ "^v[0-9]+"`, false),
+		Entry("a set variable", `inputs.repo.metadata.tag`, true),
+		Entry("an unset variable", `inputs.other.metadata.tag`, false),
+		Entry("an unset variable compared to the empty string", `inputs.other.version.ref == ""`, true),
+		Entry("negation", `!(steps.unit.status == "succeeded")`, true),
+		Entry("conjunction", `build.trigger == "manual" && steps.unit.status == "succeeded"`, false),
+		Entry("disjunction", `build.trigger == "manual" || steps.unit.status == "succeeded"`, true),
+		Entry("precedence", `false && false || true`, true),
+		Entry("literals", `true`, true),
+	)
+
+	DescribeTable("parsing invalid expressions",
+		func(expression string, message string) {
+			_, err := condition.Parse(expression)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("an empty expression", ``, "empty expression"),
+		Entry("an unterminated string", `build.job_name == "deploy`, "unterminated string"),
+		Entry("a dangling operator", `build.job_name ==`, "unexpected end of expression"),
+		Entry("an unbalanced parenthesis", `(build.job_name == "deploy"`, "missing ')'"),
+		Entry("an unknown character", `build.job_name = "deploy"`, "unexpected '='"),
+		Entry("trailing tokens", `build.job_name "deploy"`, "unexpected 'deploy'"),
+		Entry("an invalid regular expression", `build.job_name =~ "("`, "invalid regular expression"),
+	)
+})
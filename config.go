@@ -124,6 +124,10 @@ type JobConfig struct {
 	RawMaxInFlight       int      `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
 	BuildLogsToRetain    int      `yaml:"build_logs_to_retain,omitempty" json:"build_logs_to_retain,omitempty" mapstructure:"build_logs_to_retain"`
 
+	Priority             int    `yaml:"priority,omitempty" json:"priority,omitempty" mapstructure:"priority"`
+	InterruptSuperseded  bool   `yaml:"interrupt_superseded,omitempty" json:"interrupt_superseded,omitempty" mapstructure:"interrupt_superseded"`
+	InterruptGracePeriod string `yaml:"interrupt_grace_period,omitempty" json:"interrupt_grace_period,omitempty" mapstructure:"interrupt_grace_period"`
+
 	Plan PlanSequence `yaml:"plan,omitempty" json:"plan,omitempty" mapstructure:"plan"`
 
 	Failure *PlanConfig `yaml:"on_failure,omitempty" json:"on_failure,omitempty" mapstructure:"on_failure"`
@@ -199,6 +203,12 @@ func collectPlans(plan PlanConfig) []PlanConfig {
 		}
 	}
 
+	if plan.InParallel != nil {
+		for _, p := range plan.InParallel.Steps {
+			plans = append(plans, collectPlans(p)...)
+		}
+	}
+
 	return append(plans, plan)
 }
 
@@ -346,6 +356,10 @@ type PlanConfig struct {
 	// corresponds to an Aggregate plan, keyed by the name of each sub-plan
 	Aggregate *PlanSequence `yaml:"aggregate,omitempty" json:"aggregate,omitempty" mapstructure:"aggregate"`
 
+	// corresponds to an InParallel plan, optionally bounding how many of the
+	// steps run at once and stopping the rest once one fails
+	InParallel *InParallelConfig `yaml:"in_parallel,omitempty" json:"in_parallel,omitempty" mapstructure:"in_parallel"`
+
 	// corresponds to Get and Put resource plans, respectively
 	// name of 'input', e.g. bosh-stemcell
 	Get string `yaml:"get,omitempty" json:"get,omitempty" mapstructure:"get"`
@@ -365,11 +379,23 @@ type PlanConfig struct {
 	Task string `yaml:"task,omitempty" json:"task,omitempty" mapstructure:"task"`
 	// run task privileged
 	Privileged bool `yaml:"privileged,omitempty" json:"privileged,omitempty" mapstructure:"privileged"`
-	// task config path, e.g. foo/build.yml
+	// task config path, e.g. foo/build.yml, or the file a LoadVar plan reads
 	TaskConfigPath string `yaml:"file,omitempty" json:"file,omitempty" mapstructure:"file"`
 	// inlined task config
 	TaskConfig *TaskConfig `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
 
+	// corresponds to an Approve plan
+	// name of the gate a team member must approve, e.g. deploy-to-production
+	Approve string `yaml:"approve,omitempty" json:"approve,omitempty" mapstructure:"approve"`
+
+	// corresponds to a LoadVar plan
+	// name of the build variable to load the file into, e.g. version
+	LoadVar string `yaml:"load_var,omitempty" json:"load_var,omitempty" mapstructure:"load_var"`
+	// how to parse the file: raw, trim, json or yaml (defaults to its extension)
+	Format string `yaml:"format,omitempty" json:"format,omitempty" mapstructure:"format"`
+	// redact the loaded value from the build's output
+	Sensitive bool `yaml:"sensitive,omitempty" json:"sensitive,omitempty" mapstructure:"sensitive"`
+
 	// used by Get and Put for specifying params to the resource
 	Params Params `yaml:"params,omitempty" json:"params,omitempty" mapstructure:"params"`
 
@@ -377,6 +403,9 @@ type PlanConfig struct {
 	InputMapping  map[string]string `yaml:"input_mapping,omitempty" json:"input_mapping,omitempty" mapstructure:"input_mapping"`
 	OutputMapping map[string]string `yaml:"output_mapping,omitempty" json:"output_mapping,omitempty" mapstructure:"output_mapping"`
 
+	// used by Task to retain outputs in the blob store once the build finishes
+	Artifacts []string `yaml:"artifacts,omitempty" json:"artifacts,omitempty" mapstructure:"artifacts"`
+
 	// used to specify an image artifact from a previous build to be used as the image for a subsequent task container
 	ImageArtifactName string `yaml:"image,omitempty" json:"image,omitempty" mapstructure:"image"`
 
@@ -386,6 +415,9 @@ type PlanConfig struct {
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
+	// used by any step to select workers by their labels
+	Placement *WorkerPlacement `yaml:"placement,omitempty" json:"placement,omitempty" mapstructure:"placement"`
+
 	// used by any step to run something when the step reports a failure
 	Failure *PlanConfig `yaml:"on_failure,omitempty" json:"on_failure,omitempty" mapstructure:"on_failure"`
 
@@ -401,15 +433,197 @@ type PlanConfig struct {
 	// used on any step to interrupt the step after a given duration
 	Timeout string `yaml:"timeout,omitempty" json:"timeout,omitempty" mapstructure:"timeout"`
 
+	// used on any step to skip it unless the expression holds when it is reached
+	If string `yaml:"if,omitempty" json:"if,omitempty" mapstructure:"if"`
+
 	// not present in yaml
 	DependentGet string `yaml:"-" json:"-"`
 
 	// repeat the step up to N times, until it works
-	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
+	Attempts *AttemptsConfig `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
 }
 
+const (
+	LoadVarFormatRaw  = "raw"
+	LoadVarFormatTrim = "trim"
+	LoadVarFormatJSON = "json"
+	LoadVarFormatYAML = "yaml"
+)
+
+const (
+	RetryBackoffConstant    = "constant"
+	RetryBackoffExponential = "exponential"
+)
+
+// An AttemptsConfig is the number of times to attempt a step and, optionally,
+// the policy for retrying it. It can be configured as just the number.
+type AttemptsConfig struct {
+	Count int `yaml:"count" json:"count" mapstructure:"count"`
+
+	// how long to wait before retrying, e.g. '30s'
+	Delay string `yaml:"delay,omitempty" json:"delay,omitempty" mapstructure:"delay"`
+
+	// 'constant' or 'exponential', doubling the delay after each attempt
+	Backoff string `yaml:"backoff,omitempty" json:"backoff,omitempty" mapstructure:"backoff"`
+
+	// the longest to wait when backing off exponentially
+	MaxDelay string `yaml:"max_delay,omitempty" json:"max_delay,omitempty" mapstructure:"max_delay"`
+
+	// only retry attempts which errored, not those which failed
+	ErroredOnly bool `yaml:"errored_only,omitempty" json:"errored_only,omitempty" mapstructure:"errored_only"`
+
+	// run each attempt on a different worker than the ones earlier attempts ran on
+	DifferentWorker bool `yaml:"different_worker,omitempty" json:"different_worker,omitempty" mapstructure:"different_worker"`
+}
+
+// RetryPolicy returns the policy for retrying the step, or nil if only the
+// number of attempts was configured.
+func (c AttemptsConfig) RetryPolicy() *RetryPolicy {
+	policy := RetryPolicy{
+		Delay:           c.Delay,
+		Backoff:         c.Backoff,
+		MaxDelay:        c.MaxDelay,
+		ErroredOnly:     c.ErroredOnly,
+		DifferentWorker: c.DifferentWorker,
+	}
+
+	if policy == (RetryPolicy{}) {
+		return nil
+	}
+
+	return &policy
+}
+
+// attemptsConfig has the same fields as AttemptsConfig but none of its
+// marshaling methods.
+type attemptsConfig AttemptsConfig
+
+func (c *AttemptsConfig) UnmarshalJSON(data []byte) error {
+	var count int
+	err := json.Unmarshal(data, &count)
+	if err == nil {
+		*c = AttemptsConfig{Count: count}
+		return nil
+	}
+
+	var config attemptsConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		return errors.New("unknown type for attempts")
+	}
+
+	*c = AttemptsConfig(config)
+
+	return nil
+}
+
+func (c AttemptsConfig) MarshalJSON() ([]byte, error) {
+	if c.RetryPolicy() == nil {
+		return json.Marshal(c.Count)
+	}
+
+	return json.Marshal(attemptsConfig(c))
+}
+
+func (c *AttemptsConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var count int
+	err := unmarshal(&count)
+	if err == nil {
+		*c = AttemptsConfig{Count: count}
+		return nil
+	}
+
+	var config attemptsConfig
+	err = unmarshal(&config)
+	if err != nil {
+		return errors.New("unknown type for attempts")
+	}
+
+	*c = AttemptsConfig(config)
+
+	return nil
+}
+
+func (c AttemptsConfig) MarshalYAML() (interface{}, error) {
+	if c.RetryPolicy() == nil {
+		return c.Count, nil
+	}
+
+	return attemptsConfig(c), nil
+}
+
+// An InParallelConfig is the steps to run in parallel and, optionally, how to
+// run them. It can be configured as just the steps.
+type InParallelConfig struct {
+	Steps PlanSequence `yaml:"steps,omitempty" json:"steps" mapstructure:"steps"`
+
+	// the most steps to run at once; all of them if zero
+	Limit int `yaml:"limit,omitempty" json:"limit,omitempty" mapstructure:"limit"`
+
+	// interrupt the steps still running, and start no more, once one fails
+	FailFast bool `yaml:"fail_fast,omitempty" json:"fail_fast,omitempty" mapstructure:"fail_fast"`
+}
+
+// inParallelConfig has the same fields as InParallelConfig but none of its
+// marshaling methods.
+type inParallelConfig InParallelConfig
+
+func (c *InParallelConfig) UnmarshalJSON(data []byte) error {
+	var steps PlanSequence
+	err := json.Unmarshal(data, &steps)
+	if err == nil {
+		*c = InParallelConfig{Steps: steps}
+		return nil
+	}
+
+	var config inParallelConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		return errors.New("unknown type for in_parallel")
+	}
+
+	*c = InParallelConfig(config)
+
+	return nil
+}
+
+func (c InParallelConfig) MarshalJSON() ([]byte, error) {
+	if c.Limit == 0 && !c.FailFast {
+		return json.Marshal(c.Steps)
+	}
+
+	return json.Marshal(inParallelConfig(c))
+}
+
+func (c *InParallelConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var steps PlanSequence
+	err := unmarshal(&steps)
+	if err == nil {
+		*c = InParallelConfig{Steps: steps}
+		return nil
+	}
+
+	var config inParallelConfig
+	err = unmarshal(&config)
+	if err != nil {
+		return errors.New("unknown type for in_parallel")
+	}
+
+	*c = InParallelConfig(config)
+
+	return nil
+}
+
+func (c InParallelConfig) MarshalYAML() (interface{}, error) {
+	if c.Limit == 0 && !c.FailFast {
+		return c.Steps, nil
+	}
+
+	return inParallelConfig(c), nil
+}
+
 func (config PlanConfig) Name() string {
 	if config.RawName != "" {
 		return config.RawName
@@ -427,6 +641,14 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.Approve != "" {
+		return config.Approve
+	}
+
+	if config.LoadVar != "" {
+		return config.LoadVar
+	}
+
 	return ""
 }
 
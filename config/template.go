@@ -0,0 +1,110 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var templateParamRegex = regexp.MustCompile(`\(\(([-\w\p{L}]+)\)\)`)
+
+// UndefinedParamsError is returned when a template refers to params that were
+// not given.
+type UndefinedParamsError struct {
+	Names []string
+}
+
+func (err UndefinedParamsError) Error() string {
+	return fmt.Sprintf("undefined params: %s", strings.Join(err.Names, ", "))
+}
+
+// RenderTemplate parses a YAML pipeline template and replaces each ((param))
+// in it with the given value. A string consisting of nothing but a param is
+// replaced by the value itself, so that params can hold lists or maps;
+// params within longer strings are interpolated as text, with values that are
+// not strings encoded as JSON.
+//
+// The result is suitable for decoding into an atc.Config.
+func RenderTemplate(template []byte, params map[string]interface{}) (interface{}, error) {
+	var structure interface{}
+	err := yaml.Unmarshal(template, &structure)
+	if err != nil {
+		return nil, err
+	}
+
+	undefined := map[string]bool{}
+
+	rendered := renderParams(structure, params, undefined)
+
+	if len(undefined) > 0 {
+		names := []string{}
+		for name := range undefined {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, UndefinedParamsError{Names: names}
+	}
+
+	return rendered, nil
+}
+
+func renderParams(node interface{}, params map[string]interface{}, undefined map[string]bool) interface{} {
+	switch n := node.(type) {
+	case map[interface{}]interface{}:
+		rendered := make(map[interface{}]interface{}, len(n))
+		for k, v := range n {
+			rendered[k] = renderParams(v, params, undefined)
+		}
+
+		return rendered
+
+	case []interface{}:
+		rendered := make([]interface{}, len(n))
+		for i, v := range n {
+			rendered[i] = renderParams(v, params, undefined)
+		}
+
+		return rendered
+
+	case string:
+		if match := templateParamRegex.FindStringSubmatch(n); match != nil && match[0] == n {
+			value, found := params[match[1]]
+			if !found {
+				undefined[match[1]] = true
+				return n
+			}
+
+			return value
+		}
+
+		return templateParamRegex.ReplaceAllStringFunc(n, func(param string) string {
+			name := templateParamRegex.FindStringSubmatch(param)[1]
+
+			value, found := params[name]
+			if !found {
+				undefined[name] = true
+				return param
+			}
+
+			if str, ok := value.(string); ok {
+				return str
+			}
+
+			payload, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Sprintf("%v", value)
+			}
+
+			return string(payload)
+		})
+
+	default:
+		return node
+	}
+}
@@ -0,0 +1,123 @@
+package config_test
+
+import (
+	"github.com/concourse/atc/config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RenderTemplate", func() {
+	var (
+		template []byte
+		params   map[string]interface{}
+
+		rendered  interface{}
+		renderErr error
+	)
+
+	BeforeEach(func() {
+		params = map[string]interface{}{}
+	})
+
+	JustBeforeEach(func() {
+		rendered, renderErr = config.RenderTemplate(template, params)
+	})
+
+	Context("when every param is given", func() {
+		BeforeEach(func() {
+			template = []byte(`
+resources:
+- name: repo
+  type: git
+  source:
+    uri: https://example.com/((repo)).git
+    branch: ((branch))
+    paths: ((paths))
+    depth: ((depth))
+`)
+
+			params = map[string]interface{}{
+				"repo":   "some-repo",
+				"branch": "master",
+				"paths":  []interface{}{"a", "b"},
+				"depth":  1,
+			}
+		})
+
+		It("replaces whole values with the param and interpolates the rest", func() {
+			Expect(renderErr).NotTo(HaveOccurred())
+			Expect(rendered).To(Equal(map[interface{}]interface{}{
+				"resources": []interface{}{
+					map[interface{}]interface{}{
+						"name": "repo",
+						"type": "git",
+						"source": map[interface{}]interface{}{
+							"uri":    "https://example.com/some-repo.git",
+							"branch": "master",
+							"paths":  []interface{}{"a", "b"},
+							"depth":  1,
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Context("when non-string params are interpolated into a string", func() {
+		BeforeEach(func() {
+			template = []byte(`
+jobs:
+- name: "deploy ((count)) to ((zones)) with ((options)) ((enabled))"
+`)
+
+			params = map[string]interface{}{
+				"count":   3,
+				"zones":   []interface{}{"a", "b"},
+				"options": map[string]interface{}{"force": true},
+				"enabled": false,
+			}
+		})
+
+		It("interpolates them as JSON", func() {
+			Expect(renderErr).NotTo(HaveOccurred())
+			Expect(rendered).To(Equal(map[interface{}]interface{}{
+				"jobs": []interface{}{
+					map[interface{}]interface{}{
+						"name": `deploy 3 to ["a","b"] with {"force":true} false`,
+					},
+				},
+			}))
+		})
+	})
+
+	Context("when params are missing", func() {
+		BeforeEach(func() {
+			template = []byte(`
+resources:
+- name: ((name))
+  type: git
+  source: {uri: "((uri))", branch: "((branch))-((name))"}
+`)
+
+			params = map[string]interface{}{
+				"uri": "some-uri",
+			}
+		})
+
+		It("returns an error naming each of them once", func() {
+			Expect(renderErr).To(Equal(config.UndefinedParamsError{
+				Names: []string{"branch", "name"},
+			}))
+		})
+	})
+
+	Context("when the template is not valid YAML", func() {
+		BeforeEach(func() {
+			template = []byte("{")
+		})
+
+		It("returns an error", func() {
+			Expect(renderErr).To(HaveOccurred())
+		})
+	})
+})
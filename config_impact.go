@@ -0,0 +1,17 @@
+package atc
+
+// ConfigImpact describes what saving a config would do to a pipeline, without
+// saving it.
+type ConfigImpact struct {
+	Groups        ConfigDiff `json:"groups"`
+	Resources     ConfigDiff `json:"resources"`
+	ResourceTypes ConfigDiff `json:"resource_types"`
+	Jobs          ConfigDiff `json:"jobs"`
+
+	// resource types which would be checked again, as they're new or their
+	// definition changed
+	RecheckedResourceTypes []string `json:"rechecked_resource_types"`
+
+	// pending or running builds of jobs which would be removed
+	OrphanedBuilds []Build `json:"orphaned_builds"`
+}
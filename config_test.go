@@ -139,4 +139,122 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("AttemptsConfig", func() {
+		Context("when unmarshaling a plain number from YAML", func() {
+			It("produces an attempts config with only a count", func() {
+				var attemptsConfig AttemptsConfig
+				err := yaml.Unmarshal([]byte(`3`), &attemptsConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(attemptsConfig).To(Equal(AttemptsConfig{Count: 3}))
+				Expect(attemptsConfig.RetryPolicy()).To(BeNil())
+			})
+		})
+
+		Context("when unmarshaling a retry policy from YAML", func() {
+			It("produces the attempts config and its retry policy", func() {
+				var attemptsConfig AttemptsConfig
+				bs := []byte(`{count: 3, delay: 10s, backoff: exponential, max_delay: 1m, errored_only: true, different_worker: true}`)
+				err := yaml.Unmarshal(bs, &attemptsConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(attemptsConfig).To(Equal(AttemptsConfig{
+					Count:           3,
+					Delay:           "10s",
+					Backoff:         RetryBackoffExponential,
+					MaxDelay:        "1m",
+					ErroredOnly:     true,
+					DifferentWorker: true,
+				}))
+
+				Expect(attemptsConfig.RetryPolicy()).To(Equal(&RetryPolicy{
+					Delay:           "10s",
+					Backoff:         RetryBackoffExponential,
+					MaxDelay:        "1m",
+					ErroredOnly:     true,
+					DifferentWorker: true,
+				}))
+			})
+		})
+
+		Context("when unmarshaling a plain number from JSON", func() {
+			It("produces an attempts config with only a count", func() {
+				var attemptsConfig AttemptsConfig
+				err := json.Unmarshal([]byte(`3`), &attemptsConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(attemptsConfig).To(Equal(AttemptsConfig{Count: 3}))
+			})
+		})
+
+		Context("when marshaling an attempts config with only a count to JSON", func() {
+			It("produces a plain number", func() {
+				bs, err := json.Marshal(AttemptsConfig{Count: 3})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bs).To(MatchJSON(`3`))
+			})
+		})
+	})
+
+	Describe("InParallelConfig", func() {
+		Context("when unmarshaling a list of steps from YAML", func() {
+			It("produces an in_parallel config with only steps", func() {
+				var inParallelConfig InParallelConfig
+				err := yaml.Unmarshal([]byte(`[{get: some-resource}, {task: some-task}]`), &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(inParallelConfig).To(Equal(InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+						{Task: "some-task"},
+					},
+				}))
+			})
+		})
+
+		Context("when unmarshaling steps with options from YAML", func() {
+			It("produces the in_parallel config with its options", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`{steps: [{get: some-resource}], limit: 2, fail_fast: true}`)
+				err := yaml.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(inParallelConfig).To(Equal(InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+					},
+					Limit:    2,
+					FailFast: true,
+				}))
+			})
+		})
+
+		Context("when unmarshaling a list of steps from JSON", func() {
+			It("produces an in_parallel config with only steps", func() {
+				var inParallelConfig InParallelConfig
+				err := json.Unmarshal([]byte(`[{"get": "some-resource"}]`), &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(inParallelConfig).To(Equal(InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+					},
+				}))
+			})
+		})
+
+		Context("when marshaling an in_parallel config with options to JSON", func() {
+			It("produces an object", func() {
+				bs, err := json.Marshal(InParallelConfig{
+					Steps:    PlanSequence{{Get: "some-resource"}},
+					FailFast: true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(bs).To(MatchJSON(`{"steps": [{"get": "some-resource"}], "fail_fast": true}`))
+			})
+		})
+	})
 })
@@ -209,5 +209,33 @@ var _ = Describe("Builds", func() {
 			// Not required behavior, just a sanity check for what I think will happen
 			Expect(build4DB.ReapTime()).To(Equal(build1DB.ReapTime()))
 		})
+
+		It("deletes the build log index of the given builds, so their logs are no longer searchable", func() {
+			build1DB, err := teamDB.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build2DB, err := teamDB.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, build := range []db.Build{build1DB, build2DB} {
+				_, err = dbConn.Exec(`
+					INSERT INTO build_log_index (build_id, origin_id, payload, tsv)
+					VALUES ($1, 'some-step', 'connection refused', to_tsvector('simple', 'connection refused'))
+				`, build.ID())
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			err = database.DeleteBuildEventsByBuildIDs([]int{build1DB.ID()})
+			Expect(err).NotTo(HaveOccurred())
+
+			var build1Rows, build2Rows int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM build_log_index WHERE build_id = $1`, build1DB.ID()).Scan(&build1Rows)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build1Rows).To(BeZero())
+
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM build_log_index WHERE build_id = $1`, build2DB.ID()).Scan(&build2Rows)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build2Rows).To(Equal(1))
+		})
 	})
 })
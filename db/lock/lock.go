@@ -20,6 +20,7 @@ const (
 	LockTypeBatch
 	LockTypeVolumeCreating
 	LockTypeContainerCreating
+	LockTypeTeamEvents
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
@@ -52,6 +53,10 @@ func NewContainerCreatingLockID(containerID int) LockID {
 	return LockID{LockTypeContainerCreating, containerID}
 }
 
+func NewTeamEventsLockID(teamID int) LockID {
+	return LockID{LockTypeTeamEvents, teamID}
+}
+
 //go:generate counterfeiter . LockFactory
 
 type LockFactory interface {
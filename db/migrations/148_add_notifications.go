@@ -0,0 +1,33 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddNotifications(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE teams
+		ADD COLUMN notifications json NOT NULL DEFAULT '[]'
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE TABLE build_notification_deliveries (
+			id serial PRIMARY KEY,
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			notification text NOT NULL,
+			attempt int NOT NULL,
+			delivered bool NOT NULL,
+			error text,
+			created_at timestamp with time zone NOT NULL DEFAULT now()
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX build_notification_deliveries_build_id ON build_notification_deliveries (build_id)
+	`)
+	return err
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddBuildApprovals(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE build_approvals (
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			plan_id text NOT NULL,
+			requested_at timestamp with time zone NOT NULL DEFAULT now(),
+			decided_at timestamp with time zone,
+			approved bool,
+			approver text,
+			comment text,
+			UNIQUE (build_id, plan_id)
+		)
+	`)
+	return err
+}
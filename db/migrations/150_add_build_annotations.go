@@ -0,0 +1,23 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddBuildAnnotations(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE build_annotations (
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			step text NOT NULL,
+			name text NOT NULL,
+			value text NOT NULL,
+			UNIQUE (build_id, name)
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX build_annotations_name ON build_annotations (name)
+	`)
+	return err
+}
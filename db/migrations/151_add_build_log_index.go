@@ -0,0 +1,30 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddBuildLogIndex(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE build_log_index (
+			id serial PRIMARY KEY,
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			origin_id text NOT NULL,
+			payload text NOT NULL,
+			tsv tsvector NOT NULL
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX build_log_index_tsv ON build_log_index USING gin (tsv)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX build_log_index_build_id ON build_log_index (build_id)
+	`)
+	return err
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddTeamEvents(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE team_events (
+			id bigserial PRIMARY KEY,
+			team_id int NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+			type text NOT NULL,
+			version text NOT NULL,
+			payload text NOT NULL,
+			created_at timestamp with time zone NOT NULL DEFAULT now()
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX team_events_team_id_id ON team_events (team_id, id)
+	`)
+	return err
+}
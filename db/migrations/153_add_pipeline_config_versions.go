@@ -0,0 +1,29 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddPipelineConfigVersions(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE pipeline_config_versions (
+			id serial PRIMARY KEY,
+			pipeline_id int NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+			version bigint NOT NULL,
+			config text NOT NULL,
+			author text NOT NULL DEFAULT '',
+			created_at timestamp with time zone NOT NULL DEFAULT now(),
+			UNIQUE (pipeline_id, version)
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	// keep the current config of existing pipelines so that there is something
+	// to diff against or roll back to
+	_, err = tx.Exec(`
+		INSERT INTO pipeline_config_versions (pipeline_id, version, config)
+		SELECT id, version, config
+		FROM pipelines
+	`)
+	return err
+}
@@ -0,0 +1,28 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddPipelineTemplates(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE pipeline_templates (
+			id serial PRIMARY KEY,
+			team_id int NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+			name text NOT NULL,
+			template text NOT NULL,
+			UNIQUE (team_id, name)
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE TABLE pipeline_template_instances (
+			template_id int NOT NULL REFERENCES pipeline_templates (id) ON DELETE CASCADE,
+			pipeline_name text NOT NULL,
+			params text NOT NULL,
+			UNIQUE (template_id, pipeline_name)
+		)
+	`)
+	return err
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddQuarantinedStateAndProbesToWorkers(tx migration.LimitedTx) error {
+	// enum values cannot be added within a transaction, so the type is
+	// replaced instead
+	_, err := tx.Exec(`
+		ALTER TYPE worker_state RENAME TO worker_state_old
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE TYPE worker_state AS ENUM (
+			'running',
+			'stalled',
+			'landing',
+			'landed',
+			'retiring',
+			'quarantined'
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE workers
+		ALTER COLUMN state DROP DEFAULT,
+		ALTER COLUMN state TYPE worker_state USING state::text::worker_state,
+		ALTER COLUMN state SET DEFAULT 'running'
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DROP TYPE worker_state_old
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE workers
+		ADD COLUMN probes json NOT NULL DEFAULT '[]'
+	`)
+	return err
+}
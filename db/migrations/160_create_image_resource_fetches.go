@@ -0,0 +1,32 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func CreateImageResourceFetches(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE image_resource_fetches (
+			resource_cache_id int PRIMARY KEY REFERENCES resource_caches (id) ON DELETE CASCADE,
+			team_id int NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+			build_id int REFERENCES builds (id) ON DELETE SET NULL,
+			resource_id int REFERENCES resources (id) ON DELETE SET NULL,
+			resource_type_id int REFERENCES resource_types (id) ON DELETE SET NULL,
+			type text NOT NULL,
+			source text NOT NULL,
+			version text NOT NULL,
+			resource_types text NOT NULL,
+			tags text NOT NULL,
+			size_in_bytes bigint NOT NULL DEFAULT 0,
+			fetches int NOT NULL DEFAULT 0,
+			hits int NOT NULL DEFAULT 0,
+			last_fetched timestamp with time zone NOT NULL DEFAULT now()
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX image_resource_fetches_team_id_fetches ON image_resource_fetches (team_id, fetches DESC)
+	`)
+	return err
+}
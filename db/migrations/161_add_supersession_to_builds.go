@@ -0,0 +1,12 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddSupersessionToBuilds(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE builds
+		ADD COLUMN superseded_at timestamp with time zone,
+		ADD COLUMN abort_reason text
+	`)
+	return err
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddBuildQueueing(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE builds
+		ADD COLUMN queued bool NOT NULL DEFAULT false,
+		ADD COLUMN admitted bool NOT NULL DEFAULT false,
+		ADD COLUMN priority integer NOT NULL DEFAULT 0,
+		ADD COLUMN queue_position integer
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX builds_queued ON builds (id) WHERE queued AND status = 'pending'
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE teams
+		ADD COLUMN scheduling_weight integer NOT NULL DEFAULT 1,
+		ADD COLUMN scheduling_priority integer NOT NULL DEFAULT 0
+	`)
+	return err
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddTeamEventRetention(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE teams
+		ADD COLUMN reaped_event_id bigint NOT NULL DEFAULT 0
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX team_events_created_at ON team_events (created_at)
+	`)
+	return err
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func AddPendingLinesToBuildLogIndex(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE build_log_index
+		ADD COLUMN complete boolean NOT NULL DEFAULT true
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX build_log_index_pending ON build_log_index (build_id, origin_id) WHERE NOT complete
+	`)
+	return err
+}
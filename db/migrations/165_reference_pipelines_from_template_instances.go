@@ -0,0 +1,53 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func ReferencePipelinesFromTemplateInstances(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE pipeline_template_instances
+		ADD COLUMN pipeline_id int REFERENCES pipelines (id) ON DELETE CASCADE
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE pipeline_template_instances i
+		SET pipeline_id = p.id
+		FROM pipeline_templates t, pipelines p
+		WHERE t.id = i.template_id
+		AND p.team_id = t.team_id
+		AND p.name = i.pipeline_name
+	`)
+	if err != nil {
+		return err
+	}
+
+	// instances of pipelines that have since been destroyed
+	_, err = tx.Exec(`
+		DELETE FROM pipeline_template_instances
+		WHERE pipeline_id IS NULL
+	`)
+	if err != nil {
+		return err
+	}
+
+	// a pipeline may only be an instance of one template; keep the newest
+	_, err = tx.Exec(`
+		DELETE FROM pipeline_template_instances a
+		USING pipeline_template_instances b
+		WHERE a.pipeline_id = b.pipeline_id
+		AND a.template_id < b.template_id
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE pipeline_template_instances
+		ALTER COLUMN pipeline_id SET NOT NULL,
+		DROP COLUMN pipeline_name,
+		ADD CONSTRAINT pipeline_template_instances_pipeline_id_key UNIQUE (pipeline_id)
+	`)
+	return err
+}
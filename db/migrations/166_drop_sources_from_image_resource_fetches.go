@@ -0,0 +1,12 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func DropSourcesFromImageResourceFetches(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		ALTER TABLE image_resource_fetches
+		DROP COLUMN source,
+		DROP COLUMN resource_types
+	`)
+	return err
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func CreatePendingNotifications(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE pending_notifications (
+			id serial PRIMARY KEY,
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			notification text NOT NULL,
+			message json NOT NULL,
+			attempts int NOT NULL DEFAULT 0,
+			due_at timestamp with time zone NOT NULL DEFAULT now(),
+			claimed_until timestamp with time zone
+		)
+	`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		CREATE INDEX pending_notifications_due_at ON pending_notifications (due_at)
+	`)
+	return err
+}
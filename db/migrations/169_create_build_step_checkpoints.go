@@ -0,0 +1,15 @@
+package migrations
+
+import "github.com/concourse/atc/dbng/migration"
+
+func CreateBuildStepCheckpoints(tx migration.LimitedTx) error {
+	_, err := tx.Exec(`
+		CREATE TABLE build_step_checkpoints (
+			build_id int NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+			plan_id text NOT NULL,
+			checkpoint json NOT NULL,
+			PRIMARY KEY (build_id, plan_id)
+		)
+	`)
+	return err
+}
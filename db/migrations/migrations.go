@@ -156,4 +156,26 @@ var Migrations = []migration.Migrator{
 	RemoveDuplicateIndices,
 	CleanUpContainerColumns,
 	AddAuthToTeams,
+	AddNotifications,
+	AddBuildApprovals,
+	AddBuildAnnotations,
+	AddBuildLogIndex,
+	AddTeamEvents,
+	AddPipelineConfigVersions,
+	AddPipelineTemplates,
+	AddRedactionRulesToTeams,
+	AddQuarantinedStateAndProbesToWorkers,
+	AddLabelsToWorkers,
+	AddDrainDeadlineToWorkers,
+	AddStreamEncodingsToWorkers,
+	CreateImageResourceFetches,
+	AddSupersessionToBuilds,
+	AddBuildQueueing,
+	AddTeamEventRetention,
+	AddPendingLinesToBuildLogIndex,
+	ReferencePipelinesFromTemplateInstances,
+	DropSourcesFromImageResourceFetches,
+	CreatePendingNotifications,
+	AddRunningPutsToBuilds,
+	CreateBuildStepCheckpoints,
 }
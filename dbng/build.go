@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -28,7 +29,22 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.start_time, b.end_time, b.reap_time, j.name, p.id, p.name, t.name").
+type NotificationDelivery struct {
+	Notification string
+	Attempt      int
+	Delivered    bool
+	Error        string
+	CreatedAt    time.Time
+}
+
+type BuildApproval struct {
+	Approved  bool
+	Approver  string
+	Comment   string
+	DecidedAt time.Time
+}
+
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.start_time, b.end_time, b.reap_time, b.abort_reason, CASE WHEN b.status = 'pending' THEN b.queue_position END, j.name, p.id, p.name, t.name").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON j.pipeline_id = p.id").
@@ -51,6 +67,8 @@ type Build interface {
 	StartTime() time.Time
 	EndTime() time.Time
 	ReapTime() time.Time
+	AbortReason() string
+	QueuePosition() int
 	IsManuallyTriggered() bool
 	IsScheduled() bool
 
@@ -78,13 +96,33 @@ type Build interface {
 	GetVersionedResources() (SavedVersionedResources, error)
 	SaveImageResourceVersion(planID atc.PlanID, resourceVersion atc.Version, resourceHash string) error
 
+	SaveStepCheckpoint(planID atc.PlanID, checkpoint json.RawMessage) error
+	StepCheckpoint(planID atc.PlanID) (json.RawMessage, bool, error)
+
 	Pipeline() (Pipeline, bool, error)
 
+	PreviousStatus() (BuildStatus, bool, error)
+	SaveNotificationDelivery(delivery NotificationDelivery) error
+	NotificationDeliveries() ([]NotificationDelivery, error)
+
+	RequestApproval(planID atc.PlanID) error
+	Approval(planID atc.PlanID) (BuildApproval, bool, error)
+	DecideApproval(planID atc.PlanID, approved bool, approver string, comment string) (bool, error)
+	ApprovalNotifier(planID atc.PlanID) (Notifier, error)
+
+	SaveAnnotations(step string, annotations map[string]string) error
+	Annotations() ([]atc.BuildAnnotation, error)
+
 	Finish(s BuildStatus) error
 	Delete() (bool, error)
 	Abort() error
 	AbortNotifier() (Notifier, error)
 	Schedule() (bool, error)
+
+	Supersede() (time.Time, error)
+	ClaimAbort(reason string) (bool, error)
+	StartPut() (bool, error)
+	FinishPut() error
 }
 
 type build struct {
@@ -110,6 +148,9 @@ type build struct {
 	endTime   time.Time
 	reapTime  time.Time
 
+	abortReason   string
+	queuePosition int
+
 	conn        Conn
 	lockFactory lock.LockFactory
 }
@@ -132,6 +173,8 @@ func (b *build) EndTime() time.Time        { return b.endTime }
 func (b *build) ReapTime() time.Time       { return b.reapTime }
 func (b *build) Status() BuildStatus       { return b.status }
 func (b *build) IsScheduled() bool         { return b.scheduled }
+func (b *build) AbortReason() string       { return b.abortReason }
+func (b *build) QueuePosition() int        { return b.queuePosition }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -239,6 +282,11 @@ func (b *build) Start(engine, metadata string) (bool, error) {
 		return false, err
 	}
 
+	err = saveTeamEvent(tx, b.teamID, b.statusChangedEvent(atc.StatusStarted, startTime))
+	if err != nil {
+		return false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -249,6 +297,11 @@ func (b *build) Start(engine, metadata string) (bool, error) {
 		return false, err
 	}
 
+	err = b.conn.Bus().Notify(teamEventsChannel(b.teamID))
+	if err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -318,6 +371,11 @@ func (b *build) Finish(s BuildStatus) error {
 		return err
 	}
 
+	err = saveTeamEvent(tx, b.teamID, b.statusChangedEvent(atc.BuildStatus(s), endTime))
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(fmt.Sprintf(`
 		DROP SEQUENCE %s
 	`, buildEventSeq(b.id)))
@@ -335,9 +393,25 @@ func (b *build) Finish(s BuildStatus) error {
 		return err
 	}
 
+	err = b.conn.Bus().Notify(teamEventsChannel(b.teamID))
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (b *build) statusChangedEvent(status atc.BuildStatus, at time.Time) event.BuildStatusChanged {
+	return event.BuildStatusChanged{
+		Time:         at.Unix(),
+		BuildID:      b.id,
+		BuildName:    b.name,
+		JobName:      b.jobName,
+		PipelineName: b.pipelineName,
+		Status:       status,
+	}
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -393,6 +467,94 @@ func (b *build) AbortNotifier() (Notifier, error) {
 	})
 }
 
+// Supersede records that a newer build of the job should replace the build,
+// and returns when that was first recorded.
+func (b *build) Supersede() (time.Time, error) {
+	var supersededAt time.Time
+
+	err := psql.Update("builds").
+		Set("superseded_at", sq.Expr("COALESCE(superseded_at, now())")).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING superseded_at").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&supersededAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, ErrBuildDisappeared
+		}
+		return time.Time{}, err
+	}
+
+	return supersededAt, nil
+}
+
+// ClaimAbort records why the build is about to be aborted, unless one of its
+// put steps is running, in which case it returns false and the build should
+// not be aborted yet. Once claimed, the build's put steps no longer start, so
+// a put cannot begin between the claim and the abort being delivered.
+func (b *build) ClaimAbort(reason string) (bool, error) {
+	result, err := psql.Update("builds").
+		Set("abort_reason", reason).
+		Where(sq.Eq{
+			"id":           b.id,
+			"running_puts": 0,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if affected == 0 {
+		return false, nil
+	}
+
+	b.abortReason = reason
+
+	return true, nil
+}
+
+// StartPut records that a put step of the build is running, unless an abort
+// of the build has been claimed, in which case it returns false and the put
+// must not run. Each started put must be followed by a call to FinishPut.
+func (b *build) StartPut() (bool, error) {
+	result, err := psql.Update("builds").
+		Set("running_puts", sq.Expr("running_puts + 1")).
+		Where(sq.Eq{
+			"id":           b.id,
+			"abort_reason": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected == 1, nil
+}
+
+// FinishPut records that a put step of the build started by StartPut is no
+// longer running, however it ended.
+func (b *build) FinishPut() error {
+	_, err := psql.Update("builds").
+		Set("running_puts", sq.Expr("GREATEST(running_puts - 1, 0)")).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
 func (b *build) Schedule() (bool, error) {
 	result, err := psql.Update("builds").
 		Set("scheduled", true).
@@ -462,6 +624,43 @@ func (b *build) SaveImageResourceVersion(planID atc.PlanID, resourceVersion atc.
 	)
 }
 
+// SaveStepCheckpoint records what the given step left behind once it has
+// completed, so that it is not run again if the build is resumed by another
+// ATC. Only the first checkpoint of each step is kept.
+func (b *build) SaveStepCheckpoint(planID atc.PlanID, checkpoint json.RawMessage) error {
+	_, err := psql.Insert("build_step_checkpoints").
+		Columns("build_id", "plan_id", "checkpoint").
+		Values(b.id, string(planID), []byte(checkpoint)).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+// StepCheckpoint returns the checkpoint saved by the given step, if it has
+// completed.
+func (b *build) StepCheckpoint(planID atc.PlanID) (json.RawMessage, bool, error) {
+	var checkpoint []byte
+	err := psql.Select("checkpoint").
+		From("build_step_checkpoints").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&checkpoint)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return json.RawMessage(checkpoint), true, nil
+}
+
 func (b *build) AcquireTrackingLock(logger lager.Logger, interval time.Duration) (lock.Lock, bool, error) {
 	lock := b.lockFactory.NewLock(
 		logger.Session("lock", lager.Data{
@@ -949,6 +1148,255 @@ func (b *build) getVersionedResources(resourceRequest string) (SavedVersionedRes
 	return savedVersionedResources, nil
 }
 
+// PreviousStatus returns the status of the latest finished build of the same
+// job that was created before this one. One-off builds have no previous build.
+func (b *build) PreviousStatus() (BuildStatus, bool, error) {
+	if b.jobID == 0 {
+		return "", false, nil
+	}
+
+	var status string
+	err := psql.Select("status").
+		From("builds").
+		Where(sq.Eq{"job_id": b.jobID}).
+		Where("id < ?", b.id).
+		Where(sq.NotEq{"status": []string{string(BuildStatusPending), string(BuildStatusStarted)}}).
+		OrderBy("id DESC").
+		Limit(1).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&status)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	return BuildStatus(status), true, nil
+}
+
+func (b *build) SaveNotificationDelivery(delivery NotificationDelivery) error {
+	return saveNotificationDelivery(b.conn, b.id, delivery)
+}
+
+func saveNotificationDelivery(runner sq.Runner, buildID int, delivery NotificationDelivery) error {
+	var deliveryErr sql.NullString
+	if delivery.Error != "" {
+		deliveryErr = sql.NullString{String: delivery.Error, Valid: true}
+	}
+
+	_, err := psql.Insert("build_notification_deliveries").
+		Columns("build_id", "notification", "attempt", "delivered", "error").
+		Values(buildID, delivery.Notification, delivery.Attempt, delivery.Delivered, deliveryErr).
+		RunWith(runner).
+		Exec()
+	return err
+}
+
+func (b *build) NotificationDeliveries() ([]NotificationDelivery, error) {
+	rows, err := psql.Select("notification", "attempt", "delivered", "error", "created_at").
+		From("build_notification_deliveries").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	deliveries := []NotificationDelivery{}
+	for rows.Next() {
+		var delivery NotificationDelivery
+		var deliveryErr sql.NullString
+
+		err := rows.Scan(&delivery.Notification, &delivery.Attempt, &delivery.Delivered, &deliveryErr, &delivery.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.Error = deliveryErr.String
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// RequestApproval records that the approve step with the given plan ID is
+// waiting for a decision. Requesting approval for the same step again is a
+// no-op.
+func (b *build) RequestApproval(planID atc.PlanID) error {
+	_, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id").
+		Values(b.id, string(planID)).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Approval returns the decision made for the given approve step. It returns
+// false if no decision has been made yet.
+func (b *build) Approval(planID atc.PlanID) (BuildApproval, bool, error) {
+	var approval BuildApproval
+	var approver, comment sql.NullString
+
+	err := psql.Select("approved", "approver", "comment", "decided_at").
+		From("build_approvals").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		Where(sq.NotEq{"decided_at": nil}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&approval.Approved, &approver, &comment, &approval.DecidedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildApproval{}, false, nil
+		}
+
+		return BuildApproval{}, false, err
+	}
+
+	approval.Approver = approver.String
+	approval.Comment = comment.String
+
+	return approval, true, nil
+}
+
+// DecideApproval approves or rejects the given approve step and wakes up
+// anything waiting on it. It returns false if the step is not waiting for a
+// decision, either because it never requested one or because it has already
+// been decided.
+func (b *build) DecideApproval(planID atc.PlanID, approved bool, approver string, comment string) (bool, error) {
+	result, err := psql.Update("build_approvals").
+		Set("approved", approved).
+		Set("approver", approver).
+		Set("comment", comment).
+		Set("decided_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"build_id":   b.id,
+			"plan_id":    string(planID),
+			"decided_at": nil,
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rows == 0 {
+		return false, nil
+	}
+
+	err = b.conn.Bus().Notify(buildApprovalChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (b *build) ApprovalNotifier(planID atc.PlanID) (Notifier, error) {
+	return newConditionNotifier(b.conn.Bus(), buildApprovalChannel(b.id), func() (bool, error) {
+		_, decided, err := b.Approval(planID)
+		return decided, err
+	})
+}
+
+// SaveAnnotations records the annotations written by the given step. An
+// annotation replaces any earlier annotation of the same name, even one
+// written by a different step.
+func (b *build) SaveAnnotations(step string, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(annotations))
+	for name := range annotations {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Delete("build_annotations").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"name":     names,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	insert := psql.Insert("build_annotations").
+		Columns("build_id", "step", "name", "value")
+
+	for _, name := range names {
+		insert = insert.Values(b.id, step, name, annotations[name])
+	}
+
+	_, err = insert.RunWith(tx).Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (b *build) Annotations() ([]atc.BuildAnnotation, error) {
+	rows, err := psql.Select("step", "name", "value").
+		From("build_annotations").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("name ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	annotations := []atc.BuildAnnotation{}
+	for rows.Next() {
+		var annotation atc.BuildAnnotation
+
+		err := rows.Scan(&annotation.Step, &annotation.Name, &annotation.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	return annotations, nil
+}
+
 func createBuildEventSeq(tx Tx, buildid int) error {
 	_, err := tx.Exec(fmt.Sprintf(`
 		CREATE SEQUENCE %s MINVALUE 0
@@ -964,12 +1412,14 @@ func scanBuild(b *build, row scannable) error {
 	var (
 		jobID, pipelineID                             sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName sql.NullString
+		abortReason                                   sql.NullString
+		queuePosition                                 sql.NullInt64
 		startTime, endTime, reapTime                  pq.NullTime
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &startTime, &endTime, &reapTime, &abortReason, &queuePosition, &jobName, &pipelineID, &pipelineName, &b.teamName)
 	if err != nil {
 		return err
 	}
@@ -984,12 +1434,14 @@ func scanBuild(b *build, row scannable) error {
 	b.startTime = startTime.Time
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
+	b.abortReason = abortReason.String
+	b.queuePosition = int(queuePosition.Int64)
 
 	return nil
 }
 
-func (b *build) saveEvent(tx Tx, event atc.Event) error {
-	payload, err := json.Marshal(event)
+func (b *build) saveEvent(tx Tx, ev atc.Event) error {
+	payload, err := json.Marshal(ev)
 	if err != nil {
 		return err
 	}
@@ -1000,13 +1452,17 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 	}
 	_, err = psql.Insert(table).
 		Columns("event_id", "build_id", "type", "version", "payload").
-		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
+		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(ev.EventType()), string(ev.Version()), payload).
 		RunWith(tx).
 		Exec()
 	if err != nil {
 		return err
 	}
 
+	if log, ok := ev.(event.Log); ok {
+		return b.indexLog(tx, log)
+	}
+
 	return nil
 }
 
@@ -1017,3 +1473,7 @@ func buildEventsChannel(buildID int) string {
 func buildAbortChannel(buildID int) string {
 	return fmt.Sprintf("build_abort_%d", buildID)
 }
+
+func buildApprovalChannel(buildID int) string {
+	return fmt.Sprintf("build_approval_%d", buildID)
+}
@@ -94,7 +94,7 @@ var _ = Describe("BuildFactory", func() {
 							Name: "some-other-job",
 						},
 					},
-				}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				pb1, err := p.CreateJobBuild("some-other-job")
@@ -179,13 +179,13 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = privatePipeline.CreateJobBuild("some-job")
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).NotTo(HaveOccurred())
 			publicPipeline.Expose()
 
@@ -213,7 +213,7 @@ var _ = Describe("BuildFactory", func() {
 						Name: "some-job",
 					},
 				},
-			}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+			}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			build1DB, err = team.CreateOneOffBuild()
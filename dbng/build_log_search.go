@@ -0,0 +1,219 @@
+package dbng
+
+import (
+	"database/sql"
+	"strings"
+	"unicode/utf8"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/atc/event"
+)
+
+// logSearchConfig is the Postgres text search configuration used to index
+// build logs. The 'simple' configuration does no stemming, so searching for
+// an error message finds exactly the words that were printed.
+const logSearchConfig = "simple"
+
+const maxSnippetsPerMatch = 5
+const maxSnippetLength = 200
+
+// maxPendingLogLength bounds how much of an unfinished line is carried over to
+// the next chunk of output, so that output which never prints a newline is
+// still indexed in pieces.
+const maxPendingLogLength = 64 * 1024
+
+// BuildLogMatch is a step of a build whose output matched a log search.
+type BuildLogMatch struct {
+	BuildID      int
+	BuildName    string
+	JobName      string
+	PipelineName string
+	TeamName     string
+	Origin       string
+	Snippets     []string
+}
+
+// indexLog indexes complete lines of output, so that a word split across two
+// chunks of output is still found. The unfinished line at the end of a chunk
+// is indexed on its own until the next chunk from the same origin completes
+// it.
+func (b *build) indexLog(tx Tx, log event.Log) error {
+	origin := string(log.Origin.ID)
+
+	var pending string
+	err := psql.Delete("build_log_index").
+		Where(sq.Eq{
+			"build_id":  b.id,
+			"origin_id": origin,
+			"complete":  false,
+		}).
+		Suffix("RETURNING payload").
+		RunWith(tx).
+		QueryRow().
+		Scan(&pending)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	text := pending + log.Payload
+
+	cut := strings.LastIndex(text, "\n") + 1
+	if len(text)-cut > maxPendingLogLength {
+		cut = len(text)
+	}
+
+	if cut > 0 {
+		err = b.insertLogIndex(tx, origin, text[:cut], true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cut < len(text) {
+		err = b.insertLogIndex(tx, origin, text[cut:], false)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *build) insertLogIndex(tx Tx, origin string, payload string, complete bool) error {
+	_, err := psql.Insert("build_log_index").
+		Columns("build_id", "origin_id", "payload", "tsv", "complete").
+		Values(b.id, origin, payload, sq.Expr("to_tsvector('"+logSearchConfig+"', ?)", payload), complete).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// SearchBuildLogs finds the build steps in the team whose output contains
+// every word of the query, most recent build first. When publicOnly is set,
+// only builds of public jobs in public pipelines are searched.
+func (t *team) SearchBuildLogs(query string, publicOnly bool, limit int) ([]BuildLogMatch, error) {
+	tsQuery := sq.Expr("i.tsv @@ plainto_tsquery('"+logSearchConfig+"', ?)", query)
+
+	matching := psql.Select("i.build_id", "i.origin_id").
+		From("build_log_index i").
+		Join("builds b ON b.id = i.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = j.pipeline_id").
+		Where(sq.Eq{"b.team_id": t.id}).
+		Where(tsQuery)
+
+	if publicOnly {
+		matching = matching.
+			Where(sq.Eq{"p.public": true}).
+			Where(sq.Expr("j.config->>'public' = 'true'"))
+	}
+
+	rows, err := matching.
+		GroupBy("i.build_id", "i.origin_id").
+		OrderBy("i.build_id DESC", "i.origin_id ASC").
+		Limit(uint64(limit)).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	matches := []BuildLogMatch{}
+	steps := sq.Or{}
+	for rows.Next() {
+		var match BuildLogMatch
+
+		err := rows.Scan(&match.BuildID, &match.Origin)
+		if err != nil {
+			return nil, err
+		}
+
+		match.TeamName = t.name
+		match.Snippets = []string{}
+
+		matches = append(matches, match)
+		steps = append(steps, sq.Eq{"i.build_id": match.BuildID, "i.origin_id": match.Origin})
+	}
+
+	if len(matches) == 0 {
+		return matches, nil
+	}
+
+	rows, err = psql.Select("i.build_id", "i.origin_id", "b.name", "j.name", "p.name", "i.payload").
+		From("build_log_index i").
+		Join("builds b ON b.id = i.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = j.pipeline_id").
+		Where(steps).
+		Where(tsQuery).
+		OrderBy("i.id ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	terms := strings.Fields(strings.ToLower(query))
+
+	for rows.Next() {
+		var buildID int
+		var origin, buildName, payload string
+		var jobName, pipelineName sql.NullString
+
+		err := rows.Scan(&buildID, &origin, &buildName, &jobName, &pipelineName, &payload)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range matches {
+			match := &matches[i]
+			if match.BuildID != buildID || match.Origin != origin {
+				continue
+			}
+
+			match.BuildName = buildName
+			match.JobName = jobName.String
+			match.PipelineName = pipelineName.String
+
+			for _, snippet := range logSnippets(payload, terms) {
+				if len(match.Snippets) == maxSnippetsPerMatch {
+					break
+				}
+
+				match.Snippets = append(match.Snippets, snippet)
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func logSnippets(payload string, terms []string) []string {
+	snippets := []string{}
+
+	for _, line := range strings.Split(payload, "\n") {
+		line = strings.TrimRight(line, "\r")
+
+		lowered := strings.ToLower(line)
+		for _, term := range terms {
+			if strings.Contains(lowered, term) {
+				snippets = append(snippets, truncateSnippet(strings.TrimSpace(line)))
+				break
+			}
+		}
+	}
+
+	return snippets
+}
+
+func truncateSnippet(line string) string {
+	if utf8.RuneCountInString(line) <= maxSnippetLength {
+		return line
+	}
+
+	return string([]rune(line)[:maxSnippetLength]) + "..."
+}
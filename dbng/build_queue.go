@@ -0,0 +1,203 @@
+package dbng
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// BuildQueueEntry is a pending build waiting for capacity to start.
+type BuildQueueEntry struct {
+	BuildID int
+	TeamID  int
+
+	// Priority is the build's job priority plus its team's priority.
+	Priority int
+
+	// Weight is the team's share of capacity relative to other teams.
+	Weight int
+}
+
+//go:generate counterfeiter . BuildQueue
+
+type BuildQueue interface {
+	// Enqueue adds the pending build to the queue with the given priority, and
+	// returns whether it has been admitted to start.
+	Enqueue(buildID int, priority int) (bool, error)
+
+	// Entries returns the queued builds waiting to be admitted, oldest first.
+	// Builds of paused jobs or pipelines are omitted, as they cannot start.
+	Entries() ([]BuildQueueEntry, error)
+
+	// ActiveBuilds returns the number of started builds of each team.
+	ActiveBuilds() (map[int]int, error)
+
+	// AdmittedBuilds returns the number of builds of each team that have been
+	// admitted but have not started yet.
+	AdmittedBuilds() (map[int]int, error)
+
+	// Update admits the given builds to start, and sets the queue positions
+	// of the builds that are to keep waiting. Builds that have already been
+	// admitted stay admitted, as they may be starting.
+	Update(admitted []int, positions map[int]int) error
+}
+
+type buildQueue struct {
+	conn Conn
+}
+
+func NewBuildQueue(conn Conn) BuildQueue {
+	return &buildQueue{
+		conn: conn,
+	}
+}
+
+func (q *buildQueue) Enqueue(buildID int, priority int) (bool, error) {
+	var admitted bool
+	err := psql.Update("builds").
+		Set("queued", true).
+		Set("priority", priority).
+		Where(sq.Eq{
+			"id":     buildID,
+			"status": BuildStatusPending,
+		}).
+		Suffix("RETURNING admitted").
+		RunWith(q.conn).
+		QueryRow().
+		Scan(&admitted)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// the build is no longer pending
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return admitted, nil
+}
+
+func (q *buildQueue) Entries() ([]BuildQueueEntry, error) {
+	rows, err := psql.Select("b.id", "b.team_id", "b.priority + t.scheduling_priority", "t.scheduling_weight").
+		From("builds b").
+		Join("teams t ON t.id = b.team_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("pipelines p ON p.id = j.pipeline_id").
+		Where(sq.Eq{
+			"b.queued":   true,
+			"b.admitted": false,
+			"b.status":   BuildStatusPending,
+		}).
+		Where(sq.Or{
+			sq.Eq{"j.id": nil},
+			sq.Eq{
+				"j.paused": false,
+				"p.paused": false,
+			},
+		}).
+		OrderBy("b.id").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	entries := []BuildQueueEntry{}
+	for rows.Next() {
+		var entry BuildQueueEntry
+		err = rows.Scan(&entry.BuildID, &entry.TeamID, &entry.Priority, &entry.Weight)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (q *buildQueue) ActiveBuilds() (map[int]int, error) {
+	return q.countBuilds(sq.Eq{"status": BuildStatusStarted})
+}
+
+func (q *buildQueue) AdmittedBuilds() (map[int]int, error) {
+	return q.countBuilds(sq.Eq{
+		"queued":   true,
+		"admitted": true,
+		"status":   BuildStatusPending,
+	})
+}
+
+func (q *buildQueue) countBuilds(where sq.Eq) (map[int]int, error) {
+	rows, err := psql.Select("team_id", "COUNT(*)").
+		From("builds").
+		Where(where).
+		GroupBy("team_id").
+		RunWith(q.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	active := map[int]int{}
+	for rows.Next() {
+		var teamID, count int
+		err = rows.Scan(&teamID, &count)
+		if err != nil {
+			return nil, err
+		}
+
+		active[teamID] = count
+	}
+
+	return active, nil
+}
+
+func (q *buildQueue) Update(admitted []int, positions map[int]int) error {
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Update("builds").
+		Set("queue_position", nil).
+		Where(sq.Eq{
+			"queued": true,
+			"status": BuildStatusPending,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	if len(admitted) > 0 {
+		_, err = psql.Update("builds").
+			Set("admitted", true).
+			Where(sq.Eq{"id": admitted}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	for buildID, position := range positions {
+		_, err = psql.Update("builds").
+			Set("queue_position", position).
+			Where(sq.Eq{"id": buildID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
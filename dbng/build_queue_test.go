@@ -0,0 +1,247 @@
+package dbng_test
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var (
+		buildQueue dbng.BuildQueue
+
+		build      dbng.Build
+		otherBuild dbng.Build
+	)
+
+	BeforeEach(func() {
+		buildQueue = dbng.NewBuildQueue(dbConn)
+
+		var err error
+		build, err = defaultPipeline.CreateJobBuild("some-job")
+		Expect(err).NotTo(HaveOccurred())
+
+		otherBuild, err = defaultPipeline.CreateJobBuild("some-job")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("Enqueue", func() {
+		It("queues the build without admitting it", func() {
+			admitted, err := buildQueue.Enqueue(build.ID(), 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(Equal([]dbng.BuildQueueEntry{
+				{
+					BuildID:  build.ID(),
+					TeamID:   defaultTeam.ID(),
+					Priority: 5,
+					Weight:   atc.DefaultSchedulingWeight,
+				},
+			}))
+		})
+
+		It("returns whether the build has been admitted", func() {
+			_, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = buildQueue.Update([]int{build.ID()}, map[int]int{})
+			Expect(err).NotTo(HaveOccurred())
+
+			admitted, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+		})
+
+		It("returns false when the build is no longer pending", func() {
+			Expect(build.Abort()).To(Succeed())
+
+			admitted, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeFalse())
+		})
+	})
+
+	Describe("Entries", func() {
+		BeforeEach(func() {
+			_, err := buildQueue.Enqueue(build.ID(), 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = buildQueue.Enqueue(otherBuild.ID(), 2)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the queued builds oldest first", func() {
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].BuildID).To(Equal(build.ID()))
+			Expect(entries[1].BuildID).To(Equal(otherBuild.ID()))
+		})
+
+		It("adds the team's priority and includes its weight", func() {
+			err := defaultTeam.UpdateScheduling(atc.TeamScheduling{Weight: 3, Priority: 10})
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries[0].Priority).To(Equal(11))
+			Expect(entries[0].Weight).To(Equal(3))
+		})
+
+		It("omits started builds", func() {
+			started, err := build.Start("engine", "metadata")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].BuildID).To(Equal(otherBuild.ID()))
+		})
+
+		It("omits builds that have been admitted", func() {
+			err := buildQueue.Update([]int{build.ID()}, map[int]int{otherBuild.ID(): 1})
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].BuildID).To(Equal(otherBuild.ID()))
+		})
+
+		It("omits one-off builds, which start without being queued", func() {
+			_, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+		})
+
+		It("omits builds of paused jobs", func() {
+			Expect(defaultPipeline.PauseJob("some-job")).To(Succeed())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("omits builds of paused pipelines", func() {
+			Expect(defaultPipeline.Pause()).To(Succeed())
+
+			entries, err := buildQueue.Entries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+
+	Describe("ActiveBuilds", func() {
+		It("counts the started builds of each team, including one-off builds", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).NotTo(HaveOccurred())
+
+			oneOffBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, b := range []dbng.Build{build, otherBuild, oneOffBuild} {
+				started, err := b.Start("engine", "metadata")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+			}
+
+			_, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			active, err := buildQueue.ActiveBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(active).To(Equal(map[int]int{
+				defaultTeam.ID(): 2,
+				otherTeam.ID():   1,
+			}))
+		})
+	})
+
+	Describe("AdmittedBuilds", func() {
+		It("counts the builds of each team that were admitted but have not started", func() {
+			_, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = buildQueue.Enqueue(otherBuild.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = buildQueue.Update([]int{build.ID(), otherBuild.ID()}, map[int]int{})
+			Expect(err).NotTo(HaveOccurred())
+
+			admitted, err := buildQueue.AdmittedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(Equal(map[int]int{defaultTeam.ID(): 2}))
+
+			started, err := build.Start("engine", "metadata")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			admitted, err = buildQueue.AdmittedBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(Equal(map[int]int{defaultTeam.ID(): 1}))
+		})
+	})
+
+	Describe("Update", func() {
+		BeforeEach(func() {
+			_, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = buildQueue.Enqueue(otherBuild.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = buildQueue.Update([]int{build.ID()}, map[int]int{otherBuild.ID(): 1})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sets the queue position of the waiting builds", func() {
+			found, err := otherBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(otherBuild.QueuePosition()).To(Equal(1))
+
+			found, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.QueuePosition()).To(BeZero())
+		})
+
+		It("keeps admitting builds that were admitted before, as they may be starting", func() {
+			err := buildQueue.Update([]int{otherBuild.ID()}, map[int]int{})
+			Expect(err).NotTo(HaveOccurred())
+
+			admitted, err := buildQueue.Enqueue(build.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+
+			admitted, err = buildQueue.Enqueue(otherBuild.ID(), 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+
+			found, err := otherBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(otherBuild.QueuePosition()).To(BeZero())
+		})
+
+		It("does not report a queue position once the build has started", func() {
+			started, err := otherBuild.Start("engine", "metadata")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			found, err := otherBuild.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(otherBuild.QueuePosition()).To(BeZero())
+		})
+	})
+})
@@ -114,6 +114,262 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("PreviousStatus", func() {
+		var pipeline dbng.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+					{Name: "some-other-job"},
+				},
+			}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns false for one-off builds", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := build.PreviousStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false for the first build of a job", func() {
+			build, err := pipeline.CreateJobBuild("some-job")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := build.PreviousStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the status of the latest finished build of the same job", func() {
+			failedBuild, err := pipeline.CreateJobBuild("some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(failedBuild.Finish(dbng.BuildStatusFailed)).To(Succeed())
+
+			_, err = pipeline.CreateJobBuild("some-job")
+			Expect(err).NotTo(HaveOccurred())
+
+			otherJobBuild, err := pipeline.CreateJobBuild("some-other-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherJobBuild.Finish(dbng.BuildStatusSucceeded)).To(Succeed())
+
+			build, err := pipeline.CreateJobBuild("some-job")
+			Expect(err).NotTo(HaveOccurred())
+
+			status, found, err := build.PreviousStatus()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(status).To(Equal(dbng.BuildStatusFailed))
+		})
+	})
+
+	Describe("NotificationDeliveries", func() {
+		var build dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns no deliveries by default", func() {
+			deliveries, err := build.NotificationDeliveries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+		})
+
+		It("returns the deliveries saved for the build in order", func() {
+			err := build.SaveNotificationDelivery(dbng.NotificationDelivery{
+				Notification: "some-webhook",
+				Attempt:      1,
+				Delivered:    false,
+				Error:        "connection refused",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveNotificationDelivery(dbng.NotificationDelivery{
+				Notification: "some-webhook",
+				Attempt:      2,
+				Delivered:    true,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			deliveries, err := build.NotificationDeliveries()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(HaveLen(2))
+
+			Expect(deliveries[0].Notification).To(Equal("some-webhook"))
+			Expect(deliveries[0].Attempt).To(Equal(1))
+			Expect(deliveries[0].Delivered).To(BeFalse())
+			Expect(deliveries[0].Error).To(Equal("connection refused"))
+			Expect(deliveries[0].CreatedAt).NotTo(BeZero())
+
+			Expect(deliveries[1].Attempt).To(Equal(2))
+			Expect(deliveries[1].Delivered).To(BeTrue())
+			Expect(deliveries[1].Error).To(BeEmpty())
+		})
+	})
+
+	Describe("Approvals", func() {
+		var build dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no decision for a step that never requested one", func() {
+			_, found, err := build.Approval("some-plan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("cannot decide a step that never requested approval", func() {
+			decided, err := build.DecideApproval("some-plan", true, "some-team", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decided).To(BeFalse())
+		})
+
+		Context("when approval has been requested", func() {
+			BeforeEach(func() {
+				err := build.RequestApproval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("can be requested again", func() {
+				err := build.RequestApproval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("has no decision yet", func() {
+				_, found, err := build.Approval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("records the decision and who made it", func() {
+				decided, err := build.DecideApproval("some-plan", false, "some-team", "not on a friday")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeTrue())
+
+				approval, found, err := build.Approval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(approval.Approved).To(BeFalse())
+				Expect(approval.Approver).To(Equal("some-team"))
+				Expect(approval.Comment).To(Equal("not on a friday"))
+				Expect(approval.DecidedAt).NotTo(BeZero())
+			})
+
+			It("can only be decided once", func() {
+				decided, err := build.DecideApproval("some-plan", true, "some-team", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeTrue())
+
+				decided, err = build.DecideApproval("some-plan", false, "other-team", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decided).To(BeFalse())
+
+				approval, _, err := build.Approval("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(approval.Approved).To(BeTrue())
+				Expect(approval.Approver).To(Equal("some-team"))
+			})
+
+			It("does not decide other steps", func() {
+				err := build.RequestApproval("other-plan")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.DecideApproval("some-plan", true, "some-team", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, found, err := build.Approval("other-plan")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("notifies once the step is decided", func() {
+				notifier, err := build.ApprovalNotifier("some-plan")
+				Expect(err).NotTo(HaveOccurred())
+
+				defer notifier.Close()
+
+				Consistently(notifier.Notify()).ShouldNot(Receive())
+
+				_, err = build.DecideApproval("some-plan", true, "some-team", "")
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(notifier.Notify()).Should(Receive())
+			})
+		})
+	})
+
+	Describe("Annotations", func() {
+		var build dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no annotations to begin with", func() {
+			annotations, err := build.Annotations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(BeEmpty())
+		})
+
+		It("returns saved annotations ordered by name", func() {
+			err := build.SaveAnnotations("unit", map[string]string{
+				"tests":    "120",
+				"coverage": "87.5",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			annotations, err := build.Annotations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(Equal([]atc.BuildAnnotation{
+				{Step: "unit", Name: "coverage", Value: "87.5"},
+				{Step: "unit", Name: "tests", Value: "120"},
+			}))
+		})
+
+		It("replaces annotations of the same name, keeping the rest", func() {
+			err := build.SaveAnnotations("unit", map[string]string{
+				"tests":    "120",
+				"coverage": "87.5",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveAnnotations("integration", map[string]string{
+				"tests": "30",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			annotations, err := build.Annotations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(Equal([]atc.BuildAnnotation{
+				{Step: "unit", Name: "coverage", Value: "87.5"},
+				{Step: "integration", Name: "tests", Value: "30"},
+			}))
+		})
+
+		It("does nothing when there are no annotations to save", func() {
+			err := build.SaveAnnotations("unit", map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+
+			annotations, err := build.Annotations()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(BeEmpty())
+		})
+	})
+
 	Describe("Abort", func() {
 		var build dbng.Build
 		BeforeEach(func() {
@@ -133,6 +389,115 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Supersede", func() {
+		It("returns when the build was first superseded", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			supersededAt, err := build.Supersede()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(supersededAt).NotTo(BeZero())
+
+			Expect(build.Supersede()).To(Equal(supersededAt))
+		})
+	})
+
+	Describe("ClaimAbort", func() {
+		var build dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("saves the reason on the build", func() {
+			Expect(build.ClaimAbort("superseded")).To(BeTrue())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.AbortReason()).To(Equal("superseded"))
+		})
+
+		It("keeps puts from starting", func() {
+			Expect(build.ClaimAbort("superseded")).To(BeTrue())
+			Expect(build.StartPut()).To(BeFalse())
+		})
+
+		Context("while a put is running", func() {
+			BeforeEach(func() {
+				Expect(build.StartPut()).To(BeTrue())
+			})
+
+			It("does not claim the abort", func() {
+				Expect(build.ClaimAbort("superseded")).To(BeFalse())
+
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.AbortReason()).To(BeEmpty())
+			})
+
+			It("claims the abort once the put has finished", func() {
+				Expect(build.FinishPut()).To(Succeed())
+				Expect(build.ClaimAbort("superseded")).To(BeTrue())
+			})
+
+			It("waits for every running put to finish", func() {
+				Expect(build.StartPut()).To(BeTrue())
+				Expect(build.FinishPut()).To(Succeed())
+				Expect(build.ClaimAbort("superseded")).To(BeFalse())
+
+				Expect(build.FinishPut()).To(Succeed())
+				Expect(build.ClaimAbort("superseded")).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("StepCheckpoint", func() {
+		var build dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("is not found until the step saves one", func() {
+			_, found, err := build.StepCheckpoint("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns the checkpoint saved by the step", func() {
+			err := build.SaveStepCheckpoint("some-plan-id", json.RawMessage(`{"some":"checkpoint"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			checkpoint, found, err := build.StepCheckpoint("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(checkpoint).To(MatchJSON(`{"some":"checkpoint"}`))
+
+			_, found, err = build.StepCheckpoint("other-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("keeps the first checkpoint of each step", func() {
+			err := build.SaveStepCheckpoint("some-plan-id", json.RawMessage(`{"some":"checkpoint"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveStepCheckpoint("some-plan-id", json.RawMessage(`{"other":"checkpoint"}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			checkpoint, found, err := build.StepCheckpoint("some-plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(checkpoint).To(MatchJSON(`{"some":"checkpoint"}`))
+		})
+	})
+
 	Describe("Events", func() {
 		It("saves and emits status events", func() {
 			build, err := team.CreateOneOffBuild()
@@ -274,7 +639,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -370,7 +735,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 		Context("when a job build", func() {
@@ -500,7 +865,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 
 				Expect(err).ToNot(HaveOccurred())
 
@@ -600,7 +965,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 
 				Expect(err).ToNot(HaveOccurred())
 				build, err = pipeline.CreateJobBuild("some-job")
@@ -773,7 +1138,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(2), dbng.PipelineUnpaused)
+					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(2), dbng.PipelineUnpaused, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = pipeline.SaveResourceVersions(
@@ -848,7 +1213,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 
 				Expect(err).ToNot(HaveOccurred())
 
@@ -929,7 +1294,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build, err = pipeline.CreateJobBuild("some-job")
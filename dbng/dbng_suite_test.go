@@ -142,7 +142,7 @@ var _ = BeforeEach(func() {
 				},
 			},
 		},
-	}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+	}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
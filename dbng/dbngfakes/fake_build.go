@@ -2,6 +2,7 @@
 package dbngfakes
 
 import (
+	"encoding/json"
 	"sync"
 	"time"
 
@@ -138,6 +139,24 @@ type FakeBuild struct {
 	reapTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	AbortReasonStub        func() string
+	abortReasonMutex       sync.RWMutex
+	abortReasonArgsForCall []struct{}
+	abortReasonReturns     struct {
+		result1 string
+	}
+	abortReasonReturnsOnCall map[int]struct {
+		result1 string
+	}
+	QueuePositionStub        func() int
+	queuePositionMutex       sync.RWMutex
+	queuePositionArgsForCall []struct{}
+	queuePositionReturns     struct {
+		result1 int
+	}
+	queuePositionReturnsOnCall map[int]struct {
+		result1 int
+	}
 	IsManuallyTriggeredStub        func() bool
 	isManuallyTriggeredMutex       sync.RWMutex
 	isManuallyTriggeredArgsForCall []struct{}
@@ -358,6 +377,33 @@ type FakeBuild struct {
 	saveImageResourceVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveStepCheckpointStub        func(planID atc.PlanID, checkpoint json.RawMessage) error
+	saveStepCheckpointMutex       sync.RWMutex
+	saveStepCheckpointArgsForCall []struct {
+		planID     atc.PlanID
+		checkpoint json.RawMessage
+	}
+	saveStepCheckpointReturns struct {
+		result1 error
+	}
+	saveStepCheckpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StepCheckpointStub        func(planID atc.PlanID) (json.RawMessage, bool, error)
+	stepCheckpointMutex       sync.RWMutex
+	stepCheckpointArgsForCall []struct {
+		planID atc.PlanID
+	}
+	stepCheckpointReturns struct {
+		result1 json.RawMessage
+		result2 bool
+		result3 error
+	}
+	stepCheckpointReturnsOnCall map[int]struct {
+		result1 json.RawMessage
+		result2 bool
+		result3 error
+	}
 	PipelineStub        func() (dbng.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct{}
@@ -371,6 +417,119 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	PreviousStatusStub        func() (dbng.BuildStatus, bool, error)
+	previousStatusMutex       sync.RWMutex
+	previousStatusArgsForCall []struct{}
+	previousStatusReturns     struct {
+		result1 dbng.BuildStatus
+		result2 bool
+		result3 error
+	}
+	previousStatusReturnsOnCall map[int]struct {
+		result1 dbng.BuildStatus
+		result2 bool
+		result3 error
+	}
+	SaveNotificationDeliveryStub        func(delivery dbng.NotificationDelivery) error
+	saveNotificationDeliveryMutex       sync.RWMutex
+	saveNotificationDeliveryArgsForCall []struct {
+		delivery dbng.NotificationDelivery
+	}
+	saveNotificationDeliveryReturns struct {
+		result1 error
+	}
+	saveNotificationDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	NotificationDeliveriesStub        func() ([]dbng.NotificationDelivery, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct{}
+	notificationDeliveriesReturns     struct {
+		result1 []dbng.NotificationDelivery
+		result2 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []dbng.NotificationDelivery
+		result2 error
+	}
+	RequestApprovalStub        func(planID atc.PlanID) error
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct {
+		planID atc.PlanID
+	}
+	requestApprovalReturns struct {
+		result1 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApprovalStub        func(planID atc.PlanID) (dbng.BuildApproval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		planID atc.PlanID
+	}
+	approvalReturns struct {
+		result1 dbng.BuildApproval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 dbng.BuildApproval
+		result2 bool
+		result3 error
+	}
+	DecideApprovalStub        func(planID atc.PlanID, approved bool, approver string, comment string) (bool, error)
+	decideApprovalMutex       sync.RWMutex
+	decideApprovalArgsForCall []struct {
+		planID   atc.PlanID
+		approved bool
+		approver string
+		comment  string
+	}
+	decideApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	decideApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ApprovalNotifierStub        func(planID atc.PlanID) (dbng.Notifier, error)
+	approvalNotifierMutex       sync.RWMutex
+	approvalNotifierArgsForCall []struct {
+		planID atc.PlanID
+	}
+	approvalNotifierReturns struct {
+		result1 dbng.Notifier
+		result2 error
+	}
+	approvalNotifierReturnsOnCall map[int]struct {
+		result1 dbng.Notifier
+		result2 error
+	}
+	SaveAnnotationsStub        func(step string, annotations map[string]string) error
+	saveAnnotationsMutex       sync.RWMutex
+	saveAnnotationsArgsForCall []struct {
+		step        string
+		annotations map[string]string
+	}
+	saveAnnotationsReturns struct {
+		result1 error
+	}
+	saveAnnotationsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AnnotationsStub        func() ([]atc.BuildAnnotation, error)
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct{}
+	annotationsReturns     struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}
 	FinishStub        func(s dbng.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -424,6 +583,50 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	SupersedeStub        func() (time.Time, error)
+	supersedeMutex       sync.RWMutex
+	supersedeArgsForCall []struct{}
+	supersedeReturns     struct {
+		result1 time.Time
+		result2 error
+	}
+	supersedeReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 error
+	}
+	ClaimAbortStub        func(reason string) (bool, error)
+	claimAbortMutex       sync.RWMutex
+	claimAbortArgsForCall []struct {
+		reason string
+	}
+	claimAbortReturns struct {
+		result1 bool
+		result2 error
+	}
+	claimAbortReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	StartPutStub        func() (bool, error)
+	startPutMutex       sync.RWMutex
+	startPutArgsForCall []struct{}
+	startPutReturns     struct {
+		result1 bool
+		result2 error
+	}
+	startPutReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FinishPutStub        func() error
+	finishPutMutex       sync.RWMutex
+	finishPutArgsForCall []struct{}
+	finishPutReturns     struct {
+		result1 error
+	}
+	finishPutReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -988,6 +1191,86 @@ func (fake *FakeBuild) ReapTimeReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeBuild) AbortReason() string {
+	fake.abortReasonMutex.Lock()
+	ret, specificReturn := fake.abortReasonReturnsOnCall[len(fake.abortReasonArgsForCall)]
+	fake.abortReasonArgsForCall = append(fake.abortReasonArgsForCall, struct{}{})
+	fake.recordInvocation("AbortReason", []interface{}{})
+	fake.abortReasonMutex.Unlock()
+	if fake.AbortReasonStub != nil {
+		return fake.AbortReasonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.abortReasonReturns.result1
+}
+
+func (fake *FakeBuild) AbortReasonCallCount() int {
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
+	return len(fake.abortReasonArgsForCall)
+}
+
+func (fake *FakeBuild) AbortReasonReturns(result1 string) {
+	fake.AbortReasonStub = nil
+	fake.abortReasonReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) AbortReasonReturnsOnCall(i int, result1 string) {
+	fake.AbortReasonStub = nil
+	if fake.abortReasonReturnsOnCall == nil {
+		fake.abortReasonReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.abortReasonReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) QueuePosition() int {
+	fake.queuePositionMutex.Lock()
+	ret, specificReturn := fake.queuePositionReturnsOnCall[len(fake.queuePositionArgsForCall)]
+	fake.queuePositionArgsForCall = append(fake.queuePositionArgsForCall, struct{}{})
+	fake.recordInvocation("QueuePosition", []interface{}{})
+	fake.queuePositionMutex.Unlock()
+	if fake.QueuePositionStub != nil {
+		return fake.QueuePositionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.queuePositionReturns.result1
+}
+
+func (fake *FakeBuild) QueuePositionCallCount() int {
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
+	return len(fake.queuePositionArgsForCall)
+}
+
+func (fake *FakeBuild) QueuePositionReturns(result1 int) {
+	fake.QueuePositionStub = nil
+	fake.queuePositionReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) QueuePositionReturnsOnCall(i int, result1 int) {
+	fake.QueuePositionStub = nil
+	if fake.queuePositionReturnsOnCall == nil {
+		fake.queuePositionReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.queuePositionReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) IsManuallyTriggered() bool {
 	fake.isManuallyTriggeredMutex.Lock()
 	ret, specificReturn := fake.isManuallyTriggeredReturnsOnCall[len(fake.isManuallyTriggeredArgsForCall)]
@@ -1879,6 +2162,109 @@ func (fake *FakeBuild) SaveImageResourceVersionReturnsOnCall(i int, result1 erro
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveStepCheckpoint(planID atc.PlanID, checkpoint json.RawMessage) error {
+	fake.saveStepCheckpointMutex.Lock()
+	ret, specificReturn := fake.saveStepCheckpointReturnsOnCall[len(fake.saveStepCheckpointArgsForCall)]
+	fake.saveStepCheckpointArgsForCall = append(fake.saveStepCheckpointArgsForCall, struct {
+		planID     atc.PlanID
+		checkpoint json.RawMessage
+	}{planID, checkpoint})
+	fake.recordInvocation("SaveStepCheckpoint", []interface{}{planID, checkpoint})
+	fake.saveStepCheckpointMutex.Unlock()
+	if fake.SaveStepCheckpointStub != nil {
+		return fake.SaveStepCheckpointStub(planID, checkpoint)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveStepCheckpointReturns.result1
+}
+
+func (fake *FakeBuild) SaveStepCheckpointCallCount() int {
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	return len(fake.saveStepCheckpointArgsForCall)
+}
+
+func (fake *FakeBuild) SaveStepCheckpointArgsForCall(i int) (atc.PlanID, json.RawMessage) {
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	return fake.saveStepCheckpointArgsForCall[i].planID, fake.saveStepCheckpointArgsForCall[i].checkpoint
+}
+
+func (fake *FakeBuild) SaveStepCheckpointReturns(result1 error) {
+	fake.SaveStepCheckpointStub = nil
+	fake.saveStepCheckpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveStepCheckpointReturnsOnCall(i int, result1 error) {
+	fake.SaveStepCheckpointStub = nil
+	if fake.saveStepCheckpointReturnsOnCall == nil {
+		fake.saveStepCheckpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveStepCheckpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) StepCheckpoint(planID atc.PlanID) (json.RawMessage, bool, error) {
+	fake.stepCheckpointMutex.Lock()
+	ret, specificReturn := fake.stepCheckpointReturnsOnCall[len(fake.stepCheckpointArgsForCall)]
+	fake.stepCheckpointArgsForCall = append(fake.stepCheckpointArgsForCall, struct {
+		planID atc.PlanID
+	}{planID})
+	fake.recordInvocation("StepCheckpoint", []interface{}{planID})
+	fake.stepCheckpointMutex.Unlock()
+	if fake.StepCheckpointStub != nil {
+		return fake.StepCheckpointStub(planID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.stepCheckpointReturns.result1, fake.stepCheckpointReturns.result2, fake.stepCheckpointReturns.result3
+}
+
+func (fake *FakeBuild) StepCheckpointCallCount() int {
+	fake.stepCheckpointMutex.RLock()
+	defer fake.stepCheckpointMutex.RUnlock()
+	return len(fake.stepCheckpointArgsForCall)
+}
+
+func (fake *FakeBuild) StepCheckpointArgsForCall(i int) atc.PlanID {
+	fake.stepCheckpointMutex.RLock()
+	defer fake.stepCheckpointMutex.RUnlock()
+	return fake.stepCheckpointArgsForCall[i].planID
+}
+
+func (fake *FakeBuild) StepCheckpointReturns(result1 json.RawMessage, result2 bool, result3 error) {
+	fake.StepCheckpointStub = nil
+	fake.stepCheckpointReturns = struct {
+		result1 json.RawMessage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) StepCheckpointReturnsOnCall(i int, result1 json.RawMessage, result2 bool, result3 error) {
+	fake.StepCheckpointStub = nil
+	if fake.stepCheckpointReturnsOnCall == nil {
+		fake.stepCheckpointReturnsOnCall = make(map[int]struct {
+			result1 json.RawMessage
+			result2 bool
+			result3 error
+		})
+	}
+	fake.stepCheckpointReturnsOnCall[i] = struct {
+		result1 json.RawMessage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) Pipeline() (dbng.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1925,6 +2311,442 @@ func (fake *FakeBuild) PipelineReturnsOnCall(i int, result1 dbng.Pipeline, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) PreviousStatus() (dbng.BuildStatus, bool, error) {
+	fake.previousStatusMutex.Lock()
+	ret, specificReturn := fake.previousStatusReturnsOnCall[len(fake.previousStatusArgsForCall)]
+	fake.previousStatusArgsForCall = append(fake.previousStatusArgsForCall, struct{}{})
+	fake.recordInvocation("PreviousStatus", []interface{}{})
+	fake.previousStatusMutex.Unlock()
+	if fake.PreviousStatusStub != nil {
+		return fake.PreviousStatusStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.previousStatusReturns.result1, fake.previousStatusReturns.result2, fake.previousStatusReturns.result3
+}
+
+func (fake *FakeBuild) PreviousStatusCallCount() int {
+	fake.previousStatusMutex.RLock()
+	defer fake.previousStatusMutex.RUnlock()
+	return len(fake.previousStatusArgsForCall)
+}
+
+func (fake *FakeBuild) PreviousStatusReturns(result1 dbng.BuildStatus, result2 bool, result3 error) {
+	fake.PreviousStatusStub = nil
+	fake.previousStatusReturns = struct {
+		result1 dbng.BuildStatus
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) PreviousStatusReturnsOnCall(i int, result1 dbng.BuildStatus, result2 bool, result3 error) {
+	fake.PreviousStatusStub = nil
+	if fake.previousStatusReturnsOnCall == nil {
+		fake.previousStatusReturnsOnCall = make(map[int]struct {
+			result1 dbng.BuildStatus
+			result2 bool
+			result3 error
+		})
+	}
+	fake.previousStatusReturnsOnCall[i] = struct {
+		result1 dbng.BuildStatus
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) SaveNotificationDelivery(delivery dbng.NotificationDelivery) error {
+	fake.saveNotificationDeliveryMutex.Lock()
+	ret, specificReturn := fake.saveNotificationDeliveryReturnsOnCall[len(fake.saveNotificationDeliveryArgsForCall)]
+	fake.saveNotificationDeliveryArgsForCall = append(fake.saveNotificationDeliveryArgsForCall, struct {
+		delivery dbng.NotificationDelivery
+	}{delivery})
+	fake.recordInvocation("SaveNotificationDelivery", []interface{}{delivery})
+	fake.saveNotificationDeliveryMutex.Unlock()
+	if fake.SaveNotificationDeliveryStub != nil {
+		return fake.SaveNotificationDeliveryStub(delivery)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveNotificationDeliveryReturns.result1
+}
+
+func (fake *FakeBuild) SaveNotificationDeliveryCallCount() int {
+	fake.saveNotificationDeliveryMutex.RLock()
+	defer fake.saveNotificationDeliveryMutex.RUnlock()
+	return len(fake.saveNotificationDeliveryArgsForCall)
+}
+
+func (fake *FakeBuild) SaveNotificationDeliveryArgsForCall(i int) dbng.NotificationDelivery {
+	fake.saveNotificationDeliveryMutex.RLock()
+	defer fake.saveNotificationDeliveryMutex.RUnlock()
+	return fake.saveNotificationDeliveryArgsForCall[i].delivery
+}
+
+func (fake *FakeBuild) SaveNotificationDeliveryReturns(result1 error) {
+	fake.SaveNotificationDeliveryStub = nil
+	fake.saveNotificationDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveNotificationDeliveryReturnsOnCall(i int, result1 error) {
+	fake.SaveNotificationDeliveryStub = nil
+	if fake.saveNotificationDeliveryReturnsOnCall == nil {
+		fake.saveNotificationDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveNotificationDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) NotificationDeliveries() ([]dbng.NotificationDelivery, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct{}{})
+	fake.recordInvocation("NotificationDeliveries", []interface{}{})
+	fake.notificationDeliveriesMutex.Unlock()
+	if fake.NotificationDeliveriesStub != nil {
+		return fake.NotificationDeliveriesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.notificationDeliveriesReturns.result1, fake.notificationDeliveriesReturns.result2
+}
+
+func (fake *FakeBuild) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeBuild) NotificationDeliveriesReturns(result1 []dbng.NotificationDelivery, result2 error) {
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []dbng.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) NotificationDeliveriesReturnsOnCall(i int, result1 []dbng.NotificationDelivery, result2 error) {
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []dbng.NotificationDelivery
+			result2 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []dbng.NotificationDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) RequestApproval(planID atc.PlanID) error {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct {
+		planID atc.PlanID
+	}{planID})
+	fake.recordInvocation("RequestApproval", []interface{}{planID})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub(planID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestApprovalReturns.result1
+}
+
+func (fake *FakeBuild) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) RequestApprovalArgsForCall(i int) atc.PlanID {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return fake.requestApprovalArgsForCall[i].planID
+}
+
+func (fake *FakeBuild) RequestApprovalReturns(result1 error) {
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) RequestApprovalReturnsOnCall(i int, result1 error) {
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) Approval(planID atc.PlanID) (dbng.BuildApproval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		planID atc.PlanID
+	}{planID})
+	fake.recordInvocation("Approval", []interface{}{planID})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub(planID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.approvalReturns.result1, fake.approvalReturns.result2, fake.approvalReturns.result3
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalArgsForCall(i int) atc.PlanID {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return fake.approvalArgsForCall[i].planID
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 dbng.BuildApproval, result2 bool, result3 error) {
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 dbng.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 dbng.BuildApproval, result2 bool, result3 error) {
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 dbng.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 dbng.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) DecideApproval(planID atc.PlanID, approved bool, approver string, comment string) (bool, error) {
+	fake.decideApprovalMutex.Lock()
+	ret, specificReturn := fake.decideApprovalReturnsOnCall[len(fake.decideApprovalArgsForCall)]
+	fake.decideApprovalArgsForCall = append(fake.decideApprovalArgsForCall, struct {
+		planID   atc.PlanID
+		approved bool
+		approver string
+		comment  string
+	}{planID, approved, approver, comment})
+	fake.recordInvocation("DecideApproval", []interface{}{planID, approved, approver, comment})
+	fake.decideApprovalMutex.Unlock()
+	if fake.DecideApprovalStub != nil {
+		return fake.DecideApprovalStub(planID, approved, approver, comment)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.decideApprovalReturns.result1, fake.decideApprovalReturns.result2
+}
+
+func (fake *FakeBuild) DecideApprovalCallCount() int {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return len(fake.decideApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) DecideApprovalArgsForCall(i int) (atc.PlanID, bool, string, string) {
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	return fake.decideApprovalArgsForCall[i].planID, fake.decideApprovalArgsForCall[i].approved, fake.decideApprovalArgsForCall[i].approver, fake.decideApprovalArgsForCall[i].comment
+}
+
+func (fake *FakeBuild) DecideApprovalReturns(result1 bool, result2 error) {
+	fake.DecideApprovalStub = nil
+	fake.decideApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) DecideApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.DecideApprovalStub = nil
+	if fake.decideApprovalReturnsOnCall == nil {
+		fake.decideApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.decideApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalNotifier(planID atc.PlanID) (dbng.Notifier, error) {
+	fake.approvalNotifierMutex.Lock()
+	ret, specificReturn := fake.approvalNotifierReturnsOnCall[len(fake.approvalNotifierArgsForCall)]
+	fake.approvalNotifierArgsForCall = append(fake.approvalNotifierArgsForCall, struct {
+		planID atc.PlanID
+	}{planID})
+	fake.recordInvocation("ApprovalNotifier", []interface{}{planID})
+	fake.approvalNotifierMutex.Unlock()
+	if fake.ApprovalNotifierStub != nil {
+		return fake.ApprovalNotifierStub(planID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.approvalNotifierReturns.result1, fake.approvalNotifierReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalNotifierCallCount() int {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return len(fake.approvalNotifierArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalNotifierArgsForCall(i int) atc.PlanID {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return fake.approvalNotifierArgsForCall[i].planID
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturns(result1 dbng.Notifier, result2 error) {
+	fake.ApprovalNotifierStub = nil
+	fake.approvalNotifierReturns = struct {
+		result1 dbng.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturnsOnCall(i int, result1 dbng.Notifier, result2 error) {
+	fake.ApprovalNotifierStub = nil
+	if fake.approvalNotifierReturnsOnCall == nil {
+		fake.approvalNotifierReturnsOnCall = make(map[int]struct {
+			result1 dbng.Notifier
+			result2 error
+		})
+	}
+	fake.approvalNotifierReturnsOnCall[i] = struct {
+		result1 dbng.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SaveAnnotations(step string, annotations map[string]string) error {
+	fake.saveAnnotationsMutex.Lock()
+	ret, specificReturn := fake.saveAnnotationsReturnsOnCall[len(fake.saveAnnotationsArgsForCall)]
+	fake.saveAnnotationsArgsForCall = append(fake.saveAnnotationsArgsForCall, struct {
+		step        string
+		annotations map[string]string
+	}{step, annotations})
+	fake.recordInvocation("SaveAnnotations", []interface{}{step, annotations})
+	fake.saveAnnotationsMutex.Unlock()
+	if fake.SaveAnnotationsStub != nil {
+		return fake.SaveAnnotationsStub(step, annotations)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveAnnotationsReturns.result1
+}
+
+func (fake *FakeBuild) SaveAnnotationsCallCount() int {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	return len(fake.saveAnnotationsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveAnnotationsArgsForCall(i int) (string, map[string]string) {
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	return fake.saveAnnotationsArgsForCall[i].step, fake.saveAnnotationsArgsForCall[i].annotations
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturns(result1 error) {
+	fake.SaveAnnotationsStub = nil
+	fake.saveAnnotationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveAnnotationsReturnsOnCall(i int, result1 error) {
+	fake.SaveAnnotationsStub = nil
+	if fake.saveAnnotationsReturnsOnCall == nil {
+		fake.saveAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAnnotationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) Annotations() ([]atc.BuildAnnotation, error) {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct{}{})
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if fake.AnnotationsStub != nil {
+		return fake.AnnotationsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.annotationsReturns.result1, fake.annotationsReturns.result2
+}
+
+func (fake *FakeBuild) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotationsReturns(result1 []atc.BuildAnnotation, result2 error) {
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) AnnotationsReturnsOnCall(i int, result1 []atc.BuildAnnotation, result2 error) {
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildAnnotation
+			result2 error
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Finish(s dbng.BuildStatus) error {
 	fake.finishMutex.Lock()
 	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
@@ -2142,6 +2964,183 @@ func (fake *FakeBuild) ScheduleReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) Supersede() (time.Time, error) {
+	fake.supersedeMutex.Lock()
+	ret, specificReturn := fake.supersedeReturnsOnCall[len(fake.supersedeArgsForCall)]
+	fake.supersedeArgsForCall = append(fake.supersedeArgsForCall, struct{}{})
+	fake.recordInvocation("Supersede", []interface{}{})
+	fake.supersedeMutex.Unlock()
+	if fake.SupersedeStub != nil {
+		return fake.SupersedeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.supersedeReturns.result1, fake.supersedeReturns.result2
+}
+
+func (fake *FakeBuild) SupersedeCallCount() int {
+	fake.supersedeMutex.RLock()
+	defer fake.supersedeMutex.RUnlock()
+	return len(fake.supersedeArgsForCall)
+}
+
+func (fake *FakeBuild) SupersedeReturns(result1 time.Time, result2 error) {
+	fake.SupersedeStub = nil
+	fake.supersedeReturns = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SupersedeReturnsOnCall(i int, result1 time.Time, result2 error) {
+	fake.SupersedeStub = nil
+	if fake.supersedeReturnsOnCall == nil {
+		fake.supersedeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 error
+		})
+	}
+	fake.supersedeReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ClaimAbort(reason string) (bool, error) {
+	fake.claimAbortMutex.Lock()
+	ret, specificReturn := fake.claimAbortReturnsOnCall[len(fake.claimAbortArgsForCall)]
+	fake.claimAbortArgsForCall = append(fake.claimAbortArgsForCall, struct {
+		reason string
+	}{reason})
+	fake.recordInvocation("ClaimAbort", []interface{}{reason})
+	fake.claimAbortMutex.Unlock()
+	if fake.ClaimAbortStub != nil {
+		return fake.ClaimAbortStub(reason)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.claimAbortReturns.result1, fake.claimAbortReturns.result2
+}
+
+func (fake *FakeBuild) ClaimAbortCallCount() int {
+	fake.claimAbortMutex.RLock()
+	defer fake.claimAbortMutex.RUnlock()
+	return len(fake.claimAbortArgsForCall)
+}
+
+func (fake *FakeBuild) ClaimAbortArgsForCall(i int) string {
+	fake.claimAbortMutex.RLock()
+	defer fake.claimAbortMutex.RUnlock()
+	return fake.claimAbortArgsForCall[i].reason
+}
+
+func (fake *FakeBuild) ClaimAbortReturns(result1 bool, result2 error) {
+	fake.ClaimAbortStub = nil
+	fake.claimAbortReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ClaimAbortReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.ClaimAbortStub = nil
+	if fake.claimAbortReturnsOnCall == nil {
+		fake.claimAbortReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.claimAbortReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) StartPut() (bool, error) {
+	fake.startPutMutex.Lock()
+	ret, specificReturn := fake.startPutReturnsOnCall[len(fake.startPutArgsForCall)]
+	fake.startPutArgsForCall = append(fake.startPutArgsForCall, struct{}{})
+	fake.recordInvocation("StartPut", []interface{}{})
+	fake.startPutMutex.Unlock()
+	if fake.StartPutStub != nil {
+		return fake.StartPutStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.startPutReturns.result1, fake.startPutReturns.result2
+}
+
+func (fake *FakeBuild) StartPutCallCount() int {
+	fake.startPutMutex.RLock()
+	defer fake.startPutMutex.RUnlock()
+	return len(fake.startPutArgsForCall)
+}
+
+func (fake *FakeBuild) StartPutReturns(result1 bool, result2 error) {
+	fake.StartPutStub = nil
+	fake.startPutReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) StartPutReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.StartPutStub = nil
+	if fake.startPutReturnsOnCall == nil {
+		fake.startPutReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.startPutReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) FinishPut() error {
+	fake.finishPutMutex.Lock()
+	ret, specificReturn := fake.finishPutReturnsOnCall[len(fake.finishPutArgsForCall)]
+	fake.finishPutArgsForCall = append(fake.finishPutArgsForCall, struct{}{})
+	fake.recordInvocation("FinishPut", []interface{}{})
+	fake.finishPutMutex.Unlock()
+	if fake.FinishPutStub != nil {
+		return fake.FinishPutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.finishPutReturns.result1
+}
+
+func (fake *FakeBuild) FinishPutCallCount() int {
+	fake.finishPutMutex.RLock()
+	defer fake.finishPutMutex.RUnlock()
+	return len(fake.finishPutArgsForCall)
+}
+
+func (fake *FakeBuild) FinishPutReturns(result1 error) {
+	fake.FinishPutStub = nil
+	fake.finishPutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) FinishPutReturnsOnCall(i int, result1 error) {
+	fake.FinishPutStub = nil
+	if fake.finishPutReturnsOnCall == nil {
+		fake.finishPutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.finishPutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2173,6 +3172,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.endTimeMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
+	fake.abortReasonMutex.RLock()
+	defer fake.abortReasonMutex.RUnlock()
+	fake.queuePositionMutex.RLock()
+	defer fake.queuePositionMutex.RUnlock()
 	fake.isManuallyTriggeredMutex.RLock()
 	defer fake.isManuallyTriggeredMutex.RUnlock()
 	fake.isScheduledMutex.RLock()
@@ -2211,8 +3214,30 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.getVersionedResourcesMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
 	defer fake.saveImageResourceVersionMutex.RUnlock()
+	fake.saveStepCheckpointMutex.RLock()
+	defer fake.saveStepCheckpointMutex.RUnlock()
+	fake.stepCheckpointMutex.RLock()
+	defer fake.stepCheckpointMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
+	fake.previousStatusMutex.RLock()
+	defer fake.previousStatusMutex.RUnlock()
+	fake.saveNotificationDeliveryMutex.RLock()
+	defer fake.saveNotificationDeliveryMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.decideApprovalMutex.RLock()
+	defer fake.decideApprovalMutex.RUnlock()
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	fake.saveAnnotationsMutex.RLock()
+	defer fake.saveAnnotationsMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.deleteMutex.RLock()
@@ -2223,6 +3248,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.scheduleMutex.RLock()
 	defer fake.scheduleMutex.RUnlock()
+	fake.supersedeMutex.RLock()
+	defer fake.supersedeMutex.RUnlock()
+	fake.claimAbortMutex.RLock()
+	defer fake.claimAbortMutex.RUnlock()
+	fake.startPutMutex.RLock()
+	defer fake.startPutMutex.RUnlock()
+	fake.finishPutMutex.RLock()
+	defer fake.finishPutMutex.RUnlock()
 	return fake.invocations
 }
 
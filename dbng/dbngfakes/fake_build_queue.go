@@ -0,0 +1,337 @@
+// This file was generated by counterfeiter
+package dbngfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc/dbng"
+)
+
+type FakeBuildQueue struct {
+	EnqueueStub        func(buildID int, priority int) (bool, error)
+	enqueueMutex       sync.RWMutex
+	enqueueArgsForCall []struct {
+		buildID  int
+		priority int
+	}
+	enqueueReturns struct {
+		result1 bool
+		result2 error
+	}
+	enqueueReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	EntriesStub        func() ([]dbng.BuildQueueEntry, error)
+	entriesMutex       sync.RWMutex
+	entriesArgsForCall []struct{}
+	entriesReturns     struct {
+		result1 []dbng.BuildQueueEntry
+		result2 error
+	}
+	entriesReturnsOnCall map[int]struct {
+		result1 []dbng.BuildQueueEntry
+		result2 error
+	}
+	ActiveBuildsStub        func() (map[int]int, error)
+	activeBuildsMutex       sync.RWMutex
+	activeBuildsArgsForCall []struct{}
+	activeBuildsReturns     struct {
+		result1 map[int]int
+		result2 error
+	}
+	activeBuildsReturnsOnCall map[int]struct {
+		result1 map[int]int
+		result2 error
+	}
+	AdmittedBuildsStub        func() (map[int]int, error)
+	admittedBuildsMutex       sync.RWMutex
+	admittedBuildsArgsForCall []struct{}
+	admittedBuildsReturns     struct {
+		result1 map[int]int
+		result2 error
+	}
+	admittedBuildsReturnsOnCall map[int]struct {
+		result1 map[int]int
+		result2 error
+	}
+	UpdateStub        func(admitted []int, positions map[int]int) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		admitted  []int
+		positions map[int]int
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildQueue) Enqueue(buildID int, priority int) (bool, error) {
+	fake.enqueueMutex.Lock()
+	ret, specificReturn := fake.enqueueReturnsOnCall[len(fake.enqueueArgsForCall)]
+	fake.enqueueArgsForCall = append(fake.enqueueArgsForCall, struct {
+		buildID  int
+		priority int
+	}{buildID, priority})
+	fake.recordInvocation("Enqueue", []interface{}{buildID, priority})
+	fake.enqueueMutex.Unlock()
+	if fake.EnqueueStub != nil {
+		return fake.EnqueueStub(buildID, priority)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.enqueueReturns.result1, fake.enqueueReturns.result2
+}
+
+func (fake *FakeBuildQueue) EnqueueCallCount() int {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	return len(fake.enqueueArgsForCall)
+}
+
+func (fake *FakeBuildQueue) EnqueueArgsForCall(i int) (int, int) {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	return fake.enqueueArgsForCall[i].buildID, fake.enqueueArgsForCall[i].priority
+}
+
+func (fake *FakeBuildQueue) EnqueueReturns(result1 bool, result2 error) {
+	fake.EnqueueStub = nil
+	fake.enqueueReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) EnqueueReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.EnqueueStub = nil
+	if fake.enqueueReturnsOnCall == nil {
+		fake.enqueueReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.enqueueReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) Entries() ([]dbng.BuildQueueEntry, error) {
+	fake.entriesMutex.Lock()
+	ret, specificReturn := fake.entriesReturnsOnCall[len(fake.entriesArgsForCall)]
+	fake.entriesArgsForCall = append(fake.entriesArgsForCall, struct{}{})
+	fake.recordInvocation("Entries", []interface{}{})
+	fake.entriesMutex.Unlock()
+	if fake.EntriesStub != nil {
+		return fake.EntriesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entriesReturns.result1, fake.entriesReturns.result2
+}
+
+func (fake *FakeBuildQueue) EntriesCallCount() int {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return len(fake.entriesArgsForCall)
+}
+
+func (fake *FakeBuildQueue) EntriesReturns(result1 []dbng.BuildQueueEntry, result2 error) {
+	fake.EntriesStub = nil
+	fake.entriesReturns = struct {
+		result1 []dbng.BuildQueueEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) EntriesReturnsOnCall(i int, result1 []dbng.BuildQueueEntry, result2 error) {
+	fake.EntriesStub = nil
+	if fake.entriesReturnsOnCall == nil {
+		fake.entriesReturnsOnCall = make(map[int]struct {
+			result1 []dbng.BuildQueueEntry
+			result2 error
+		})
+	}
+	fake.entriesReturnsOnCall[i] = struct {
+		result1 []dbng.BuildQueueEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) ActiveBuilds() (map[int]int, error) {
+	fake.activeBuildsMutex.Lock()
+	ret, specificReturn := fake.activeBuildsReturnsOnCall[len(fake.activeBuildsArgsForCall)]
+	fake.activeBuildsArgsForCall = append(fake.activeBuildsArgsForCall, struct{}{})
+	fake.recordInvocation("ActiveBuilds", []interface{}{})
+	fake.activeBuildsMutex.Unlock()
+	if fake.ActiveBuildsStub != nil {
+		return fake.ActiveBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.activeBuildsReturns.result1, fake.activeBuildsReturns.result2
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsCallCount() int {
+	fake.activeBuildsMutex.RLock()
+	defer fake.activeBuildsMutex.RUnlock()
+	return len(fake.activeBuildsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsReturns(result1 map[int]int, result2 error) {
+	fake.ActiveBuildsStub = nil
+	fake.activeBuildsReturns = struct {
+		result1 map[int]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) ActiveBuildsReturnsOnCall(i int, result1 map[int]int, result2 error) {
+	fake.ActiveBuildsStub = nil
+	if fake.activeBuildsReturnsOnCall == nil {
+		fake.activeBuildsReturnsOnCall = make(map[int]struct {
+			result1 map[int]int
+			result2 error
+		})
+	}
+	fake.activeBuildsReturnsOnCall[i] = struct {
+		result1 map[int]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) AdmittedBuilds() (map[int]int, error) {
+	fake.admittedBuildsMutex.Lock()
+	ret, specificReturn := fake.admittedBuildsReturnsOnCall[len(fake.admittedBuildsArgsForCall)]
+	fake.admittedBuildsArgsForCall = append(fake.admittedBuildsArgsForCall, struct{}{})
+	fake.recordInvocation("AdmittedBuilds", []interface{}{})
+	fake.admittedBuildsMutex.Unlock()
+	if fake.AdmittedBuildsStub != nil {
+		return fake.AdmittedBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.admittedBuildsReturns.result1, fake.admittedBuildsReturns.result2
+}
+
+func (fake *FakeBuildQueue) AdmittedBuildsCallCount() int {
+	fake.admittedBuildsMutex.RLock()
+	defer fake.admittedBuildsMutex.RUnlock()
+	return len(fake.admittedBuildsArgsForCall)
+}
+
+func (fake *FakeBuildQueue) AdmittedBuildsReturns(result1 map[int]int, result2 error) {
+	fake.AdmittedBuildsStub = nil
+	fake.admittedBuildsReturns = struct {
+		result1 map[int]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) AdmittedBuildsReturnsOnCall(i int, result1 map[int]int, result2 error) {
+	fake.AdmittedBuildsStub = nil
+	if fake.admittedBuildsReturnsOnCall == nil {
+		fake.admittedBuildsReturnsOnCall = make(map[int]struct {
+			result1 map[int]int
+			result2 error
+		})
+	}
+	fake.admittedBuildsReturnsOnCall[i] = struct {
+		result1 map[int]int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildQueue) Update(admitted []int, positions map[int]int) error {
+	var admittedCopy []int
+	if admitted != nil {
+		admittedCopy = make([]int, len(admitted))
+		copy(admittedCopy, admitted)
+	}
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		admitted  []int
+		positions map[int]int
+	}{admittedCopy, positions})
+	fake.recordInvocation("Update", []interface{}{admittedCopy, positions})
+	fake.updateMutex.Unlock()
+	if fake.UpdateStub != nil {
+		return fake.UpdateStub(admitted, positions)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
+}
+
+func (fake *FakeBuildQueue) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeBuildQueue) UpdateArgsForCall(i int) ([]int, map[int]int) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.updateArgsForCall[i].admitted, fake.updateArgsForCall[i].positions
+}
+
+func (fake *FakeBuildQueue) UpdateReturns(result1 error) {
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildQueue) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	fake.activeBuildsMutex.RLock()
+	defer fake.activeBuildsMutex.RUnlock()
+	fake.admittedBuildsMutex.RLock()
+	defer fake.admittedBuildsMutex.RUnlock()
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeBuildQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ dbng.BuildQueue = new(FakeBuildQueue)
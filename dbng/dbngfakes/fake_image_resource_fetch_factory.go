@@ -0,0 +1,164 @@
+// This file was generated by counterfeiter
+package dbngfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc/dbng"
+)
+
+type FakeImageResourceFetchFactory struct {
+	RecordFetchStub        func(fetch dbng.ImageResourceFetch, hit bool) error
+	recordFetchMutex       sync.RWMutex
+	recordFetchArgsForCall []struct {
+		fetch dbng.ImageResourceFetch
+		hit   bool
+	}
+	recordFetchReturns struct {
+		result1 error
+	}
+	recordFetchReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MostFetchedStub        func(teamID int, limit int) ([]dbng.ImageResourceFetch, error)
+	mostFetchedMutex       sync.RWMutex
+	mostFetchedArgsForCall []struct {
+		teamID int
+		limit  int
+	}
+	mostFetchedReturns struct {
+		result1 []dbng.ImageResourceFetch
+		result2 error
+	}
+	mostFetchedReturnsOnCall map[int]struct {
+		result1 []dbng.ImageResourceFetch
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImageResourceFetchFactory) RecordFetch(fetch dbng.ImageResourceFetch, hit bool) error {
+	fake.recordFetchMutex.Lock()
+	ret, specificReturn := fake.recordFetchReturnsOnCall[len(fake.recordFetchArgsForCall)]
+	fake.recordFetchArgsForCall = append(fake.recordFetchArgsForCall, struct {
+		fetch dbng.ImageResourceFetch
+		hit   bool
+	}{fetch, hit})
+	fake.recordInvocation("RecordFetch", []interface{}{fetch, hit})
+	fake.recordFetchMutex.Unlock()
+	if fake.RecordFetchStub != nil {
+		return fake.RecordFetchStub(fetch, hit)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recordFetchReturns.result1
+}
+
+func (fake *FakeImageResourceFetchFactory) RecordFetchCallCount() int {
+	fake.recordFetchMutex.RLock()
+	defer fake.recordFetchMutex.RUnlock()
+	return len(fake.recordFetchArgsForCall)
+}
+
+func (fake *FakeImageResourceFetchFactory) RecordFetchArgsForCall(i int) (dbng.ImageResourceFetch, bool) {
+	fake.recordFetchMutex.RLock()
+	defer fake.recordFetchMutex.RUnlock()
+	return fake.recordFetchArgsForCall[i].fetch, fake.recordFetchArgsForCall[i].hit
+}
+
+func (fake *FakeImageResourceFetchFactory) RecordFetchReturns(result1 error) {
+	fake.RecordFetchStub = nil
+	fake.recordFetchReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImageResourceFetchFactory) RecordFetchReturnsOnCall(i int, result1 error) {
+	fake.RecordFetchStub = nil
+	if fake.recordFetchReturnsOnCall == nil {
+		fake.recordFetchReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordFetchReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImageResourceFetchFactory) MostFetched(teamID int, limit int) ([]dbng.ImageResourceFetch, error) {
+	fake.mostFetchedMutex.Lock()
+	ret, specificReturn := fake.mostFetchedReturnsOnCall[len(fake.mostFetchedArgsForCall)]
+	fake.mostFetchedArgsForCall = append(fake.mostFetchedArgsForCall, struct {
+		teamID int
+		limit  int
+	}{teamID, limit})
+	fake.recordInvocation("MostFetched", []interface{}{teamID, limit})
+	fake.mostFetchedMutex.Unlock()
+	if fake.MostFetchedStub != nil {
+		return fake.MostFetchedStub(teamID, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.mostFetchedReturns.result1, fake.mostFetchedReturns.result2
+}
+
+func (fake *FakeImageResourceFetchFactory) MostFetchedCallCount() int {
+	fake.mostFetchedMutex.RLock()
+	defer fake.mostFetchedMutex.RUnlock()
+	return len(fake.mostFetchedArgsForCall)
+}
+
+func (fake *FakeImageResourceFetchFactory) MostFetchedArgsForCall(i int) (int, int) {
+	fake.mostFetchedMutex.RLock()
+	defer fake.mostFetchedMutex.RUnlock()
+	return fake.mostFetchedArgsForCall[i].teamID, fake.mostFetchedArgsForCall[i].limit
+}
+
+func (fake *FakeImageResourceFetchFactory) MostFetchedReturns(result1 []dbng.ImageResourceFetch, result2 error) {
+	fake.MostFetchedStub = nil
+	fake.mostFetchedReturns = struct {
+		result1 []dbng.ImageResourceFetch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageResourceFetchFactory) MostFetchedReturnsOnCall(i int, result1 []dbng.ImageResourceFetch, result2 error) {
+	fake.MostFetchedStub = nil
+	if fake.mostFetchedReturnsOnCall == nil {
+		fake.mostFetchedReturnsOnCall = make(map[int]struct {
+			result1 []dbng.ImageResourceFetch
+			result2 error
+		})
+	}
+	fake.mostFetchedReturnsOnCall[i] = struct {
+		result1 []dbng.ImageResourceFetch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImageResourceFetchFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordFetchMutex.RLock()
+	defer fake.recordFetchMutex.RUnlock()
+	fake.mostFetchedMutex.RLock()
+	defer fake.mostFetchedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeImageResourceFetchFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ dbng.ImageResourceFetchFactory = new(FakeImageResourceFetchFactory)
@@ -90,6 +90,20 @@ type FakeJob struct {
 	configReturnsOnCall map[int]struct {
 		result1 atc.JobConfig
 	}
+	AnnotationsStub        func(name string, limit int) ([]atc.BuildAnnotation, error)
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+		name  string
+		limit int
+	}
+	annotationsReturns struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -454,6 +468,58 @@ func (fake *FakeJob) ConfigReturnsOnCall(i int, result1 atc.JobConfig) {
 	}{result1}
 }
 
+func (fake *FakeJob) Annotations(name string, limit int) ([]atc.BuildAnnotation, error) {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+		name  string
+		limit int
+	}{name, limit})
+	fake.recordInvocation("Annotations", []interface{}{name, limit})
+	fake.annotationsMutex.Unlock()
+	if fake.AnnotationsStub != nil {
+		return fake.AnnotationsStub(name, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.annotationsReturns.result1, fake.annotationsReturns.result2
+}
+
+func (fake *FakeJob) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeJob) AnnotationsArgsForCall(i int) (string, int) {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return fake.annotationsArgsForCall[i].name, fake.annotationsArgsForCall[i].limit
+}
+
+func (fake *FakeJob) AnnotationsReturns(result1 []atc.BuildAnnotation, result2 error) {
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) AnnotationsReturnsOnCall(i int, result1 []atc.BuildAnnotation, result2 error) {
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildAnnotation
+			result2 error
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 []atc.BuildAnnotation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -475,6 +541,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
 	return fake.invocations
 }
 
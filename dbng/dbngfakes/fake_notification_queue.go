@@ -0,0 +1,299 @@
+// This file was generated by counterfeiter
+package dbngfakes
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/concourse/atc/dbng"
+)
+
+type FakeNotificationQueue struct {
+	EnqueueStub        func(buildID int, notification string, message json.RawMessage) error
+	enqueueMutex       sync.RWMutex
+	enqueueArgsForCall []struct {
+		buildID      int
+		notification string
+		message      json.RawMessage
+	}
+	enqueueReturns struct {
+		result1 error
+	}
+	enqueueReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ClaimStub        func(lease time.Duration) (dbng.PendingNotification, bool, error)
+	claimMutex       sync.RWMutex
+	claimArgsForCall []struct {
+		lease time.Duration
+	}
+	claimReturns struct {
+		result1 dbng.PendingNotification
+		result2 bool
+		result3 error
+	}
+	claimReturnsOnCall map[int]struct {
+		result1 dbng.PendingNotification
+		result2 bool
+		result3 error
+	}
+	CompleteStub        func(pending dbng.PendingNotification, delivery dbng.NotificationDelivery) error
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		pending  dbng.PendingNotification
+		delivery dbng.NotificationDelivery
+	}
+	completeReturns struct {
+		result1 error
+	}
+	completeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RetryStub        func(pending dbng.PendingNotification, delivery dbng.NotificationDelivery, delay time.Duration) error
+	retryMutex       sync.RWMutex
+	retryArgsForCall []struct {
+		pending  dbng.PendingNotification
+		delivery dbng.NotificationDelivery
+		delay    time.Duration
+	}
+	retryReturns struct {
+		result1 error
+	}
+	retryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotificationQueue) Enqueue(buildID int, notification string, message json.RawMessage) error {
+	fake.enqueueMutex.Lock()
+	ret, specificReturn := fake.enqueueReturnsOnCall[len(fake.enqueueArgsForCall)]
+	fake.enqueueArgsForCall = append(fake.enqueueArgsForCall, struct {
+		buildID      int
+		notification string
+		message      json.RawMessage
+	}{buildID, notification, message})
+	fake.recordInvocation("Enqueue", []interface{}{buildID, notification, message})
+	fake.enqueueMutex.Unlock()
+	if fake.EnqueueStub != nil {
+		return fake.EnqueueStub(buildID, notification, message)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.enqueueReturns.result1
+}
+
+func (fake *FakeNotificationQueue) EnqueueCallCount() int {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	return len(fake.enqueueArgsForCall)
+}
+
+func (fake *FakeNotificationQueue) EnqueueArgsForCall(i int) (int, string, json.RawMessage) {
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	return fake.enqueueArgsForCall[i].buildID, fake.enqueueArgsForCall[i].notification, fake.enqueueArgsForCall[i].message
+}
+
+func (fake *FakeNotificationQueue) EnqueueReturns(result1 error) {
+	fake.EnqueueStub = nil
+	fake.enqueueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) EnqueueReturnsOnCall(i int, result1 error) {
+	fake.EnqueueStub = nil
+	if fake.enqueueReturnsOnCall == nil {
+		fake.enqueueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enqueueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) Claim(lease time.Duration) (dbng.PendingNotification, bool, error) {
+	fake.claimMutex.Lock()
+	ret, specificReturn := fake.claimReturnsOnCall[len(fake.claimArgsForCall)]
+	fake.claimArgsForCall = append(fake.claimArgsForCall, struct {
+		lease time.Duration
+	}{lease})
+	fake.recordInvocation("Claim", []interface{}{lease})
+	fake.claimMutex.Unlock()
+	if fake.ClaimStub != nil {
+		return fake.ClaimStub(lease)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.claimReturns.result1, fake.claimReturns.result2, fake.claimReturns.result3
+}
+
+func (fake *FakeNotificationQueue) ClaimCallCount() int {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	return len(fake.claimArgsForCall)
+}
+
+func (fake *FakeNotificationQueue) ClaimArgsForCall(i int) time.Duration {
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	return fake.claimArgsForCall[i].lease
+}
+
+func (fake *FakeNotificationQueue) ClaimReturns(result1 dbng.PendingNotification, result2 bool, result3 error) {
+	fake.ClaimStub = nil
+	fake.claimReturns = struct {
+		result1 dbng.PendingNotification
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNotificationQueue) ClaimReturnsOnCall(i int, result1 dbng.PendingNotification, result2 bool, result3 error) {
+	fake.ClaimStub = nil
+	if fake.claimReturnsOnCall == nil {
+		fake.claimReturnsOnCall = make(map[int]struct {
+			result1 dbng.PendingNotification
+			result2 bool
+			result3 error
+		})
+	}
+	fake.claimReturnsOnCall[i] = struct {
+		result1 dbng.PendingNotification
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeNotificationQueue) Complete(pending dbng.PendingNotification, delivery dbng.NotificationDelivery) error {
+	fake.completeMutex.Lock()
+	ret, specificReturn := fake.completeReturnsOnCall[len(fake.completeArgsForCall)]
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		pending  dbng.PendingNotification
+		delivery dbng.NotificationDelivery
+	}{pending, delivery})
+	fake.recordInvocation("Complete", []interface{}{pending, delivery})
+	fake.completeMutex.Unlock()
+	if fake.CompleteStub != nil {
+		return fake.CompleteStub(pending, delivery)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.completeReturns.result1
+}
+
+func (fake *FakeNotificationQueue) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeNotificationQueue) CompleteArgsForCall(i int) (dbng.PendingNotification, dbng.NotificationDelivery) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return fake.completeArgsForCall[i].pending, fake.completeArgsForCall[i].delivery
+}
+
+func (fake *FakeNotificationQueue) CompleteReturns(result1 error) {
+	fake.CompleteStub = nil
+	fake.completeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) CompleteReturnsOnCall(i int, result1 error) {
+	fake.CompleteStub = nil
+	if fake.completeReturnsOnCall == nil {
+		fake.completeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) Retry(pending dbng.PendingNotification, delivery dbng.NotificationDelivery, delay time.Duration) error {
+	fake.retryMutex.Lock()
+	ret, specificReturn := fake.retryReturnsOnCall[len(fake.retryArgsForCall)]
+	fake.retryArgsForCall = append(fake.retryArgsForCall, struct {
+		pending  dbng.PendingNotification
+		delivery dbng.NotificationDelivery
+		delay    time.Duration
+	}{pending, delivery, delay})
+	fake.recordInvocation("Retry", []interface{}{pending, delivery, delay})
+	fake.retryMutex.Unlock()
+	if fake.RetryStub != nil {
+		return fake.RetryStub(pending, delivery, delay)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryReturns.result1
+}
+
+func (fake *FakeNotificationQueue) RetryCallCount() int {
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	return len(fake.retryArgsForCall)
+}
+
+func (fake *FakeNotificationQueue) RetryArgsForCall(i int) (dbng.PendingNotification, dbng.NotificationDelivery, time.Duration) {
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	return fake.retryArgsForCall[i].pending, fake.retryArgsForCall[i].delivery, fake.retryArgsForCall[i].delay
+}
+
+func (fake *FakeNotificationQueue) RetryReturns(result1 error) {
+	fake.RetryStub = nil
+	fake.retryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) RetryReturnsOnCall(i int, result1 error) {
+	fake.RetryStub = nil
+	if fake.retryReturnsOnCall == nil {
+		fake.retryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotificationQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.enqueueMutex.RLock()
+	defer fake.enqueueMutex.RUnlock()
+	fake.claimMutex.RLock()
+	defer fake.claimMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeNotificationQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ dbng.NotificationQueue = new(FakeNotificationQueue)
@@ -153,6 +153,17 @@ type FakePipeline struct {
 		result1 map[string][]dbng.Build
 		result2 error
 	}
+	InFlightBuildsStub        func() ([]dbng.Build, error)
+	inFlightBuildsMutex       sync.RWMutex
+	inFlightBuildsArgsForCall []struct{}
+	inFlightBuildsReturns     struct {
+		result1 []dbng.Build
+		result2 error
+	}
+	inFlightBuildsReturnsOnCall map[int]struct {
+		result1 []dbng.Build
+		result2 error
+	}
 	SaveResourceVersionsStub        func(atc.ResourceConfig, []atc.Version) error
 	saveResourceVersionsMutex       sync.RWMutex
 	saveResourceVersionsArgsForCall []struct {
@@ -543,6 +554,32 @@ type FakePipeline struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ConfigVersionsStub        func() ([]dbng.PipelineConfigVersion, error)
+	configVersionsMutex       sync.RWMutex
+	configVersionsArgsForCall []struct{}
+	configVersionsReturns     struct {
+		result1 []dbng.PipelineConfigVersion
+		result2 error
+	}
+	configVersionsReturnsOnCall map[int]struct {
+		result1 []dbng.PipelineConfigVersion
+		result2 error
+	}
+	ConfigAtVersionStub        func(dbng.ConfigVersion) (dbng.PipelineConfigVersion, bool, error)
+	configAtVersionMutex       sync.RWMutex
+	configAtVersionArgsForCall []struct {
+		arg1 dbng.ConfigVersion
+	}
+	configAtVersionReturns struct {
+		result1 dbng.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	configAtVersionReturnsOnCall map[int]struct {
+		result1 dbng.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1142,6 +1179,49 @@ func (fake *FakePipeline) GetAllPendingBuildsReturnsOnCall(i int, result1 map[st
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) InFlightBuilds() ([]dbng.Build, error) {
+	fake.inFlightBuildsMutex.Lock()
+	ret, specificReturn := fake.inFlightBuildsReturnsOnCall[len(fake.inFlightBuildsArgsForCall)]
+	fake.inFlightBuildsArgsForCall = append(fake.inFlightBuildsArgsForCall, struct{}{})
+	fake.recordInvocation("InFlightBuilds", []interface{}{})
+	fake.inFlightBuildsMutex.Unlock()
+	if fake.InFlightBuildsStub != nil {
+		return fake.InFlightBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.inFlightBuildsReturns.result1, fake.inFlightBuildsReturns.result2
+}
+
+func (fake *FakePipeline) InFlightBuildsCallCount() int {
+	fake.inFlightBuildsMutex.RLock()
+	defer fake.inFlightBuildsMutex.RUnlock()
+	return len(fake.inFlightBuildsArgsForCall)
+}
+
+func (fake *FakePipeline) InFlightBuildsReturns(result1 []dbng.Build, result2 error) {
+	fake.InFlightBuildsStub = nil
+	fake.inFlightBuildsReturns = struct {
+		result1 []dbng.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) InFlightBuildsReturnsOnCall(i int, result1 []dbng.Build, result2 error) {
+	fake.InFlightBuildsStub = nil
+	if fake.inFlightBuildsReturnsOnCall == nil {
+		fake.inFlightBuildsReturnsOnCall = make(map[int]struct {
+			result1 []dbng.Build
+			result2 error
+		})
+	}
+	fake.inFlightBuildsReturnsOnCall[i] = struct {
+		result1 []dbng.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) SaveResourceVersions(arg1 atc.ResourceConfig, arg2 []atc.Version) error {
 	var arg2Copy []atc.Version
 	if arg2 != nil {
@@ -2663,6 +2743,103 @@ func (fake *FakePipeline) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) ConfigVersions() ([]dbng.PipelineConfigVersion, error) {
+	fake.configVersionsMutex.Lock()
+	ret, specificReturn := fake.configVersionsReturnsOnCall[len(fake.configVersionsArgsForCall)]
+	fake.configVersionsArgsForCall = append(fake.configVersionsArgsForCall, struct{}{})
+	fake.recordInvocation("ConfigVersions", []interface{}{})
+	fake.configVersionsMutex.Unlock()
+	if fake.ConfigVersionsStub != nil {
+		return fake.ConfigVersionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.configVersionsReturns.result1, fake.configVersionsReturns.result2
+}
+
+func (fake *FakePipeline) ConfigVersionsCallCount() int {
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
+	return len(fake.configVersionsArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigVersionsReturns(result1 []dbng.PipelineConfigVersion, result2 error) {
+	fake.ConfigVersionsStub = nil
+	fake.configVersionsReturns = struct {
+		result1 []dbng.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigVersionsReturnsOnCall(i int, result1 []dbng.PipelineConfigVersion, result2 error) {
+	fake.ConfigVersionsStub = nil
+	if fake.configVersionsReturnsOnCall == nil {
+		fake.configVersionsReturnsOnCall = make(map[int]struct {
+			result1 []dbng.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configVersionsReturnsOnCall[i] = struct {
+		result1 []dbng.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigAtVersion(arg1 dbng.ConfigVersion) (dbng.PipelineConfigVersion, bool, error) {
+	fake.configAtVersionMutex.Lock()
+	ret, specificReturn := fake.configAtVersionReturnsOnCall[len(fake.configAtVersionArgsForCall)]
+	fake.configAtVersionArgsForCall = append(fake.configAtVersionArgsForCall, struct {
+		arg1 dbng.ConfigVersion
+	}{arg1})
+	fake.recordInvocation("ConfigAtVersion", []interface{}{arg1})
+	fake.configAtVersionMutex.Unlock()
+	if fake.ConfigAtVersionStub != nil {
+		return fake.ConfigAtVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.configAtVersionReturns.result1, fake.configAtVersionReturns.result2, fake.configAtVersionReturns.result3
+}
+
+func (fake *FakePipeline) ConfigAtVersionCallCount() int {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	return len(fake.configAtVersionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigAtVersionArgsForCall(i int) dbng.ConfigVersion {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	return fake.configAtVersionArgsForCall[i].arg1
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturns(result1 dbng.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.ConfigAtVersionStub = nil
+	fake.configAtVersionReturns = struct {
+		result1 dbng.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturnsOnCall(i int, result1 dbng.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.ConfigAtVersionStub = nil
+	if fake.configAtVersionReturnsOnCall == nil {
+		fake.configAtVersionReturnsOnCall = make(map[int]struct {
+			result1 dbng.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configAtVersionReturnsOnCall[i] = struct {
+		result1 dbng.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2694,6 +2871,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.setResourceCheckErrorMutex.RUnlock()
 	fake.getAllPendingBuildsMutex.RLock()
 	defer fake.getAllPendingBuildsMutex.RUnlock()
+	fake.inFlightBuildsMutex.RLock()
+	defer fake.inFlightBuildsMutex.RUnlock()
 	fake.saveResourceVersionsMutex.RLock()
 	defer fake.saveResourceVersionsMutex.RUnlock()
 	fake.getResourceVersionsMutex.RLock()
@@ -2756,6 +2935,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.destroyMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.configVersionsMutex.RLock()
+	defer fake.configVersionsMutex.RUnlock()
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
 	return fake.invocations
 }
 
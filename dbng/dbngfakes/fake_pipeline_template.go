@@ -0,0 +1,373 @@
+// This file was generated by counterfeiter
+package dbngfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+)
+
+type FakePipelineTemplate struct {
+	IDStub        func() int
+	iDMutex       sync.RWMutex
+	iDArgsForCall []struct{}
+	iDReturns     struct {
+		result1 int
+	}
+	iDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	NameStub        func() string
+	nameMutex       sync.RWMutex
+	nameArgsForCall []struct{}
+	nameReturns     struct {
+		result1 string
+	}
+	nameReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TeamIDStub        func() int
+	teamIDMutex       sync.RWMutex
+	teamIDArgsForCall []struct{}
+	teamIDReturns     struct {
+		result1 int
+	}
+	teamIDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	TemplateStub        func() []byte
+	templateMutex       sync.RWMutex
+	templateArgsForCall []struct{}
+	templateReturns     struct {
+		result1 []byte
+	}
+	templateReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	InstancesStub        func() ([]dbng.PipelineTemplateInstance, error)
+	instancesMutex       sync.RWMutex
+	instancesArgsForCall []struct{}
+	instancesReturns     struct {
+		result1 []dbng.PipelineTemplateInstance
+		result2 error
+	}
+	instancesReturnsOnCall map[int]struct {
+		result1 []dbng.PipelineTemplateInstance
+		result2 error
+	}
+	SaveInstanceStub        func(pipelineName string, config atc.Config, from dbng.ConfigVersion, author string, params map[string]interface{}) (dbng.Pipeline, bool, error)
+	saveInstanceMutex       sync.RWMutex
+	saveInstanceArgsForCall []struct {
+		pipelineName string
+		config       atc.Config
+		from         dbng.ConfigVersion
+		author       string
+		params       map[string]interface{}
+	}
+	saveInstanceReturns struct {
+		result1 dbng.Pipeline
+		result2 bool
+		result3 error
+	}
+	saveInstanceReturnsOnCall map[int]struct {
+		result1 dbng.Pipeline
+		result2 bool
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePipelineTemplate) ID() int {
+	fake.iDMutex.Lock()
+	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
+	fake.iDArgsForCall = append(fake.iDArgsForCall, struct{}{})
+	fake.recordInvocation("ID", []interface{}{})
+	fake.iDMutex.Unlock()
+	if fake.IDStub != nil {
+		return fake.IDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.iDReturns.result1
+}
+
+func (fake *FakePipelineTemplate) IDCallCount() int {
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	return len(fake.iDArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) IDReturns(result1 int) {
+	fake.IDStub = nil
+	fake.iDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) IDReturnsOnCall(i int, result1 int) {
+	fake.IDStub = nil
+	if fake.iDReturnsOnCall == nil {
+		fake.iDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.iDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) Name() string {
+	fake.nameMutex.Lock()
+	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
+	fake.nameArgsForCall = append(fake.nameArgsForCall, struct{}{})
+	fake.recordInvocation("Name", []interface{}{})
+	fake.nameMutex.Unlock()
+	if fake.NameStub != nil {
+		return fake.NameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.nameReturns.result1
+}
+
+func (fake *FakePipelineTemplate) NameCallCount() int {
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	return len(fake.nameArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) NameReturns(result1 string) {
+	fake.NameStub = nil
+	fake.nameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) NameReturnsOnCall(i int, result1 string) {
+	fake.NameStub = nil
+	if fake.nameReturnsOnCall == nil {
+		fake.nameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.nameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) TeamID() int {
+	fake.teamIDMutex.Lock()
+	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
+	fake.teamIDArgsForCall = append(fake.teamIDArgsForCall, struct{}{})
+	fake.recordInvocation("TeamID", []interface{}{})
+	fake.teamIDMutex.Unlock()
+	if fake.TeamIDStub != nil {
+		return fake.TeamIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.teamIDReturns.result1
+}
+
+func (fake *FakePipelineTemplate) TeamIDCallCount() int {
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	return len(fake.teamIDArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) TeamIDReturns(result1 int) {
+	fake.TeamIDStub = nil
+	fake.teamIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) TeamIDReturnsOnCall(i int, result1 int) {
+	fake.TeamIDStub = nil
+	if fake.teamIDReturnsOnCall == nil {
+		fake.teamIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.teamIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) Template() []byte {
+	fake.templateMutex.Lock()
+	ret, specificReturn := fake.templateReturnsOnCall[len(fake.templateArgsForCall)]
+	fake.templateArgsForCall = append(fake.templateArgsForCall, struct{}{})
+	fake.recordInvocation("Template", []interface{}{})
+	fake.templateMutex.Unlock()
+	if fake.TemplateStub != nil {
+		return fake.TemplateStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.templateReturns.result1
+}
+
+func (fake *FakePipelineTemplate) TemplateCallCount() int {
+	fake.templateMutex.RLock()
+	defer fake.templateMutex.RUnlock()
+	return len(fake.templateArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) TemplateReturns(result1 []byte) {
+	fake.TemplateStub = nil
+	fake.templateReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) TemplateReturnsOnCall(i int, result1 []byte) {
+	fake.TemplateStub = nil
+	if fake.templateReturnsOnCall == nil {
+		fake.templateReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.templateReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakePipelineTemplate) Instances() ([]dbng.PipelineTemplateInstance, error) {
+	fake.instancesMutex.Lock()
+	ret, specificReturn := fake.instancesReturnsOnCall[len(fake.instancesArgsForCall)]
+	fake.instancesArgsForCall = append(fake.instancesArgsForCall, struct{}{})
+	fake.recordInvocation("Instances", []interface{}{})
+	fake.instancesMutex.Unlock()
+	if fake.InstancesStub != nil {
+		return fake.InstancesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.instancesReturns.result1, fake.instancesReturns.result2
+}
+
+func (fake *FakePipelineTemplate) InstancesCallCount() int {
+	fake.instancesMutex.RLock()
+	defer fake.instancesMutex.RUnlock()
+	return len(fake.instancesArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) InstancesReturns(result1 []dbng.PipelineTemplateInstance, result2 error) {
+	fake.InstancesStub = nil
+	fake.instancesReturns = struct {
+		result1 []dbng.PipelineTemplateInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipelineTemplate) InstancesReturnsOnCall(i int, result1 []dbng.PipelineTemplateInstance, result2 error) {
+	fake.InstancesStub = nil
+	if fake.instancesReturnsOnCall == nil {
+		fake.instancesReturnsOnCall = make(map[int]struct {
+			result1 []dbng.PipelineTemplateInstance
+			result2 error
+		})
+	}
+	fake.instancesReturnsOnCall[i] = struct {
+		result1 []dbng.PipelineTemplateInstance
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipelineTemplate) SaveInstance(pipelineName string, config atc.Config, from dbng.ConfigVersion, author string, params map[string]interface{}) (dbng.Pipeline, bool, error) {
+	fake.saveInstanceMutex.Lock()
+	ret, specificReturn := fake.saveInstanceReturnsOnCall[len(fake.saveInstanceArgsForCall)]
+	fake.saveInstanceArgsForCall = append(fake.saveInstanceArgsForCall, struct {
+		pipelineName string
+		config       atc.Config
+		from         dbng.ConfigVersion
+		author       string
+		params       map[string]interface{}
+	}{pipelineName, config, from, author, params})
+	fake.recordInvocation("SaveInstance", []interface{}{pipelineName, config, from, author, params})
+	fake.saveInstanceMutex.Unlock()
+	if fake.SaveInstanceStub != nil {
+		return fake.SaveInstanceStub(pipelineName, config, from, author, params)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.saveInstanceReturns.result1, fake.saveInstanceReturns.result2, fake.saveInstanceReturns.result3
+}
+
+func (fake *FakePipelineTemplate) SaveInstanceCallCount() int {
+	fake.saveInstanceMutex.RLock()
+	defer fake.saveInstanceMutex.RUnlock()
+	return len(fake.saveInstanceArgsForCall)
+}
+
+func (fake *FakePipelineTemplate) SaveInstanceArgsForCall(i int) (string, atc.Config, dbng.ConfigVersion, string, map[string]interface{}) {
+	fake.saveInstanceMutex.RLock()
+	defer fake.saveInstanceMutex.RUnlock()
+	return fake.saveInstanceArgsForCall[i].pipelineName, fake.saveInstanceArgsForCall[i].config, fake.saveInstanceArgsForCall[i].from, fake.saveInstanceArgsForCall[i].author, fake.saveInstanceArgsForCall[i].params
+}
+
+func (fake *FakePipelineTemplate) SaveInstanceReturns(result1 dbng.Pipeline, result2 bool, result3 error) {
+	fake.SaveInstanceStub = nil
+	fake.saveInstanceReturns = struct {
+		result1 dbng.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipelineTemplate) SaveInstanceReturnsOnCall(i int, result1 dbng.Pipeline, result2 bool, result3 error) {
+	fake.SaveInstanceStub = nil
+	if fake.saveInstanceReturnsOnCall == nil {
+		fake.saveInstanceReturnsOnCall = make(map[int]struct {
+			result1 dbng.Pipeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.saveInstanceReturnsOnCall[i] = struct {
+		result1 dbng.Pipeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipelineTemplate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	fake.nameMutex.RLock()
+	defer fake.nameMutex.RUnlock()
+	fake.teamIDMutex.RLock()
+	defer fake.teamIDMutex.RUnlock()
+	fake.templateMutex.RLock()
+	defer fake.templateMutex.RUnlock()
+	fake.instancesMutex.RLock()
+	defer fake.instancesMutex.RUnlock()
+	fake.saveInstanceMutex.RLock()
+	defer fake.saveInstanceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePipelineTemplate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ dbng.PipelineTemplate = new(FakePipelineTemplate)
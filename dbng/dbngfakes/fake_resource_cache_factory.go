@@ -74,6 +74,19 @@ type FakeResourceCacheFactory struct {
 	cleanUpInvalidCachesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExistingResourceCacheIDsStub        func(ids []int) ([]int, error)
+	existingResourceCacheIDsMutex       sync.RWMutex
+	existingResourceCacheIDsArgsForCall []struct {
+		ids []int
+	}
+	existingResourceCacheIDsReturns struct {
+		result1 []int
+		result2 error
+	}
+	existingResourceCacheIDsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -335,6 +348,62 @@ func (fake *FakeResourceCacheFactory) CleanUpInvalidCachesReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeResourceCacheFactory) ExistingResourceCacheIDs(ids []int) ([]int, error) {
+	var idsCopy []int
+	if ids != nil {
+		idsCopy = make([]int, len(ids))
+		copy(idsCopy, ids)
+	}
+	fake.existingResourceCacheIDsMutex.Lock()
+	ret, specificReturn := fake.existingResourceCacheIDsReturnsOnCall[len(fake.existingResourceCacheIDsArgsForCall)]
+	fake.existingResourceCacheIDsArgsForCall = append(fake.existingResourceCacheIDsArgsForCall, struct {
+		ids []int
+	}{idsCopy})
+	fake.recordInvocation("ExistingResourceCacheIDs", []interface{}{idsCopy})
+	fake.existingResourceCacheIDsMutex.Unlock()
+	if fake.ExistingResourceCacheIDsStub != nil {
+		return fake.ExistingResourceCacheIDsStub(ids)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.existingResourceCacheIDsReturns.result1, fake.existingResourceCacheIDsReturns.result2
+}
+
+func (fake *FakeResourceCacheFactory) ExistingResourceCacheIDsCallCount() int {
+	fake.existingResourceCacheIDsMutex.RLock()
+	defer fake.existingResourceCacheIDsMutex.RUnlock()
+	return len(fake.existingResourceCacheIDsArgsForCall)
+}
+
+func (fake *FakeResourceCacheFactory) ExistingResourceCacheIDsArgsForCall(i int) []int {
+	fake.existingResourceCacheIDsMutex.RLock()
+	defer fake.existingResourceCacheIDsMutex.RUnlock()
+	return fake.existingResourceCacheIDsArgsForCall[i].ids
+}
+
+func (fake *FakeResourceCacheFactory) ExistingResourceCacheIDsReturns(result1 []int, result2 error) {
+	fake.ExistingResourceCacheIDsStub = nil
+	fake.existingResourceCacheIDsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceCacheFactory) ExistingResourceCacheIDsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.ExistingResourceCacheIDsStub = nil
+	if fake.existingResourceCacheIDsReturnsOnCall == nil {
+		fake.existingResourceCacheIDsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.existingResourceCacheIDsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceCacheFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -350,6 +419,8 @@ func (fake *FakeResourceCacheFactory) Invocations() map[string][][]interface{} {
 	defer fake.cleanUsesForPausedPipelineResourcesMutex.RUnlock()
 	fake.cleanUpInvalidCachesMutex.RLock()
 	defer fake.cleanUpInvalidCachesMutex.RUnlock()
+	fake.existingResourceCacheIDsMutex.RLock()
+	defer fake.existingResourceCacheIDsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -65,13 +65,14 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(pipelineName string, config atc.Config, from dbng.ConfigVersion, pausedState dbng.PipelinePausedState) (dbng.Pipeline, bool, error)
+	SavePipelineStub        func(pipelineName string, config atc.Config, from dbng.ConfigVersion, pausedState dbng.PipelinePausedState, author string) (dbng.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
 		pipelineName string
 		config       atc.Config
 		from         dbng.ConfigVersion
 		pausedState  dbng.PipelinePausedState
+		author       string
 	}
 	savePipelineReturns struct {
 		result1 dbng.Pipeline
@@ -142,6 +143,46 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SavePipelineTemplateStub        func(name string, template []byte) (dbng.PipelineTemplate, error)
+	savePipelineTemplateMutex       sync.RWMutex
+	savePipelineTemplateArgsForCall []struct {
+		name     string
+		template []byte
+	}
+	savePipelineTemplateReturns struct {
+		result1 dbng.PipelineTemplate
+		result2 error
+	}
+	savePipelineTemplateReturnsOnCall map[int]struct {
+		result1 dbng.PipelineTemplate
+		result2 error
+	}
+	PipelineTemplateStub        func(name string) (dbng.PipelineTemplate, bool, error)
+	pipelineTemplateMutex       sync.RWMutex
+	pipelineTemplateArgsForCall []struct {
+		name string
+	}
+	pipelineTemplateReturns struct {
+		result1 dbng.PipelineTemplate
+		result2 bool
+		result3 error
+	}
+	pipelineTemplateReturnsOnCall map[int]struct {
+		result1 dbng.PipelineTemplate
+		result2 bool
+		result3 error
+	}
+	PipelineTemplatesStub        func() ([]dbng.PipelineTemplate, error)
+	pipelineTemplatesMutex       sync.RWMutex
+	pipelineTemplatesArgsForCall []struct{}
+	pipelineTemplatesReturns     struct {
+		result1 []dbng.PipelineTemplate
+		result2 error
+	}
+	pipelineTemplatesReturnsOnCall map[int]struct {
+		result1 []dbng.PipelineTemplate
+		result2 error
+	}
 	CreateOneOffBuildStub        func() (dbng.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct{}
@@ -168,6 +209,45 @@ type FakeTeam struct {
 		result2 dbng.Pagination
 		result3 error
 	}
+	SearchBuildLogsStub        func(query string, publicOnly bool, limit int) ([]dbng.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		query      string
+		publicOnly bool
+		limit      int
+	}
+	searchBuildLogsReturns struct {
+		result1 []dbng.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []dbng.BuildLogMatch
+		result2 error
+	}
+	EventsStub        func(after uint) (dbng.TeamEventSource, error)
+	eventsMutex       sync.RWMutex
+	eventsArgsForCall []struct {
+		after uint
+	}
+	eventsReturns struct {
+		result1 dbng.TeamEventSource
+		result2 error
+	}
+	eventsReturnsOnCall map[int]struct {
+		result1 dbng.TeamEventSource
+		result2 error
+	}
+	LatestEventIDStub        func() (uint, error)
+	latestEventIDMutex       sync.RWMutex
+	latestEventIDArgsForCall []struct{}
+	latestEventIDReturns     struct {
+		result1 uint
+		result2 error
+	}
+	latestEventIDReturnsOnCall map[int]struct {
+		result1 uint
+		result2 error
+	}
 	SaveWorkerStub        func(atcWorker atc.Worker, ttl time.Duration) (dbng.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -383,6 +463,72 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	NotificationsStub        func() ([]atc.NotificationConfig, error)
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct{}
+	notificationsReturns     struct {
+		result1 []atc.NotificationConfig
+		result2 error
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 []atc.NotificationConfig
+		result2 error
+	}
+	UpdateNotificationsStub        func(notifications []atc.NotificationConfig) error
+	updateNotificationsMutex       sync.RWMutex
+	updateNotificationsArgsForCall []struct {
+		notifications []atc.NotificationConfig
+	}
+	updateNotificationsReturns struct {
+		result1 error
+	}
+	updateNotificationsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RedactionRulesStub        func() (atc.RedactionRules, error)
+	redactionRulesMutex       sync.RWMutex
+	redactionRulesArgsForCall []struct{}
+	redactionRulesReturns     struct {
+		result1 atc.RedactionRules
+		result2 error
+	}
+	redactionRulesReturnsOnCall map[int]struct {
+		result1 atc.RedactionRules
+		result2 error
+	}
+	UpdateRedactionRulesStub        func(rules atc.RedactionRules) error
+	updateRedactionRulesMutex       sync.RWMutex
+	updateRedactionRulesArgsForCall []struct {
+		rules atc.RedactionRules
+	}
+	updateRedactionRulesReturns struct {
+		result1 error
+	}
+	updateRedactionRulesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SchedulingStub        func() (atc.TeamScheduling, error)
+	schedulingMutex       sync.RWMutex
+	schedulingArgsForCall []struct{}
+	schedulingReturns     struct {
+		result1 atc.TeamScheduling
+		result2 error
+	}
+	schedulingReturnsOnCall map[int]struct {
+		result1 atc.TeamScheduling
+		result2 error
+	}
+	UpdateSchedulingStub        func(scheduling atc.TeamScheduling) error
+	updateSchedulingMutex       sync.RWMutex
+	updateSchedulingArgsForCall []struct {
+		scheduling atc.TeamScheduling
+	}
+	updateSchedulingReturns struct {
+		result1 error
+	}
+	updateSchedulingReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -627,7 +773,7 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(pipelineName string, config atc.Config, from dbng.ConfigVersion, pausedState dbng.PipelinePausedState) (dbng.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(pipelineName string, config atc.Config, from dbng.ConfigVersion, pausedState dbng.PipelinePausedState, author string) (dbng.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
@@ -635,11 +781,12 @@ func (fake *FakeTeam) SavePipeline(pipelineName string, config atc.Config, from
 		config       atc.Config
 		from         dbng.ConfigVersion
 		pausedState  dbng.PipelinePausedState
-	}{pipelineName, config, from, pausedState})
-	fake.recordInvocation("SavePipeline", []interface{}{pipelineName, config, from, pausedState})
+		author       string
+	}{pipelineName, config, from, pausedState, author})
+	fake.recordInvocation("SavePipeline", []interface{}{pipelineName, config, from, pausedState, author})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
-		return fake.SavePipelineStub(pipelineName, config, from, pausedState)
+		return fake.SavePipelineStub(pipelineName, config, from, pausedState, author)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -653,10 +800,10 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, dbng.ConfigVersion, dbng.PipelinePausedState) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, dbng.ConfigVersion, dbng.PipelinePausedState, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
-	return fake.savePipelineArgsForCall[i].pipelineName, fake.savePipelineArgsForCall[i].config, fake.savePipelineArgsForCall[i].from, fake.savePipelineArgsForCall[i].pausedState
+	return fake.savePipelineArgsForCall[i].pipelineName, fake.savePipelineArgsForCall[i].config, fake.savePipelineArgsForCall[i].from, fake.savePipelineArgsForCall[i].pausedState, fake.savePipelineArgsForCall[i].author
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 dbng.Pipeline, result2 bool, result3 error) {
@@ -920,6 +1067,160 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) SavePipelineTemplate(name string, template []byte) (dbng.PipelineTemplate, error) {
+	var templateCopy []byte
+	if template != nil {
+		templateCopy = make([]byte, len(template))
+		copy(templateCopy, template)
+	}
+	fake.savePipelineTemplateMutex.Lock()
+	ret, specificReturn := fake.savePipelineTemplateReturnsOnCall[len(fake.savePipelineTemplateArgsForCall)]
+	fake.savePipelineTemplateArgsForCall = append(fake.savePipelineTemplateArgsForCall, struct {
+		name     string
+		template []byte
+	}{name, templateCopy})
+	fake.recordInvocation("SavePipelineTemplate", []interface{}{name, templateCopy})
+	fake.savePipelineTemplateMutex.Unlock()
+	if fake.SavePipelineTemplateStub != nil {
+		return fake.SavePipelineTemplateStub(name, template)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.savePipelineTemplateReturns.result1, fake.savePipelineTemplateReturns.result2
+}
+
+func (fake *FakeTeam) SavePipelineTemplateCallCount() int {
+	fake.savePipelineTemplateMutex.RLock()
+	defer fake.savePipelineTemplateMutex.RUnlock()
+	return len(fake.savePipelineTemplateArgsForCall)
+}
+
+func (fake *FakeTeam) SavePipelineTemplateArgsForCall(i int) (string, []byte) {
+	fake.savePipelineTemplateMutex.RLock()
+	defer fake.savePipelineTemplateMutex.RUnlock()
+	return fake.savePipelineTemplateArgsForCall[i].name, fake.savePipelineTemplateArgsForCall[i].template
+}
+
+func (fake *FakeTeam) SavePipelineTemplateReturns(result1 dbng.PipelineTemplate, result2 error) {
+	fake.SavePipelineTemplateStub = nil
+	fake.savePipelineTemplateReturns = struct {
+		result1 dbng.PipelineTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SavePipelineTemplateReturnsOnCall(i int, result1 dbng.PipelineTemplate, result2 error) {
+	fake.SavePipelineTemplateStub = nil
+	if fake.savePipelineTemplateReturnsOnCall == nil {
+		fake.savePipelineTemplateReturnsOnCall = make(map[int]struct {
+			result1 dbng.PipelineTemplate
+			result2 error
+		})
+	}
+	fake.savePipelineTemplateReturnsOnCall[i] = struct {
+		result1 dbng.PipelineTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PipelineTemplate(name string) (dbng.PipelineTemplate, bool, error) {
+	fake.pipelineTemplateMutex.Lock()
+	ret, specificReturn := fake.pipelineTemplateReturnsOnCall[len(fake.pipelineTemplateArgsForCall)]
+	fake.pipelineTemplateArgsForCall = append(fake.pipelineTemplateArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("PipelineTemplate", []interface{}{name})
+	fake.pipelineTemplateMutex.Unlock()
+	if fake.PipelineTemplateStub != nil {
+		return fake.PipelineTemplateStub(name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.pipelineTemplateReturns.result1, fake.pipelineTemplateReturns.result2, fake.pipelineTemplateReturns.result3
+}
+
+func (fake *FakeTeam) PipelineTemplateCallCount() int {
+	fake.pipelineTemplateMutex.RLock()
+	defer fake.pipelineTemplateMutex.RUnlock()
+	return len(fake.pipelineTemplateArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineTemplateArgsForCall(i int) string {
+	fake.pipelineTemplateMutex.RLock()
+	defer fake.pipelineTemplateMutex.RUnlock()
+	return fake.pipelineTemplateArgsForCall[i].name
+}
+
+func (fake *FakeTeam) PipelineTemplateReturns(result1 dbng.PipelineTemplate, result2 bool, result3 error) {
+	fake.PipelineTemplateStub = nil
+	fake.pipelineTemplateReturns = struct {
+		result1 dbng.PipelineTemplate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineTemplateReturnsOnCall(i int, result1 dbng.PipelineTemplate, result2 bool, result3 error) {
+	fake.PipelineTemplateStub = nil
+	if fake.pipelineTemplateReturnsOnCall == nil {
+		fake.pipelineTemplateReturnsOnCall = make(map[int]struct {
+			result1 dbng.PipelineTemplate
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineTemplateReturnsOnCall[i] = struct {
+		result1 dbng.PipelineTemplate
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineTemplates() ([]dbng.PipelineTemplate, error) {
+	fake.pipelineTemplatesMutex.Lock()
+	ret, specificReturn := fake.pipelineTemplatesReturnsOnCall[len(fake.pipelineTemplatesArgsForCall)]
+	fake.pipelineTemplatesArgsForCall = append(fake.pipelineTemplatesArgsForCall, struct{}{})
+	fake.recordInvocation("PipelineTemplates", []interface{}{})
+	fake.pipelineTemplatesMutex.Unlock()
+	if fake.PipelineTemplatesStub != nil {
+		return fake.PipelineTemplatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pipelineTemplatesReturns.result1, fake.pipelineTemplatesReturns.result2
+}
+
+func (fake *FakeTeam) PipelineTemplatesCallCount() int {
+	fake.pipelineTemplatesMutex.RLock()
+	defer fake.pipelineTemplatesMutex.RUnlock()
+	return len(fake.pipelineTemplatesArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineTemplatesReturns(result1 []dbng.PipelineTemplate, result2 error) {
+	fake.PipelineTemplatesStub = nil
+	fake.pipelineTemplatesReturns = struct {
+		result1 []dbng.PipelineTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PipelineTemplatesReturnsOnCall(i int, result1 []dbng.PipelineTemplate, result2 error) {
+	fake.PipelineTemplatesStub = nil
+	if fake.pipelineTemplatesReturnsOnCall == nil {
+		fake.pipelineTemplatesReturnsOnCall = make(map[int]struct {
+			result1 []dbng.PipelineTemplate
+			result2 error
+		})
+	}
+	fake.pipelineTemplatesReturnsOnCall[i] = struct {
+		result1 []dbng.PipelineTemplate
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateOneOffBuild() (dbng.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -1017,6 +1318,153 @@ func (fake *FakeTeam) PrivateAndPublicBuildsReturnsOnCall(i int, result1 []dbng.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(query string, publicOnly bool, limit int) ([]dbng.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		query      string
+		publicOnly bool
+		limit      int
+	}{query, publicOnly, limit})
+	fake.recordInvocation("SearchBuildLogs", []interface{}{query, publicOnly, limit})
+	fake.searchBuildLogsMutex.Unlock()
+	if fake.SearchBuildLogsStub != nil {
+		return fake.SearchBuildLogsStub(query, publicOnly, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.searchBuildLogsReturns.result1, fake.searchBuildLogsReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) (string, bool, int) {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return fake.searchBuildLogsArgsForCall[i].query, fake.searchBuildLogsArgsForCall[i].publicOnly, fake.searchBuildLogsArgsForCall[i].limit
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []dbng.BuildLogMatch, result2 error) {
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []dbng.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []dbng.BuildLogMatch, result2 error) {
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []dbng.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []dbng.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Events(after uint) (dbng.TeamEventSource, error) {
+	fake.eventsMutex.Lock()
+	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
+	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
+		after uint
+	}{after})
+	fake.recordInvocation("Events", []interface{}{after})
+	fake.eventsMutex.Unlock()
+	if fake.EventsStub != nil {
+		return fake.EventsStub(after)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.eventsReturns.result1, fake.eventsReturns.result2
+}
+
+func (fake *FakeTeam) EventsCallCount() int {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return len(fake.eventsArgsForCall)
+}
+
+func (fake *FakeTeam) EventsArgsForCall(i int) uint {
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	return fake.eventsArgsForCall[i].after
+}
+
+func (fake *FakeTeam) EventsReturns(result1 dbng.TeamEventSource, result2 error) {
+	fake.EventsStub = nil
+	fake.eventsReturns = struct {
+		result1 dbng.TeamEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) EventsReturnsOnCall(i int, result1 dbng.TeamEventSource, result2 error) {
+	fake.EventsStub = nil
+	if fake.eventsReturnsOnCall == nil {
+		fake.eventsReturnsOnCall = make(map[int]struct {
+			result1 dbng.TeamEventSource
+			result2 error
+		})
+	}
+	fake.eventsReturnsOnCall[i] = struct {
+		result1 dbng.TeamEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) LatestEventID() (uint, error) {
+	fake.latestEventIDMutex.Lock()
+	ret, specificReturn := fake.latestEventIDReturnsOnCall[len(fake.latestEventIDArgsForCall)]
+	fake.latestEventIDArgsForCall = append(fake.latestEventIDArgsForCall, struct{}{})
+	fake.recordInvocation("LatestEventID", []interface{}{})
+	fake.latestEventIDMutex.Unlock()
+	if fake.LatestEventIDStub != nil {
+		return fake.LatestEventIDStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.latestEventIDReturns.result1, fake.latestEventIDReturns.result2
+}
+
+func (fake *FakeTeam) LatestEventIDCallCount() int {
+	fake.latestEventIDMutex.RLock()
+	defer fake.latestEventIDMutex.RUnlock()
+	return len(fake.latestEventIDArgsForCall)
+}
+
+func (fake *FakeTeam) LatestEventIDReturns(result1 uint, result2 error) {
+	fake.LatestEventIDStub = nil
+	fake.latestEventIDReturns = struct {
+		result1 uint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) LatestEventIDReturnsOnCall(i int, result1 uint, result2 error) {
+	fake.LatestEventIDStub = nil
+	if fake.latestEventIDReturnsOnCall == nil {
+		fake.latestEventIDReturnsOnCall = make(map[int]struct {
+			result1 uint
+			result2 error
+		})
+	}
+	fake.latestEventIDReturnsOnCall[i] = struct {
+		result1 uint
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (dbng.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1801,6 +2249,284 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) Notifications() ([]atc.NotificationConfig, error) {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct{}{})
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if fake.NotificationsStub != nil {
+		return fake.NotificationsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.notificationsReturns.result1, fake.notificationsReturns.result2
+}
+
+func (fake *FakeTeam) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationsReturns(result1 []atc.NotificationConfig, result2 error) {
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 []atc.NotificationConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationsReturnsOnCall(i int, result1 []atc.NotificationConfig, result2 error) {
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationConfig
+			result2 error
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 []atc.NotificationConfig
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateNotifications(notifications []atc.NotificationConfig) error {
+	var notificationsCopy []atc.NotificationConfig
+	if notifications != nil {
+		notificationsCopy = make([]atc.NotificationConfig, len(notifications))
+		copy(notificationsCopy, notifications)
+	}
+	fake.updateNotificationsMutex.Lock()
+	ret, specificReturn := fake.updateNotificationsReturnsOnCall[len(fake.updateNotificationsArgsForCall)]
+	fake.updateNotificationsArgsForCall = append(fake.updateNotificationsArgsForCall, struct {
+		notifications []atc.NotificationConfig
+	}{notificationsCopy})
+	fake.recordInvocation("UpdateNotifications", []interface{}{notificationsCopy})
+	fake.updateNotificationsMutex.Unlock()
+	if fake.UpdateNotificationsStub != nil {
+		return fake.UpdateNotificationsStub(notifications)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateNotificationsReturns.result1
+}
+
+func (fake *FakeTeam) UpdateNotificationsCallCount() int {
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
+	return len(fake.updateNotificationsArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateNotificationsArgsForCall(i int) []atc.NotificationConfig {
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
+	return fake.updateNotificationsArgsForCall[i].notifications
+}
+
+func (fake *FakeTeam) UpdateNotificationsReturns(result1 error) {
+	fake.UpdateNotificationsStub = nil
+	fake.updateNotificationsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateNotificationsReturnsOnCall(i int, result1 error) {
+	fake.UpdateNotificationsStub = nil
+	if fake.updateNotificationsReturnsOnCall == nil {
+		fake.updateNotificationsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateNotificationsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) RedactionRules() (atc.RedactionRules, error) {
+	fake.redactionRulesMutex.Lock()
+	ret, specificReturn := fake.redactionRulesReturnsOnCall[len(fake.redactionRulesArgsForCall)]
+	fake.redactionRulesArgsForCall = append(fake.redactionRulesArgsForCall, struct{}{})
+	fake.recordInvocation("RedactionRules", []interface{}{})
+	fake.redactionRulesMutex.Unlock()
+	if fake.RedactionRulesStub != nil {
+		return fake.RedactionRulesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.redactionRulesReturns.result1, fake.redactionRulesReturns.result2
+}
+
+func (fake *FakeTeam) RedactionRulesCallCount() int {
+	fake.redactionRulesMutex.RLock()
+	defer fake.redactionRulesMutex.RUnlock()
+	return len(fake.redactionRulesArgsForCall)
+}
+
+func (fake *FakeTeam) RedactionRulesReturns(result1 atc.RedactionRules, result2 error) {
+	fake.RedactionRulesStub = nil
+	fake.redactionRulesReturns = struct {
+		result1 atc.RedactionRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RedactionRulesReturnsOnCall(i int, result1 atc.RedactionRules, result2 error) {
+	fake.RedactionRulesStub = nil
+	if fake.redactionRulesReturnsOnCall == nil {
+		fake.redactionRulesReturnsOnCall = make(map[int]struct {
+			result1 atc.RedactionRules
+			result2 error
+		})
+	}
+	fake.redactionRulesReturnsOnCall[i] = struct {
+		result1 atc.RedactionRules
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateRedactionRules(rules atc.RedactionRules) error {
+	fake.updateRedactionRulesMutex.Lock()
+	ret, specificReturn := fake.updateRedactionRulesReturnsOnCall[len(fake.updateRedactionRulesArgsForCall)]
+	fake.updateRedactionRulesArgsForCall = append(fake.updateRedactionRulesArgsForCall, struct {
+		rules atc.RedactionRules
+	}{rules})
+	fake.recordInvocation("UpdateRedactionRules", []interface{}{rules})
+	fake.updateRedactionRulesMutex.Unlock()
+	if fake.UpdateRedactionRulesStub != nil {
+		return fake.UpdateRedactionRulesStub(rules)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateRedactionRulesReturns.result1
+}
+
+func (fake *FakeTeam) UpdateRedactionRulesCallCount() int {
+	fake.updateRedactionRulesMutex.RLock()
+	defer fake.updateRedactionRulesMutex.RUnlock()
+	return len(fake.updateRedactionRulesArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateRedactionRulesArgsForCall(i int) atc.RedactionRules {
+	fake.updateRedactionRulesMutex.RLock()
+	defer fake.updateRedactionRulesMutex.RUnlock()
+	return fake.updateRedactionRulesArgsForCall[i].rules
+}
+
+func (fake *FakeTeam) UpdateRedactionRulesReturns(result1 error) {
+	fake.UpdateRedactionRulesStub = nil
+	fake.updateRedactionRulesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateRedactionRulesReturnsOnCall(i int, result1 error) {
+	fake.UpdateRedactionRulesStub = nil
+	if fake.updateRedactionRulesReturnsOnCall == nil {
+		fake.updateRedactionRulesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateRedactionRulesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) Scheduling() (atc.TeamScheduling, error) {
+	fake.schedulingMutex.Lock()
+	ret, specificReturn := fake.schedulingReturnsOnCall[len(fake.schedulingArgsForCall)]
+	fake.schedulingArgsForCall = append(fake.schedulingArgsForCall, struct{}{})
+	fake.recordInvocation("Scheduling", []interface{}{})
+	fake.schedulingMutex.Unlock()
+	if fake.SchedulingStub != nil {
+		return fake.SchedulingStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.schedulingReturns.result1, fake.schedulingReturns.result2
+}
+
+func (fake *FakeTeam) SchedulingCallCount() int {
+	fake.schedulingMutex.RLock()
+	defer fake.schedulingMutex.RUnlock()
+	return len(fake.schedulingArgsForCall)
+}
+
+func (fake *FakeTeam) SchedulingReturns(result1 atc.TeamScheduling, result2 error) {
+	fake.SchedulingStub = nil
+	fake.schedulingReturns = struct {
+		result1 atc.TeamScheduling
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SchedulingReturnsOnCall(i int, result1 atc.TeamScheduling, result2 error) {
+	fake.SchedulingStub = nil
+	if fake.schedulingReturnsOnCall == nil {
+		fake.schedulingReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamScheduling
+			result2 error
+		})
+	}
+	fake.schedulingReturnsOnCall[i] = struct {
+		result1 atc.TeamScheduling
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UpdateScheduling(scheduling atc.TeamScheduling) error {
+	fake.updateSchedulingMutex.Lock()
+	ret, specificReturn := fake.updateSchedulingReturnsOnCall[len(fake.updateSchedulingArgsForCall)]
+	fake.updateSchedulingArgsForCall = append(fake.updateSchedulingArgsForCall, struct {
+		scheduling atc.TeamScheduling
+	}{scheduling})
+	fake.recordInvocation("UpdateScheduling", []interface{}{scheduling})
+	fake.updateSchedulingMutex.Unlock()
+	if fake.UpdateSchedulingStub != nil {
+		return fake.UpdateSchedulingStub(scheduling)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateSchedulingReturns.result1
+}
+
+func (fake *FakeTeam) UpdateSchedulingCallCount() int {
+	fake.updateSchedulingMutex.RLock()
+	defer fake.updateSchedulingMutex.RUnlock()
+	return len(fake.updateSchedulingArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateSchedulingArgsForCall(i int) atc.TeamScheduling {
+	fake.updateSchedulingMutex.RLock()
+	defer fake.updateSchedulingMutex.RUnlock()
+	return fake.updateSchedulingArgsForCall[i].scheduling
+}
+
+func (fake *FakeTeam) UpdateSchedulingReturns(result1 error) {
+	fake.UpdateSchedulingStub = nil
+	fake.updateSchedulingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateSchedulingReturnsOnCall(i int, result1 error) {
+	fake.UpdateSchedulingStub = nil
+	if fake.updateSchedulingReturnsOnCall == nil {
+		fake.updateSchedulingReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateSchedulingReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1828,10 +2554,22 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.visiblePipelinesMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
+	fake.savePipelineTemplateMutex.RLock()
+	defer fake.savePipelineTemplateMutex.RUnlock()
+	fake.pipelineTemplateMutex.RLock()
+	defer fake.pipelineTemplateMutex.RUnlock()
+	fake.pipelineTemplatesMutex.RLock()
+	defer fake.pipelineTemplatesMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.privateAndPublicBuildsMutex.RLock()
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	fake.eventsMutex.RLock()
+	defer fake.eventsMutex.RUnlock()
+	fake.latestEventIDMutex.RLock()
+	defer fake.latestEventIDMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.workersMutex.RLock()
@@ -1862,6 +2600,18 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.updateBasicAuthMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	fake.updateNotificationsMutex.RLock()
+	defer fake.updateNotificationsMutex.RUnlock()
+	fake.redactionRulesMutex.RLock()
+	defer fake.redactionRulesMutex.RUnlock()
+	fake.updateRedactionRulesMutex.RLock()
+	defer fake.updateRedactionRulesMutex.RUnlock()
+	fake.schedulingMutex.RLock()
+	defer fake.schedulingMutex.RUnlock()
+	fake.updateSchedulingMutex.RLock()
+	defer fake.updateSchedulingMutex.RUnlock()
 	return fake.invocations
 }
 
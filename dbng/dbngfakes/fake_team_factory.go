@@ -3,6 +3,7 @@ package dbngfakes
 
 import (
 	"sync"
+	"time"
 
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/dbng"
@@ -59,6 +60,17 @@ type FakeTeamFactory struct {
 	getByIDReturnsOnCall map[int]struct {
 		result1 dbng.Team
 	}
+	ReapTeamEventsStub        func(retention time.Duration) error
+	reapTeamEventsMutex       sync.RWMutex
+	reapTeamEventsArgsForCall []struct {
+		retention time.Duration
+	}
+	reapTeamEventsReturns struct {
+		result1 error
+	}
+	reapTeamEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -259,6 +271,54 @@ func (fake *FakeTeamFactory) GetByIDReturnsOnCall(i int, result1 dbng.Team) {
 	}{result1}
 }
 
+func (fake *FakeTeamFactory) ReapTeamEvents(retention time.Duration) error {
+	fake.reapTeamEventsMutex.Lock()
+	ret, specificReturn := fake.reapTeamEventsReturnsOnCall[len(fake.reapTeamEventsArgsForCall)]
+	fake.reapTeamEventsArgsForCall = append(fake.reapTeamEventsArgsForCall, struct {
+		retention time.Duration
+	}{retention})
+	fake.recordInvocation("ReapTeamEvents", []interface{}{retention})
+	fake.reapTeamEventsMutex.Unlock()
+	if fake.ReapTeamEventsStub != nil {
+		return fake.ReapTeamEventsStub(retention)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.reapTeamEventsReturns.result1
+}
+
+func (fake *FakeTeamFactory) ReapTeamEventsCallCount() int {
+	fake.reapTeamEventsMutex.RLock()
+	defer fake.reapTeamEventsMutex.RUnlock()
+	return len(fake.reapTeamEventsArgsForCall)
+}
+
+func (fake *FakeTeamFactory) ReapTeamEventsArgsForCall(i int) time.Duration {
+	fake.reapTeamEventsMutex.RLock()
+	defer fake.reapTeamEventsMutex.RUnlock()
+	return fake.reapTeamEventsArgsForCall[i].retention
+}
+
+func (fake *FakeTeamFactory) ReapTeamEventsReturns(result1 error) {
+	fake.ReapTeamEventsStub = nil
+	fake.reapTeamEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeamFactory) ReapTeamEventsReturnsOnCall(i int, result1 error) {
+	fake.ReapTeamEventsStub = nil
+	if fake.reapTeamEventsReturnsOnCall == nil {
+		fake.reapTeamEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.reapTeamEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -270,6 +330,8 @@ func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	defer fake.getTeamsMutex.RUnlock()
 	fake.getByIDMutex.RLock()
 	defer fake.getByIDMutex.RUnlock()
+	fake.reapTeamEventsMutex.RLock()
+	defer fake.reapTeamEventsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -127,6 +127,24 @@ type FakeWorker struct {
 	tagsReturnsOnCall map[int]struct {
 		result1 []string
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct{}
+	labelsReturns     struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	StreamEncodingsStub        func() []string
+	streamEncodingsMutex       sync.RWMutex
+	streamEncodingsArgsForCall []struct{}
+	streamEncodingsReturns     struct {
+		result1 []string
+	}
+	streamEncodingsReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	TeamIDStub        func() int
 	teamIDMutex       sync.RWMutex
 	teamIDArgsForCall []struct{}
@@ -163,6 +181,24 @@ type FakeWorker struct {
 	expiresAtReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	ProbesStub        func() []dbng.WorkerProbe
+	probesMutex       sync.RWMutex
+	probesArgsForCall []struct{}
+	probesReturns     struct {
+		result1 []dbng.WorkerProbe
+	}
+	probesReturnsOnCall map[int]struct {
+		result1 []dbng.WorkerProbe
+	}
+	DrainDeadlineStub        func() *time.Time
+	drainDeadlineMutex       sync.RWMutex
+	drainDeadlineArgsForCall []struct{}
+	drainDeadlineReturns     struct {
+		result1 *time.Time
+	}
+	drainDeadlineReturnsOnCall map[int]struct {
+		result1 *time.Time
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct{}
@@ -210,6 +246,70 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DrainStub        func(retire bool, timeout time.Duration) error
+	drainMutex       sync.RWMutex
+	drainArgsForCall []struct {
+		retire  bool
+		timeout time.Duration
+	}
+	drainReturns struct {
+		result1 error
+	}
+	drainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DrainProgressStub        func() (dbng.WorkerDrainProgress, error)
+	drainProgressMutex       sync.RWMutex
+	drainProgressArgsForCall []struct{}
+	drainProgressReturns     struct {
+		result1 dbng.WorkerDrainProgress
+		result2 error
+	}
+	drainProgressReturnsOnCall map[int]struct {
+		result1 dbng.WorkerDrainProgress
+		result2 error
+	}
+	ActiveBuildContainersStub        func() ([]dbng.CreatedContainer, error)
+	activeBuildContainersMutex       sync.RWMutex
+	activeBuildContainersArgsForCall []struct{}
+	activeBuildContainersReturns     struct {
+		result1 []dbng.CreatedContainer
+		result2 error
+	}
+	activeBuildContainersReturnsOnCall map[int]struct {
+		result1 []dbng.CreatedContainer
+		result2 error
+	}
+	SaveProbeStub        func(probe dbng.WorkerProbe, keep int) error
+	saveProbeMutex       sync.RWMutex
+	saveProbeArgsForCall []struct {
+		probe dbng.WorkerProbe
+		keep  int
+	}
+	saveProbeReturns struct {
+		result1 error
+	}
+	saveProbeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	QuarantineStub        func() error
+	quarantineMutex       sync.RWMutex
+	quarantineArgsForCall []struct{}
+	quarantineReturns     struct {
+		result1 error
+	}
+	quarantineReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnquarantineStub        func() error
+	unquarantineMutex       sync.RWMutex
+	unquarantineArgsForCall []struct{}
+	unquarantineReturns     struct {
+		result1 error
+	}
+	unquarantineReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -734,6 +834,86 @@ func (fake *FakeWorker) TagsReturnsOnCall(i int, result1 []string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct{}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.labelsReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) StreamEncodings() []string {
+	fake.streamEncodingsMutex.Lock()
+	ret, specificReturn := fake.streamEncodingsReturnsOnCall[len(fake.streamEncodingsArgsForCall)]
+	fake.streamEncodingsArgsForCall = append(fake.streamEncodingsArgsForCall, struct{}{})
+	fake.recordInvocation("StreamEncodings", []interface{}{})
+	fake.streamEncodingsMutex.Unlock()
+	if fake.StreamEncodingsStub != nil {
+		return fake.StreamEncodingsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.streamEncodingsReturns.result1
+}
+
+func (fake *FakeWorker) StreamEncodingsCallCount() int {
+	fake.streamEncodingsMutex.RLock()
+	defer fake.streamEncodingsMutex.RUnlock()
+	return len(fake.streamEncodingsArgsForCall)
+}
+
+func (fake *FakeWorker) StreamEncodingsReturns(result1 []string) {
+	fake.StreamEncodingsStub = nil
+	fake.streamEncodingsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeWorker) StreamEncodingsReturnsOnCall(i int, result1 []string) {
+	fake.StreamEncodingsStub = nil
+	if fake.streamEncodingsReturnsOnCall == nil {
+		fake.streamEncodingsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.streamEncodingsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeWorker) TeamID() int {
 	fake.teamIDMutex.Lock()
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
@@ -894,6 +1074,86 @@ func (fake *FakeWorker) ExpiresAtReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Probes() []dbng.WorkerProbe {
+	fake.probesMutex.Lock()
+	ret, specificReturn := fake.probesReturnsOnCall[len(fake.probesArgsForCall)]
+	fake.probesArgsForCall = append(fake.probesArgsForCall, struct{}{})
+	fake.recordInvocation("Probes", []interface{}{})
+	fake.probesMutex.Unlock()
+	if fake.ProbesStub != nil {
+		return fake.ProbesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.probesReturns.result1
+}
+
+func (fake *FakeWorker) ProbesCallCount() int {
+	fake.probesMutex.RLock()
+	defer fake.probesMutex.RUnlock()
+	return len(fake.probesArgsForCall)
+}
+
+func (fake *FakeWorker) ProbesReturns(result1 []dbng.WorkerProbe) {
+	fake.ProbesStub = nil
+	fake.probesReturns = struct {
+		result1 []dbng.WorkerProbe
+	}{result1}
+}
+
+func (fake *FakeWorker) ProbesReturnsOnCall(i int, result1 []dbng.WorkerProbe) {
+	fake.ProbesStub = nil
+	if fake.probesReturnsOnCall == nil {
+		fake.probesReturnsOnCall = make(map[int]struct {
+			result1 []dbng.WorkerProbe
+		})
+	}
+	fake.probesReturnsOnCall[i] = struct {
+		result1 []dbng.WorkerProbe
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainDeadline() *time.Time {
+	fake.drainDeadlineMutex.Lock()
+	ret, specificReturn := fake.drainDeadlineReturnsOnCall[len(fake.drainDeadlineArgsForCall)]
+	fake.drainDeadlineArgsForCall = append(fake.drainDeadlineArgsForCall, struct{}{})
+	fake.recordInvocation("DrainDeadline", []interface{}{})
+	fake.drainDeadlineMutex.Unlock()
+	if fake.DrainDeadlineStub != nil {
+		return fake.DrainDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.drainDeadlineReturns.result1
+}
+
+func (fake *FakeWorker) DrainDeadlineCallCount() int {
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
+	return len(fake.drainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) DrainDeadlineReturns(result1 *time.Time) {
+	fake.DrainDeadlineStub = nil
+	fake.drainDeadlineReturns = struct {
+		result1 *time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainDeadlineReturnsOnCall(i int, result1 *time.Time) {
+	fake.DrainDeadlineStub = nil
+	if fake.drainDeadlineReturnsOnCall == nil {
+		fake.drainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 *time.Time
+		})
+	}
+	fake.drainDeadlineReturnsOnCall[i] = struct {
+		result1 *time.Time
+	}{result1}
+}
+
 func (fake *FakeWorker) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -1097,6 +1357,270 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Drain(retire bool, timeout time.Duration) error {
+	fake.drainMutex.Lock()
+	ret, specificReturn := fake.drainReturnsOnCall[len(fake.drainArgsForCall)]
+	fake.drainArgsForCall = append(fake.drainArgsForCall, struct {
+		retire  bool
+		timeout time.Duration
+	}{retire, timeout})
+	fake.recordInvocation("Drain", []interface{}{retire, timeout})
+	fake.drainMutex.Unlock()
+	if fake.DrainStub != nil {
+		return fake.DrainStub(retire, timeout)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.drainReturns.result1
+}
+
+func (fake *FakeWorker) DrainCallCount() int {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return len(fake.drainArgsForCall)
+}
+
+func (fake *FakeWorker) DrainArgsForCall(i int) (bool, time.Duration) {
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	return fake.drainArgsForCall[i].retire, fake.drainArgsForCall[i].timeout
+}
+
+func (fake *FakeWorker) DrainReturns(result1 error) {
+	fake.DrainStub = nil
+	fake.drainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainReturnsOnCall(i int, result1 error) {
+	fake.DrainStub = nil
+	if fake.drainReturnsOnCall == nil {
+		fake.drainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.drainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainProgress() (dbng.WorkerDrainProgress, error) {
+	fake.drainProgressMutex.Lock()
+	ret, specificReturn := fake.drainProgressReturnsOnCall[len(fake.drainProgressArgsForCall)]
+	fake.drainProgressArgsForCall = append(fake.drainProgressArgsForCall, struct{}{})
+	fake.recordInvocation("DrainProgress", []interface{}{})
+	fake.drainProgressMutex.Unlock()
+	if fake.DrainProgressStub != nil {
+		return fake.DrainProgressStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.drainProgressReturns.result1, fake.drainProgressReturns.result2
+}
+
+func (fake *FakeWorker) DrainProgressCallCount() int {
+	fake.drainProgressMutex.RLock()
+	defer fake.drainProgressMutex.RUnlock()
+	return len(fake.drainProgressArgsForCall)
+}
+
+func (fake *FakeWorker) DrainProgressReturns(result1 dbng.WorkerDrainProgress, result2 error) {
+	fake.DrainProgressStub = nil
+	fake.drainProgressReturns = struct {
+		result1 dbng.WorkerDrainProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) DrainProgressReturnsOnCall(i int, result1 dbng.WorkerDrainProgress, result2 error) {
+	fake.DrainProgressStub = nil
+	if fake.drainProgressReturnsOnCall == nil {
+		fake.drainProgressReturnsOnCall = make(map[int]struct {
+			result1 dbng.WorkerDrainProgress
+			result2 error
+		})
+	}
+	fake.drainProgressReturnsOnCall[i] = struct {
+		result1 dbng.WorkerDrainProgress
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ActiveBuildContainers() ([]dbng.CreatedContainer, error) {
+	fake.activeBuildContainersMutex.Lock()
+	ret, specificReturn := fake.activeBuildContainersReturnsOnCall[len(fake.activeBuildContainersArgsForCall)]
+	fake.activeBuildContainersArgsForCall = append(fake.activeBuildContainersArgsForCall, struct{}{})
+	fake.recordInvocation("ActiveBuildContainers", []interface{}{})
+	fake.activeBuildContainersMutex.Unlock()
+	if fake.ActiveBuildContainersStub != nil {
+		return fake.ActiveBuildContainersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.activeBuildContainersReturns.result1, fake.activeBuildContainersReturns.result2
+}
+
+func (fake *FakeWorker) ActiveBuildContainersCallCount() int {
+	fake.activeBuildContainersMutex.RLock()
+	defer fake.activeBuildContainersMutex.RUnlock()
+	return len(fake.activeBuildContainersArgsForCall)
+}
+
+func (fake *FakeWorker) ActiveBuildContainersReturns(result1 []dbng.CreatedContainer, result2 error) {
+	fake.ActiveBuildContainersStub = nil
+	fake.activeBuildContainersReturns = struct {
+		result1 []dbng.CreatedContainer
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) ActiveBuildContainersReturnsOnCall(i int, result1 []dbng.CreatedContainer, result2 error) {
+	fake.ActiveBuildContainersStub = nil
+	if fake.activeBuildContainersReturnsOnCall == nil {
+		fake.activeBuildContainersReturnsOnCall = make(map[int]struct {
+			result1 []dbng.CreatedContainer
+			result2 error
+		})
+	}
+	fake.activeBuildContainersReturnsOnCall[i] = struct {
+		result1 []dbng.CreatedContainer
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) SaveProbe(probe dbng.WorkerProbe, keep int) error {
+	fake.saveProbeMutex.Lock()
+	ret, specificReturn := fake.saveProbeReturnsOnCall[len(fake.saveProbeArgsForCall)]
+	fake.saveProbeArgsForCall = append(fake.saveProbeArgsForCall, struct {
+		probe dbng.WorkerProbe
+		keep  int
+	}{probe, keep})
+	fake.recordInvocation("SaveProbe", []interface{}{probe, keep})
+	fake.saveProbeMutex.Unlock()
+	if fake.SaveProbeStub != nil {
+		return fake.SaveProbeStub(probe, keep)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.saveProbeReturns.result1
+}
+
+func (fake *FakeWorker) SaveProbeCallCount() int {
+	fake.saveProbeMutex.RLock()
+	defer fake.saveProbeMutex.RUnlock()
+	return len(fake.saveProbeArgsForCall)
+}
+
+func (fake *FakeWorker) SaveProbeArgsForCall(i int) (dbng.WorkerProbe, int) {
+	fake.saveProbeMutex.RLock()
+	defer fake.saveProbeMutex.RUnlock()
+	return fake.saveProbeArgsForCall[i].probe, fake.saveProbeArgsForCall[i].keep
+}
+
+func (fake *FakeWorker) SaveProbeReturns(result1 error) {
+	fake.SaveProbeStub = nil
+	fake.saveProbeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) SaveProbeReturnsOnCall(i int, result1 error) {
+	fake.SaveProbeStub = nil
+	if fake.saveProbeReturnsOnCall == nil {
+		fake.saveProbeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveProbeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) Quarantine() error {
+	fake.quarantineMutex.Lock()
+	ret, specificReturn := fake.quarantineReturnsOnCall[len(fake.quarantineArgsForCall)]
+	fake.quarantineArgsForCall = append(fake.quarantineArgsForCall, struct{}{})
+	fake.recordInvocation("Quarantine", []interface{}{})
+	fake.quarantineMutex.Unlock()
+	if fake.QuarantineStub != nil {
+		return fake.QuarantineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.quarantineReturns.result1
+}
+
+func (fake *FakeWorker) QuarantineCallCount() int {
+	fake.quarantineMutex.RLock()
+	defer fake.quarantineMutex.RUnlock()
+	return len(fake.quarantineArgsForCall)
+}
+
+func (fake *FakeWorker) QuarantineReturns(result1 error) {
+	fake.QuarantineStub = nil
+	fake.quarantineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) QuarantineReturnsOnCall(i int, result1 error) {
+	fake.QuarantineStub = nil
+	if fake.quarantineReturnsOnCall == nil {
+		fake.quarantineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.quarantineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) Unquarantine() error {
+	fake.unquarantineMutex.Lock()
+	ret, specificReturn := fake.unquarantineReturnsOnCall[len(fake.unquarantineArgsForCall)]
+	fake.unquarantineArgsForCall = append(fake.unquarantineArgsForCall, struct{}{})
+	fake.recordInvocation("Unquarantine", []interface{}{})
+	fake.unquarantineMutex.Unlock()
+	if fake.UnquarantineStub != nil {
+		return fake.UnquarantineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unquarantineReturns.result1
+}
+
+func (fake *FakeWorker) UnquarantineCallCount() int {
+	fake.unquarantineMutex.RLock()
+	defer fake.unquarantineMutex.RUnlock()
+	return len(fake.unquarantineArgsForCall)
+}
+
+func (fake *FakeWorker) UnquarantineReturns(result1 error) {
+	fake.UnquarantineStub = nil
+	fake.unquarantineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) UnquarantineReturnsOnCall(i int, result1 error) {
+	fake.UnquarantineStub = nil
+	if fake.unquarantineReturnsOnCall == nil {
+		fake.unquarantineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unquarantineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -1126,6 +1650,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.platformMutex.RUnlock()
 	fake.tagsMutex.RLock()
 	defer fake.tagsMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	fake.streamEncodingsMutex.RLock()
+	defer fake.streamEncodingsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
@@ -1134,6 +1662,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.startTimeMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
 	defer fake.expiresAtMutex.RUnlock()
+	fake.probesMutex.RLock()
+	defer fake.probesMutex.RUnlock()
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.landMutex.RLock()
@@ -1144,6 +1676,18 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.pruneMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.drainMutex.RLock()
+	defer fake.drainMutex.RUnlock()
+	fake.drainProgressMutex.RLock()
+	defer fake.drainProgressMutex.RUnlock()
+	fake.activeBuildContainersMutex.RLock()
+	defer fake.activeBuildContainersMutex.RUnlock()
+	fake.saveProbeMutex.RLock()
+	defer fake.saveProbeMutex.RUnlock()
+	fake.quarantineMutex.RLock()
+	defer fake.quarantineMutex.RUnlock()
+	fake.unquarantineMutex.RLock()
+	defer fake.unquarantineMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,3 @@
+package dbng
+
+var SaveTeamEvent = saveTeamEvent
@@ -0,0 +1,361 @@
+package dbng
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// ImageResourceFetch tracks how often the image of a resource cache has been
+// fetched for containers via an image resource, so that the most used images
+// can be fetched on workers ahead of time.
+//
+// The user, team, and tags are those of the most recent fetch.
+//
+// The source and resource types of the image are never stored, as they may
+// carry credentials. MostFetched re-derives them from the pipeline of the
+// user instead.
+type ImageResourceFetch struct {
+	ResourceCacheID int
+	TeamID          int
+	User            ResourceUser
+
+	ResourceType  string
+	Source        atc.Source
+	Version       atc.Version
+	ResourceTypes atc.VersionedResourceTypes
+	Tags          atc.Tags
+
+	SizeInBytes int64
+
+	Fetches     int
+	Hits        int
+	LastFetched time.Time
+}
+
+//go:generate counterfeiter . ImageResourceFetchFactory
+
+type ImageResourceFetchFactory interface {
+	// RecordFetch counts a fetch of the image, and whether the worker already
+	// had it. A SizeInBytes of 0 leaves any previously recorded size alone.
+	RecordFetch(fetch ImageResourceFetch, hit bool) error
+
+	// MostFetched returns the images fetched most often, most recently fetched
+	// first among equals. If teamID is 0, images fetched by every team are
+	// returned.
+	//
+	// Images are omitted if their source can no longer be found in the config
+	// of their last user's pipeline, as they cannot be fetched on its behalf.
+	// This includes images last fetched by one-off builds, or by tasks whose
+	// config is loaded from a file.
+	MostFetched(teamID int, limit int) ([]ImageResourceFetch, error)
+}
+
+type imageResourceFetchFactory struct {
+	conn Conn
+}
+
+func NewImageResourceFetchFactory(conn Conn) ImageResourceFetchFactory {
+	return &imageResourceFetchFactory{
+		conn: conn,
+	}
+}
+
+func (f *imageResourceFetchFactory) RecordFetch(fetch ImageResourceFetch, hit bool) error {
+	buildID, resourceID, resourceTypeID := resourceUserIDs(fetch.User)
+
+	version, err := json.Marshal(fetch.Version)
+	if err != nil {
+		return err
+	}
+
+	tags, err := json.Marshal(fetch.Tags)
+	if err != nil {
+		return err
+	}
+
+	hits := 0
+	if hit {
+		hits = 1
+	}
+
+	_, err = psql.Insert("image_resource_fetches").
+		Columns(
+			"resource_cache_id",
+			"team_id",
+			"build_id",
+			"resource_id",
+			"resource_type_id",
+			"type",
+			"version",
+			"tags",
+			"size_in_bytes",
+			"fetches",
+			"hits",
+		).
+		Values(
+			fetch.ResourceCacheID,
+			fetch.TeamID,
+			buildID,
+			resourceID,
+			resourceTypeID,
+			fetch.ResourceType,
+			string(version),
+			string(tags),
+			fetch.SizeInBytes,
+			1,
+			hits,
+		).
+		Suffix(`
+			ON CONFLICT (resource_cache_id) DO UPDATE SET
+				team_id = EXCLUDED.team_id,
+				build_id = EXCLUDED.build_id,
+				resource_id = EXCLUDED.resource_id,
+				resource_type_id = EXCLUDED.resource_type_id,
+				type = EXCLUDED.type,
+				version = EXCLUDED.version,
+				tags = EXCLUDED.tags,
+				size_in_bytes = CASE
+					WHEN EXCLUDED.size_in_bytes > 0 THEN EXCLUDED.size_in_bytes
+					ELSE image_resource_fetches.size_in_bytes
+				END,
+				fetches = image_resource_fetches.fetches + 1,
+				hits = image_resource_fetches.hits + EXCLUDED.hits,
+				last_fetched = now()
+		`).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "foreign_key_violation" {
+			// the resource cache or team went away
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (f *imageResourceFetchFactory) MostFetched(teamID int, limit int) ([]ImageResourceFetch, error) {
+	query := psql.Select(
+		"f.resource_cache_id",
+		"f.team_id",
+		"f.build_id",
+		"f.resource_id",
+		"f.resource_type_id",
+		"f.type",
+		"f.version",
+		"f.tags",
+		"f.size_in_bytes",
+		"f.fetches",
+		"f.hits",
+		"f.last_fetched",
+		"rf.source_hash",
+		"COALESCE(j.pipeline_id, r.pipeline_id, rt.pipeline_id)",
+	).
+		From("image_resource_fetches f").
+		Join("resource_caches rc ON rc.id = f.resource_cache_id").
+		Join("resource_configs rf ON rf.id = rc.resource_config_id").
+		LeftJoin("builds b ON b.id = f.build_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		LeftJoin("resources r ON r.id = f.resource_id").
+		LeftJoin("resource_types rt ON rt.id = f.resource_type_id").
+		Where(sq.Expr("COALESCE(j.pipeline_id, r.pipeline_id, rt.pipeline_id) IS NOT NULL")).
+		OrderBy("f.fetches DESC", "f.last_fetched DESC").
+		Limit(uint64(limit))
+
+	if teamID != 0 {
+		query = query.Where(sq.Eq{"f.team_id": teamID})
+	}
+
+	rows, err := query.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	type storedFetch struct {
+		fetch      ImageResourceFetch
+		sourceHash string
+		pipelineID int
+	}
+
+	stored := []storedFetch{}
+	for rows.Next() {
+		var s storedFetch
+		var buildID, resourceID, resourceTypeID sql.NullInt64
+		var version, tags string
+
+		err = rows.Scan(
+			&s.fetch.ResourceCacheID,
+			&s.fetch.TeamID,
+			&buildID,
+			&resourceID,
+			&resourceTypeID,
+			&s.fetch.ResourceType,
+			&version,
+			&tags,
+			&s.fetch.SizeInBytes,
+			&s.fetch.Fetches,
+			&s.fetch.Hits,
+			&s.fetch.LastFetched,
+			&s.sourceHash,
+			&s.pipelineID,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case buildID.Valid:
+			s.fetch.User = ForBuild(int(buildID.Int64))
+		case resourceID.Valid:
+			s.fetch.User = ForResource(int(resourceID.Int64))
+		case resourceTypeID.Valid:
+			s.fetch.User = ForResourceType(int(resourceTypeID.Int64))
+		}
+
+		err = json.Unmarshal([]byte(version), &s.fetch.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(tags), &s.fetch.Tags)
+		if err != nil {
+			return nil, err
+		}
+
+		stored = append(stored, s)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineImages := map[int][]pipelineImage{}
+
+	fetches := []ImageResourceFetch{}
+	for _, s := range stored {
+		images, found := pipelineImages[s.pipelineID]
+		if !found {
+			images, err = f.pipelineImages(s.pipelineID)
+			if err != nil {
+				return nil, err
+			}
+
+			pipelineImages[s.pipelineID] = images
+		}
+
+		for _, image := range images {
+			if image.resourceType == s.fetch.ResourceType && mapHash(image.source) == s.sourceHash {
+				s.fetch.Source = image.source
+				s.fetch.ResourceTypes = image.resourceTypes
+				fetches = append(fetches, s.fetch)
+				break
+			}
+		}
+	}
+
+	return fetches, nil
+}
+
+type pipelineImage struct {
+	resourceType  string
+	source        atc.Source
+	resourceTypes atc.VersionedResourceTypes
+}
+
+// pipelineImages returns the images that containers of the pipeline may be
+// run with: those of its resource types, and of its tasks configured with an
+// image resource inline.
+func (f *imageResourceFetchFactory) pipelineImages(pipelineID int) ([]pipelineImage, error) {
+	var configBlob []byte
+	err := psql.Select("config").
+		From("pipelines").
+		Where(sq.Eq{"id": pipelineID}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&configBlob)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(configBlob, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := resourceTypesQuery.Where(sq.Eq{"pipeline_id": pipelineID}).RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	resourceTypes := ResourceTypes{}
+	for rows.Next() {
+		resourceType := &resourceType{conn: f.conn}
+		err := scanResourceType(resourceType, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+
+	versionedResourceTypes := resourceTypes.Deserialize()
+
+	images := []pipelineImage{}
+	for _, t := range versionedResourceTypes {
+		images = append(images, pipelineImage{
+			resourceType:  t.Type,
+			source:        t.Source,
+			resourceTypes: versionedResourceTypes.Without(t.Name),
+		})
+	}
+
+	for _, job := range config.Jobs {
+		for _, plan := range job.Plans() {
+			if plan.TaskConfig == nil || plan.TaskConfig.ImageResource == nil {
+				continue
+			}
+
+			images = append(images, pipelineImage{
+				resourceType:  plan.TaskConfig.ImageResource.Type,
+				source:        plan.TaskConfig.ImageResource.Source,
+				resourceTypes: versionedResourceTypes,
+			})
+		}
+	}
+
+	return images, nil
+}
+
+// resourceUserIDs returns the columns that the user is recorded under in
+// resource_cache_uses, only one of which is set.
+func resourceUserIDs(user ResourceUser) (sql.NullInt64, sql.NullInt64, sql.NullInt64) {
+	var buildID, resourceID, resourceTypeID sql.NullInt64
+
+	switch u := user.(type) {
+	case forBuild:
+		buildID = sql.NullInt64{Int64: int64(u.BuildID), Valid: true}
+	case forResource:
+		resourceID = sql.NullInt64{Int64: int64(u.ResourceID), Valid: true}
+	case forResourceType:
+		resourceTypeID = sql.NullInt64{Int64: int64(u.ResourceTypeID), Valid: true}
+	}
+
+	return buildID, resourceID, resourceTypeID
+}
@@ -0,0 +1,245 @@
+package dbng_test
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ImageResourceFetchFactory", func() {
+	var (
+		imageResourceFetchFactory dbng.ImageResourceFetchFactory
+
+		fetch dbng.ImageResourceFetch
+	)
+
+	BeforeEach(func() {
+		imageResourceFetchFactory = dbng.NewImageResourceFetchFactory(dbConn)
+
+		fetch = createImageResourceFetch(dbng.ForResource(defaultResource.ID()), atc.Version{"some": "version"})
+	})
+
+	Describe("RecordFetch", func() {
+		It("counts fetches and hits", func() {
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, false)).To(Succeed())
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, true)).To(Succeed())
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, true)).To(Succeed())
+
+			fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches).To(HaveLen(1))
+
+			Expect(fetches[0].ResourceCacheID).To(Equal(fetch.ResourceCacheID))
+			Expect(fetches[0].TeamID).To(Equal(defaultTeam.ID()))
+			Expect(fetches[0].User).To(Equal(dbng.ForResource(defaultResource.ID())))
+			Expect(fetches[0].ResourceType).To(Equal("some-base-resource-type"))
+			Expect(fetches[0].Source).To(Equal(atc.Source{"some-type": "source"}))
+			Expect(fetches[0].ResourceTypes).To(BeEmpty())
+			Expect(fetches[0].Version).To(Equal(atc.Version{"some": "version"}))
+			Expect(fetches[0].Tags).To(Equal(atc.Tags{"some-tag"}))
+			Expect(fetches[0].SizeInBytes).To(Equal(int64(1024)))
+			Expect(fetches[0].Fetches).To(Equal(3))
+			Expect(fetches[0].Hits).To(Equal(2))
+		})
+
+		It("does not lose fetches recorded concurrently", func() {
+			wg := new(sync.WaitGroup)
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					Expect(imageResourceFetchFactory.RecordFetch(fetch, true)).To(Succeed())
+				}()
+			}
+
+			wg.Wait()
+
+			fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches[0].Fetches).To(Equal(10))
+			Expect(fetches[0].Hits).To(Equal(10))
+		})
+
+		It("does not store the source of the image", func() {
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, false)).To(Succeed())
+
+			var columns int
+			err := dbConn.QueryRow(`
+				SELECT COUNT(*)
+				FROM information_schema.columns
+				WHERE table_name = 'image_resource_fetches'
+				AND column_name IN ('source', 'resource_types')
+			`).Scan(&columns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(columns).To(BeZero())
+		})
+
+		It("keeps the recorded size when a fetch does not know it", func() {
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, false)).To(Succeed())
+
+			fetch.SizeInBytes = 0
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, true)).To(Succeed())
+
+			fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches[0].SizeInBytes).To(Equal(int64(1024)))
+		})
+	})
+
+	Describe("MostFetched", func() {
+		var otherFetch dbng.ImageResourceFetch
+
+		BeforeEach(func() {
+			otherFetch = createImageResourceFetch(dbng.ForResource(defaultResource.ID()), atc.Version{"some": "other-version"})
+
+			Expect(imageResourceFetchFactory.RecordFetch(fetch, false)).To(Succeed())
+			Expect(imageResourceFetchFactory.RecordFetch(otherFetch, false)).To(Succeed())
+			Expect(imageResourceFetchFactory.RecordFetch(otherFetch, true)).To(Succeed())
+		})
+
+		It("returns the most fetched images first, up to the limit", func() {
+			fetches, err := imageResourceFetchFactory.MostFetched(0, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches).To(HaveLen(1))
+			Expect(fetches[0].ResourceCacheID).To(Equal(otherFetch.ResourceCacheID))
+		})
+
+		It("returns only the images of the given team", func() {
+			fetches, err := imageResourceFetchFactory.MostFetched(defaultTeam.ID(), 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches).To(HaveLen(2))
+
+			fetches, err = imageResourceFetchFactory.MostFetched(defaultTeam.ID()+1, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetches).To(BeEmpty())
+		})
+
+		Context("when the user of an image has gone away", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`UPDATE image_resource_fetches SET resource_id = NULL WHERE resource_cache_id = $1`, otherFetch.ResourceCacheID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("omits it", func() {
+				fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetches).To(HaveLen(1))
+				Expect(fetches[0].ResourceCacheID).To(Equal(fetch.ResourceCacheID))
+			})
+		})
+
+		Context("when the image was last fetched by a one-off build", func() {
+			BeforeEach(func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				otherFetch.User = dbng.ForBuild(build.ID())
+				Expect(imageResourceFetchFactory.RecordFetch(otherFetch, false)).To(Succeed())
+			})
+
+			It("omits it, as its source cannot be re-derived", func() {
+				fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetches).To(HaveLen(1))
+				Expect(fetches[0].ResourceCacheID).To(Equal(fetch.ResourceCacheID))
+			})
+		})
+
+		Context("when the image was last fetched by a job's task", func() {
+			var pipeline dbng.Pipeline
+
+			BeforeEach(func() {
+				var err error
+				pipeline, _, err = defaultTeam.SavePipeline("task-pipeline", atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							Plan: atc.PlanSequence{
+								{
+									Task: "some-task",
+									TaskConfig: &atc.TaskConfig{
+										ImageResource: &atc.ImageResource{
+											Type:   "some-base-resource-type",
+											Source: atc.Source{"some": "task-source"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("re-derives the source from the task's config", func() {
+				build, err := pipeline.CreateJobBuild("some-job")
+				Expect(err).NotTo(HaveOccurred())
+
+				taskFetch := createImageResourceFetchWithSource(dbng.ForBuild(build.ID()), atc.Version{"some": "version"}, atc.Source{"some": "task-source"})
+				Expect(imageResourceFetchFactory.RecordFetch(taskFetch, false)).To(Succeed())
+
+				fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fetches).To(HaveLen(3))
+
+				var found bool
+				for _, f := range fetches {
+					if f.ResourceCacheID == taskFetch.ResourceCacheID {
+						found = true
+						Expect(f.Source).To(Equal(atc.Source{"some": "task-source"}))
+						Expect(f.ResourceTypes).To(BeEmpty())
+					}
+				}
+
+				Expect(found).To(BeTrue())
+			})
+
+			Context("when the task's image has since been changed", func() {
+				It("omits it", func() {
+					build, err := pipeline.CreateJobBuild("some-job")
+					Expect(err).NotTo(HaveOccurred())
+
+					taskFetch := createImageResourceFetchWithSource(dbng.ForBuild(build.ID()), atc.Version{"some": "version"}, atc.Source{"some": "old-task-source"})
+					Expect(imageResourceFetchFactory.RecordFetch(taskFetch, false)).To(Succeed())
+
+					fetches, err := imageResourceFetchFactory.MostFetched(0, 10)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fetches).To(HaveLen(2))
+				})
+			})
+		})
+	})
+})
+
+func createImageResourceFetch(user dbng.ResourceUser, version atc.Version) dbng.ImageResourceFetch {
+	return createImageResourceFetchWithSource(user, version, atc.Source{"some-type": "source"})
+}
+
+func createImageResourceFetchWithSource(user dbng.ResourceUser, version atc.Version, source atc.Source) dbng.ImageResourceFetch {
+	usedResourceCache, err := resourceCacheFactory.FindOrCreateResourceCache(
+		lagertest.NewTestLogger("test"),
+		user,
+		"some-base-resource-type",
+		version,
+		source,
+		atc.Params{},
+		atc.VersionedResourceTypes{},
+	)
+	Expect(err).NotTo(HaveOccurred())
+
+	return dbng.ImageResourceFetch{
+		ResourceCacheID: usedResourceCache.ID,
+		TeamID:          defaultTeam.ID(),
+		User:            user,
+		ResourceType:    "some-base-resource-type",
+		Version:         version,
+		Tags:            atc.Tags{"some-tag"},
+		SizeInBytes:     1024,
+	}
+}
@@ -19,6 +19,8 @@ type Job interface {
 	TeamID() int
 	TeamName() string
 	Config() atc.JobConfig
+
+	Annotations(name string, limit int) ([]atc.BuildAnnotation, error)
 }
 
 var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name").
@@ -67,3 +69,38 @@ func scanJob(j *job, row scannable) error {
 
 	return nil
 }
+
+// Annotations returns the values of the named annotation across the job's
+// builds, most recent build first.
+func (j *job) Annotations(name string, limit int) ([]atc.BuildAnnotation, error) {
+	rows, err := psql.Select("b.id", "b.name", "a.step", "a.name", "a.value").
+		From("build_annotations a").
+		Join("builds b ON b.id = a.build_id").
+		Where(sq.Eq{
+			"b.job_id": j.id,
+			"a.name":   name,
+		}).
+		OrderBy("b.id DESC").
+		Limit(uint64(limit)).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	annotations := []atc.BuildAnnotation{}
+	for rows.Next() {
+		var annotation atc.BuildAnnotation
+
+		err := rows.Scan(&annotation.BuildID, &annotation.BuildName, &annotation.Step, &annotation.Name, &annotation.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		annotations = append(annotations, annotation)
+	}
+
+	return annotations, nil
+}
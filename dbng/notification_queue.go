@@ -0,0 +1,159 @@
+package dbng
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// PendingNotification is a notification of a finished build that has yet to
+// be delivered.
+type PendingNotification struct {
+	ID           int
+	BuildID      int
+	TeamID       int
+	Notification string
+
+	// Message is the encoded message describing the build.
+	Message json.RawMessage
+
+	// Attempts is the number of deliveries attempted so far.
+	Attempts int
+}
+
+//go:generate counterfeiter . NotificationQueue
+
+// NotificationQueue holds the notifications waiting to be delivered, so that
+// pending deliveries and their retries survive the ATC restarting, and are
+// shared between every ATC in the cluster.
+type NotificationQueue interface {
+	// Enqueue adds a delivery of the named notification for the build, due
+	// immediately.
+	Enqueue(buildID int, notification string, message json.RawMessage) error
+
+	// Claim returns a notification that is due to be delivered, and keeps it
+	// from being claimed again until the lease expires. A notification whose
+	// lease expires without being completed or retried, e.g. because the ATC
+	// delivering it went away, is claimed again.
+	Claim(lease time.Duration) (PendingNotification, bool, error)
+
+	// Complete removes the notification from the queue, recording its final
+	// delivery attempt against the build.
+	Complete(pending PendingNotification, delivery NotificationDelivery) error
+
+	// Retry records the failed delivery attempt against the build, and makes
+	// the notification due again after the delay.
+	Retry(pending PendingNotification, delivery NotificationDelivery, delay time.Duration) error
+}
+
+type notificationQueue struct {
+	conn Conn
+}
+
+func NewNotificationQueue(conn Conn) NotificationQueue {
+	return &notificationQueue{
+		conn: conn,
+	}
+}
+
+func (q *notificationQueue) Enqueue(buildID int, notification string, message json.RawMessage) error {
+	_, err := psql.Insert("pending_notifications").
+		Columns("build_id", "notification", "message").
+		Values(buildID, notification, string(message)).
+		RunWith(q.conn).
+		Exec()
+	return err
+}
+
+func (q *notificationQueue) Claim(lease time.Duration) (PendingNotification, bool, error) {
+	var pending PendingNotification
+	var message string
+
+	err := q.conn.QueryRow(`
+		UPDATE pending_notifications n
+		SET claimed_until = now() + ($1 || ' SECONDS')::INTERVAL
+		FROM builds b
+		WHERE n.id = (
+			SELECT id
+			FROM pending_notifications
+			WHERE due_at <= now()
+			AND (claimed_until IS NULL OR claimed_until <= now())
+			ORDER BY due_at ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		AND b.id = n.build_id
+		RETURNING n.id, n.build_id, b.team_id, n.notification, n.message, n.attempts
+	`, lease.Seconds()).Scan(
+		&pending.ID,
+		&pending.BuildID,
+		&pending.TeamID,
+		&pending.Notification,
+		&message,
+		&pending.Attempts,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PendingNotification{}, false, nil
+		}
+
+		return PendingNotification{}, false, err
+	}
+
+	pending.Message = json.RawMessage(message)
+
+	return pending, true, nil
+}
+
+func (q *notificationQueue) Complete(pending PendingNotification, delivery NotificationDelivery) error {
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Delete("pending_notifications").
+		Where(sq.Eq{"id": pending.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = saveNotificationDelivery(tx, pending.BuildID, delivery)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (q *notificationQueue) Retry(pending PendingNotification, delivery NotificationDelivery, delay time.Duration) error {
+	tx, err := q.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Update("pending_notifications").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("due_at", sq.Expr("now() + (? || ' SECONDS')::INTERVAL", delay.Seconds())).
+		Set("claimed_until", nil).
+		Where(sq.Eq{"id": pending.ID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = saveNotificationDelivery(tx, pending.BuildID, delivery)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,168 @@
+package dbng_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/atc/dbng"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationQueue", func() {
+	var (
+		queue dbng.NotificationQueue
+		build dbng.Build
+	)
+
+	BeforeEach(func() {
+		queue = dbng.NewNotificationQueue(dbConn)
+
+		var err error
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when nothing is queued", func() {
+		It("claims nothing", func() {
+			_, found, err := queue.Claim(time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when a notification is queued", func() {
+		var pending dbng.PendingNotification
+
+		BeforeEach(func() {
+			err := queue.Enqueue(build.ID(), "some-hook", json.RawMessage(`{"build_id":1}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			var found bool
+			pending, found, err = queue.Claim(time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("claims it with its build's team", func() {
+			Expect(pending.BuildID).To(Equal(build.ID()))
+			Expect(pending.TeamID).To(Equal(defaultTeam.ID()))
+			Expect(pending.Notification).To(Equal("some-hook"))
+			Expect(pending.Message).To(MatchJSON(`{"build_id":1}`))
+			Expect(pending.Attempts).To(BeZero())
+		})
+
+		It("cannot be claimed again while it is leased", func() {
+			_, found, err := dbng.NewNotificationQueue(dbConn).Claim(time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the lease expires", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`UPDATE pending_notifications SET claimed_until = now() - interval '1 second'`)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("can be claimed again, e.g. by another ATC", func() {
+				reclaimed, found, err := dbng.NewNotificationQueue(dbConn).Claim(time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(reclaimed.ID).To(Equal(pending.ID))
+			})
+		})
+
+		Describe("Complete", func() {
+			BeforeEach(func() {
+				err := queue.Complete(pending, dbng.NotificationDelivery{
+					Notification: "some-hook",
+					Attempt:      1,
+					Delivered:    true,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes it from the queue", func() {
+				_, err := dbConn.Exec(`UPDATE pending_notifications SET claimed_until = NULL`)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, found, err := queue.Claim(time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("records the delivery against the build", func() {
+				deliveries, err := build.NotificationDeliveries()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Notification).To(Equal("some-hook"))
+				Expect(deliveries[0].Delivered).To(BeTrue())
+			})
+		})
+
+		Describe("Retry", func() {
+			var delay time.Duration
+
+			JustBeforeEach(func() {
+				err := queue.Retry(pending, dbng.NotificationDelivery{
+					Notification: "some-hook",
+					Attempt:      1,
+					Delivered:    false,
+					Error:        "connection refused",
+				}, delay)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("before the delay has passed", func() {
+				BeforeEach(func() {
+					delay = time.Hour
+				})
+
+				It("is not claimed", func() {
+					_, found, err := queue.Claim(time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+
+			Context("once the delay has passed", func() {
+				BeforeEach(func() {
+					delay = 0
+				})
+
+				It("is claimed again, counting the attempt", func() {
+					retried, found, err := queue.Claim(time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(retried.ID).To(Equal(pending.ID))
+					Expect(retried.Attempts).To(Equal(1))
+				})
+			})
+
+			It("records the failed delivery against the build", func() {
+				deliveries, err := build.NotificationDeliveries()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Delivered).To(BeFalse())
+				Expect(deliveries[0].Error).To(Equal("connection refused"))
+			})
+		})
+
+		Context("when the build is deleted", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec(`DELETE FROM builds WHERE id = $1`, build.ID())
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = dbConn.Exec(`UPDATE pending_notifications SET claimed_until = NULL`)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("is removed from the queue", func() {
+				_, found, err := queue.Claim(time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/db/algorithm"
 	"github.com/concourse/atc/db/lock"
+	"github.com/concourse/atc/event"
 )
 
 //go:generate counterfeiter . Pipeline
@@ -35,6 +36,7 @@ type Pipeline interface {
 	SetResourceCheckError(Resource, error) error
 
 	GetAllPendingBuilds() (map[string][]Build, error)
+	InFlightBuilds() ([]Build, error)
 
 	SaveResourceVersions(atc.ResourceConfig, []atc.Version) error
 	GetResourceVersions(resourceName string, page Page) ([]SavedVersionedResource, Pagination, bool, error)
@@ -89,6 +91,9 @@ type Pipeline interface {
 
 	Destroy() error
 	Rename(string) error
+
+	ConfigVersions() ([]PipelineConfigVersion, error)
+	ConfigAtVersion(ConfigVersion) (PipelineConfigVersion, bool, error)
 }
 
 type pipeline struct {
@@ -271,23 +276,56 @@ func (p *pipeline) NextBuildInputs(jobName string) ([]BuildInput, bool, error) {
 }
 
 func (p *pipeline) SetResourceCheckError(resource Resource, cause error) error {
-	var err error
-
-	if cause == nil {
-		_, err = psql.Update("resources").
-			Set("check_error", nil).
-			Where(sq.Eq{"id": resource.ID()}).
-			RunWith(p.conn).
-			Exec()
-	} else {
-		_, err = psql.Update("resources").
-			Set("check_error", cause.Error()).
-			Where(sq.Eq{"id": resource.ID()}).
-			RunWith(p.conn).
-			Exec()
+	var checkError interface{}
+	checkEvent := event.ResourceCheckError{
+		PipelineName: p.name,
+		Resource:     resource.Name(),
 	}
 
-	return err
+	if cause != nil {
+		checkError = cause.Error()
+		checkEvent.Error = cause.Error()
+	}
+
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	result, err := psql.Update("resources").
+		Set("check_error", checkError).
+		Where(sq.Eq{"id": resource.ID()}).
+		Where(sq.Expr("check_error IS DISTINCT FROM ?", checkError)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	// only tell the team when the check starts failing, fails differently,
+	// or recovers, not every time it's checked
+	if changed == 0 {
+		return nil
+	}
+
+	err = saveTeamEvent(tx, p.teamID, checkEvent)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return p.conn.Bus().Notify(teamEventsChannel(p.teamID))
 }
 
 func (p *pipeline) GetPendingBuildsForJob(jobName string) ([]Build, error) {
@@ -363,6 +401,38 @@ func (p *pipeline) GetAllPendingBuilds() (map[string][]Build, error) {
 	return builds, nil
 }
 
+// InFlightBuilds returns the pending and started builds of the pipeline's
+// jobs, oldest first.
+func (p *pipeline) InFlightBuilds() ([]Build, error) {
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.status": []string{string(BuildStatusPending), string(BuildStatusStarted)},
+			"j.active": true,
+			"p.id":     p.id,
+		}).
+		OrderBy("b.id").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	builds := []Build{}
+	for rows.Next() {
+		build := &build{conn: p.conn, lockFactory: p.lockFactory}
+		err = scanBuild(build, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		builds = append(builds, build)
+	}
+
+	return builds, nil
+}
+
 func (p *pipeline) EnsurePendingBuildExists(jobName string) error {
 	tx, err := p.conn.Begin()
 	if err != nil {
@@ -417,6 +487,8 @@ func (p *pipeline) SaveResourceVersions(config atc.ResourceConfig, versions []at
 
 	defer tx.Rollback()
 
+	newVersions := []atc.Version{}
+
 	for _, version := range versions {
 		vr := VersionedResource{
 			Resource: config.Name,
@@ -440,23 +512,40 @@ func (p *pipeline) SaveResourceVersions(config atc.ResourceConfig, versions []at
 			return err
 		}
 
-		_, _, err = p.saveVersionedResource(tx, resourceID, vr)
+		_, created, err := p.saveVersionedResource(tx, resourceID, vr)
 		if err != nil {
 			return err
 		}
 
+		if created {
+			newVersions = append(newVersions, version)
+		}
+
 		err = p.incrementCheckOrderWhenNewerVersion(tx, resourceID, vr.Type, string(versionJSON))
 		if err != nil {
 			return err
 		}
 	}
 
+	if len(newVersions) == 0 {
+		return tx.Commit()
+	}
+
+	err = saveTeamEvent(tx, p.teamID, event.NewResourceVersions{
+		PipelineName: p.name,
+		Resource:     config.Name,
+		Versions:     newVersions,
+	})
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return p.conn.Bus().Notify(teamEventsChannel(p.teamID))
 }
 
 func (p *pipeline) GetResourceVersions(resourceName string, page Page) ([]SavedVersionedResource, Pagination, bool, error) {
@@ -950,27 +1039,43 @@ func (p *pipeline) Job(name string) (Job, bool, error) {
 }
 
 func (p *pipeline) Pause() error {
-	_, err := psql.Update("pipelines").
-		Set("paused", true).
-		Where(sq.Eq{
-			"id": p.id,
-		}).
-		RunWith(p.conn).
-		Exec()
-
-	return err
+	return p.updatePaused(true, event.PipelinePaused{PipelineName: p.name})
 }
 
 func (p *pipeline) Unpause() error {
-	_, err := psql.Update("pipelines").
-		Set("paused", false).
+	return p.updatePaused(false, event.PipelineUnpaused{PipelineName: p.name})
+}
+
+func (p *pipeline) updatePaused(paused bool, ev atc.Event) error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Update("pipelines").
+		Set("paused", paused).
 		Where(sq.Eq{
 			"id": p.id,
 		}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	err = saveTeamEvent(tx, p.teamID, ev)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return p.conn.Bus().Notify(teamEventsChannel(p.teamID))
 }
 
 func (p *pipeline) Hide() error {
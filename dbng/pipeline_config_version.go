@@ -0,0 +1,98 @@
+package dbng
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/atc"
+)
+
+// PipelineConfigVersion is a config that was saved for a pipeline at some
+// point, along with who saved it.
+type PipelineConfigVersion struct {
+	Version   ConfigVersion
+	Config    atc.Config
+	Author    string
+	CreatedAt time.Time
+}
+
+var pipelineConfigVersionsQuery = psql.Select("version", "config", "author", "created_at").
+	From("pipeline_config_versions")
+
+func savePipelineConfigVersion(tx Tx, pipelineID int, author string) error {
+	_, err := tx.Exec(`
+		INSERT INTO pipeline_config_versions (pipeline_id, version, config, author)
+		SELECT id, version, config, $2
+		FROM pipelines
+		WHERE id = $1
+	`, pipelineID, author)
+	return err
+}
+
+// ConfigVersions returns every config saved for the pipeline, newest first.
+func (p *pipeline) ConfigVersions() ([]PipelineConfigVersion, error) {
+	rows, err := pipelineConfigVersionsQuery.
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	versions := []PipelineConfigVersion{}
+	for rows.Next() {
+		version, err := scanPipelineConfigVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (p *pipeline) ConfigAtVersion(version ConfigVersion) (PipelineConfigVersion, bool, error) {
+	configVersion, err := scanPipelineConfigVersion(
+		pipelineConfigVersionsQuery.
+			Where(sq.Eq{
+				"pipeline_id": p.id,
+				"version":     version,
+			}).
+			RunWith(p.conn).
+			QueryRow(),
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PipelineConfigVersion{}, false, nil
+		}
+
+		return PipelineConfigVersion{}, false, err
+	}
+
+	return configVersion, true, nil
+}
+
+func scanPipelineConfigVersion(row scannable) (PipelineConfigVersion, error) {
+	var (
+		version    PipelineConfigVersion
+		configBlob []byte
+	)
+
+	err := row.Scan(&version.Version, &configBlob, &version.Author, &version.CreatedAt)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	err = json.Unmarshal(configBlob, &version.Config)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	return version, nil
+}
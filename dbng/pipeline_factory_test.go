@@ -32,7 +32,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Expose()).To(Succeed())
 			Expect(pipeline1.Reload()).To(BeTrue())
@@ -41,14 +41,14 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline3, _, err = defaultTeam.SavePipeline("fake-pipeline-three", atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -0,0 +1,250 @@
+package dbng
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/atc"
+)
+
+//go:generate counterfeiter . PipelineTemplate
+
+// PipelineTemplate is a pipeline config containing ((params)), from which any
+// number of pipelines can be rendered.
+type PipelineTemplate interface {
+	ID() int
+	Name() string
+	TeamID() int
+	Template() []byte
+
+	Instances() ([]PipelineTemplateInstance, error)
+	SaveInstance(pipelineName string, config atc.Config, from ConfigVersion, author string, params map[string]interface{}) (Pipeline, bool, error)
+}
+
+// PipelineTemplateInstance is a pipeline rendered from a template, along with
+// the params it was rendered with.
+type PipelineTemplateInstance struct {
+	PipelineName string
+	Params       map[string]interface{}
+}
+
+var pipelineTemplatesQuery = psql.Select("id", "name", "team_id", "template").
+	From("pipeline_templates")
+
+type pipelineTemplate struct {
+	id       int
+	name     string
+	teamID   int
+	template []byte
+
+	conn Conn
+	team *team
+}
+
+func (t *pipelineTemplate) ID() int          { return t.id }
+func (t *pipelineTemplate) Name() string     { return t.name }
+func (t *pipelineTemplate) TeamID() int      { return t.teamID }
+func (t *pipelineTemplate) Template() []byte { return t.template }
+
+func (t *pipelineTemplate) Instances() ([]PipelineTemplateInstance, error) {
+	rows, err := psql.Select("p.name", "i.params").
+		From("pipeline_template_instances i").
+		Join("pipelines p ON p.id = i.pipeline_id").
+		Where(sq.Eq{"i.template_id": t.id}).
+		OrderBy("p.name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	instances := []PipelineTemplateInstance{}
+	for rows.Next() {
+		var instance PipelineTemplateInstance
+		var paramsBlob []byte
+
+		err := rows.Scan(&instance.PipelineName, &paramsBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(paramsBlob, &instance.Params)
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
+// SaveInstance saves the config rendered from the template as the named
+// pipeline and records the params it was rendered with, in one transaction. A
+// pipeline is an instance of at most one template, so saving it as an
+// instance of this template takes it over from any other.
+func (t *pipelineTemplate) SaveInstance(
+	pipelineName string,
+	config atc.Config,
+	from ConfigVersion,
+	author string,
+	params map[string]interface{},
+) (Pipeline, bool, error) {
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, false, err
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer tx.Rollback()
+
+	pipeline, created, err := t.team.savePipeline(tx, pipelineName, config, from, PipelineNoChange, author)
+	if err != nil {
+		return nil, false, err
+	}
+
+	updated, err := checkIfRowsUpdated(tx, `
+		UPDATE pipeline_template_instances
+		SET template_id = $1, params = $3
+		WHERE pipeline_id = $2
+	`, t.id, pipeline.ID(), payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !updated {
+		_, err = tx.Exec(`
+			INSERT INTO pipeline_template_instances (template_id, pipeline_id, params)
+			VALUES ($1, $2, $3)
+		`, t.id, pipeline.ID(), payload)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = t.conn.Bus().Notify(teamEventsChannel(t.team.id))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return pipeline, created, nil
+}
+
+func (t *team) SavePipelineTemplate(name string, template []byte) (PipelineTemplate, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	updated, err := checkIfRowsUpdated(tx, `
+		UPDATE pipeline_templates
+		SET template = $3
+		WHERE team_id = $1 AND name = $2
+	`, t.id, name, string(template))
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated {
+		_, err = tx.Exec(`
+			INSERT INTO pipeline_templates (team_id, name, template)
+			VALUES ($1, $2, $3)
+		`, t.id, name, string(template))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pipelineTemplate := &pipelineTemplate{conn: t.conn, team: t}
+	err = scanPipelineTemplate(pipelineTemplate, pipelineTemplatesQuery.
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return pipelineTemplate, nil
+}
+
+func (t *team) PipelineTemplate(name string) (PipelineTemplate, bool, error) {
+	pipelineTemplate := &pipelineTemplate{conn: t.conn, team: t}
+	err := scanPipelineTemplate(pipelineTemplate, pipelineTemplatesQuery.
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return pipelineTemplate, true, nil
+}
+
+func (t *team) PipelineTemplates() ([]PipelineTemplate, error) {
+	rows, err := pipelineTemplatesQuery.
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	templates := []PipelineTemplate{}
+	for rows.Next() {
+		pipelineTemplate := &pipelineTemplate{conn: t.conn, team: t}
+
+		err := scanPipelineTemplate(pipelineTemplate, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, pipelineTemplate)
+	}
+
+	return templates, nil
+}
+
+func scanPipelineTemplate(t *pipelineTemplate, row scannable) error {
+	var template string
+
+	err := row.Scan(&t.id, &t.name, &t.teamID, &template)
+	if err != nil {
+		return err
+	}
+
+	t.template = []byte(template)
+
+	return nil
+}
@@ -41,7 +41,7 @@ var _ = Describe("Pipeline", func() {
 					Source: atc.Source{"some": "source"},
 				},
 			},
-		}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+		}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 	})
@@ -150,7 +150,7 @@ var _ = Describe("Pipeline", func() {
 						},
 					},
 				}
-				pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, err := pipeline.Resource("some-resource")
@@ -217,7 +217,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = pipeline.Resource("some-resource")
@@ -307,7 +307,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = pipeline.SaveResourceVersions(
@@ -423,7 +423,7 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, 0, dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", config, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			err = pipeline.SaveResourceVersions(
@@ -457,7 +457,7 @@ var _ = Describe("Pipeline", func() {
 
 			versions = []dbng.SavedVersionedResource{reversions[2], reversions[1], reversions[0]}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, dbng.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -692,6 +692,109 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("job annotations", func() {
+		var job dbng.Job
+
+		BeforeEach(func() {
+			build1, err := pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build1.SaveAnnotations("unit", map[string]string{"coverage": "80.0", "tests": "100"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			build3, err := pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build3.SaveAnnotations("unit", map[string]string{"coverage": "82.5"})
+			Expect(err).NotTo(HaveOccurred())
+
+			var found bool
+			job, found, err = pipeline.Job("job-name")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("returns the named annotation across builds, most recent first", func() {
+			annotations, err := job.Annotations("coverage", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(HaveLen(2))
+
+			Expect(annotations[0].BuildName).To(Equal("3"))
+			Expect(annotations[0].Value).To(Equal("82.5"))
+			Expect(annotations[0].Step).To(Equal("unit"))
+
+			Expect(annotations[1].BuildName).To(Equal("1"))
+			Expect(annotations[1].Value).To(Equal("80.0"))
+		})
+
+		It("respects the limit", func() {
+			annotations, err := job.Annotations("coverage", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(HaveLen(1))
+			Expect(annotations[0].BuildName).To(Equal("3"))
+		})
+
+		It("returns nothing for an unknown annotation", func() {
+			annotations, err := job.Annotations("bogus", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(annotations).To(BeEmpty())
+		})
+	})
+
+	Describe("config versions", func() {
+		var originalVersion dbng.ConfigVersion
+		var newConfig atc.Config
+
+		BeforeEach(func() {
+			originalVersion = pipeline.ConfigVersion()
+
+			newConfig = atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "job-name", Serial: true},
+				},
+			}
+
+			_, _, err := team.SavePipeline("fake-pipeline", newConfig, originalVersion, dbng.PipelineNoChange, "some-author")
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("keeps every saved config, newest first", func() {
+			versions, err := pipeline.ConfigVersions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(versions).To(HaveLen(2))
+
+			Expect(versions[0].Version).To(Equal(pipeline.ConfigVersion()))
+			Expect(versions[0].Config).To(Equal(newConfig))
+			Expect(versions[0].Author).To(Equal("some-author"))
+			Expect(versions[0].CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+			Expect(versions[1].Version).To(Equal(originalVersion))
+			Expect(versions[1].Config.Resources).To(HaveLen(3))
+			Expect(versions[1].Author).To(BeEmpty())
+		})
+
+		It("can look up a config by its version", func() {
+			version, found, err := pipeline.ConfigAtVersion(originalVersion)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Version).To(Equal(originalVersion))
+			Expect(version.Config.Jobs).To(Equal(atc.JobConfigs{{Name: "job-name"}}))
+		})
+
+		It("does not find versions that were never saved", func() {
+			_, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
 	Describe("saving build inputs", func() {
 		var (
 			buildMetadata []dbng.ResourceMetadataField
@@ -730,7 +833,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -859,10 +962,10 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = pipeline.CreateJobBuild("some-job")
@@ -1175,10 +1278,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbngPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, dbng.PipelineUnpaused)
+			dbngPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBNGPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, dbng.PipelineUnpaused)
+			otherDBNGPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbngPipeline.Resource(resourceName)
@@ -1844,7 +1947,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+			pipelineDB, _, err = team.SavePipeline("some-pipeline", pipelineConfig, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2076,6 +2179,40 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("InFlightBuilds", func() {
+		var pendingBuild, startedBuild dbng.Build
+
+		BeforeEach(func() {
+			var err error
+			pendingBuild, err = pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			startedBuild, err = pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := startedBuild.Start("engine", "metadata")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			finishedBuild, err := pipeline.CreateJobBuild("job-name")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = finishedBuild.Finish(dbng.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the pipeline's pending and started builds, oldest first", func() {
+			builds, err := pipeline.InFlightBuilds()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(2))
+			Expect(builds[0].ID()).To(Equal(pendingBuild.ID()))
+			Expect(builds[1].ID()).To(Equal(startedBuild.ID()))
+		})
+	})
+
 	Describe("VersionsDB caching", func() {
 		var otherPipeline dbng.Pipeline
 		BeforeEach(func() {
@@ -2096,7 +2233,7 @@ var _ = Describe("Pipeline", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, dbng.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -29,6 +29,8 @@ type ResourceCacheFactory interface {
 	CleanUsesForPausedPipelineResources() error
 
 	CleanUpInvalidCaches() error
+
+	ExistingResourceCacheIDs(ids []int) ([]int, error)
 }
 
 type resourceCacheFactory struct {
@@ -230,6 +232,38 @@ func (f *resourceCacheFactory) CleanUpInvalidCaches() error {
 	return nil
 }
 
+// ExistingResourceCacheIDs returns those of the given IDs whose resource
+// caches have not been cleaned up.
+func (f *resourceCacheFactory) ExistingResourceCacheIDs(ids []int) ([]int, error) {
+	existing := []int{}
+	if len(ids) == 0 {
+		return existing, nil
+	}
+
+	rows, err := psql.Select("id").
+		From("resource_caches").
+		Where(sq.Eq{"id": ids}).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		existing = append(existing, id)
+	}
+
+	return existing, nil
+}
+
 func (f *resourceCacheFactory) CleanUsesForPausedPipelineResources() error {
 	pausedPipelineIds, _, err := sq.
 		Select("id").
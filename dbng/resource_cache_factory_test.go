@@ -118,6 +118,7 @@ var _ = Describe("ResourceCacheFactory", func() {
 			},
 			dbng.ConfigVersion(0),
 			dbng.PipelineUnpaused,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -346,6 +347,35 @@ var _ = Describe("ResourceCacheFactory", func() {
 		})
 	})
 
+	Describe("ExistingResourceCacheIDs", func() {
+		It("returns only the IDs of resource caches that exist", func() {
+			usedResourceCache, err := resourceCacheFactory.FindOrCreateResourceCache(
+				logger,
+				dbng.ForBuild(build.ID()),
+				"some-type",
+				atc.Version{"some": "version"},
+				atc.Source{
+					"some": "source",
+				},
+				atc.Params{"some": "params"},
+				atc.VersionedResourceTypes{
+					resourceType1,
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			existing, err := resourceCacheFactory.ExistingResourceCacheIDs([]int{usedResourceCache.ID, usedResourceCache.ID + 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(Equal([]int{usedResourceCache.ID}))
+		})
+
+		It("returns an empty list when given no IDs", func() {
+			existing, err := resourceCacheFactory.ExistingResourceCacheIDs(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(existing).To(BeEmpty())
+		})
+	})
+
 	Describe("CleanUpInvalidCaches", func() {
 		countResourceCaches := func() int {
 			var result int
@@ -34,6 +34,7 @@ var _ = Describe("Resource", func() {
 			},
 			0,
 			dbng.PipelineUnpaused,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -13,6 +13,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/db/lock"
+	"github.com/concourse/atc/event"
 	"github.com/lib/pq"
 	uuid "github.com/nu7hatch/gouuid"
 )
@@ -37,6 +38,7 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
+		author string,
 	) (Pipeline, bool, error)
 
 	Pipeline(pipelineName string) (Pipeline, bool, error)
@@ -45,8 +47,16 @@ type Team interface {
 	VisiblePipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
 
+	SavePipelineTemplate(name string, template []byte) (PipelineTemplate, error)
+	PipelineTemplate(name string) (PipelineTemplate, bool, error)
+	PipelineTemplates() ([]PipelineTemplate, error)
+
 	CreateOneOffBuild() (Build, error)
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
+	SearchBuildLogs(query string, publicOnly bool, limit int) ([]BuildLogMatch, error)
+
+	Events(after uint) (TeamEventSource, error)
+	LatestEventID() (uint, error)
 
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
@@ -69,6 +79,15 @@ type Team interface {
 
 	UpdateBasicAuth(basicAuth *atc.BasicAuth) error
 	UpdateProviderAuth(auth map[string]*json.RawMessage) error
+
+	Notifications() ([]atc.NotificationConfig, error)
+	UpdateNotifications(notifications []atc.NotificationConfig) error
+
+	RedactionRules() (atc.RedactionRules, error)
+	UpdateRedactionRules(rules atc.RedactionRules) error
+
+	Scheduling() (atc.TeamScheduling, error)
+	UpdateScheduling(scheduling atc.TeamScheduling) error
 }
 
 type team struct {
@@ -446,21 +465,51 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
+	author string,
 ) (Pipeline, bool, error) {
-	payload, err := json.Marshal(config)
+	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, false, err
 	}
 
-	var created bool
-	var existingConfig int
+	defer tx.Rollback()
 
-	tx, err := t.conn.Begin()
+	pipeline, created, err := t.savePipeline(tx, pipelineName, config, from, pausedState, author)
 	if err != nil {
 		return nil, false, err
 	}
 
-	defer tx.Rollback()
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = t.conn.Bus().Notify(teamEventsChannel(t.id))
+	if err != nil {
+		return nil, false, err
+	}
+
+	return pipeline, created, nil
+}
+
+// savePipeline saves the config within the given transaction. The caller is
+// responsible for committing it and then notifying the team's event
+// subscribers.
+func (t *team) savePipeline(
+	tx Tx,
+	pipelineName string,
+	config atc.Config,
+	from ConfigVersion,
+	pausedState PipelinePausedState,
+	author string,
+) (*pipeline, bool, error) {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var created bool
+	var existingConfig int
 
 	err = tx.QueryRow(`
 		SELECT COUNT(1)
@@ -609,6 +658,11 @@ func (t *team) SavePipeline(
 		}
 	}
 
+	err = savePipelineConfigVersion(tx, pipelineID, author)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
 	err = scanPipeline(
@@ -622,7 +676,10 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
-	err = tx.Commit()
+	err = saveTeamEvent(tx, t.id, event.PipelineConfigSaved{
+		PipelineName: pipelineName,
+		Created:      created,
+	})
 	if err != nil {
 		return nil, false, err
 	}
@@ -881,6 +938,156 @@ func (t *team) UpdateProviderAuth(auth map[string]*json.RawMessage) error {
 	return t.queryTeam(query, params)
 }
 
+func (t *team) Notifications() ([]atc.NotificationConfig, error) {
+	var payload []byte
+	err := psql.Select("notifications").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrTeamDisappeared
+		}
+
+		return nil, err
+	}
+
+	var notifications []atc.NotificationConfig
+	err = json.Unmarshal(payload, &notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (t *team) UpdateNotifications(notifications []atc.NotificationConfig) error {
+	if notifications == nil {
+		notifications = []atc.NotificationConfig{}
+	}
+
+	payload, err := json.Marshal(notifications)
+	if err != nil {
+		return err
+	}
+
+	result, err := psql.Update("teams").
+		Set("notifications", string(payload)).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrTeamDisappeared
+	}
+
+	return nil
+}
+
+func (t *team) RedactionRules() (atc.RedactionRules, error) {
+	var payload []byte
+	err := psql.Select("redaction_rules").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.RedactionRules{}, ErrTeamDisappeared
+		}
+
+		return atc.RedactionRules{}, err
+	}
+
+	var rules atc.RedactionRules
+	err = json.Unmarshal(payload, &rules)
+	if err != nil {
+		return atc.RedactionRules{}, err
+	}
+
+	return rules, nil
+}
+
+func (t *team) UpdateRedactionRules(rules atc.RedactionRules) error {
+	payload, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	result, err := psql.Update("teams").
+		Set("redaction_rules", string(payload)).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrTeamDisappeared
+	}
+
+	return nil
+}
+
+func (t *team) Scheduling() (atc.TeamScheduling, error) {
+	var scheduling atc.TeamScheduling
+	err := psql.Select("scheduling_weight", "scheduling_priority").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&scheduling.Weight, &scheduling.Priority)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.TeamScheduling{}, ErrTeamDisappeared
+		}
+
+		return atc.TeamScheduling{}, err
+	}
+
+	return scheduling, nil
+}
+
+func (t *team) UpdateScheduling(scheduling atc.TeamScheduling) error {
+	result, err := psql.Update("teams").
+		Set("scheduling_weight", scheduling.Weight).
+		Set("scheduling_priority", scheduling.Priority).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrTeamDisappeared
+	}
+
+	return nil
+}
+
 func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int) error {
 	configPayload, err := json.Marshal(job)
 	if err != nil {
@@ -1152,3 +1359,50 @@ func encryptedJSON(b *atc.BasicAuth) (string, error) {
 	json, err := json.Marshal(result)
 	return string(json), err
 }
+
+// Events streams the team's events that come after the given event ID. If
+// events after it have since been reaped, ErrTeamEventsReaped is returned.
+func (t *team) Events(after uint) (TeamEventSource, error) {
+	var reapedEventID uint
+	err := psql.Select("reaped_event_id").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&reapedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if after < reapedEventID {
+		return nil, ErrTeamEventsReaped
+	}
+
+	notifier, err := newConditionNotifier(t.conn.Bus(), teamEventsChannel(t.id), func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newTeamEventSource(t.id, t.conn, notifier, after), nil
+}
+
+// LatestEventID returns the ID of the team's most recent event, or 0 if
+// nothing has happened yet.
+func (t *team) LatestEventID() (uint, error) {
+	var id uint
+	err := psql.Select("GREATEST(MAX(e.id), t.reaped_event_id)").
+		From("teams t").
+		LeftJoin("team_events e ON e.team_id = t.id").
+		Where(sq.Eq{"t.id": t.id}).
+		GroupBy("t.reaped_event_id").
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
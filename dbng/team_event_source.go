@@ -0,0 +1,205 @@
+package dbng
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/db/lock"
+	"github.com/concourse/atc/event"
+)
+
+var ErrTeamEventStreamClosed = errors.New("team event stream closed")
+
+// ErrTeamEventsReaped is returned when resuming a team's event stream from an
+// event that is older than the events still retained, as some of the events
+// that came after it are gone.
+var ErrTeamEventsReaped = errors.New("team events have been reaped")
+
+// TeamEvent is an event that happened somewhere in a team, along with its
+// position in the team's event stream.
+type TeamEvent struct {
+	ID       uint
+	Envelope event.Envelope
+}
+
+//go:generate counterfeiter . TeamEventSource
+
+type TeamEventSource interface {
+	Next() (TeamEvent, error)
+	Close() error
+}
+
+// saveTeamEvent records the event in the team's event stream. Event sources
+// read the stream by ID, so the team's events are saved one transaction at a
+// time: the lock is held until the transaction ends, and so each event's ID
+// is only drawn once every event before it has been committed or rolled back.
+func saveTeamEvent(tx Tx, teamID int, ev atc.Event) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	lockID := lock.NewTeamEventsLockID(teamID)
+	_, err = tx.Exec(`SELECT pg_advisory_xact_lock($1, $2)`, lockID[0], lockID[1])
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("team_events").
+		Columns("team_id", "type", "version", "payload").
+		Values(teamID, string(ev.EventType()), string(ev.Version()), payload).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func teamEventsChannel(teamID int) string {
+	return fmt.Sprintf("team_events_%d", teamID)
+}
+
+func newTeamEventSource(
+	teamID int,
+	conn Conn,
+	notifier Notifier,
+	after uint,
+) *teamEventSource {
+	wg := new(sync.WaitGroup)
+
+	source := &teamEventSource{
+		teamID: teamID,
+
+		conn: conn,
+
+		notifier: notifier,
+
+		events: make(chan TeamEvent, 2000),
+		stop:   make(chan struct{}),
+		wg:     wg,
+	}
+
+	wg.Add(1)
+	go source.collectEvents(after)
+
+	return source
+}
+
+type teamEventSource struct {
+	teamID int
+
+	conn     Conn
+	notifier Notifier
+
+	events chan TeamEvent
+	stop   chan struct{}
+	err    error
+	wg     *sync.WaitGroup
+}
+
+func (source *teamEventSource) Next() (TeamEvent, error) {
+	e, ok := <-source.events
+	if !ok {
+		return TeamEvent{}, source.err
+	}
+
+	return e, nil
+}
+
+func (source *teamEventSource) Close() error {
+	select {
+	case <-source.stop:
+		return nil
+	default:
+		close(source.stop)
+	}
+
+	source.wg.Wait()
+
+	return source.notifier.Close()
+}
+
+func (source *teamEventSource) collectEvents(cursor uint) {
+	defer source.wg.Done()
+
+	var batchSize = cap(source.events)
+
+	for {
+		select {
+		case <-source.stop:
+			source.err = ErrTeamEventStreamClosed
+			close(source.events)
+			return
+		default:
+		}
+
+		rows, err := psql.Select("id", "type", "version", "payload").
+			From("team_events").
+			Where(sq.Eq{"team_id": source.teamID}).
+			Where("id > ?", cursor).
+			OrderBy("id ASC").
+			Limit(uint64(batchSize)).
+			RunWith(source.conn).
+			Query()
+		if err != nil {
+			source.err = err
+			close(source.events)
+			return
+		}
+
+		rowsReturned := 0
+
+		for rows.Next() {
+			rowsReturned++
+
+			var id uint
+			var t, v, p string
+			err := rows.Scan(&id, &t, &v, &p)
+			if err != nil {
+				rows.Close()
+
+				source.err = err
+				close(source.events)
+				return
+			}
+
+			cursor = id
+
+			data := json.RawMessage(p)
+
+			ev := TeamEvent{
+				ID: id,
+				Envelope: event.Envelope{
+					Data:    &data,
+					Event:   atc.EventType(t),
+					Version: atc.EventVersion(v),
+				},
+			}
+
+			select {
+			case source.events <- ev:
+			case <-source.stop:
+				rows.Close()
+
+				source.err = ErrTeamEventStreamClosed
+				close(source.events)
+				return
+			}
+		}
+
+		if rowsReturned == batchSize {
+			// still more events
+			continue
+		}
+
+		select {
+		case <-source.notifier.Notify():
+		case <-source.stop:
+			source.err = ErrTeamEventStreamClosed
+			close(source.events)
+			return
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"encoding/json"
 
@@ -19,6 +20,10 @@ type TeamFactory interface {
 	FindTeam(string) (Team, bool, error)
 	GetTeams() ([]Team, error)
 	GetByID(teamID int) Team
+
+	// ReapTeamEvents deletes the events of every team that are older than
+	// the given retention period.
+	ReapTeamEvents(retention time.Duration) error
 }
 
 type teamFactory struct {
@@ -92,6 +97,27 @@ func (factory *teamFactory) GetByID(teamID int) Team {
 	}
 }
 
+func (factory *teamFactory) ReapTeamEvents(retention time.Duration) error {
+	// remember the newest event reaped from each team, so that subscribers
+	// resuming from before it can be told they have missed events
+	_, err := factory.conn.Exec(`
+		WITH reaped AS (
+			DELETE FROM team_events
+			WHERE created_at < now() - make_interval(secs => $1)
+			RETURNING team_id, id
+		)
+		UPDATE teams t
+		SET reaped_event_id = r.max_id
+		FROM (
+			SELECT team_id, MAX(id) AS max_id
+			FROM reaped
+			GROUP BY team_id
+		) r
+		WHERE t.id = r.team_id
+	`, retention.Seconds())
+	return err
+}
+
 func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 	team := &team{
 		conn:        factory.conn,
@@ -2,6 +2,7 @@ package dbng_test
 
 import (
 	"encoding/json"
+	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -158,4 +159,46 @@ var _ = Describe("Team Factory", func() {
 			})
 		})
 	})
+
+	Describe("ReapTeamEvents", func() {
+		countEvents := func(olderThan time.Duration) int {
+			var count int
+			err := dbConn.QueryRow(`
+				SELECT COUNT(*)
+				FROM team_events
+				WHERE created_at < now() - make_interval(secs => $1)
+			`, olderThan.Seconds()).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			return count
+		}
+
+		BeforeEach(func() {
+			oldBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = oldBuild.Start("engine", "metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE team_events SET created_at = now() - interval '2 hours'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			newBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = newBuild.Start("engine", "metadata")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes only the events older than the retention period", func() {
+			Expect(countEvents(time.Hour)).ToNot(BeZero())
+			recent := countEvents(0) - countEvents(time.Hour)
+			Expect(recent).ToNot(BeZero())
+
+			err := teamFactory.ReapTeamEvents(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(countEvents(time.Hour)).To(BeZero())
+			Expect(countEvents(0)).To(Equal(recent))
+		})
+	})
 })
@@ -2,6 +2,7 @@ package dbng_test
 
 import (
 	"encoding/json"
+	"errors"
 	"strconv"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/event"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -875,6 +877,91 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("Notifications", func() {
+		It("returns no notifications by default", func() {
+			notifications, err := team.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+		})
+
+		It("returns the notifications saved for the team", func() {
+			notifications := []atc.NotificationConfig{
+				{
+					Name:    "some-webhook",
+					Webhook: &atc.WebhookNotification{URL: "https://example.com/hook"},
+					Filter: atc.NotificationFilter{
+						Jobs:        []string{"some-job"},
+						Transitions: []atc.BuildTransition{atc.TransitionBroken},
+					},
+				},
+			}
+
+			err := team.UpdateNotifications(notifications)
+			Expect(err).NotTo(HaveOccurred())
+
+			savedNotifications, err := team.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(savedNotifications).To(Equal(notifications))
+
+			otherTeamNotifications, err := otherTeam.Notifications()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherTeamNotifications).To(BeEmpty())
+		})
+	})
+
+	Describe("RedactionRules", func() {
+		It("returns the default rules by default", func() {
+			rules, err := team.RedactionRules()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rules).To(Equal(atc.RedactionRules{}))
+		})
+
+		It("returns the rules saved for the team", func() {
+			rules := atc.RedactionRules{
+				MinLength:   8,
+				IgnoreKeys:  []string{"uri", "branch"},
+				Placeholder: "***",
+			}
+
+			err := team.UpdateRedactionRules(rules)
+			Expect(err).NotTo(HaveOccurred())
+
+			savedRules, err := team.RedactionRules()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(savedRules).To(Equal(rules))
+
+			otherTeamRules, err := otherTeam.RedactionRules()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherTeamRules).To(Equal(atc.RedactionRules{}))
+		})
+	})
+
+	Describe("Scheduling", func() {
+		It("returns the default weight and priority by default", func() {
+			scheduling, err := team.Scheduling()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduling).To(Equal(atc.TeamScheduling{
+				Weight:   atc.DefaultSchedulingWeight,
+				Priority: 0,
+			}))
+		})
+
+		It("returns the scheduling saved for the team", func() {
+			scheduling := atc.TeamScheduling{Weight: 3, Priority: 10}
+
+			err := team.UpdateScheduling(scheduling)
+			Expect(err).NotTo(HaveOccurred())
+
+			savedScheduling, err := team.Scheduling()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(savedScheduling).To(Equal(scheduling))
+
+			otherTeamScheduling, err := otherTeam.Scheduling()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(otherTeamScheduling.Weight).To(Equal(atc.DefaultSchedulingWeight))
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []dbng.Pipeline
@@ -895,14 +982,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -937,14 +1024,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -966,6 +1053,432 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("SearchBuildLogs", func() {
+		var (
+			privateJobBuild dbng.Build
+			publicJobBuild  dbng.Build
+			oneOffBuild     dbng.Build
+		)
+
+		BeforeEach(func() {
+			pipeline, _, err := team.SavePipeline("some-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "private-job"},
+					{Name: "public-job", Public: true},
+				},
+			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pipeline.Expose()).To(Succeed())
+
+			privateJobBuild, err = pipeline.CreateJobBuild("private-job")
+			Expect(err).ToNot(HaveOccurred())
+
+			publicJobBuild, err = pipeline.CreateJobBuild("public-job")
+			Expect(err).ToNot(HaveOccurred())
+
+			oneOffBuild, err = team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTeamBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, build := range []dbng.Build{privateJobBuild, publicJobBuild, oneOffBuild, otherTeamBuild} {
+				err = build.SaveEvent(event.Log{
+					Origin:  event.Origin{ID: "some-step"},
+					Payload: "compiling...\ndial tcp 10.0.0.1:443: connection refused\n",
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			err = publicJobBuild.SaveEvent(event.Log{
+				Origin:  event.Origin{ID: "other-step"},
+				Payload: "all good\n",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("finds the team's steps whose output matches, most recent build first", func() {
+			matches, err := team.SearchBuildLogs("connection refused", false, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(Equal([]dbng.BuildLogMatch{
+				{
+					BuildID:   oneOffBuild.ID(),
+					BuildName: oneOffBuild.Name(),
+					TeamName:  "some-team",
+					Origin:    "some-step",
+					Snippets:  []string{"dial tcp 10.0.0.1:443: connection refused"},
+				},
+				{
+					BuildID:      publicJobBuild.ID(),
+					BuildName:    publicJobBuild.Name(),
+					JobName:      "public-job",
+					PipelineName: "some-pipeline",
+					TeamName:     "some-team",
+					Origin:       "some-step",
+					Snippets:     []string{"dial tcp 10.0.0.1:443: connection refused"},
+				},
+				{
+					BuildID:      privateJobBuild.ID(),
+					BuildName:    privateJobBuild.Name(),
+					JobName:      "private-job",
+					PipelineName: "some-pipeline",
+					TeamName:     "some-team",
+					Origin:       "some-step",
+					Snippets:     []string{"dial tcp 10.0.0.1:443: connection refused"},
+				},
+			}))
+		})
+
+		It("only searches public jobs of public pipelines when asked to", func() {
+			matches, err := team.SearchBuildLogs("connection refused", true, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].BuildID).To(Equal(publicJobBuild.ID()))
+		})
+
+		It("respects the limit", func() {
+			matches, err := team.SearchBuildLogs("connection refused", false, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].BuildID).To(Equal(oneOffBuild.ID()))
+		})
+
+		It("requires every word to match", func() {
+			matches, err := team.SearchBuildLogs("connection timeout", false, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+		})
+
+		It("finds words split across chunks of output", func() {
+			for _, chunk := range []string{"dial tcp: conn", "ection ref", "used\nretrying\n"} {
+				err := oneOffBuild.SaveEvent(event.Log{
+					Origin:  event.Origin{ID: "split-step"},
+					Payload: chunk,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			matches, err := team.SearchBuildLogs("connection refused", false, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(ContainElement(dbng.BuildLogMatch{
+				BuildID:   oneOffBuild.ID(),
+				BuildName: oneOffBuild.Name(),
+				TeamName:  "some-team",
+				Origin:    "split-step",
+				Snippets:  []string{"dial tcp: connection refused"},
+			}))
+		})
+
+		It("finds words in a line that is still being written", func() {
+			for _, chunk := range []string{"downloading ", "kaboomium"} {
+				err := oneOffBuild.SaveEvent(event.Log{
+					Origin:  event.Origin{ID: "unfinished-step"},
+					Payload: chunk,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			matches, err := team.SearchBuildLogs("kaboomium", false, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].Snippets).To(Equal([]string{"downloading kaboomium"}))
+		})
+
+		It("does not index events other than logs", func() {
+			err := publicJobBuild.SaveEvent(event.Error{
+				Origin:  event.Origin{ID: "error-step"},
+				Message: "kaboom",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			matches, err := team.SearchBuildLogs("kaboom", false, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+		})
+	})
+
+	Describe("Events", func() {
+		var pipeline dbng.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+				},
+				Resources: atc.ResourceConfigs{
+					{Name: "some-resource", Type: "some-type"},
+				},
+			}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not skip events committed after events that were saved later", func() {
+			latestID, err := team.LatestEventID()
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err := team.Events(latestID)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer events.Close()
+
+			firstTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = dbng.SaveTeamEvent(firstTx, team.ID(), event.BuildStatusChanged{BuildID: 1, Status: atc.StatusStarted})
+			Expect(err).ToNot(HaveOccurred())
+
+			secondSaved := make(chan error, 1)
+			go func() {
+				defer GinkgoRecover()
+
+				secondTx, err := dbConn.Begin()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = dbng.SaveTeamEvent(secondTx, team.ID(), event.BuildStatusChanged{BuildID: 2, Status: atc.StatusStarted})
+				if err != nil {
+					secondTx.Rollback()
+					secondSaved <- err
+					return
+				}
+
+				secondSaved <- secondTx.Commit()
+			}()
+
+			Consistently(secondSaved).ShouldNot(Receive())
+
+			err = firstTx.Commit()
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(secondSaved).Should(Receive(BeNil()))
+
+			first, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(first.Envelope).To(Equal(envelope(event.BuildStatusChanged{BuildID: 1, Status: atc.StatusStarted})))
+
+			second, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.ID).To(BeNumerically(">", first.ID))
+			Expect(second.Envelope).To(Equal(envelope(event.BuildStatusChanged{BuildID: 2, Status: atc.StatusStarted})))
+		})
+
+		It("saves and emits the team's events in order", func() {
+			latestID, err := team.LatestEventID()
+			Expect(err).ToNot(HaveOccurred())
+
+			By("allowing you to subscribe to what happens from now on")
+			events, err := team.Events(latestID)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer events.Close()
+
+			By("emitting an event when a build changes status")
+			build, err := pipeline.CreateJobBuild("some-job")
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := build.Start("engine", "metadata")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			ev, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.ID).To(BeNumerically(">", latestID))
+			Expect(ev.Envelope).To(Equal(envelope(event.BuildStatusChanged{
+				Time:         build.StartTime().Unix(),
+				BuildID:      build.ID(),
+				BuildName:    build.Name(),
+				JobName:      "some-job",
+				PipelineName: "some-pipeline",
+				Status:       atc.StatusStarted,
+			})))
+
+			lastID := ev.ID
+
+			By("emitting an event when new versions of a resource are found")
+			err = pipeline.SaveResourceVersions(atc.ResourceConfig{
+				Name: "some-resource",
+				Type: "some-type",
+			}, []atc.Version{{"ref": "v1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.ID).To(BeNumerically(">", lastID))
+			Expect(ev.Envelope).To(Equal(envelope(event.NewResourceVersions{
+				PipelineName: "some-pipeline",
+				Resource:     "some-resource",
+				Versions:     []atc.Version{{"ref": "v1"}},
+			})))
+
+			By("not emitting an event for versions that were already known")
+			err = pipeline.SaveResourceVersions(atc.ResourceConfig{
+				Name: "some-resource",
+				Type: "some-type",
+			}, []atc.Version{{"ref": "v1"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			By("emitting an event when a resource starts failing its checks")
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = pipeline.SetResourceCheckError(resource, errors.New("oh no"))
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Envelope).To(Equal(envelope(event.ResourceCheckError{
+				PipelineName: "some-pipeline",
+				Resource:     "some-resource",
+				Error:        "oh no",
+			})))
+
+			By("not emitting an event when it keeps failing the same way")
+			err = pipeline.SetResourceCheckError(resource, errors.New("oh no"))
+			Expect(err).ToNot(HaveOccurred())
+
+			By("emitting an event when it recovers")
+			err = pipeline.SetResourceCheckError(resource, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Envelope).To(Equal(envelope(event.ResourceCheckError{
+				PipelineName: "some-pipeline",
+				Resource:     "some-resource",
+			})))
+
+			By("emitting events when the pipeline is paused and unpaused")
+			Expect(pipeline.Pause()).To(Succeed())
+			Expect(pipeline.Unpause()).To(Succeed())
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Envelope).To(Equal(envelope(event.PipelinePaused{
+				PipelineName: "some-pipeline",
+			})))
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Envelope).To(Equal(envelope(event.PipelineUnpaused{
+				PipelineName: "some-pipeline",
+			})))
+
+			By("emitting an event when the pipeline's config is saved")
+			_, created, err := team.SavePipeline("some-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job"},
+				},
+			}, pipeline.ConfigVersion(), dbng.PipelineNoChange, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+
+			ev, err = events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Envelope).To(Equal(envelope(event.PipelineConfigSaved{
+				PipelineName: "some-pipeline",
+				Created:      false,
+			})))
+
+			By("not emitting other teams' events")
+			otherBuild, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = otherBuild.Start("engine", "metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			latestID, err = team.LatestEventID()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(latestID).To(Equal(ev.ID))
+		})
+
+		It("can resume after a given event", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.Start("engine", "metadata")
+			Expect(err).ToNot(HaveOccurred())
+
+			startedID, err := team.LatestEventID()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(dbng.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			events, err := team.Events(startedID)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer events.Close()
+
+			ev, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.ID).To(BeNumerically(">", startedID))
+			Expect(ev.Envelope).To(Equal(envelope(event.BuildStatusChanged{
+				Time:      build.EndTime().Unix(),
+				BuildID:   build.ID(),
+				BuildName: build.Name(),
+				Status:    atc.StatusSucceeded,
+			})))
+		})
+
+		It("ends the stream once closed", func() {
+			events, err := team.Events(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(events.Close()).To(Succeed())
+
+			_, err = events.Next()
+			Expect(err).To(Equal(dbng.ErrTeamEventStreamClosed))
+		})
+
+		Context("when events have been reaped", func() {
+			var reapedID uint
+
+			BeforeEach(func() {
+				build, err := team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = build.Start("engine", "metadata")
+				Expect(err).ToNot(HaveOccurred())
+
+				reapedID, err = team.LatestEventID()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbConn.Exec(`UPDATE team_events SET created_at = now() - interval '2 hours'`)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = teamFactory.ReapTeamEvents(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("refuses to resume from before the reaped events", func() {
+				_, err := team.Events(0)
+				Expect(err).To(Equal(dbng.ErrTeamEventsReaped))
+			})
+
+			It("resumes from the last reaped event", func() {
+				events, err := team.Events(reapedID)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events.Close()).To(Succeed())
+			})
+
+			It("still reports the latest event", func() {
+				latestID, err := team.LatestEventID()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(latestID).To(Equal(reapedID))
+			})
+		})
+	})
+
 	Describe("VisiblePipelines", func() {
 		var (
 			pipelines []dbng.Pipeline
@@ -986,14 +1499,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = otherTeam.SavePipeline("fake-pipeline-two", atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pipeline2.Expose()).To(Succeed())
@@ -1012,7 +1525,7 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-fake-again"},
 						},
-					}, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+					}, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1037,14 +1550,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, dbng.PipelineUnpaused)
+			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, dbng.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, dbng.PipelineUnpaused)
+			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, dbng.PipelineUnpaused)
+			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, dbng.PipelineUnpaused, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1123,7 +1636,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused)
+				pipeline, _, err = team.SavePipeline("some-pipeline", config, dbng.ConfigVersion(1), dbng.PipelineUnpaused, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				for i := 3; i < 5; i++ {
@@ -1247,4 +1760,131 @@ var _ = Describe("Team", func() {
 			})
 		})
 	})
+
+	Describe("Pipeline templates", func() {
+		It("saves, updates and finds templates", func() {
+			template, err := team.SavePipelineTemplate("some-template", []byte("jobs: ((jobs))"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(template.Name()).To(Equal("some-template"))
+			Expect(template.TeamID()).To(Equal(team.ID()))
+			Expect(template.Template()).To(Equal([]byte("jobs: ((jobs))")))
+
+			updatedTemplate, err := team.SavePipelineTemplate("some-template", []byte("resources: ((resources))"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedTemplate.ID()).To(Equal(template.ID()))
+
+			_, err = team.SavePipelineTemplate("another-template", []byte("jobs: []"))
+			Expect(err).NotTo(HaveOccurred())
+
+			foundTemplate, found, err := team.PipelineTemplate("some-template")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(foundTemplate.Template()).To(Equal([]byte("resources: ((resources))")))
+
+			templates, err := team.PipelineTemplates()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(HaveLen(2))
+			Expect(templates[0].Name()).To(Equal("another-template"))
+			Expect(templates[1].Name()).To(Equal("some-template"))
+		})
+
+		It("does not find templates belonging to other teams", func() {
+			_, err := otherTeam.SavePipelineTemplate("some-template", []byte("jobs: []"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err := team.PipelineTemplate("some-template")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			templates, err := team.PipelineTemplates()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(BeEmpty())
+		})
+
+		Describe("instances", func() {
+			var (
+				template dbng.PipelineTemplate
+				config   atc.Config
+			)
+
+			BeforeEach(func() {
+				var err error
+				template, err = team.SavePipelineTemplate("some-template", []byte("jobs: ((jobs))"))
+				Expect(err).NotTo(HaveOccurred())
+
+				config = atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "some-job"},
+					},
+				}
+			})
+
+			It("saves and updates pipelines along with their params", func() {
+				pipelineB, created, err := template.SaveInstance("pipeline-b", config, 0, "some-author", map[string]interface{}{"jobs": "b"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+				Expect(pipelineB.Config()).To(Equal(config))
+
+				_, _, err = template.SaveInstance("pipeline-a", config, 0, "some-author", map[string]interface{}{"jobs": "a"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, created, err = template.SaveInstance("pipeline-b", config, pipelineB.ConfigVersion(), "some-author", map[string]interface{}{"jobs": "new-b"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+
+				instances, err := template.Instances()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(Equal([]dbng.PipelineTemplateInstance{
+					{PipelineName: "pipeline-a", Params: map[string]interface{}{"jobs": "a"}},
+					{PipelineName: "pipeline-b", Params: map[string]interface{}{"jobs": "new-b"}},
+				}))
+			})
+
+			It("saves neither the pipeline nor the params when saving the pipeline fails", func() {
+				pipeline, _, err := template.SaveInstance("some-pipeline", config, 0, "some-author", map[string]interface{}{"jobs": "a"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = template.SaveInstance("some-pipeline", atc.Config{}, pipeline.ConfigVersion()-1, "some-author", map[string]interface{}{"jobs": "b"})
+				Expect(err).To(Equal(dbng.ErrConfigComparisonFailed))
+
+				instances, err := template.Instances()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(Equal([]dbng.PipelineTemplateInstance{
+					{PipelineName: "some-pipeline", Params: map[string]interface{}{"jobs": "a"}},
+				}))
+			})
+
+			It("forgets instances whose pipeline is destroyed", func() {
+				pipeline, _, err := template.SaveInstance("some-pipeline", config, 0, "some-author", map[string]interface{}{"jobs": "a"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(pipeline.Destroy()).To(Succeed())
+
+				instances, err := template.Instances()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(BeEmpty())
+			})
+
+			It("makes a pipeline an instance of only the template it was last saved from", func() {
+				otherTemplate, err := team.SavePipelineTemplate("other-template", []byte("jobs: ((jobs))"))
+				Expect(err).NotTo(HaveOccurred())
+
+				pipeline, _, err := template.SaveInstance("some-pipeline", config, 0, "some-author", map[string]interface{}{"jobs": "a"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = otherTemplate.SaveInstance("some-pipeline", config, pipeline.ConfigVersion(), "some-author", map[string]interface{}{"jobs": "b"})
+				Expect(err).NotTo(HaveOccurred())
+
+				instances, err := template.Instances()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(BeEmpty())
+
+				instances, err = otherTemplate.Instances()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(instances).To(Equal([]dbng.PipelineTemplateInstance{
+					{PipelineName: "some-pipeline", Params: map[string]interface{}{"jobs": "b"}},
+				}))
+			})
+		})
+	})
 })
@@ -273,6 +273,7 @@ func (factory *volumeFactory) GetOrphanedVolumes() ([]CreatedVolume, []Destroyin
 			sq.Eq{"w.state": string(WorkerStateRunning)},
 			sq.Eq{"w.state": string(WorkerStateLanding)},
 			sq.Eq{"w.state": string(WorkerStateRetiring)},
+			sq.Eq{"w.state": string(WorkerStateQuarantined)},
 		}).
 		ToSql()
 	if err != nil {
@@ -322,6 +323,7 @@ func (factory *volumeFactory) GetDuplicateResourceCacheVolumes() ([]CreatingVolu
 			sq.Eq{"w.state": string(WorkerStateRunning)},
 			sq.Eq{"w.state": string(WorkerStateLanding)},
 			sq.Eq{"w.state": string(WorkerStateRetiring)},
+			sq.Eq{"w.state": string(WorkerStateQuarantined)},
 		}).
 		ToSql()
 	if err != nil {
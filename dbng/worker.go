@@ -2,7 +2,9 @@ package dbng
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -12,6 +14,7 @@ import (
 var (
 	ErrWorkerNotPresent         = errors.New("worker-not-present-in-db")
 	ErrCannotPruneRunningWorker = errors.New("worker-not-stalled-for-pruning")
+	ErrWorkerNotQuarantinable   = errors.New("worker-not-running-or-quarantined")
 )
 
 type WorkerState string
@@ -22,8 +25,27 @@ const (
 	WorkerStateLanding  = WorkerState("landing")
 	WorkerStateLanded   = WorkerState("landed")
 	WorkerStateRetiring = WorkerState("retiring")
+
+	// WorkerStateQuarantined is a worker which heartbeats but is failing
+	// health probes; it is excluded from placement until it recovers.
+	WorkerStateQuarantined = WorkerState("quarantined")
 )
 
+// WorkerProbe is the outcome of creating and destroying a container and
+// volume on a worker to check that its Garden and Baggageclaim are healthy.
+type WorkerProbe struct {
+	Succeeded bool      `json:"succeeded"`
+	Error     string    `json:"error,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// WorkerDrainProgress is what is left on a draining worker before it can
+// land or retire.
+type WorkerDrainProgress struct {
+	RemainingContainers int
+	RemainingBuilds     int
+}
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -40,10 +62,14 @@ type Worker interface {
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() map[string]string
+	StreamEncodings() []string
 	TeamID() int
 	TeamName() string
 	StartTime() int64
 	ExpiresAt() time.Time
+	Probes() []WorkerProbe
+	DrainDeadline() *time.Time
 
 	Reload() (bool, error)
 
@@ -51,6 +77,14 @@ type Worker interface {
 	Retire() error
 	Prune() error
 	Delete() error
+
+	Drain(retire bool, timeout time.Duration) error
+	DrainProgress() (WorkerDrainProgress, error)
+	ActiveBuildContainers() ([]CreatedContainer, error)
+
+	SaveProbe(probe WorkerProbe, keep int) error
+	Quarantine() error
+	Unquarantine() error
 }
 
 type worker struct {
@@ -69,10 +103,14 @@ type worker struct {
 	resourceTypes              []atc.WorkerResourceType
 	platform                   string
 	tags                       []string
+	labels                     map[string]string
+	streamEncodings            []string
 	teamID                     int
 	teamName                   string
 	startTime                  int64
 	expiresAt                  time.Time
+	probes                     []WorkerProbe
+	drainDeadline              *time.Time
 }
 
 func (worker *worker) Name() string                            { return worker.name }
@@ -88,6 +126,8 @@ func (worker *worker) ActiveContainers() int                   { return worker.a
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() map[string]string               { return worker.labels }
+func (worker *worker) StreamEncodings() []string               { return worker.streamEncodings }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 
@@ -95,6 +135,13 @@ func (worker *worker) TeamName() string                        { return worker.t
 func (worker *worker) StartTime() int64     { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
 
+// Probes returns the worker's most recent probes, newest first.
+func (worker *worker) Probes() []WorkerProbe { return worker.probes }
+
+// DrainDeadline returns when the builds on a draining worker will be
+// aborted, or nil if the worker is not being drained.
+func (worker *worker) DrainDeadline() *time.Time { return worker.drainDeadline }
+
 func (worker *worker) Reload() (bool, error) {
 	row := workersQuery.Where(sq.Eq{"w.name": worker.name}).
 		RunWith(worker.conn).
@@ -166,6 +213,124 @@ func (worker *worker) Retire() error {
 	return nil
 }
 
+// Drain lands the worker, or retires it if retire is true, giving the builds
+// running on it until timeout has elapsed before they are aborted.
+func (worker *worker) Drain(retire bool, timeout time.Duration) error {
+	var state interface{} = string(WorkerStateRetiring)
+	if !retire {
+		cSql, _, err := sq.Case("state").
+			When("'landed'::worker_state", "'landed'::worker_state").
+			Else("'landing'::worker_state").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		state = sq.Expr("(" + cSql + ")")
+	}
+
+	var (
+		newState      string
+		drainDeadline time.Time
+	)
+
+	err := psql.Update("workers").
+		Set("state", state).
+		Set("drain_deadline", sq.Expr(fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(timeout.Seconds())))).
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("RETURNING state, drain_deadline").
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&newState, &drainDeadline)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWorkerNotPresent
+		}
+		return err
+	}
+
+	worker.state = WorkerState(newState)
+	worker.drainDeadline = &drainDeadline
+
+	return nil
+}
+
+// DrainProgress counts the containers left on the worker and the builds which
+// are still using them.
+func (worker *worker) DrainProgress() (WorkerDrainProgress, error) {
+	var progress WorkerDrainProgress
+
+	err := psql.Select("COUNT(*)").
+		From("containers").
+		Where(sq.Eq{"worker_name": worker.name}).
+		Where(sq.NotEq{"state": ContainerStateDestroying}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&progress.RemainingContainers)
+	if err != nil {
+		return WorkerDrainProgress{}, err
+	}
+
+	err = psql.Select("COUNT(DISTINCT b.id)").
+		From("builds b").
+		Join("containers c ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.worker_name": worker.name,
+			"b.status":      []string{string(BuildStatusStarted), string(BuildStatusPending)},
+		}).
+		Where(sq.NotEq{"c.state": ContainerStateDestroying}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&progress.RemainingBuilds)
+	if err != nil {
+		return WorkerDrainProgress{}, err
+	}
+
+	return progress, nil
+}
+
+// ActiveBuildContainers returns the created containers on the worker which
+// belong to pending or started builds.
+func (worker *worker) ActiveBuildContainers() ([]CreatedContainer, error) {
+	query, args, err := selectContainers("c").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.worker_name": worker.name,
+			"c.state":       ContainerStateCreated,
+			"b.status":      []string{string(BuildStatusStarted), string(BuildStatusPending)},
+		}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := worker.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	containers := []CreatedContainer{}
+	for rows.Next() {
+		_, createdContainer, _, err := scanContainer(rows, worker.conn)
+		if err != nil {
+			return nil, err
+		}
+
+		if createdContainer != nil {
+			containers = append(containers, createdContainer)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return containers, nil
+}
+
 func (worker *worker) Prune() error {
 	rows, err := sq.Delete("workers").
 		Where(sq.Eq{
@@ -222,3 +387,114 @@ func (worker *worker) Delete() error {
 
 	return nil
 }
+
+// SaveProbe records a probe against the worker, keeping only the newest keep
+// probes.
+func (worker *worker) SaveProbe(probe WorkerProbe, keep int) error {
+	tx, err := worker.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	var probesBlob []byte
+	err = psql.Select("probes").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&probesBlob)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWorkerNotPresent
+		}
+		return err
+	}
+
+	var probes []WorkerProbe
+	err = json.Unmarshal(probesBlob, &probes)
+	if err != nil {
+		return err
+	}
+
+	probes = append([]WorkerProbe{probe}, probes...)
+	if len(probes) > keep {
+		probes = probes[:keep]
+	}
+
+	payload, err := json.Marshal(probes)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("workers").
+		Set("probes", payload).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	worker.probes = probes
+
+	return nil
+}
+
+// Quarantine moves a running worker into the quarantined state. It is a no-op
+// for a worker that is already quarantined.
+func (worker *worker) Quarantine() error {
+	return worker.transitionState(WorkerStateRunning, WorkerStateQuarantined)
+}
+
+// Unquarantine returns a quarantined worker to the running state. It is a
+// no-op for a worker that is already running.
+func (worker *worker) Unquarantine() error {
+	return worker.transitionState(WorkerStateQuarantined, WorkerStateRunning)
+}
+
+func (worker *worker) transitionState(from WorkerState, to WorkerState) error {
+	result, err := psql.Update("workers").
+		Set("state", string(to)).
+		Where(sq.Eq{
+			"name":  worker.name,
+			"state": []string{string(from), string(to)},
+		}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		var one int
+		err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
+			RunWith(worker.conn).
+			QueryRow().
+			Scan(&one)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrWorkerNotPresent
+			}
+			return err
+		}
+
+		return ErrWorkerNotQuarantinable
+	}
+
+	worker.state = to
+
+	return nil
+}
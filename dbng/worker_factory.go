@@ -44,10 +44,14 @@ var workersQuery = psql.Select(`
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
+		w.stream_encodings,
 		t.name,
 		w.team_id,
 		w.start_time,
-		w.expires
+		w.expires,
+		w.probes,
+		w.drain_deadline
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -112,10 +116,14 @@ func scanWorker(worker *worker, row scannable) error {
 		resourceTypes      []byte
 		platform           sql.NullString
 		tags               []byte
+		labels             []byte
+		streamEncodings    []byte
 		teamName           sql.NullString
 		teamID             sql.NullInt64
 		startTime          sql.NullInt64
 		expiresAt          *time.Time
+		probes             []byte
+		drainDeadline      *time.Time
 	)
 
 	err := row.Scan(
@@ -132,10 +140,14 @@ func scanWorker(worker *worker, row scannable) error {
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
+		&streamEncodings,
 		&teamName,
 		&teamID,
 		&startTime,
 		&expiresAt,
+		&probes,
+		&drainDeadline,
 	)
 	if err != nil {
 		return err
@@ -159,6 +171,8 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.expiresAt = *expiresAt
 	}
 
+	worker.drainDeadline = drainDeadline
+
 	if httpProxyURL.Valid {
 		worker.httpProxyURL = httpProxyURL.String
 	}
@@ -201,6 +215,21 @@ func scanWorker(worker *worker, row scannable) error {
 	if err != nil {
 		return err
 	}
+
+	err = json.Unmarshal(labels, &worker.labels)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(streamEncodings, &worker.streamEncodings)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(probes, &worker.probes)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -226,6 +255,7 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		When("'landing'::worker_state", "'landing'::worker_state").
 		When("'landed'::worker_state", "'landed'::worker_state").
 		When("'retiring'::worker_state", "'retiring'::worker_state").
+		When("'quarantined'::worker_state", "'quarantined'::worker_state").
 		Else("'running'::worker_state").
 		ToSql()
 
@@ -318,6 +348,26 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	workerLabels := atcWorker.Labels
+	if workerLabels == nil {
+		workerLabels = map[string]string{}
+	}
+
+	labels, err := json.Marshal(workerLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	workerStreamEncodings := atcWorker.StreamEncodings
+	if workerStreamEncodings == nil {
+		workerStreamEncodings = []string{}
+	}
+
+	streamEncodings, err := json.Marshal(workerStreamEncodings)
+	if err != nil {
+		return nil, err
+	}
+
 	certificatesSymlinkedPaths, err := json.Marshal(atcWorker.CertificatesSymlinkedPaths)
 	if err != nil {
 		return nil, err
@@ -350,6 +400,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 					"active_containers",
 					"resource_types",
 					"tags",
+					"labels",
+					"stream_encodings",
 					"platform",
 					"baggageclaim_url",
 					"http_proxy_url",
@@ -368,6 +420,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 					atcWorker.ActiveContainers,
 					resourceTypes,
 					tags,
+					labels,
+					streamEncodings,
 					atcWorker.Platform,
 					atcWorker.BaggageclaimURL,
 					atcWorker.HTTPProxyURL,
@@ -400,6 +454,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			Set("active_containers", atcWorker.ActiveContainers).
 			Set("resource_types", resourceTypes).
 			Set("tags", tags).
+			Set("labels", labels).
+			Set("stream_encodings", streamEncodings).
 			Set("platform", atcWorker.Platform).
 			Set("baggageclaim_url", atcWorker.BaggageclaimURL).
 			Set("http_proxy_url", atcWorker.HTTPProxyURL).
@@ -410,6 +466,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			Set("state", string(workerState)).
 			Set("certificates_path", atcWorker.CertificatesPath).
 			Set("certificates_symlinked_paths", certificatesSymlinkedPaths).
+			Set("drain_deadline", nil).
 			Where(sq.Eq{
 				"name": atcWorker.Name,
 			}).
@@ -439,6 +496,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		resourceTypes:              atcWorker.ResourceTypes,
 		platform:                   atcWorker.Platform,
 		tags:                       atcWorker.Tags,
+		labels:                     atcWorker.Labels,
+		streamEncodings:            atcWorker.StreamEncodings,
 		teamName:                   atcWorker.Team,
 		teamID:                     workerTeamID,
 		startTime:                  atcWorker.StartTime,
@@ -39,10 +39,12 @@ var _ = Describe("WorkerFactory", func() {
 					Version: "other-version",
 				},
 			},
-			Platform:  "some-platform",
-			Tags:      atc.Tags{"some", "tags"},
-			Name:      "some-name",
-			StartTime: 55,
+			Platform:        "some-platform",
+			Tags:            atc.Tags{"some", "tags"},
+			Labels:          map[string]string{"zone": "a"},
+			StreamEncodings: []string{"zstd"},
+			Name:            "some-name",
+			StartTime:       55,
 		}
 	})
 
@@ -159,6 +161,8 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(map[string]string{"zone": "a"}))
+				Expect(foundWorker.StreamEncodings()).To(Equal([]string{"zstd"}))
 				Expect(foundWorker.StartTime()).To(Equal(int64(55)))
 				Expect(foundWorker.State()).To(Equal(dbng.WorkerStateRunning))
 			})
@@ -302,6 +306,19 @@ var _ = Describe("WorkerFactory", func() {
 				})
 			})
 
+			Context("when the current state is quarantined", func() {
+				BeforeEach(func() {
+					atcWorker.State = string(dbng.WorkerStateQuarantined)
+				})
+
+				It("keeps the state as quarantined", func() {
+					foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(foundWorker.State()).To(Equal(dbng.WorkerStateQuarantined))
+				})
+			})
+
 			Context("when the current state is running", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(dbng.WorkerStateRunning)
@@ -32,7 +32,7 @@ func (lifecycle *workerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 			"baggageclaim_url": nil,
 			"expires":          nil,
 		}).
-		Where(sq.Eq{"state": string(WorkerStateRunning)}).
+		Where(sq.Eq{"state": []string{string(WorkerStateRunning), string(WorkerStateQuarantined)}}).
 		Where(sq.Expr("expires < NOW()")).
 		Suffix("RETURNING name").
 		ToSql()
@@ -76,6 +76,9 @@ func (lifecycle *workerLifecycle) DeleteFinishedRetiringWorkers() ([]string, err
 			sq.Eq{
 				"b.job_id": nil,
 			},
+		}).
+		Where(sq.NotEq{
+			"c.state": ContainerStateDestroying,
 		}).ToSql()
 
 	if err != nil {
@@ -132,6 +135,9 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 			sq.Eq{
 				"b.job_id": nil,
 			},
+		}).
+		Where(sq.NotEq{
+			"c.state": ContainerStateDestroying,
 		}).ToSql()
 
 	if err != nil {
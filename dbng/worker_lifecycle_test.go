@@ -71,6 +71,20 @@ var _ = Describe("Worker Lifecycle", func() {
 				Expect(stalledWorkers[0]).To(Equal("some-name"))
 			})
 		})
+
+		Context("when a quarantined worker has not heartbeated recently", func() {
+			BeforeEach(func() {
+				atcWorker.State = string(dbng.WorkerStateQuarantined)
+				_, err := workerFactory.SaveWorker(atcWorker, -1*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("marks the worker as `stalled`", func() {
+				stalledWorkers, err := workerLifecycle.StallUnresponsiveWorkers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stalledWorkers).To(Equal([]string{"some-name"}))
+			})
+		})
 	})
 
 	Describe("DeleteFinishedRetiringWorkers", func() {
@@ -188,7 +202,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: false,
 							},
 						},
-					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -216,7 +230,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: true,
 							},
 						},
-					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -373,6 +387,29 @@ var _ = Describe("Worker Lifecycle", func() {
 				Entry("errored", dbng.BuildStatusErrored, dbng.WorkerStateLanded),
 			)
 
+			Context("when the containers of a running build are being destroyed", func() {
+				It("lands worker", func() {
+					dbBuild, err := defaultTeam.CreateOneOffBuild()
+					Expect(err).NotTo(HaveOccurred())
+
+					err = dbBuild.SaveStatus(dbng.BuildStatusStarted)
+					Expect(err).NotTo(HaveOccurred())
+
+					creatingContainer, err := defaultTeam.CreateBuildContainer(dbWorker.Name(), dbBuild.ID(), atc.PlanID(4), dbng.ContainerMetadata{})
+					Expect(err).NotTo(HaveOccurred())
+
+					createdContainer, err := creatingContainer.Created()
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = createdContainer.Destroying()
+					Expect(err).NotTo(HaveOccurred())
+
+					landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(landedWorkers).To(ConsistOf(atcWorker.Name))
+				})
+			})
+
 			ItLandsWorkerWithExpectedState := func(s dbng.BuildStatus, expectedState dbng.WorkerState) {
 				err := dbBuild.SaveStatus(s)
 				Expect(err).NotTo(HaveOccurred())
@@ -402,7 +439,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: false,
 							},
 						},
-					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -430,7 +467,7 @@ var _ = Describe("Worker Lifecycle", func() {
 								Interruptible: true,
 							},
 						},
-					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused)
+					}, dbng.ConfigVersion(0), dbng.PipelineUnpaused, "")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(created).To(BeTrue())
 
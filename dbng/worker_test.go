@@ -205,4 +205,267 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("Quarantine", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the worker is running", func() {
+			It("marks the worker as `quarantined`", func() {
+				err := worker.Quarantine()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateQuarantined))
+			})
+		})
+
+		Context("when the worker is already quarantined", func() {
+			BeforeEach(func() {
+				err := worker.Quarantine()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("leaves the worker quarantined", func() {
+				err := worker.Quarantine()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateQuarantined))
+			})
+		})
+
+		Context("when the worker is landing", func() {
+			BeforeEach(func() {
+				err := worker.Land()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns ErrWorkerNotQuarantinable and leaves the state alone", func() {
+				err := worker.Quarantine()
+				Expect(err).To(Equal(ErrWorkerNotQuarantinable))
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateLanding))
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Quarantine()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("Unquarantine", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.Quarantine()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the worker as `running`", func() {
+			err := worker.Unquarantine()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+		})
+	})
+
+	Describe("SaveProbe", func() {
+		var (
+			firstProbe  WorkerProbe
+			secondProbe WorkerProbe
+			thirdProbe  WorkerProbe
+		)
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			now := time.Now().UTC().Truncate(time.Second)
+
+			firstProbe = WorkerProbe{Succeeded: true, Time: now}
+			secondProbe = WorkerProbe{Succeeded: false, Error: "garden is down", Time: now.Add(time.Minute)}
+			thirdProbe = WorkerProbe{Succeeded: true, Time: now.Add(2 * time.Minute)}
+		})
+
+		It("starts with no probes", func() {
+			Expect(worker.Probes()).To(BeEmpty())
+		})
+
+		It("keeps the newest probes first, up to the limit", func() {
+			Expect(worker.SaveProbe(firstProbe, 2)).To(Succeed())
+			Expect(worker.SaveProbe(secondProbe, 2)).To(Succeed())
+			Expect(worker.SaveProbe(thirdProbe, 2)).To(Succeed())
+
+			Expect(worker.Probes()).To(HaveLen(2))
+
+			_, err := worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			probes := worker.Probes()
+			Expect(probes).To(HaveLen(2))
+			Expect(probes[0].Succeeded).To(BeTrue())
+			Expect(probes[0].Time).To(BeTemporally("==", thirdProbe.Time))
+			Expect(probes[1].Succeeded).To(BeFalse())
+			Expect(probes[1].Error).To(Equal("garden is down"))
+			Expect(probes[1].Time).To(BeTemporally("==", secondProbe.Time))
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.SaveProbe(firstProbe, 2)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("Drain", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the worker as `landing` with a drain deadline", func() {
+			err := worker.Drain(false, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateLanding))
+			Expect(worker.DrainDeadline()).NotTo(BeNil())
+			Expect(*worker.DrainDeadline()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		Context("when retiring", func() {
+			It("marks the worker as `retiring`", func() {
+				err := worker.Drain(true, time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateRetiring))
+				Expect(worker.DrainDeadline()).NotTo(BeNil())
+			})
+		})
+
+		Context("when the worker registers again", func() {
+			It("clears the drain deadline", func() {
+				err := worker.Drain(false, time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.DrainDeadline()).To(BeNil())
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Drain(false, time.Hour)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("DrainProgress and ActiveBuildContainers", func() {
+		var createdContainer CreatedContainer
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			startedBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(startedBuild.SaveStatus(BuildStatusStarted)).To(Succeed())
+
+			creatingContainer, err := defaultTeam.CreateBuildContainer(worker.Name(), startedBuild.ID(), atc.PlanID("some-plan"), ContainerMetadata{
+				Type:    ContainerTypeTask,
+				Attempt: "1",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			createdContainer, err = creatingContainer.Created()
+			Expect(err).NotTo(HaveOccurred())
+
+			finishedBuild, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(finishedBuild.SaveStatus(BuildStatusSucceeded)).To(Succeed())
+
+			_, err = defaultTeam.CreateBuildContainer(worker.Name(), finishedBuild.ID(), atc.PlanID("some-plan"), ContainerMetadata{
+				Type: ContainerTypeTask,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("counts the remaining containers and the builds still using them", func() {
+			progress, err := worker.DrainProgress()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress).To(Equal(WorkerDrainProgress{
+				RemainingContainers: 2,
+				RemainingBuilds:     1,
+			}))
+		})
+
+		It("returns the created containers of active builds", func() {
+			containers, err := worker.ActiveBuildContainers()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].Handle()).To(Equal(createdContainer.Handle()))
+			Expect(containers[0].Metadata().Attempt).To(Equal("1"))
+		})
+
+		Context("when the container is being destroyed", func() {
+			BeforeEach(func() {
+				_, err := createdContainer.Destroying()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("no longer counts it", func() {
+				progress, err := worker.DrainProgress()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(progress).To(Equal(WorkerDrainProgress{
+					RemainingContainers: 1,
+					RemainingBuilds:     0,
+				}))
+
+				containers, err := worker.ActiveBuildContainers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(containers).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -48,6 +48,38 @@ var VersionConfigDecodeHook = func(
 	return data, nil
 }
 
+var AttemptsConfigDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf(AttemptsConfig{}) {
+		return data, nil
+	}
+
+	if srcType.Kind() == reflect.Map {
+		return data, nil
+	}
+
+	return map[string]interface{}{"count": data}, nil
+}
+
+var InParallelConfigDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf(InParallelConfig{}) {
+		return data, nil
+	}
+
+	if srcType.Kind() != reflect.Slice {
+		return data, nil
+	}
+
+	return map[string]interface{}{"steps": data}, nil
+}
+
 var SanitizeDecodeHook = func(
 	dataKind reflect.Kind,
 	valKind reflect.Kind,
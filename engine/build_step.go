@@ -1,6 +1,8 @@
 package engine
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
@@ -24,6 +26,20 @@ func (build *execBuild) buildAggregateStep(logger lager.Logger, plan atc.Plan) e
 	return step
 }
 
+func (build *execBuild) buildInParallelStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
+	logger = logger.Session("in-parallel")
+
+	steps := []exec.StepFactory{}
+
+	for _, innerPlan := range plan.InParallel.Steps {
+		innerPlan.Attempts = plan.Attempts
+		stepFactory := build.buildStepFactory(logger, innerPlan)
+		steps = append(steps, stepFactory)
+	}
+
+	return exec.InParallel(steps, plan.InParallel.Limit, plan.InParallel.FailFast)
+}
+
 func (build *execBuild) buildDoStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
 	logger = logger.Session("do")
 
@@ -48,6 +64,18 @@ func (build *execBuild) buildTimeoutStep(logger lager.Logger, plan atc.Plan) exe
 	return exec.Timeout(step, plan.Timeout.Duration, clock.NewClock())
 }
 
+func (build *execBuild) buildConditionalStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
+	innerPlan := plan.Conditional.Step
+	innerPlan.Attempts = plan.Attempts
+	step := build.buildStepFactory(logger, innerPlan)
+
+	return exec.Conditional(
+		plan.Conditional.Condition,
+		build.delegate.ConditionalDelegate(logger, *plan.Conditional, event.OriginID(plan.ID)),
+		step,
+	)
+}
+
 func (build *execBuild) buildTryStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
 	innerPlan := plan.Try.Step
 	innerPlan.Attempts = plan.Attempts
@@ -96,6 +124,11 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		return exec.Identity{}
 	}
 
+	configSource = exec.InterpolatedConfigSource{
+		ConfigSource: configSource,
+		Variables:    build.delegate.BuildVariables(),
+	}
+
 	configSource = exec.ValidatingConfigSource{configSource}
 
 	workerMetadata := build.workerMetadata(
@@ -116,6 +149,7 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 		build.delegate.ExecutionDelegate(logger, *plan.Task, event.OriginID(plan.ID)),
 		exec.Privileged(plan.Task.Privileged),
 		plan.Task.Tags,
+		plan.Task.Placement,
 		configSource,
 		plan.Task.VersionedResourceTypes,
 		plan.Task.InputMapping,
@@ -151,6 +185,7 @@ func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.St
 			Source: plan.Get.Source,
 		},
 		plan.Get.Tags,
+		plan.Get.Placement,
 		plan.Get.Params,
 		plan.Get.Version,
 		plan.Get.VersionedResourceTypes,
@@ -182,6 +217,7 @@ func (build *execBuild) buildPutStep(logger lager.Logger, plan atc.Plan) exec.St
 			Source: plan.Put.Source,
 		},
 		plan.Put.Tags,
+		plan.Put.Placement,
 		plan.Put.Params,
 		plan.Put.VersionedResourceTypes,
 	)
@@ -215,6 +251,7 @@ func (build *execBuild) buildDependentGetStep(logger lager.Logger, plan atc.Plan
 			Source: getPlan.Source,
 		},
 		getPlan.Tags,
+		getPlan.Placement,
 		getPlan.Params,
 		getPlan.VersionedResourceTypes,
 	)
@@ -232,5 +269,66 @@ func (build *execBuild) buildRetryStep(logger lager.Logger, plan atc.Plan) exec.
 		step = append(step, stepFactory)
 	}
 
-	return step
+	// plain attempts retry immediately, but their retries are still reported
+	policy := exec.RetryPolicy{}
+	if plan.RetryPolicy != nil {
+		policy = build.retryPolicy(logger, *plan.RetryPolicy)
+	}
+
+	return exec.RetryWithPolicy(
+		step,
+		policy,
+		build.delegate.RetryDelegate(logger, event.OriginID(plan.ID)),
+		clock.NewClock(),
+	)
+}
+
+func (build *execBuild) retryPolicy(logger lager.Logger, policy atc.RetryPolicy) exec.RetryPolicy {
+	retryPolicy := exec.RetryPolicy{
+		Exponential:     policy.Backoff == atc.RetryBackoffExponential,
+		ErroredOnly:     policy.ErroredOnly,
+		DifferentWorker: policy.DifferentWorker,
+	}
+
+	var err error
+
+	if policy.Delay != "" {
+		retryPolicy.Delay, err = time.ParseDuration(policy.Delay)
+		if err != nil {
+			logger.Error("failed-to-parse-retry-delay", err)
+		}
+	}
+
+	if policy.MaxDelay != "" {
+		retryPolicy.MaxDelay, err = time.ParseDuration(policy.MaxDelay)
+		if err != nil {
+			logger.Error("failed-to-parse-retry-max-delay", err)
+		}
+	}
+
+	return retryPolicy
+}
+
+func (build *execBuild) buildApproveStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
+	logger = logger.Session("approve", lager.Data{
+		"name": plan.Approve.Name,
+	})
+
+	return exec.Approve(
+		build.delegate.ApproveDelegate(logger, *plan.Approve, event.OriginID(plan.ID)),
+	)
+}
+
+func (build *execBuild) buildLoadVarStep(logger lager.Logger, plan atc.Plan) exec.StepFactory {
+	logger = logger.Session("load-var", lager.Data{
+		"name": plan.LoadVar.Name,
+	})
+
+	return exec.LoadVar(
+		plan.LoadVar.Name,
+		plan.LoadVar.File,
+		plan.LoadVar.Format,
+		plan.LoadVar.Sensitive,
+		build.delegate.LoadVarDelegate(logger, *plan.LoadVar, event.OriginID(plan.ID)),
+	)
 }
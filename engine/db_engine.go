@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"sync"
@@ -9,16 +10,29 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/event"
 	"github.com/concourse/atc/metric"
 )
 
 const trackLockDuration = time.Minute
 
-func NewDBEngine(engines Engines) Engine {
+// ErrDraining is returned when creating a build while the ATC is shutting
+// down, as the build would only be left for another ATC to pick up.
+var ErrDraining = errors.New("atc is draining")
+
+// NewDBEngine constructs an engine which tracks builds through the database,
+// delegating to the build's engine to actually run it.
+//
+// When released, builds already running are given up to drainTimeout to
+// finish before being handed off to another ATC.
+func NewDBEngine(engines Engines, drainTimeout time.Duration) Engine {
 	return &dbEngine{
-		engines:   engines,
-		releaseCh: make(chan struct{}),
-		waitGroup: new(sync.WaitGroup),
+		engines:      engines,
+		releaseCh:    make(chan struct{}),
+		drainCh:      make(chan struct{}),
+		drainLock:    new(sync.Mutex),
+		drainTimeout: drainTimeout,
+		waitGroup:    new(sync.WaitGroup),
 	}
 }
 
@@ -31,9 +45,12 @@ func (err UnknownEngineError) Error() string {
 }
 
 type dbEngine struct {
-	engines   Engines
-	releaseCh chan struct{}
-	waitGroup *sync.WaitGroup
+	engines      Engines
+	releaseCh    chan struct{}
+	drainCh      chan struct{}
+	drainLock    *sync.Mutex
+	drainTimeout time.Duration
+	waitGroup    *sync.WaitGroup
 }
 
 func (*dbEngine) Name() string {
@@ -41,6 +58,13 @@ func (*dbEngine) Name() string {
 }
 
 func (engine *dbEngine) CreateBuild(logger lager.Logger, build dbng.Build, plan atc.Plan) (Build, error) {
+	select {
+	case <-engine.drainCh:
+		logger.Info("draining-refusing-to-create-build")
+		return nil, ErrDraining
+	default:
+	}
+
 	buildEngine := engine.engines[0]
 
 	createdBuild, err := buildEngine.CreateBuild(logger, build, plan)
@@ -57,24 +81,48 @@ func (engine *dbEngine) CreateBuild(logger lager.Logger, build dbng.Build, plan
 		createdBuild.Abort(logger.Session("aborted-immediately"))
 	}
 
-	return &dbBuild{
-		engines:   engine.engines,
-		releaseCh: engine.releaseCh,
-		waitGroup: engine.waitGroup,
-		build:     build,
-	}, nil
+	return engine.newBuild(build), nil
 }
 
 func (engine *dbEngine) LookupBuild(logger lager.Logger, build dbng.Build) (Build, error) {
+	return engine.newBuild(build), nil
+}
+
+func (engine *dbEngine) newBuild(build dbng.Build) *dbBuild {
 	return &dbBuild{
 		engines:   engine.engines,
 		releaseCh: engine.releaseCh,
+		drainCh:   engine.drainCh,
+		drainLock: engine.drainLock,
 		waitGroup: engine.waitGroup,
 		build:     build,
-	}, nil
+	}
 }
 
 func (engine *dbEngine) ReleaseAll(logger lager.Logger) {
+	// stop picking up builds; anything started from here on is left for
+	// another ATC's tracker
+	engine.drainLock.Lock()
+	close(engine.drainCh)
+	engine.drainLock.Unlock()
+
+	if engine.drainTimeout > 0 {
+		logger.Info("waiting-for-builds-to-finish", lager.Data{"timeout": engine.drainTimeout.String()})
+
+		finished := make(chan struct{})
+		go func() {
+			engine.waitGroup.Wait()
+			close(finished)
+		}()
+
+		select {
+		case <-finished:
+			logger.Info("builds-finished")
+		case <-time.After(engine.drainTimeout):
+			logger.Info("timed-out-waiting-for-builds")
+		}
+	}
+
 	logger.Info("calling-release-on-builds")
 
 	close(engine.releaseCh)
@@ -93,6 +141,8 @@ func (engine *dbEngine) ReleaseAll(logger lager.Logger) {
 type dbBuild struct {
 	engines   Engines
 	releaseCh chan struct{}
+	drainCh   chan struct{}
+	drainLock *sync.Mutex
 	build     dbng.Build
 	waitGroup *sync.WaitGroup
 }
@@ -187,7 +237,19 @@ func (build *dbBuild) Abort(logger lager.Logger) error {
 }
 
 func (build *dbBuild) Resume(logger lager.Logger) {
+	build.drainLock.Lock()
+
+	select {
+	case <-build.drainCh:
+		build.drainLock.Unlock()
+		logger.Info("draining-leaving-build-for-another-atc")
+		return
+	default:
+	}
+
 	build.waitGroup.Add(1)
+	build.drainLock.Unlock()
+
 	defer build.waitGroup.Done()
 
 	lock, acquired, err := build.build.AcquireTrackingLock(logger, trackLockDuration)
@@ -294,15 +356,39 @@ func (build *dbBuild) Resume(logger lager.Logger) {
 		return
 	}
 
-	if !build.build.IsRunning() {
-		metric.BuildFinished{
-			PipelineName:  build.build.PipelineName(),
-			JobName:       build.build.JobName(),
-			BuildName:     build.build.Name(),
-			BuildID:       build.build.ID(),
-			BuildStatus:   build.build.Status(),
-			BuildDuration: build.build.EndTime().Sub(build.build.StartTime()),
-		}.Emit(logger)
+	if build.build.IsRunning() {
+		select {
+		case <-build.releaseCh:
+			build.handOff(logger)
+		default:
+		}
+
+		return
+	}
+
+	metric.BuildFinished{
+		PipelineName:  build.build.PipelineName(),
+		JobName:       build.build.JobName(),
+		BuildName:     build.build.Name(),
+		BuildID:       build.build.ID(),
+		BuildStatus:   build.build.Status(),
+		BuildDuration: build.build.EndTime().Sub(build.build.StartTime()),
+	}.Emit(logger)
+}
+
+// handOff records that the build was released while still running. Its
+// tracking lock is released once Resume returns, at which point another ATC
+// re-runs the plan. Tasks reattach to their containers' processes, gets find
+// their caches, and puts that already completed are resumed from the
+// checkpoint they saved rather than being run again.
+func (build *dbBuild) handOff(logger lager.Logger) {
+	logger.Info("handing-off-build")
+
+	err := build.build.SaveEvent(event.HandOff{
+		Time: time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-hand-off-event", err)
 	}
 }
 
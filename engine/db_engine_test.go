@@ -3,6 +3,7 @@ package engine_test
 import (
 	"encoding/json"
 	"errors"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -17,6 +18,7 @@ import (
 	"github.com/concourse/atc/dbng/dbngfakes"
 	. "github.com/concourse/atc/engine"
 	"github.com/concourse/atc/engine/enginefakes"
+	"github.com/concourse/atc/event"
 )
 
 var _ = Describe("DBEngine", func() {
@@ -42,7 +44,7 @@ var _ = Describe("DBEngine", func() {
 		dbBuild = new(dbngfakes.FakeBuild)
 		dbBuild.IDReturns(128)
 
-		dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB})
+		dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB}, 0)
 	})
 
 	Describe("CreateBuild", func() {
@@ -133,6 +135,21 @@ var _ = Describe("DBEngine", func() {
 				Expect(dbBuild.StartCallCount()).To(Equal(0))
 			})
 		})
+
+		Context("when the ATC is draining", func() {
+			BeforeEach(func() {
+				dbEngine.ReleaseAll(logger)
+			})
+
+			It("refuses to create the build", func() {
+				Expect(buildErr).To(Equal(ErrDraining))
+				Expect(fakeEngineA.CreateBuildCallCount()).To(BeZero())
+			})
+
+			It("leaves the build pending for another ATC", func() {
+				Expect(dbBuild.StartCallCount()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("LookupBuild", func() {
@@ -519,6 +536,23 @@ var _ = Describe("DBEngine", func() {
 							It("releases the lock", func() {
 								Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
 							})
+
+							It("records that the build was handed off", func() {
+								Expect(dbBuild.SaveEventCallCount()).To(Equal(1))
+								Expect(dbBuild.SaveEventArgsForCall(0)).To(BeAssignableToTypeOf(event.HandOff{}))
+							})
+
+							Context("when the build finished before being released", func() {
+								BeforeEach(func() {
+									dbBuild.IsRunningStub = func() bool {
+										return dbBuild.ReloadCallCount() < 2
+									}
+								})
+
+								It("does not hand it off", func() {
+									Expect(dbBuild.SaveEventCallCount()).To(BeZero())
+								})
+							})
 						})
 
 						Context("when listening for aborts succeeds", func() {
@@ -812,4 +846,137 @@ var _ = Describe("DBEngine", func() {
 			})
 		})
 	})
+
+	Describe("ReleaseAll", func() {
+		var (
+			drainTimeout time.Duration
+
+			realBuild *enginefakes.FakeBuild
+			fakeLock  *lockfakes.FakeLock
+
+			buildResumed chan struct{}
+			finishBuild  func()
+			buildDone    chan struct{}
+		)
+
+		BeforeEach(func() {
+			drainTimeout = 0
+
+			buildResumed = make(chan struct{})
+			buildDone = make(chan struct{})
+
+			finished := make(chan struct{})
+			finishOnce := new(sync.Once)
+			finishBuild = func() {
+				finishOnce.Do(func() { close(finished) })
+			}
+
+			fakeLock = new(lockfakes.FakeLock)
+			dbBuild.AcquireTrackingLockReturns(fakeLock, true, nil)
+			dbBuild.EngineReturns("fake-engine-b")
+			dbBuild.IsRunningReturns(true)
+			dbBuild.ReloadReturns(true, nil)
+
+			notifier := new(dbfakes.FakeNotifier)
+			notifier.NotifyReturns(make(chan struct{}))
+			dbBuild.AbortNotifierReturns(notifier, nil)
+
+			realBuild = new(enginefakes.FakeBuild)
+			realBuild.ResumeStub = func(lager.Logger) {
+				close(buildResumed)
+				<-finished
+			}
+
+			fakeEngineB.LookupBuildReturns(realBuild, nil)
+
+			// releasing the build engine's builds makes them return
+			fakeEngineB.ReleaseAllStub = func(lager.Logger) {
+				finishBuild()
+			}
+		})
+
+		JustBeforeEach(func() {
+			dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB}, drainTimeout)
+
+			build, err := dbEngine.LookupBuild(logger, dbBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			go func() {
+				defer close(buildDone)
+				build.Resume(logger)
+			}()
+
+			<-buildResumed
+		})
+
+		AfterEach(func() {
+			finishBuild()
+			<-buildDone
+		})
+
+		It("stops resuming builds", func() {
+			dbEngine.ReleaseAll(logger)
+
+			build, err := dbEngine.LookupBuild(logger, dbBuild)
+			Expect(err).NotTo(HaveOccurred())
+
+			build.Resume(logger)
+
+			Expect(dbBuild.AcquireTrackingLockCallCount()).To(Equal(1))
+			Expect(realBuild.ResumeCallCount()).To(Equal(1))
+		})
+
+		Context("when there is no drain timeout", func() {
+			It("releases running builds immediately", func() {
+				dbEngine.ReleaseAll(logger)
+
+				Expect(fakeEngineA.ReleaseAllCallCount()).To(Equal(1))
+				Expect(fakeEngineB.ReleaseAllCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when there is a drain timeout", func() {
+			var released chan struct{}
+
+			BeforeEach(func() {
+				drainTimeout = time.Minute
+
+				released = make(chan struct{})
+			})
+
+			JustBeforeEach(func() {
+				go func() {
+					defer close(released)
+					dbEngine.ReleaseAll(logger)
+				}()
+			})
+
+			It("waits for running builds to finish before releasing", func() {
+				Consistently(released).ShouldNot(BeClosed())
+				Expect(fakeEngineB.ReleaseAllCallCount()).To(BeZero())
+
+				dbBuild.IsRunningReturns(false)
+				finishBuild()
+
+				Eventually(released).Should(BeClosed())
+				Expect(fakeEngineB.ReleaseAllCallCount()).To(Equal(1))
+				Expect(dbBuild.SaveEventCallCount()).To(BeZero())
+			})
+
+			Context("when the builds do not finish in time", func() {
+				BeforeEach(func() {
+					drainTimeout = 100 * time.Millisecond
+				})
+
+				It("releases them anyway, handing them off", func() {
+					Eventually(released).Should(BeClosed())
+					Expect(fakeEngineB.ReleaseAllCallCount()).To(Equal(1))
+
+					Expect(dbBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(dbBuild.SaveEventArgsForCall(0)).To(BeAssignableToTypeOf(event.HandOff{}))
+					Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
 })
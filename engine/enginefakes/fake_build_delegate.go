@@ -51,6 +51,72 @@ type FakeBuildDelegate struct {
 	outputDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
+	ApproveDelegateStub        func(lager.Logger, atc.ApprovePlan, event.OriginID) exec.ApproveDelegate
+	approveDelegateMutex       sync.RWMutex
+	approveDelegateArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.ApprovePlan
+		arg3 event.OriginID
+	}
+	approveDelegateReturns struct {
+		result1 exec.ApproveDelegate
+	}
+	approveDelegateReturnsOnCall map[int]struct {
+		result1 exec.ApproveDelegate
+	}
+	RetryDelegateStub        func(lager.Logger, event.OriginID) exec.RetryDelegate
+	retryDelegateMutex       sync.RWMutex
+	retryDelegateArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 event.OriginID
+	}
+	retryDelegateReturns struct {
+		result1 exec.RetryDelegate
+	}
+	retryDelegateReturnsOnCall map[int]struct {
+		result1 exec.RetryDelegate
+	}
+	ConditionalDelegateStub        func(lager.Logger, atc.ConditionalPlan, event.OriginID) exec.ConditionalDelegate
+	conditionalDelegateMutex       sync.RWMutex
+	conditionalDelegateArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.ConditionalPlan
+		arg3 event.OriginID
+	}
+	conditionalDelegateReturns struct {
+		result1 exec.ConditionalDelegate
+	}
+	conditionalDelegateReturnsOnCall map[int]struct {
+		result1 exec.ConditionalDelegate
+	}
+	LoadVarDelegateStub        func(lager.Logger, atc.LoadVarPlan, event.OriginID) exec.LoadVarDelegate
+	loadVarDelegateMutex       sync.RWMutex
+	loadVarDelegateArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.LoadVarPlan
+		arg3 event.OriginID
+	}
+	loadVarDelegateReturns struct {
+		result1 exec.LoadVarDelegate
+	}
+	loadVarDelegateReturnsOnCall map[int]struct {
+		result1 exec.LoadVarDelegate
+	}
+	BuildVariablesStub        func() *exec.BuildVariables
+	buildVariablesMutex       sync.RWMutex
+	buildVariablesArgsForCall []struct{}
+	buildVariablesReturns     struct {
+		result1 *exec.BuildVariables
+	}
+	buildVariablesReturnsOnCall map[int]struct {
+		result1 *exec.BuildVariables
+	}
+	RegisterSecretsStub        func(lager.Logger, atc.Plan)
+	registerSecretsMutex       sync.RWMutex
+	registerSecretsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+	}
 	FinishStub        func(lager.Logger, error, exec.Success, bool)
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -213,6 +279,270 @@ func (fake *FakeBuildDelegate) OutputDelegateReturnsOnCall(i int, result1 exec.P
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) ApproveDelegate(arg1 lager.Logger, arg2 atc.ApprovePlan, arg3 event.OriginID) exec.ApproveDelegate {
+	fake.approveDelegateMutex.Lock()
+	ret, specificReturn := fake.approveDelegateReturnsOnCall[len(fake.approveDelegateArgsForCall)]
+	fake.approveDelegateArgsForCall = append(fake.approveDelegateArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.ApprovePlan
+		arg3 event.OriginID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ApproveDelegate", []interface{}{arg1, arg2, arg3})
+	fake.approveDelegateMutex.Unlock()
+	if fake.ApproveDelegateStub != nil {
+		return fake.ApproveDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.approveDelegateReturns.result1
+}
+
+func (fake *FakeBuildDelegate) ApproveDelegateCallCount() int {
+	fake.approveDelegateMutex.RLock()
+	defer fake.approveDelegateMutex.RUnlock()
+	return len(fake.approveDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) ApproveDelegateArgsForCall(i int) (lager.Logger, atc.ApprovePlan, event.OriginID) {
+	fake.approveDelegateMutex.RLock()
+	defer fake.approveDelegateMutex.RUnlock()
+	return fake.approveDelegateArgsForCall[i].arg1, fake.approveDelegateArgsForCall[i].arg2, fake.approveDelegateArgsForCall[i].arg3
+}
+
+func (fake *FakeBuildDelegate) ApproveDelegateReturns(result1 exec.ApproveDelegate) {
+	fake.ApproveDelegateStub = nil
+	fake.approveDelegateReturns = struct {
+		result1 exec.ApproveDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) ApproveDelegateReturnsOnCall(i int, result1 exec.ApproveDelegate) {
+	fake.ApproveDelegateStub = nil
+	if fake.approveDelegateReturnsOnCall == nil {
+		fake.approveDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.ApproveDelegate
+		})
+	}
+	fake.approveDelegateReturnsOnCall[i] = struct {
+		result1 exec.ApproveDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) RetryDelegate(arg1 lager.Logger, arg2 event.OriginID) exec.RetryDelegate {
+	fake.retryDelegateMutex.Lock()
+	ret, specificReturn := fake.retryDelegateReturnsOnCall[len(fake.retryDelegateArgsForCall)]
+	fake.retryDelegateArgsForCall = append(fake.retryDelegateArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 event.OriginID
+	}{arg1, arg2})
+	fake.recordInvocation("RetryDelegate", []interface{}{arg1, arg2})
+	fake.retryDelegateMutex.Unlock()
+	if fake.RetryDelegateStub != nil {
+		return fake.RetryDelegateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.retryDelegateReturns.result1
+}
+
+func (fake *FakeBuildDelegate) RetryDelegateCallCount() int {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	return len(fake.retryDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) RetryDelegateArgsForCall(i int) (lager.Logger, event.OriginID) {
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	return fake.retryDelegateArgsForCall[i].arg1, fake.retryDelegateArgsForCall[i].arg2
+}
+
+func (fake *FakeBuildDelegate) RetryDelegateReturns(result1 exec.RetryDelegate) {
+	fake.RetryDelegateStub = nil
+	fake.retryDelegateReturns = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) RetryDelegateReturnsOnCall(i int, result1 exec.RetryDelegate) {
+	fake.RetryDelegateStub = nil
+	if fake.retryDelegateReturnsOnCall == nil {
+		fake.retryDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.RetryDelegate
+		})
+	}
+	fake.retryDelegateReturnsOnCall[i] = struct {
+		result1 exec.RetryDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegate(arg1 lager.Logger, arg2 atc.ConditionalPlan, arg3 event.OriginID) exec.ConditionalDelegate {
+	fake.conditionalDelegateMutex.Lock()
+	ret, specificReturn := fake.conditionalDelegateReturnsOnCall[len(fake.conditionalDelegateArgsForCall)]
+	fake.conditionalDelegateArgsForCall = append(fake.conditionalDelegateArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.ConditionalPlan
+		arg3 event.OriginID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ConditionalDelegate", []interface{}{arg1, arg2, arg3})
+	fake.conditionalDelegateMutex.Unlock()
+	if fake.ConditionalDelegateStub != nil {
+		return fake.ConditionalDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.conditionalDelegateReturns.result1
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateCallCount() int {
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
+	return len(fake.conditionalDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateArgsForCall(i int) (lager.Logger, atc.ConditionalPlan, event.OriginID) {
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
+	return fake.conditionalDelegateArgsForCall[i].arg1, fake.conditionalDelegateArgsForCall[i].arg2, fake.conditionalDelegateArgsForCall[i].arg3
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateReturns(result1 exec.ConditionalDelegate) {
+	fake.ConditionalDelegateStub = nil
+	fake.conditionalDelegateReturns = struct {
+		result1 exec.ConditionalDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) ConditionalDelegateReturnsOnCall(i int, result1 exec.ConditionalDelegate) {
+	fake.ConditionalDelegateStub = nil
+	if fake.conditionalDelegateReturnsOnCall == nil {
+		fake.conditionalDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.ConditionalDelegate
+		})
+	}
+	fake.conditionalDelegateReturnsOnCall[i] = struct {
+		result1 exec.ConditionalDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegate(arg1 lager.Logger, arg2 atc.LoadVarPlan, arg3 event.OriginID) exec.LoadVarDelegate {
+	fake.loadVarDelegateMutex.Lock()
+	ret, specificReturn := fake.loadVarDelegateReturnsOnCall[len(fake.loadVarDelegateArgsForCall)]
+	fake.loadVarDelegateArgsForCall = append(fake.loadVarDelegateArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.LoadVarPlan
+		arg3 event.OriginID
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LoadVarDelegate", []interface{}{arg1, arg2, arg3})
+	fake.loadVarDelegateMutex.Unlock()
+	if fake.LoadVarDelegateStub != nil {
+		return fake.LoadVarDelegateStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.loadVarDelegateReturns.result1
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateCallCount() int {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	return len(fake.loadVarDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateArgsForCall(i int) (lager.Logger, atc.LoadVarPlan, event.OriginID) {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	return fake.loadVarDelegateArgsForCall[i].arg1, fake.loadVarDelegateArgsForCall[i].arg2, fake.loadVarDelegateArgsForCall[i].arg3
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturns(result1 exec.LoadVarDelegate) {
+	fake.LoadVarDelegateStub = nil
+	fake.loadVarDelegateReturns = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturnsOnCall(i int, result1 exec.LoadVarDelegate) {
+	fake.LoadVarDelegateStub = nil
+	if fake.loadVarDelegateReturnsOnCall == nil {
+		fake.loadVarDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.LoadVarDelegate
+		})
+	}
+	fake.loadVarDelegateReturnsOnCall[i] = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) BuildVariables() *exec.BuildVariables {
+	fake.buildVariablesMutex.Lock()
+	ret, specificReturn := fake.buildVariablesReturnsOnCall[len(fake.buildVariablesArgsForCall)]
+	fake.buildVariablesArgsForCall = append(fake.buildVariablesArgsForCall, struct{}{})
+	fake.recordInvocation("BuildVariables", []interface{}{})
+	fake.buildVariablesMutex.Unlock()
+	if fake.BuildVariablesStub != nil {
+		return fake.BuildVariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.buildVariablesReturns.result1
+}
+
+func (fake *FakeBuildDelegate) BuildVariablesCallCount() int {
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
+	return len(fake.buildVariablesArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) BuildVariablesReturns(result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	fake.buildVariablesReturns = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) BuildVariablesReturnsOnCall(i int, result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	if fake.buildVariablesReturnsOnCall == nil {
+		fake.buildVariablesReturnsOnCall = make(map[int]struct {
+			result1 *exec.BuildVariables
+		})
+	}
+	fake.buildVariablesReturnsOnCall[i] = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) RegisterSecrets(arg1 lager.Logger, arg2 atc.Plan) {
+	fake.registerSecretsMutex.Lock()
+	fake.registerSecretsArgsForCall = append(fake.registerSecretsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("RegisterSecrets", []interface{}{arg1, arg2})
+	fake.registerSecretsMutex.Unlock()
+	if fake.RegisterSecretsStub != nil {
+		fake.RegisterSecretsStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeBuildDelegate) RegisterSecretsCallCount() int {
+	fake.registerSecretsMutex.RLock()
+	defer fake.registerSecretsMutex.RUnlock()
+	return len(fake.registerSecretsArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) RegisterSecretsArgsForCall(i int) (lager.Logger, atc.Plan) {
+	fake.registerSecretsMutex.RLock()
+	defer fake.registerSecretsMutex.RUnlock()
+	return fake.registerSecretsArgsForCall[i].arg1, fake.registerSecretsArgsForCall[i].arg2
+}
+
 func (fake *FakeBuildDelegate) Finish(arg1 lager.Logger, arg2 error, arg3 exec.Success, arg4 bool) {
 	fake.finishMutex.Lock()
 	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
@@ -249,6 +579,18 @@ func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	defer fake.executionDelegateMutex.RUnlock()
 	fake.outputDelegateMutex.RLock()
 	defer fake.outputDelegateMutex.RUnlock()
+	fake.approveDelegateMutex.RLock()
+	defer fake.approveDelegateMutex.RUnlock()
+	fake.retryDelegateMutex.RLock()
+	defer fake.retryDelegateMutex.RUnlock()
+	fake.conditionalDelegateMutex.RLock()
+	defer fake.conditionalDelegateMutex.RUnlock()
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
+	fake.registerSecretsMutex.RLock()
+	defer fake.registerSecretsMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	return fake.invocations
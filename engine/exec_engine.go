@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/exec"
@@ -23,25 +24,49 @@ type execMetadata struct {
 
 const execEngineName = "exec.v2"
 
+// ErrNotExecBuild is returned when asking for the plan of a build which is not
+// run by the exec engine.
+var ErrNotExecBuild = errors.New("build is not run by the exec engine")
+
+// BuildPlan returns the plan that the exec engine runs for the build.
+func BuildPlan(build dbng.Build) (atc.Plan, error) {
+	if build.Engine() != execEngineName {
+		return atc.Plan{}, ErrNotExecBuild
+	}
+
+	var metadata execMetadata
+	err := json.Unmarshal([]byte(build.EngineMetadata()), &metadata)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	return metadata.Plan, nil
+}
+
 type execEngine struct {
 	factory         exec.Factory
 	delegateFactory BuildDelegateFactory
 	teamDBFactory   db.TeamDBFactory
 	externalURL     string
+	artifactStore   blobstore.Store
 	releaseCh       chan struct{}
 }
 
+// NewExecEngine returns an Engine which runs builds via the given exec.Factory.
+// If artifactStore is nil, artifacts declared by tasks are not retained.
 func NewExecEngine(
 	factory exec.Factory,
 	delegateFactory BuildDelegateFactory,
 	teamDBFactory db.TeamDBFactory,
 	externalURL string,
+	artifactStore blobstore.Store,
 ) Engine {
 	return &execEngine{
 		factory:         factory,
 		delegateFactory: delegateFactory,
 		teamDBFactory:   teamDBFactory,
 		externalURL:     externalURL,
+		artifactStore:   artifactStore,
 		releaseCh:       make(chan struct{}),
 	}
 }
@@ -69,6 +94,8 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build dbng.Build, pla
 			Plan: plan,
 		},
 
+		artifactStore: engine.artifactStore,
+
 		releaseCh: engine.releaseCh,
 		signals:   make(chan os.Signal, 1),
 	}, nil
@@ -98,6 +125,8 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build dbng.Build) (Bu
 		delegate: engine.delegateFactory.Delegate(build),
 		metadata: metadata,
 
+		artifactStore: engine.artifactStore,
+
 		releaseCh: engine.releaseCh,
 		signals:   make(chan os.Signal, 1),
 	}, nil
@@ -134,6 +163,8 @@ type execBuild struct {
 	factory  exec.Factory
 	delegate BuildDelegate
 
+	artifactStore blobstore.Store
+
 	signals   chan os.Signal
 	releaseCh chan struct{}
 
@@ -162,8 +193,11 @@ func (build *execBuild) Abort(lager.Logger) error {
 }
 
 func (build *execBuild) Resume(logger lager.Logger) {
+	build.delegate.RegisterSecrets(logger.Session("register-secrets"), build.metadata.Plan)
+
 	stepFactory := build.buildStepFactory(logger, build.metadata.Plan)
-	source := stepFactory.Using(&exec.NoopStep{}, worker.NewArtifactRepository())
+	repository := worker.NewArtifactRepository()
+	source := stepFactory.Using(&exec.NoopStep{}, repository)
 
 	process := ifrit.Background(source)
 
@@ -185,6 +219,8 @@ func (build *execBuild) Resume(logger lager.Logger) {
 				succeeded = false
 			}
 
+			build.archiveArtifacts(logger.Session("archive-artifacts"), repository)
+
 			build.delegate.Finish(logger.Session("finish"), err, succeeded, aborted)
 			return
 
@@ -203,6 +239,10 @@ func (build *execBuild) buildStepFactory(logger lager.Logger, plan atc.Plan) exe
 		return build.buildAggregateStep(logger, plan)
 	}
 
+	if plan.InParallel != nil {
+		return build.buildInParallelStep(logger, plan)
+	}
+
 	if plan.Do != nil {
 		return build.buildDoStep(logger, plan)
 	}
@@ -215,6 +255,10 @@ func (build *execBuild) buildStepFactory(logger lager.Logger, plan atc.Plan) exe
 		return build.buildTryStep(logger, plan)
 	}
 
+	if plan.Conditional != nil {
+		return build.buildConditionalStep(logger, plan)
+	}
+
 	if plan.OnSuccess != nil {
 		return build.buildOnSuccessStep(logger, plan)
 	}
@@ -247,6 +291,14 @@ func (build *execBuild) buildStepFactory(logger lager.Logger, plan atc.Plan) exe
 		return build.buildRetryStep(logger, plan)
 	}
 
+	if plan.Approve != nil {
+		return build.buildApproveStep(logger, plan)
+	}
+
+	if plan.LoadVar != nil {
+		return build.buildLoadVarStep(logger, plan)
+	}
+
 	return exec.Identity{}
 }
 
@@ -275,3 +327,70 @@ func (build *execBuild) workerMetadata(
 		Attempt:  strings.Join(attemptStrs, "."),
 	}
 }
+
+func (build *execBuild) archiveArtifacts(logger lager.Logger, repository *worker.ArtifactRepository) {
+	if build.artifactStore == nil {
+		return
+	}
+
+	names := declaredArtifacts(build.metadata.Plan)
+	if len(names) == 0 {
+		return
+	}
+
+	err := exec.ArchiveArtifacts(logger, repository, build.artifactStore, build.buildID, names)
+	if err != nil {
+		logger.Error("failed-to-archive-artifacts", err)
+	}
+}
+
+// declaredArtifacts returns the names, as registered in the build's artifact
+// repository, of the task outputs listed under `artifacts` anywhere in the plan.
+func declaredArtifacts(plan atc.Plan) []worker.ArtifactName {
+	names := []worker.ArtifactName{}
+
+	switch {
+	case plan.Aggregate != nil:
+		for _, p := range *plan.Aggregate {
+			names = append(names, declaredArtifacts(p)...)
+		}
+	case plan.InParallel != nil:
+		for _, p := range plan.InParallel.Steps {
+			names = append(names, declaredArtifacts(p)...)
+		}
+	case plan.Do != nil:
+		for _, p := range *plan.Do {
+			names = append(names, declaredArtifacts(p)...)
+		}
+	case plan.Retry != nil:
+		for _, p := range *plan.Retry {
+			names = append(names, declaredArtifacts(p)...)
+		}
+	case plan.Ensure != nil:
+		names = append(names, declaredArtifacts(plan.Ensure.Step)...)
+		names = append(names, declaredArtifacts(plan.Ensure.Next)...)
+	case plan.OnSuccess != nil:
+		names = append(names, declaredArtifacts(plan.OnSuccess.Step)...)
+		names = append(names, declaredArtifacts(plan.OnSuccess.Next)...)
+	case plan.OnFailure != nil:
+		names = append(names, declaredArtifacts(plan.OnFailure.Step)...)
+		names = append(names, declaredArtifacts(plan.OnFailure.Next)...)
+	case plan.Try != nil:
+		names = append(names, declaredArtifacts(plan.Try.Step)...)
+	case plan.Conditional != nil:
+		names = append(names, declaredArtifacts(plan.Conditional.Step)...)
+	case plan.Timeout != nil:
+		names = append(names, declaredArtifacts(plan.Timeout.Step)...)
+	case plan.Task != nil:
+		for _, output := range plan.Task.Artifacts {
+			name := output
+			if mapped, found := plan.Task.OutputMapping[output]; found {
+				name = mapped
+			}
+
+			names = append(names, worker.ArtifactName(name))
+		}
+	}
+
+	return names
+}
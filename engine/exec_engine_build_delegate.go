@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"encoding/json"
 	"io"
 	"sync"
 	"time"
@@ -8,9 +9,12 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/condition"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/event"
 	"github.com/concourse/atc/exec"
+	"github.com/concourse/atc/metric"
+	"github.com/concourse/atc/notifications"
 	"github.com/concourse/atc/worker"
 )
 
@@ -25,6 +29,14 @@ type BuildDelegate interface {
 	InputDelegate(lager.Logger, atc.GetPlan, event.OriginID) exec.GetDelegate
 	ExecutionDelegate(lager.Logger, atc.TaskPlan, event.OriginID) exec.TaskDelegate
 	OutputDelegate(lager.Logger, atc.PutPlan, event.OriginID) exec.PutDelegate
+	ApproveDelegate(lager.Logger, atc.ApprovePlan, event.OriginID) exec.ApproveDelegate
+	RetryDelegate(lager.Logger, event.OriginID) exec.RetryDelegate
+	ConditionalDelegate(lager.Logger, atc.ConditionalPlan, event.OriginID) exec.ConditionalDelegate
+	LoadVarDelegate(lager.Logger, atc.LoadVarPlan, event.OriginID) exec.LoadVarDelegate
+
+	BuildVariables() *exec.BuildVariables
+
+	RegisterSecrets(lager.Logger, atc.Plan)
 
 	Finish(lager.Logger, error, exec.Success, bool)
 }
@@ -35,29 +47,54 @@ type BuildDelegateFactory interface {
 	Delegate(dbng.Build) BuildDelegate
 }
 
-type buildDelegateFactory struct{}
+type buildDelegateFactory struct {
+	notifier    notifications.Notifier
+	teamFactory dbng.TeamFactory
+}
 
-func NewBuildDelegateFactory() BuildDelegateFactory {
-	return buildDelegateFactory{}
+func NewBuildDelegateFactory(notifier notifications.Notifier, teamFactory dbng.TeamFactory) BuildDelegateFactory {
+	return buildDelegateFactory{
+		notifier:    notifier,
+		teamFactory: teamFactory,
+	}
 }
 
 func (factory buildDelegateFactory) Delegate(build dbng.Build) BuildDelegate {
-	return newBuildDelegate(build)
+	return newBuildDelegate(build, factory.notifier, factory.teamFactory)
 }
 
 type delegate struct {
-	build dbng.Build
+	build       dbng.Build
+	notifier    notifications.Notifier
+	teamFactory dbng.TeamFactory
 
 	implicitOutputs map[string]implicitOutput
 
+	stepStatuses  map[string]string
+	inputVersions map[string]exec.VersionInfo
+
+	variables *exec.BuildVariables
+
+	redactor   *exec.Redactor
+	logWriters map[event.OriginID][]*exec.RedactingWriter
+
 	lock sync.Mutex
 }
 
-func newBuildDelegate(build dbng.Build) BuildDelegate {
+func newBuildDelegate(build dbng.Build, notifier notifications.Notifier, teamFactory dbng.TeamFactory) BuildDelegate {
 	return &delegate{
-		build: build,
+		build:       build,
+		notifier:    notifier,
+		teamFactory: teamFactory,
 
 		implicitOutputs: make(map[string]implicitOutput),
+
+		stepStatuses:  make(map[string]string),
+		inputVersions: make(map[string]exec.VersionInfo),
+
+		variables: exec.NewBuildVariables(),
+
+		logWriters: make(map[event.OriginID][]*exec.RedactingWriter),
 	}
 }
 
@@ -91,17 +128,74 @@ func (delegate *delegate) ExecutionDelegate(logger lager.Logger, plan atc.TaskPl
 	}
 }
 
+func (delegate *delegate) ApproveDelegate(logger lager.Logger, plan atc.ApprovePlan, id event.OriginID) exec.ApproveDelegate {
+	return &approveDelegate{
+		logger: logger,
+
+		id:       id,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+func (delegate *delegate) RetryDelegate(logger lager.Logger, id event.OriginID) exec.RetryDelegate {
+	return &retryDelegate{
+		logger: logger,
+
+		id:       id,
+		delegate: delegate,
+	}
+}
+
+func (delegate *delegate) ConditionalDelegate(logger lager.Logger, plan atc.ConditionalPlan, id event.OriginID) exec.ConditionalDelegate {
+	return &conditionalDelegate{
+		logger: logger,
+
+		id:       id,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+func (delegate *delegate) LoadVarDelegate(logger lager.Logger, plan atc.LoadVarPlan, id event.OriginID) exec.LoadVarDelegate {
+	return &loadVarDelegate{
+		logger: logger,
+
+		id:       id,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+// BuildVariables are the variables loaded by the build's load_var steps so
+// far.
+func (delegate *delegate) BuildVariables() *exec.BuildVariables {
+	return delegate.variables
+}
+
+// RegisterSecrets marks every source and param in the plan as secret, so that
+// they are scrubbed from the build's logs.
+func (delegate *delegate) RegisterSecrets(logger lager.Logger, plan atc.Plan) {
+	delegate.logRedactor(logger).Register(planSecrets(plan)...)
+}
+
 func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded exec.Success, aborted bool) {
+	var status atc.BuildStatus
+	var saved bool
+
 	if aborted {
-		delegate.saveStatus(logger, atc.StatusAborted)
+		status = atc.StatusAborted
+		saved = delegate.saveStatus(logger, status)
 
 		logger.Info("aborted")
 	} else if err != nil {
-		delegate.saveStatus(logger, atc.StatusErrored)
+		status = atc.StatusErrored
+		saved = delegate.saveStatus(logger, status)
 
 		logger.Info("errored", lager.Data{"error": err.Error()})
 	} else if bool(succeeded) {
-		delegate.saveStatus(logger, atc.StatusSucceeded)
+		status = atc.StatusSucceeded
+		saved = delegate.saveStatus(logger, status)
 
 		implicits := logger.Session("implicit-outputs")
 
@@ -111,10 +205,15 @@ func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded exec.
 
 		logger.Info("succeeded")
 	} else {
-		delegate.saveStatus(logger, atc.StatusFailed)
+		status = atc.StatusFailed
+		saved = delegate.saveStatus(logger, status)
 
 		logger.Info("failed")
 	}
+
+	if saved && delegate.notifier != nil {
+		delegate.notifier.BuildFinished(logger.Session("notify"), delegate.build, status)
+	}
 }
 
 func (delegate *delegate) registerImplicitOutput(resource string, output implicitOutput) {
@@ -129,6 +228,83 @@ func (delegate *delegate) unregisterImplicitOutput(resource string) {
 	delegate.lock.Unlock()
 }
 
+// recordStep remembers how a named step ended, for the conditions of the
+// steps after it.
+func (delegate *delegate) recordStep(name string, status string) {
+	if name == "" {
+		return
+	}
+
+	delegate.lock.Lock()
+	delegate.stepStatuses[name] = status
+	delegate.lock.Unlock()
+}
+
+func (delegate *delegate) recordInput(name string, info exec.VersionInfo) {
+	delegate.lock.Lock()
+	delegate.inputVersions[name] = info
+	delegate.lock.Unlock()
+}
+
+// conditionVars are the variables available to a step's condition: the
+// build's metadata, the versions fetched and the outcomes of the steps run so
+// far, and the params of the step itself.
+func (delegate *delegate) conditionVars(params atc.Params) condition.Vars {
+	trigger := "scheduled"
+	if delegate.build.IsManuallyTriggered() {
+		trigger = "manual"
+	}
+
+	build := map[string]interface{}{
+		"id":            delegate.build.ID(),
+		"name":          delegate.build.Name(),
+		"job_name":      delegate.build.JobName(),
+		"pipeline_name": delegate.build.PipelineName(),
+		"team_name":     delegate.build.TeamName(),
+		"trigger":       trigger,
+	}
+
+	paramVars := map[string]interface{}{}
+	for name, value := range params {
+		paramVars[name] = value
+	}
+
+	delegate.lock.Lock()
+	defer delegate.lock.Unlock()
+
+	inputs := map[string]interface{}{}
+	for name, info := range delegate.inputVersions {
+		version := map[string]interface{}{}
+		for key, value := range info.Version {
+			version[key] = value
+		}
+
+		metadata := map[string]interface{}{}
+		for _, field := range info.Metadata {
+			metadata[field.Name] = field.Value
+		}
+
+		inputs[name] = map[string]interface{}{
+			"version":  version,
+			"metadata": metadata,
+		}
+	}
+
+	steps := map[string]interface{}{}
+	for name, status := range delegate.stepStatuses {
+		steps[name] = map[string]interface{}{
+			"status": status,
+		}
+	}
+
+	return condition.Vars{
+		"build":  build,
+		"inputs": inputs,
+		"steps":  steps,
+		"params": paramVars,
+	}
+}
+
 func (delegate *delegate) saveInitializeTask(logger lager.Logger, taskConfig atc.TaskConfig, origin event.Origin) {
 	err := delegate.build.SaveEvent(event.InitializeTask{
 		TaskConfig: event.ShadowTaskConfig(taskConfig),
@@ -157,6 +333,141 @@ func (delegate *delegate) saveInitializePut(logger lager.Logger, origin event.Or
 	}
 }
 
+func (delegate *delegate) saveStartGet(logger lager.Logger, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.StartGet{
+		Time:   time.Now().Unix(),
+		Origin: origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-event", err)
+	}
+}
+
+func (delegate *delegate) saveStartPut(logger lager.Logger, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.StartPut{
+		Time:   time.Now().Unix(),
+		Origin: origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-start-event", err)
+	}
+}
+
+func (delegate *delegate) saveFetchImage(logger lager.Logger, stats worker.StreamStats, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.FetchImage{
+		Time:     time.Now().Unix(),
+		Duration: milliseconds(stats.Duration),
+		Bytes:    stats.Bytes,
+		Origin:   origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-fetch-image-event", err)
+	}
+}
+
+func (delegate *delegate) saveStreamInput(logger lager.Logger, name worker.ArtifactName, stats worker.StreamStats, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.StreamInput{
+		Time:     time.Now().Unix(),
+		Name:     string(name),
+		Duration: milliseconds(stats.Duration),
+		Bytes:    stats.Bytes,
+		Origin:   origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-stream-input-event", err)
+	}
+
+	metric.VolumeStreamed{
+		PipelineName: delegate.build.PipelineName(),
+		JobName:      delegate.build.JobName(),
+		BuildName:    delegate.build.Name(),
+		BuildID:      delegate.build.ID(),
+		Bytes:        stats.Bytes,
+		Duration:     stats.Duration,
+		P2P:          stats.P2P,
+	}.Emit(logger)
+}
+
+func (delegate *delegate) saveRegisterOutput(logger lager.Logger, name worker.ArtifactName, stats worker.StreamStats, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.RegisterOutput{
+		Time:     time.Now().Unix(),
+		Name:     string(name),
+		Duration: milliseconds(stats.Duration),
+		Bytes:    stats.Bytes,
+		Origin:   origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-register-output-event", err)
+	}
+}
+
+func (delegate *delegate) saveCreateVolume(logger lager.Logger, name string, duration time.Duration, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.CreateVolume{
+		Time:     time.Now().Unix(),
+		Name:     name,
+		Duration: milliseconds(duration),
+		Origin:   origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-create-volume-event", err)
+	}
+}
+
+func (delegate *delegate) saveRequestApproval(logger lager.Logger, plan atc.ApprovePlan, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.RequestApproval{
+		Time:   time.Now().Unix(),
+		Name:   plan.Name,
+		Origin: origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-request-approval-event", err)
+	}
+}
+
+func (delegate *delegate) saveDecideApproval(logger lager.Logger, plan atc.ApprovePlan, approval exec.Approval, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.DecideApproval{
+		Time:     time.Now().Unix(),
+		Name:     plan.Name,
+		Approved: approval.Approved,
+		Approver: approval.Approver,
+		Comment:  approval.Comment,
+		Origin:   origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-decide-approval-event", err)
+	}
+}
+
+func (delegate *delegate) saveRetryAttempt(logger lager.Logger, attempt int, errVal error, delay time.Duration, origin event.Origin) {
+	ev := event.RetryAttempt{
+		Time:    time.Now().Unix(),
+		Attempt: attempt,
+		Delay:   int64(delay / time.Millisecond),
+		Origin:  origin,
+	}
+
+	if errVal != nil {
+		ev.Errored = true
+		ev.Error = errVal.Error()
+	}
+
+	err := delegate.build.SaveEvent(ev)
+	if err != nil {
+		logger.Error("failed-to-save-retry-attempt-event", err)
+	}
+}
+
+func (delegate *delegate) saveSkipped(logger lager.Logger, plan atc.ConditionalPlan, origin event.Origin) {
+	err := delegate.build.SaveEvent(event.Skipped{
+		Time:      time.Now().Unix(),
+		Condition: plan.Condition,
+		Origin:    origin,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-skipped-event", err)
+	}
+}
+
 func (delegate *delegate) saveStart(logger lager.Logger, origin event.Origin) {
 	err := delegate.build.SaveEvent(event.StartTask{
 		Time:   time.Now().Unix(),
@@ -178,11 +489,14 @@ func (delegate *delegate) saveFinish(logger lager.Logger, status exec.ExitStatus
 	}
 }
 
-func (delegate *delegate) saveStatus(logger lager.Logger, status atc.BuildStatus) {
+func (delegate *delegate) saveStatus(logger lager.Logger, status atc.BuildStatus) bool {
 	err := delegate.build.Finish(dbng.BuildStatus(status))
 	if err != nil {
 		logger.Error("failed-to-finish-build", err)
+		return false
 	}
+
+	return true
 }
 
 func (delegate *delegate) saveErr(logger lager.Logger, errVal error, origin event.Origin) {
@@ -288,10 +602,49 @@ func (delegate *delegate) saveImplicitOutput(logger lager.Logger, plan atc.GetPl
 	logger.Info("saved", lager.Data{"resource": plan.Resource})
 }
 
-func (delegate *delegate) eventWriter(origin event.Origin) io.Writer {
-	return &dbEventWriter{
+func (delegate *delegate) logRedactor(logger lager.Logger) *exec.Redactor {
+	delegate.lock.Lock()
+	defer delegate.lock.Unlock()
+
+	if delegate.redactor == nil {
+		rules, err := delegate.teamFactory.GetByID(delegate.build.TeamID()).RedactionRules()
+		if err != nil {
+			// better to redact with the defaults than to leak secrets
+			logger.Error("failed-to-get-redaction-rules", err)
+		}
+
+		delegate.redactor = exec.NewRedactor(rules)
+	}
+
+	return delegate.redactor
+}
+
+func (delegate *delegate) eventWriter(logger lager.Logger, origin event.Origin) io.Writer {
+	writer := delegate.logRedactor(logger).Writer(&dbEventWriter{
 		build:  delegate.build,
 		origin: origin,
+	})
+
+	delegate.lock.Lock()
+	delegate.logWriters[origin.ID] = append(delegate.logWriters[origin.ID], writer)
+	delegate.lock.Unlock()
+
+	return writer
+}
+
+// flushLogs saves any output held back by the step's log writers, once the
+// step is done writing it.
+func (delegate *delegate) flushLogs(logger lager.Logger, id event.OriginID) {
+	delegate.lock.Lock()
+	writers := delegate.logWriters[id]
+	delete(delegate.logWriters, id)
+	delegate.lock.Unlock()
+
+	for _, writer := range writers {
+		err := writer.Flush()
+		if err != nil {
+			logger.Error("failed-to-flush-logs", err)
+		}
 	}
 }
 
@@ -307,19 +660,32 @@ func (input *inputDelegate) Initializing() {
 	input.delegate.saveInitializeGet(input.logger, event.Origin{ID: input.id})
 }
 
+func (input *inputDelegate) Started() {
+	input.delegate.saveStartGet(input.logger, event.Origin{ID: input.id})
+}
+
 func (input *inputDelegate) Completed(status exec.ExitStatus, info *exec.VersionInfo) {
+	input.delegate.flushLogs(input.logger, input.id)
+
 	input.delegate.saveInput(input.logger, status, input.plan, info, event.Origin{
 		ID: input.id,
 	})
 
 	if info != nil {
 		input.delegate.registerImplicitOutput(input.plan.Resource, implicitOutput{input.plan, *info})
+		input.delegate.recordInput(input.plan.Name, *info)
 	}
 
+	input.delegate.recordStep(input.plan.Name, exitStatusOutcome(status))
+
 	input.logger.Info("finished", lager.Data{"version-info": info})
 }
 
 func (input *inputDelegate) Failed(err error) {
+	input.delegate.flushLogs(input.logger, input.id)
+
+	input.delegate.recordStep(input.plan.Name, stepErrored)
+
 	input.delegate.saveErr(input.logger, err, event.Origin{
 		ID: input.id,
 	})
@@ -327,19 +693,31 @@ func (input *inputDelegate) Failed(err error) {
 	input.logger.Info("errored", lager.Data{"error": err.Error()})
 }
 
+func (input *inputDelegate) BuildVariables() *exec.BuildVariables {
+	return input.delegate.variables
+}
+
 func (input *inputDelegate) ImageVersionDetermined(resourceCacheIdentifier worker.ResourceCacheIdentifier) error {
 	return input.delegate.build.SaveImageResourceVersion(atc.PlanID(input.id), resourceCacheIdentifier.ResourceVersion, resourceCacheIdentifier.ResourceHash)
 }
 
+func (input *inputDelegate) ImageFetched(stats worker.StreamStats) {
+	input.delegate.saveFetchImage(input.logger, stats, event.Origin{ID: input.id})
+}
+
+func (input *inputDelegate) VolumeCreated(name string, duration time.Duration) {
+	input.delegate.saveCreateVolume(input.logger, name, duration, event.Origin{ID: input.id})
+}
+
 func (input *inputDelegate) Stdout() io.Writer {
-	return input.delegate.eventWriter(event.Origin{
+	return input.delegate.eventWriter(input.logger, event.Origin{
 		Source: event.OriginSourceStdout,
 		ID:     input.id,
 	})
 }
 
 func (input *inputDelegate) Stderr() io.Writer {
-	return input.delegate.eventWriter(event.Origin{
+	return input.delegate.eventWriter(input.logger, event.Origin{
 		Source: event.OriginSourceStderr,
 		ID:     input.id,
 	})
@@ -354,20 +732,101 @@ type outputDelegate struct {
 	delegate *delegate
 }
 
+// Starting records the put as running against the build, so that the build
+// is not aborted for being superseded until it has finished.
+func (output *outputDelegate) Starting() (bool, error) {
+	started, err := output.delegate.build.StartPut()
+	if err != nil {
+		output.logger.Error("failed-to-start-put", err)
+		return false, err
+	}
+
+	if !started {
+		output.logger.Info("build-is-being-aborted")
+	}
+
+	return started, nil
+}
+
+func (output *outputDelegate) Finished() {
+	err := output.delegate.build.FinishPut()
+	if err != nil {
+		output.logger.Error("failed-to-finish-put", err)
+	}
+}
+
+// Resume looks up the checkpoint the put saved when it completed, in case the
+// build was handed off after that. A resumed put is accounted for as if it
+// had just completed, without saving its events again.
+func (output *outputDelegate) Resume() (exec.PutCheckpoint, bool, error) {
+	payload, found, err := output.delegate.build.StepCheckpoint(atc.PlanID(output.id))
+	if err != nil {
+		output.logger.Error("failed-to-get-checkpoint", err)
+		return exec.PutCheckpoint{}, false, err
+	}
+
+	if !found {
+		return exec.PutCheckpoint{}, false, nil
+	}
+
+	var checkpoint exec.PutCheckpoint
+	err = json.Unmarshal(payload, &checkpoint)
+	if err != nil {
+		output.logger.Error("failed-to-unmarshal-checkpoint", err)
+		return exec.PutCheckpoint{}, false, err
+	}
+
+	output.delegate.unregisterImplicitOutput(output.plan.Resource)
+	output.delegate.recordStep(output.plan.Name, exitStatusOutcome(checkpoint.ExitStatus))
+
+	output.logger.Info("resumed", lager.Data{"version-info": checkpoint.VersionInfo})
+
+	return checkpoint, true, nil
+}
+
 func (output *outputDelegate) Initializing() {
 	output.delegate.saveInitializePut(output.logger, event.Origin{ID: output.id})
 }
 
+func (output *outputDelegate) Started() {
+	output.delegate.saveStartPut(output.logger, event.Origin{ID: output.id})
+}
+
 func (output *outputDelegate) Completed(status exec.ExitStatus, info *exec.VersionInfo) {
+	output.delegate.flushLogs(output.logger, output.id)
+
 	output.delegate.unregisterImplicitOutput(output.plan.Resource)
+	output.delegate.recordStep(output.plan.Name, exitStatusOutcome(status))
 	output.delegate.saveOutput(output.logger, status, output.plan, info, event.Origin{
 		ID: output.id,
 	})
 
+	output.saveCheckpoint(exec.PutCheckpoint{
+		ExitStatus:  status,
+		VersionInfo: info,
+	})
+
 	output.logger.Info("finished", lager.Data{"version-info": info})
 }
 
+func (output *outputDelegate) saveCheckpoint(checkpoint exec.PutCheckpoint) {
+	payload, err := json.Marshal(checkpoint)
+	if err != nil {
+		output.logger.Error("failed-to-marshal-checkpoint", err)
+		return
+	}
+
+	err = output.delegate.build.SaveStepCheckpoint(atc.PlanID(output.id), payload)
+	if err != nil {
+		output.logger.Error("failed-to-save-checkpoint", err)
+	}
+}
+
 func (output *outputDelegate) Failed(err error) {
+	output.delegate.flushLogs(output.logger, output.id)
+
+	output.delegate.recordStep(output.plan.Name, stepErrored)
+
 	output.delegate.saveErr(output.logger, err, event.Origin{
 		ID: output.id,
 	})
@@ -375,19 +834,35 @@ func (output *outputDelegate) Failed(err error) {
 	output.logger.Info("errored", lager.Data{"error": err.Error()})
 }
 
+func (output *outputDelegate) BuildVariables() *exec.BuildVariables {
+	return output.delegate.variables
+}
+
 func (output *outputDelegate) ImageVersionDetermined(resourceCacheIdentifier worker.ResourceCacheIdentifier) error {
 	return output.delegate.build.SaveImageResourceVersion(atc.PlanID(output.id), resourceCacheIdentifier.ResourceVersion, resourceCacheIdentifier.ResourceHash)
 }
 
+func (output *outputDelegate) ImageFetched(stats worker.StreamStats) {
+	output.delegate.saveFetchImage(output.logger, stats, event.Origin{ID: output.id})
+}
+
+func (output *outputDelegate) VolumeCreated(name string, duration time.Duration) {
+	output.delegate.saveCreateVolume(output.logger, name, duration, event.Origin{ID: output.id})
+}
+
+func (output *outputDelegate) InputStreamed(name worker.ArtifactName, stats worker.StreamStats) {
+	output.delegate.saveStreamInput(output.logger, name, stats, event.Origin{ID: output.id})
+}
+
 func (output *outputDelegate) Stdout() io.Writer {
-	return output.delegate.eventWriter(event.Origin{
+	return output.delegate.eventWriter(output.logger, event.Origin{
 		Source: event.OriginSourceStdout,
 		ID:     output.id,
 	})
 }
 
 func (output *outputDelegate) Stderr() io.Writer {
-	return output.delegate.eventWriter(event.Origin{
+	return output.delegate.eventWriter(output.logger, event.Origin{
 		Source: event.OriginSourceStderr,
 		ID:     output.id,
 	})
@@ -403,6 +878,15 @@ type executionDelegate struct {
 }
 
 func (execution *executionDelegate) Initializing(config atc.TaskConfig) {
+	// the config may have come from a file, so its params and image are only
+	// known now
+	secrets := []interface{}{config.Params}
+	if config.ImageResource != nil {
+		secrets = append(secrets, config.ImageResource.Source)
+	}
+
+	execution.delegate.logRedactor(execution.logger).Register(secrets...)
+
 	execution.delegate.saveInitializeTask(execution.logger, config, event.Origin{
 		ID: execution.id,
 	})
@@ -419,6 +903,10 @@ func (execution *executionDelegate) Started() {
 }
 
 func (execution *executionDelegate) Finished(status exec.ExitStatus) {
+	execution.delegate.flushLogs(execution.logger, execution.id)
+
+	execution.delegate.recordStep(execution.plan.Name, exitStatusOutcome(status))
+
 	execution.delegate.saveFinish(execution.logger, status, event.Origin{
 		ID: execution.id,
 	})
@@ -427,6 +915,10 @@ func (execution *executionDelegate) Finished(status exec.ExitStatus) {
 }
 
 func (execution *executionDelegate) Failed(err error) {
+	execution.delegate.flushLogs(execution.logger, execution.id)
+
+	execution.delegate.recordStep(execution.plan.Name, stepErrored)
+
 	execution.delegate.saveErr(execution.logger, err, event.Origin{
 		ID: execution.id,
 	})
@@ -438,20 +930,214 @@ func (execution *executionDelegate) ImageVersionDetermined(resourceCacheIdentifi
 	return execution.delegate.build.SaveImageResourceVersion(atc.PlanID(execution.id), resourceCacheIdentifier.ResourceVersion, resourceCacheIdentifier.ResourceHash)
 }
 
+func (execution *executionDelegate) ImageFetched(stats worker.StreamStats) {
+	execution.delegate.saveFetchImage(execution.logger, stats, event.Origin{ID: execution.id})
+}
+
+func (execution *executionDelegate) VolumeCreated(name string, duration time.Duration) {
+	execution.delegate.saveCreateVolume(execution.logger, name, duration, event.Origin{ID: execution.id})
+}
+
+func (execution *executionDelegate) InputStreamed(name worker.ArtifactName, stats worker.StreamStats) {
+	execution.delegate.saveStreamInput(execution.logger, name, stats, event.Origin{ID: execution.id})
+}
+
+func (execution *executionDelegate) OutputRegistered(name worker.ArtifactName, stats worker.StreamStats) {
+	execution.delegate.saveRegisterOutput(execution.logger, name, stats, event.Origin{ID: execution.id})
+}
+
+func (execution *executionDelegate) Annotated(annotations map[string]string) {
+	err := execution.delegate.build.SaveAnnotations(execution.plan.Name, annotations)
+	if err != nil {
+		execution.logger.Error("failed-to-save-annotations", err)
+		return
+	}
+
+	execution.logger.Info("annotated", lager.Data{"annotations": len(annotations)})
+}
+
 func (execution *executionDelegate) Stdout() io.Writer {
-	return execution.delegate.eventWriter(event.Origin{
+	return execution.delegate.eventWriter(execution.logger, event.Origin{
 		Source: event.OriginSourceStdout,
 		ID:     execution.id,
 	})
 }
 
 func (execution *executionDelegate) Stderr() io.Writer {
-	return execution.delegate.eventWriter(event.Origin{
+	return execution.delegate.eventWriter(execution.logger, event.Origin{
 		Source: event.OriginSourceStderr,
 		ID:     execution.id,
 	})
 }
 
+type conditionalDelegate struct {
+	logger lager.Logger
+
+	plan atc.ConditionalPlan
+	id   event.OriginID
+
+	delegate *delegate
+}
+
+func (conditional *conditionalDelegate) Variables() condition.Vars {
+	_, params := conditionedStep(conditional.plan.Step)
+	return conditional.delegate.conditionVars(params)
+}
+
+func (conditional *conditionalDelegate) Skipped() {
+	name, _ := conditionedStep(conditional.plan.Step)
+	conditional.delegate.recordStep(name, stepSkipped)
+
+	conditional.delegate.saveSkipped(conditional.logger, conditional.plan, event.Origin{
+		ID: conditional.id,
+	})
+
+	conditional.logger.Info("skipped", lager.Data{"condition": conditional.plan.Condition})
+}
+
+// conditionedStep finds the name and params of the step a condition was
+// given for, looking through any hooks, retries and timeouts around it.
+func conditionedStep(plan atc.Plan) (string, atc.Params) {
+	switch {
+	case plan.Get != nil:
+		return plan.Get.Name, plan.Get.Params
+	case plan.Put != nil:
+		return plan.Put.Name, plan.Put.Params
+	case plan.Task != nil:
+		return plan.Task.Name, plan.Task.Params
+	case plan.LoadVar != nil:
+		return plan.LoadVar.Name, nil
+	case plan.Timeout != nil:
+		return conditionedStep(plan.Timeout.Step)
+	case plan.Try != nil:
+		return conditionedStep(plan.Try.Step)
+	case plan.OnSuccess != nil:
+		return conditionedStep(plan.OnSuccess.Step)
+	case plan.OnFailure != nil:
+		return conditionedStep(plan.OnFailure.Step)
+	case plan.Ensure != nil:
+		return conditionedStep(plan.Ensure.Step)
+	case plan.Retry != nil && len(*plan.Retry) > 0:
+		return conditionedStep((*plan.Retry)[0])
+	}
+
+	return "", nil
+}
+
+const (
+	stepSucceeded = "succeeded"
+	stepFailed    = "failed"
+	stepErrored   = "errored"
+	stepSkipped   = "skipped"
+)
+
+func exitStatusOutcome(status exec.ExitStatus) string {
+	if status == 0 {
+		return stepSucceeded
+	}
+
+	return stepFailed
+}
+
+type retryDelegate struct {
+	logger lager.Logger
+
+	id event.OriginID
+
+	delegate *delegate
+}
+
+func (retry *retryDelegate) Retrying(attempt int, err error, delay time.Duration) {
+	retry.delegate.saveRetryAttempt(retry.logger, attempt, err, delay, event.Origin{
+		ID: retry.id,
+	})
+}
+
+type loadVarDelegate struct {
+	logger lager.Logger
+
+	plan atc.LoadVarPlan
+	id   event.OriginID
+
+	delegate *delegate
+}
+
+func (loadVar *loadVarDelegate) VarLoaded(name string, value interface{}, sensitive bool) {
+	if sensitive {
+		loadVar.delegate.logRedactor(loadVar.logger).Register(value)
+	}
+
+	loadVar.delegate.variables.Set(name, value)
+
+	loadVar.delegate.recordStep(loadVar.plan.Name, stepSucceeded)
+
+	loadVar.logger.Info("loaded", lager.Data{"sensitive": sensitive})
+}
+
+func (loadVar *loadVarDelegate) Failed(err error) {
+	loadVar.delegate.recordStep(loadVar.plan.Name, stepErrored)
+
+	loadVar.delegate.saveErr(loadVar.logger, err, event.Origin{
+		ID: loadVar.id,
+	})
+
+	loadVar.logger.Info("errored", lager.Data{"error": err.Error()})
+}
+
+type approveDelegate struct {
+	logger lager.Logger
+
+	plan atc.ApprovePlan
+	id   event.OriginID
+
+	delegate *delegate
+}
+
+func (approve *approveDelegate) RequestApproval() error {
+	return approve.delegate.build.RequestApproval(atc.PlanID(approve.id))
+}
+
+func (approve *approveDelegate) Approval() (exec.Approval, bool, error) {
+	approval, found, err := approve.delegate.build.Approval(atc.PlanID(approve.id))
+	if err != nil || !found {
+		return exec.Approval{}, false, err
+	}
+
+	return exec.Approval{
+		Approved: approval.Approved,
+		Approver: approval.Approver,
+		Comment:  approval.Comment,
+	}, true, nil
+}
+
+func (approve *approveDelegate) ApprovalNotifier() (dbng.Notifier, error) {
+	return approve.delegate.build.ApprovalNotifier(atc.PlanID(approve.id))
+}
+
+func (approve *approveDelegate) Waiting() {
+	approve.delegate.saveRequestApproval(approve.logger, approve.plan, event.Origin{
+		ID: approve.id,
+	})
+
+	approve.logger.Info("waiting-for-approval")
+}
+
+func (approve *approveDelegate) Decided(approval exec.Approval) {
+	approve.delegate.saveDecideApproval(approve.logger, approve.plan, approval, event.Origin{
+		ID: approve.id,
+	})
+
+	approve.logger.Info("decided", lager.Data{"approved": approval.Approved, "approver": approval.Approver})
+}
+
+func (approve *approveDelegate) Failed(err error) {
+	approve.delegate.saveErr(approve.logger, err, event.Origin{
+		ID: approve.id,
+	})
+
+	approve.logger.Info("errored", lager.Data{"error": err.Error()})
+}
+
 type dbEventWriter struct {
 	build dbng.Build
 
@@ -482,6 +1168,10 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 	return len(data), nil
 }
 
+func milliseconds(d time.Duration) int64 {
+	return int64(d / time.Millisecond)
+}
+
 func vrFromInput(plan atc.GetPlan, fetchedInfo exec.VersionInfo) dbng.VersionedResource {
 	return dbng.VersionedResource{
 		Resource: plan.Resource,
@@ -1,17 +1,20 @@
 package engine_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io"
 	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/condition"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/dbng/dbngfakes"
 	. "github.com/concourse/atc/engine"
 	"github.com/concourse/atc/event"
 	"github.com/concourse/atc/exec"
+	"github.com/concourse/atc/notifications/notificationsfakes"
 	"github.com/concourse/atc/worker"
 
 	. "github.com/onsi/ginkgo"
@@ -22,7 +25,10 @@ var _ = Describe("BuildDelegate", func() {
 	var (
 		factory BuildDelegateFactory
 
-		fakeBuild *dbngfakes.FakeBuild
+		fakeBuild       *dbngfakes.FakeBuild
+		fakeNotifier    *notificationsfakes.FakeNotifier
+		fakeTeamFactory *dbngfakes.FakeTeamFactory
+		fakeTeam        *dbngfakes.FakeTeam
 
 		delegate BuildDelegate
 
@@ -32,9 +38,14 @@ var _ = Describe("BuildDelegate", func() {
 	)
 
 	BeforeEach(func() {
-		factory = NewBuildDelegateFactory()
+		fakeNotifier = new(notificationsfakes.FakeNotifier)
+		fakeTeamFactory = new(dbngfakes.FakeTeamFactory)
+		fakeTeam = new(dbngfakes.FakeTeam)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+		factory = NewBuildDelegateFactory(fakeNotifier, fakeTeamFactory)
 
 		fakeBuild = new(dbngfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(42)
 		delegate = factory.Delegate(fakeBuild)
 
 		logger = lagertest.NewTestLogger("test")
@@ -79,6 +90,60 @@ var _ = Describe("BuildDelegate", func() {
 			})
 		})
 
+		Describe("Started", func() {
+			JustBeforeEach(func() {
+				inputDelegate.Started()
+			})
+
+			It("saves a start event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.StartGet{}))
+				Expect(savedEvent.(event.StartGet).Time).To(BeNumerically("~", time.Now().Unix(), 1))
+				Expect(savedEvent.(event.StartGet).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
+		Describe("ImageFetched", func() {
+			JustBeforeEach(func() {
+				inputDelegate.ImageFetched(worker.StreamStats{
+					Duration: 1500 * time.Millisecond,
+				})
+			})
+
+			It("saves a fetch-image event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.FetchImage{}))
+				Expect(savedEvent.(event.FetchImage).Duration).To(Equal(int64(1500)))
+				Expect(savedEvent.(event.FetchImage).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
+		Describe("VolumeCreated", func() {
+			JustBeforeEach(func() {
+				inputDelegate.VolumeCreated("image", 250*time.Millisecond)
+			})
+
+			It("saves a create-volume event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.CreateVolume{}))
+				Expect(savedEvent.(event.CreateVolume).Name).To(Equal("image"))
+				Expect(savedEvent.(event.CreateVolume).Duration).To(Equal(int64(250)))
+				Expect(savedEvent.(event.CreateVolume).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
 		Describe("Completed", func() {
 			var versionInfo *exec.VersionInfo
 
@@ -537,6 +602,82 @@ var _ = Describe("BuildDelegate", func() {
 			})
 		})
 
+		Describe("InputStreamed", func() {
+			JustBeforeEach(func() {
+				executionDelegate.InputStreamed("some-input", worker.StreamStats{
+					Duration: 2 * time.Second,
+					Bytes:    1024,
+				})
+			})
+
+			It("saves a stream-input event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.StreamInput{}))
+				Expect(savedEvent.(event.StreamInput).Name).To(Equal("some-input"))
+				Expect(savedEvent.(event.StreamInput).Duration).To(Equal(int64(2000)))
+				Expect(savedEvent.(event.StreamInput).Bytes).To(Equal(int64(1024)))
+				Expect(savedEvent.(event.StreamInput).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
+		Describe("OutputRegistered", func() {
+			JustBeforeEach(func() {
+				executionDelegate.OutputRegistered("some-output", worker.StreamStats{
+					Duration: 5 * time.Millisecond,
+					Bytes:    4096,
+				})
+			})
+
+			It("saves a register-output event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.RegisterOutput{}))
+				Expect(savedEvent.(event.RegisterOutput).Name).To(Equal("some-output"))
+				Expect(savedEvent.(event.RegisterOutput).Duration).To(Equal(int64(5)))
+				Expect(savedEvent.(event.RegisterOutput).Bytes).To(Equal(int64(4096)))
+				Expect(savedEvent.(event.RegisterOutput).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
+		Describe("VolumeCreated", func() {
+			JustBeforeEach(func() {
+				executionDelegate.VolumeCreated("some-output", 40*time.Millisecond)
+			})
+
+			It("saves a create-volume event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.CreateVolume{}))
+				Expect(savedEvent.(event.CreateVolume).Name).To(Equal("some-output"))
+				Expect(savedEvent.(event.CreateVolume).Duration).To(Equal(int64(40)))
+				Expect(savedEvent.(event.CreateVolume).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
+		Describe("Annotated", func() {
+			JustBeforeEach(func() {
+				executionDelegate.Annotated(map[string]string{"coverage": "87.5"})
+			})
+
+			It("saves the annotations against the build, under the task's name", func() {
+				Expect(fakeBuild.SaveAnnotationsCallCount()).To(Equal(1))
+
+				step, annotations := fakeBuild.SaveAnnotationsArgsForCall(0)
+				Expect(step).To(Equal("some-task"))
+				Expect(annotations).To(Equal(map[string]string{"coverage": "87.5"}))
+			})
+		})
+
 		Describe("Finished", func() {
 			var exitStatus exec.ExitStatus
 
@@ -751,6 +892,108 @@ var _ = Describe("BuildDelegate", func() {
 			outputDelegate = delegate.OutputDelegate(logger, putPlan, originID)
 		})
 
+		Describe("Starting", func() {
+			It("records the put as running against the build", func() {
+				fakeBuild.StartPutReturns(true, nil)
+
+				Expect(outputDelegate.Starting()).To(BeTrue())
+				Expect(fakeBuild.StartPutCallCount()).To(Equal(1))
+			})
+
+			Context("when the build is about to be aborted", func() {
+				BeforeEach(func() {
+					fakeBuild.StartPutReturns(false, nil)
+				})
+
+				It("does not allow the put to start", func() {
+					Expect(outputDelegate.Starting()).To(BeFalse())
+				})
+			})
+
+			Context("when recording the put fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeBuild.StartPutReturns(false, disaster)
+				})
+
+				It("returns the error", func() {
+					_, err := outputDelegate.Starting()
+					Expect(err).To(Equal(disaster))
+				})
+			})
+		})
+
+		Describe("Finished", func() {
+			It("records the put as no longer running", func() {
+				outputDelegate.Finished()
+				Expect(fakeBuild.FinishPutCallCount()).To(Equal(1))
+			})
+		})
+
+		Describe("Resume", func() {
+			Context("when the put has not completed yet", func() {
+				BeforeEach(func() {
+					fakeBuild.StepCheckpointReturns(nil, false, nil)
+				})
+
+				It("does not resume it", func() {
+					_, resumed, err := outputDelegate.Resume()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resumed).To(BeFalse())
+
+					planID := fakeBuild.StepCheckpointArgsForCall(0)
+					Expect(planID).To(Equal(atc.PlanID(originID)))
+				})
+			})
+
+			Context("when the put completed before the build was handed off", func() {
+				BeforeEach(func() {
+					fakeBuild.StepCheckpointReturns(json.RawMessage(`{
+						"exit_status": 0,
+						"version_info": {
+							"Version": {"result": "version"},
+							"Metadata": [{"name": "result", "value": "metadata"}]
+						}
+					}`), true, nil)
+				})
+
+				It("returns the checkpoint it left behind", func() {
+					checkpoint, resumed, err := outputDelegate.Resume()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resumed).To(BeTrue())
+					Expect(checkpoint).To(Equal(exec.PutCheckpoint{
+						ExitStatus: 0,
+						VersionInfo: &exec.VersionInfo{
+							Version:  atc.Version{"result": "version"},
+							Metadata: []atc.MetadataField{{"result", "metadata"}},
+						},
+					}))
+				})
+
+				It("does not save its events again", func() {
+					_, _, err := outputDelegate.Resume()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+					Expect(fakeBuild.SaveOutputCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when looking up the checkpoint fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeBuild.StepCheckpointReturns(nil, false, disaster)
+				})
+
+				It("returns the error", func() {
+					_, _, err := outputDelegate.Resume()
+					Expect(err).To(Equal(disaster))
+				})
+			})
+		})
+
 		Describe("Initializing", func() {
 			JustBeforeEach(func() {
 				outputDelegate.Initializing()
@@ -768,6 +1011,23 @@ var _ = Describe("BuildDelegate", func() {
 			})
 		})
 
+		Describe("Started", func() {
+			JustBeforeEach(func() {
+				outputDelegate.Started()
+			})
+
+			It("saves a start event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.StartPut{}))
+				Expect(savedEvent.(event.StartPut).Time).To(BeNumerically("~", time.Now().Unix(), 1))
+				Expect(savedEvent.(event.StartPut).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+		})
+
 		Describe("Completed", func() {
 			var versionInfo *exec.VersionInfo
 
@@ -778,6 +1038,22 @@ var _ = Describe("BuildDelegate", func() {
 				}
 			})
 
+			It("saves a checkpoint so that the put is not run again if the build is handed off", func() {
+				outputDelegate.Completed(exec.ExitStatus(0), versionInfo)
+
+				Expect(fakeBuild.SaveStepCheckpointCallCount()).To(Equal(1))
+
+				planID, checkpoint := fakeBuild.SaveStepCheckpointArgsForCall(0)
+				Expect(planID).To(Equal(atc.PlanID(originID)))
+				Expect(checkpoint).To(MatchJSON(`{
+					"exit_status": 0,
+					"version_info": {
+						"Version": {"result": "version"},
+						"Metadata": [{"name": "result", "value": "metadata"}]
+					}
+				}`))
+			})
+
 			Context("when the version info is nil", func() {
 				JustBeforeEach(func() {
 					outputDelegate.Completed(exec.ExitStatus(0), nil)
@@ -1041,6 +1317,314 @@ var _ = Describe("BuildDelegate", func() {
 		})
 	})
 
+	Describe("ApproveDelegate", func() {
+		var (
+			approvePlan     atc.ApprovePlan
+			approveDelegate exec.ApproveDelegate
+		)
+
+		BeforeEach(func() {
+			approvePlan = atc.ApprovePlan{Name: "ship-it"}
+			approveDelegate = delegate.ApproveDelegate(logger, approvePlan, originID)
+		})
+
+		Describe("RequestApproval", func() {
+			It("requests approval for the step", func() {
+				err := approveDelegate.RequestApproval()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBuild.RequestApprovalCallCount()).To(Equal(1))
+				Expect(fakeBuild.RequestApprovalArgsForCall(0)).To(Equal(atc.PlanID(originID)))
+			})
+		})
+
+		Describe("Approval", func() {
+			Context("when the step has been decided", func() {
+				BeforeEach(func() {
+					fakeBuild.ApprovalReturns(dbng.BuildApproval{
+						Approved: true,
+						Approver: "some-team",
+						Comment:  "ship it",
+					}, true, nil)
+				})
+
+				It("returns the decision", func() {
+					approval, found, err := approveDelegate.Approval()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(approval).To(Equal(exec.Approval{
+						Approved: true,
+						Approver: "some-team",
+						Comment:  "ship it",
+					}))
+
+					Expect(fakeBuild.ApprovalArgsForCall(0)).To(Equal(atc.PlanID(originID)))
+				})
+			})
+
+			Context("when the step has not been decided", func() {
+				It("returns false", func() {
+					_, found, err := approveDelegate.Approval()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("Waiting", func() {
+			It("saves a request-approval event", func() {
+				approveDelegate.Waiting()
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent.EventType()).To(Equal(event.EventTypeRequestApproval))
+				Expect(savedEvent.(event.RequestApproval).Name).To(Equal("ship-it"))
+				Expect(savedEvent.(event.RequestApproval).Origin).To(Equal(event.Origin{ID: originID}))
+			})
+		})
+
+		Describe("Decided", func() {
+			It("saves a decide-approval event recording the approver", func() {
+				approveDelegate.Decided(exec.Approval{
+					Approved: false,
+					Approver: "some-team",
+					Comment:  "not today",
+				})
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				savedEvent := fakeBuild.SaveEventArgsForCall(0).(event.DecideApproval)
+				Expect(savedEvent.Name).To(Equal("ship-it"))
+				Expect(savedEvent.Approved).To(BeFalse())
+				Expect(savedEvent.Approver).To(Equal("some-team"))
+				Expect(savedEvent.Comment).To(Equal("not today"))
+				Expect(savedEvent.Origin).To(Equal(event.Origin{ID: originID}))
+			})
+		})
+
+		Describe("Failed", func() {
+			It("saves an error event", func() {
+				approveDelegate.Failed(errors.New("nope"))
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Error{
+					Message: "nope",
+					Origin:  event.Origin{ID: originID},
+				}))
+			})
+		})
+	})
+
+	Describe("LoadVarDelegate", func() {
+		var loadVarDelegate exec.LoadVarDelegate
+
+		BeforeEach(func() {
+			loadVarDelegate = delegate.LoadVarDelegate(logger, atc.LoadVarPlan{
+				Name: "some-var",
+				File: "some-artifact/version",
+			}, originID)
+		})
+
+		Describe("VarLoaded", func() {
+			It("sets the build variable for later steps", func() {
+				loadVarDelegate.VarLoaded("some-var", "1.2.3", false)
+
+				value, found := delegate.BuildVariables().Get("some-var")
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("1.2.3"))
+
+				inputDelegate := delegate.InputDelegate(logger, atc.GetPlan{}, originID)
+				Expect(inputDelegate.BuildVariables()).To(Equal(delegate.BuildVariables()))
+			})
+
+			It("does not redact the value", func() {
+				loadVarDelegate.VarLoaded("some-var", "some-loaded-value", false)
+
+				_, err := delegate.InputDelegate(logger, atc.GetPlan{}, originID).Stdout().Write([]byte("some-loaded-value"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("some-loaded-value"))
+			})
+
+			Context("when the value is sensitive", func() {
+				It("redacts it from the output of later steps", func() {
+					loadVarDelegate.VarLoaded("some-var", map[string]interface{}{
+						"password": "some-loaded-password",
+					}, true)
+
+					_, err := delegate.InputDelegate(logger, atc.GetPlan{}, originID).Stdout().Write([]byte("some-loaded-password"))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("((redacted))"))
+				})
+			})
+		})
+
+		Describe("Failed", func() {
+			It("saves an error event", func() {
+				loadVarDelegate.Failed(errors.New("nope"))
+
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Error{
+					Message: "nope",
+					Origin: event.Origin{
+						ID: originID,
+					},
+				}))
+			})
+		})
+	})
+
+	Describe("ConditionalDelegate", func() {
+		var (
+			conditionalPlan     atc.ConditionalPlan
+			conditionalDelegate exec.ConditionalDelegate
+		)
+
+		BeforeEach(func() {
+			fakeBuild.IDReturns(128)
+			fakeBuild.NameReturns("42")
+			fakeBuild.JobNameReturns("some-job")
+			fakeBuild.PipelineNameReturns("some-pipeline")
+			fakeBuild.TeamNameReturns("some-team")
+			fakeBuild.IsManuallyTriggeredReturns(true)
+
+			conditionalPlan = atc.ConditionalPlan{
+				Condition: `build.trigger == "manual"`,
+				Step: atc.Plan{
+					Task: &atc.TaskPlan{
+						Name:   "some-task",
+						Params: atc.Params{"SOME": "param"},
+					},
+				},
+			}
+
+			conditionalDelegate = delegate.ConditionalDelegate(logger, conditionalPlan, originID)
+		})
+
+		Describe("Variables", func() {
+			BeforeEach(func() {
+				inputDelegate := delegate.InputDelegate(logger, atc.GetPlan{
+					Name:     "some-input",
+					Resource: "some-input-resource",
+				}, event.OriginID("some-input-origin"))
+				inputDelegate.Completed(exec.ExitStatus(0), &exec.VersionInfo{
+					Version:  atc.Version{"ref": "abc"},
+					Metadata: []atc.MetadataField{{Name: "tag", Value: "v1.0.0"}},
+				})
+
+				executionDelegate := delegate.ExecutionDelegate(logger, atc.TaskPlan{
+					Name: "some-other-task",
+				}, event.OriginID("some-task-origin"))
+				executionDelegate.Finished(exec.ExitStatus(1))
+			})
+
+			It("includes the build, the steps run so far, and the step's params", func() {
+				Expect(conditionalDelegate.Variables()).To(Equal(condition.Vars{
+					"build": map[string]interface{}{
+						"id":            128,
+						"name":          "42",
+						"job_name":      "some-job",
+						"pipeline_name": "some-pipeline",
+						"team_name":     "some-team",
+						"trigger":       "manual",
+					},
+					"inputs": map[string]interface{}{
+						"some-input": map[string]interface{}{
+							"version": map[string]interface{}{
+								"ref": "abc",
+							},
+							"metadata": map[string]interface{}{
+								"tag": "v1.0.0",
+							},
+						},
+					},
+					"steps": map[string]interface{}{
+						"some-input": map[string]interface{}{
+							"status": "succeeded",
+						},
+						"some-other-task": map[string]interface{}{
+							"status": "failed",
+						},
+					},
+					"params": map[string]interface{}{
+						"SOME": "param",
+					},
+				}))
+			})
+		})
+
+		Describe("Skipped", func() {
+			JustBeforeEach(func() {
+				conditionalDelegate.Skipped()
+			})
+
+			It("saves a skipped event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.Skipped{}))
+				Expect(savedEvent.(event.Skipped).Time).To(BeNumerically("~", time.Now().Unix(), 1))
+				Expect(savedEvent.(event.Skipped).Condition).To(Equal(`build.trigger == "manual"`))
+				Expect(savedEvent.(event.Skipped).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+
+			It("records the step as skipped for later conditions", func() {
+				steps := conditionalDelegate.Variables()["steps"]
+				Expect(steps).To(HaveKeyWithValue("some-task", map[string]interface{}{
+					"status": "skipped",
+				}))
+			})
+		})
+	})
+
+	Describe("RetryDelegate", func() {
+		var retryDelegate exec.RetryDelegate
+
+		BeforeEach(func() {
+			retryDelegate = delegate.RetryDelegate(logger, originID)
+		})
+
+		Describe("Retrying", func() {
+			var attemptErr error
+
+			BeforeEach(func() {
+				attemptErr = nil
+			})
+
+			JustBeforeEach(func() {
+				retryDelegate.Retrying(2, attemptErr, 30*time.Second)
+			})
+
+			It("saves a retry attempt event", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+
+				savedEvent := fakeBuild.SaveEventArgsForCall(0)
+				Expect(savedEvent).To(BeAssignableToTypeOf(event.RetryAttempt{}))
+				Expect(savedEvent.(event.RetryAttempt).Time).To(BeNumerically("~", time.Now().Unix(), 1))
+				Expect(savedEvent.(event.RetryAttempt).Attempt).To(Equal(2))
+				Expect(savedEvent.(event.RetryAttempt).Errored).To(BeFalse())
+				Expect(savedEvent.(event.RetryAttempt).Delay).To(Equal(int64(30000)))
+				Expect(savedEvent.(event.RetryAttempt).Origin).To(Equal(event.Origin{
+					ID: originID,
+				}))
+			})
+
+			Context("when the attempt errored", func() {
+				BeforeEach(func() {
+					attemptErr = errors.New("nope")
+				})
+
+				It("saves the error in the event", func() {
+					savedEvent := fakeBuild.SaveEventArgsForCall(0)
+					Expect(savedEvent.(event.RetryAttempt).Errored).To(BeTrue())
+					Expect(savedEvent.(event.RetryAttempt).Error).To(Equal("nope"))
+				})
+			})
+		})
+	})
+
 	Describe("Aborted", func() {
 		var aborted bool
 
@@ -1091,4 +1675,153 @@ var _ = Describe("BuildDelegate", func() {
 			})
 		})
 	})
+
+	Describe("Finish", func() {
+		It("notifies the team of the build's final status", func() {
+			delegate.Finish(logger, nil, false, false)
+
+			Expect(fakeNotifier.BuildFinishedCallCount()).To(Equal(1))
+			_, notifiedBuild, status := fakeNotifier.BuildFinishedArgsForCall(0)
+			Expect(notifiedBuild).To(Equal(fakeBuild))
+			Expect(status).To(Equal(atc.StatusFailed))
+		})
+
+		Context("when saving the status fails", func() {
+			BeforeEach(func() {
+				fakeBuild.FinishReturns(errors.New("nope"))
+			})
+
+			It("does not notify", func() {
+				delegate.Finish(logger, nil, true, false)
+
+				Expect(fakeNotifier.BuildFinishedCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("log redaction", func() {
+		var plan atc.Plan
+
+		BeforeEach(func() {
+			plan = atc.Plan{
+				Do: &atc.DoPlan{
+					{
+						Get: &atc.GetPlan{
+							Name:   "some-input",
+							Source: atc.Source{"uri": "some-uri", "password": "get-password"},
+							VersionedResourceTypes: atc.VersionedResourceTypes{
+								{ResourceType: atc.ResourceType{Name: "custom", Source: atc.Source{"token": "type-token"}}},
+							},
+						},
+					},
+					{
+						OnFailure: &atc.OnFailurePlan{
+							Step: atc.Plan{
+								Task: &atc.TaskPlan{
+									Params: atc.Params{"TASK_TOKEN": "task-token"},
+								},
+							},
+							Next: atc.Plan{
+								Put: &atc.PutPlan{
+									Params: atc.Params{"api_key": "put-api-key"},
+								},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("looks up the build's team's rules", func() {
+			delegate.RegisterSecrets(logger, plan)
+
+			Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(42))
+			Expect(fakeTeam.RedactionRulesCallCount()).To(Equal(1))
+		})
+
+		It("scrubs the plan's sources and params from logs", func() {
+			delegate.RegisterSecrets(logger, plan)
+
+			writer := delegate.ExecutionDelegate(logger, atc.TaskPlan{}, originID).Stdout()
+
+			_, err := writer.Write([]byte("get-password type-token task-token put-api-key some-uri\n"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+				Origin: event.Origin{
+					Source: event.OriginSourceStdout,
+					ID:     originID,
+				},
+				Payload: "((redacted)) ((redacted)) ((redacted)) ((redacted)) ((redacted))\n",
+			}))
+		})
+
+		It("scrubs the params and image source of task configs once they are known", func() {
+			executionDelegate := delegate.ExecutionDelegate(logger, atc.TaskPlan{}, originID)
+			executionDelegate.Initializing(atc.TaskConfig{
+				ImageResource: &atc.ImageResource{
+					Type:   "docker-image",
+					Source: atc.Source{"password": "image-password"},
+				},
+				Params: map[string]string{"SECRET": "config-secret"},
+			})
+
+			_, err := executionDelegate.Stderr().Write([]byte("image-password config-secret"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+			Expect(fakeBuild.SaveEventArgsForCall(1).(event.Log).Payload).To(Equal("((redacted)) ((redacted))"))
+		})
+
+		It("saves output held back at the end of a step before finishing it", func() {
+			delegate.RegisterSecrets(logger, plan)
+
+			executionDelegate := delegate.ExecutionDelegate(logger, atc.TaskPlan{}, originID)
+
+			_, err := executionDelegate.Stdout().Write([]byte("done: get-pass"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("done: "))
+
+			executionDelegate.Finished(0)
+
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(3))
+			Expect(fakeBuild.SaveEventArgsForCall(1).(event.Log).Payload).To(Equal("get-pass"))
+			Expect(fakeBuild.SaveEventArgsForCall(2)).To(BeAssignableToTypeOf(event.FinishTask{}))
+		})
+
+		Context("when the team ignores some keys", func() {
+			BeforeEach(func() {
+				fakeTeam.RedactionRulesReturns(atc.RedactionRules{
+					IgnoreKeys: []string{"uri"},
+				}, nil)
+			})
+
+			It("leaves their values alone", func() {
+				delegate.RegisterSecrets(logger, plan)
+
+				_, err := delegate.InputDelegate(logger, atc.GetPlan{}, originID).Stdout().Write([]byte("some-uri get-password"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("some-uri ((redacted))"))
+			})
+		})
+
+		Context("when the team's rules cannot be found", func() {
+			BeforeEach(func() {
+				fakeTeam.RedactionRulesReturns(atc.RedactionRules{}, errors.New("nope"))
+			})
+
+			It("redacts with the default rules", func() {
+				delegate.RegisterSecrets(logger, plan)
+
+				_, err := delegate.InputDelegate(logger, atc.GetPlan{}, originID).Stdout().Write([]byte("get-password"))
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("((redacted))"))
+			})
+		})
+	})
 })
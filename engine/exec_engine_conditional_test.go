@@ -0,0 +1,142 @@
+package engine_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/condition"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/engine"
+	"github.com/concourse/atc/engine/enginefakes"
+
+	"github.com/concourse/atc/db/dbfakes"
+	"github.com/concourse/atc/exec/execfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Exec Engine with Conditionals", func() {
+	var (
+		fakeFactory         *execfakes.FakeFactory
+		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+
+		execEngine engine.Engine
+
+		build  *dbngfakes.FakeBuild
+		logger *lagertest.TestLogger
+
+		fakeDelegate            *enginefakes.FakeBuildDelegate
+		fakeConditionalDelegate *execfakes.FakeConditionalDelegate
+
+		inputStepFactory *execfakes.FakeStepFactory
+		inputStep        *execfakes.FakeStep
+
+		taskStepFactory *execfakes.FakeStepFactory
+		taskStep        *execfakes.FakeStep
+
+		planFactory     atc.PlanFactory
+		conditionalPlan atc.Plan
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		fakeFactory = new(execfakes.FakeFactory)
+		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+
+		fakeTeamDBFactory := new(dbfakes.FakeTeamDBFactory)
+		execEngine = engine.NewExecEngine(
+			fakeFactory,
+			fakeDelegateFactory,
+			fakeTeamDBFactory,
+			"http://example.com",
+			nil,
+		)
+
+		fakeDelegate = new(enginefakes.FakeBuildDelegate)
+		fakeDelegateFactory.DelegateReturns(fakeDelegate)
+
+		fakeConditionalDelegate = new(execfakes.FakeConditionalDelegate)
+		fakeConditionalDelegate.VariablesReturns(condition.Vars{
+			"build": map[string]interface{}{
+				"trigger": "manual",
+			},
+		})
+		fakeDelegate.ConditionalDelegateReturns(fakeConditionalDelegate)
+
+		build = new(dbngfakes.FakeBuild)
+		build.IDReturns(4444)
+		build.NameReturns("42")
+		build.JobNameReturns("some-job")
+
+		inputStepFactory = new(execfakes.FakeStepFactory)
+		inputStep = new(execfakes.FakeStep)
+		inputStep.ResultStub = successResult(true)
+		inputStepFactory.UsingReturns(inputStep)
+		fakeFactory.GetReturns(inputStepFactory)
+
+		taskStepFactory = new(execfakes.FakeStepFactory)
+		taskStep = new(execfakes.FakeStep)
+		taskStep.ResultStub = successResult(true)
+		taskStepFactory.UsingReturns(taskStep)
+		fakeFactory.TaskReturns(taskStepFactory)
+
+		planFactory = atc.NewPlanFactory(123)
+	})
+
+	JustBeforeEach(func() {
+		plan := planFactory.NewPlan(atc.OnSuccessPlan{
+			Step: conditionalPlan,
+			Next: planFactory.NewPlan(atc.TaskPlan{
+				Name:   "some-task",
+				Config: &atc.TaskConfig{},
+			}),
+		})
+
+		build, err := execEngine.CreateBuild(logger, build, plan)
+		Expect(err).NotTo(HaveOccurred())
+
+		build.Resume(logger)
+	})
+
+	Context("when the condition holds", func() {
+		BeforeEach(func() {
+			conditionalPlan = planFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `build.trigger == "manual"`,
+				Step: planFactory.NewPlan(atc.GetPlan{
+					Name: "some-input",
+				}),
+			})
+		})
+
+		It("constructs the delegate for the conditional plan", func() {
+			Expect(fakeDelegate.ConditionalDelegateCallCount()).To(Equal(1))
+			_, plan, originID := fakeDelegate.ConditionalDelegateArgsForCall(0)
+			Expect(plan).To(Equal(*conditionalPlan.Conditional))
+			Expect(string(originID)).To(Equal(string(conditionalPlan.ID)))
+		})
+
+		It("runs the step and the next one", func() {
+			Expect(inputStep.RunCallCount()).To(Equal(1))
+			Expect(taskStep.RunCallCount()).To(Equal(1))
+			Expect(fakeConditionalDelegate.SkippedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the condition does not hold", func() {
+		BeforeEach(func() {
+			conditionalPlan = planFactory.NewPlan(atc.ConditionalPlan{
+				Condition: `build.trigger == "scheduled"`,
+				Step: planFactory.NewPlan(atc.GetPlan{
+					Name: "some-input",
+				}),
+			})
+		})
+
+		It("skips the step, but runs the next one", func() {
+			Expect(inputStep.RunCallCount()).To(BeZero())
+			Expect(fakeConditionalDelegate.SkippedCallCount()).To(Equal(1))
+			Expect(taskStep.RunCallCount()).To(Equal(1))
+		})
+	})
+})
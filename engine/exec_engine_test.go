@@ -1,9 +1,12 @@
 package engine_test
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
 	"github.com/concourse/atc/db/dbfakes"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/dbng/dbngfakes"
@@ -13,6 +16,7 @@ import (
 	"github.com/concourse/atc/exec"
 	"github.com/concourse/atc/exec/execfakes"
 	"github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/workerfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -23,6 +27,7 @@ var _ = Describe("ExecEngine", func() {
 		fakeFactory         *execfakes.FakeFactory
 		fakeTeamDB          *dbfakes.FakeTeamDB
 		fakeDelegateFactory *enginefakes.FakeBuildDelegateFactory
+		fakeArtifactStore   *blobstorefakes.FakeStore
 		logger              *lagertest.TestLogger
 
 		execEngine engine.Engine
@@ -36,6 +41,7 @@ var _ = Describe("ExecEngine", func() {
 	BeforeEach(func() {
 		fakeFactory = new(execfakes.FakeFactory)
 		fakeDelegateFactory = new(enginefakes.FakeBuildDelegateFactory)
+		fakeArtifactStore = new(blobstorefakes.FakeStore)
 		logger = lagertest.NewTestLogger("test")
 
 		fakeTeamDBFactory := new(dbfakes.FakeTeamDBFactory)
@@ -46,6 +52,7 @@ var _ = Describe("ExecEngine", func() {
 			fakeDelegateFactory,
 			fakeTeamDBFactory,
 			"http://example.com",
+			fakeArtifactStore,
 		)
 	})
 
@@ -55,6 +62,7 @@ var _ = Describe("ExecEngine", func() {
 			fakeInputDelegate     *execfakes.FakeGetDelegate
 			fakeExecutionDelegate *execfakes.FakeTaskDelegate
 			fakeOutputDelegate    *execfakes.FakePutDelegate
+			buildVariables        *exec.BuildVariables
 
 			dbBuild          *dbngfakes.FakeBuild
 			expectedMetadata engine.StepMetadata
@@ -112,6 +120,9 @@ var _ = Describe("ExecEngine", func() {
 			fakeOutputDelegate = new(execfakes.FakePutDelegate)
 			fakeDelegate.OutputDelegateReturns(fakeOutputDelegate)
 
+			buildVariables = exec.NewBuildVariables()
+			fakeDelegate.BuildVariablesReturns(buildVariables)
+
 			inputStepFactory = new(execfakes.FakeStepFactory)
 			inputStep = new(execfakes.FakeStep)
 			inputStep.ResultStub = successResult(true)
@@ -189,6 +200,19 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			Context("constructing outputs", func() {
+				It("registers the plan's secrets before running it", func() {
+					var err error
+					build, err = execEngine.CreateBuild(logger, dbBuild, outputPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+
+					Expect(fakeDelegate.RegisterSecretsCallCount()).To(Equal(1))
+
+					_, plan := fakeDelegate.RegisterSecretsArgsForCall(0)
+					Expect(plan).To(Equal(outputPlan))
+				})
+
 				It("constructs the put correctly", func() {
 					var err error
 					build, err = execEngine.CreateBuild(logger, dbBuild, outputPlan)
@@ -197,7 +221,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(2))
 
-					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, params, _ := fakeFactory.PutArgsForCall(0)
+					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, _, params, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -220,7 +244,7 @@ var _ = Describe("ExecEngine", func() {
 					Expect(resourceConfig.Source).To(Equal(atc.Source{"some": "source"}))
 					Expect(params).To(Equal(atc.Params{"some": "params"}))
 
-					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, params, _ = fakeFactory.PutArgsForCall(1)
+					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, _, params, _ = fakeFactory.PutArgsForCall(1)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -252,7 +276,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.DependentGetCallCount()).To(Equal(2))
 
-					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _ := fakeFactory.DependentGetArgsForCall(0)
+					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _ := fakeFactory.DependentGetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -281,7 +305,7 @@ var _ = Describe("ExecEngine", func() {
 					Expect(resourceConfig.Source).To(Equal(atc.Source{"some": "source"}))
 					Expect(params).To(Equal(atc.Params{"another": "params"}))
 
-					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _ = fakeFactory.DependentGetArgsForCall(1)
+					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _ = fakeFactory.DependentGetArgsForCall(1)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -372,7 +396,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs the first get correctly", func() {
-				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _, _ := fakeFactory.GetArgsForCall(0)
+				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(teamID).To(Equal(expectedTeamID))
 				Expect(buildID).To(Equal(expectedBuildID))
@@ -399,7 +423,7 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs the second get correctly", func() {
-				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _, _ := fakeFactory.GetArgsForCall(1)
+				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _, _ := fakeFactory.GetArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(teamID).To(Equal(expectedTeamID))
 				Expect(buildID).To(Equal(expectedBuildID))
@@ -429,8 +453,18 @@ var _ = Describe("ExecEngine", func() {
 				Expect(*retryPlanTwo.Retry).To(HaveLen(2))
 			})
 
+			It("reports the retries of each retry step, even without a policy", func() {
+				Expect(fakeDelegate.RetryDelegateCallCount()).To(Equal(2))
+
+				_, originID := fakeDelegate.RetryDelegateArgsForCall(0)
+				Expect(originID).To(Equal(event.OriginID(retryPlanTwo.ID)))
+
+				_, originID = fakeDelegate.RetryDelegateArgsForCall(1)
+				Expect(originID).To(Equal(event.OriginID(retryPlan.ID)))
+			})
+
 			It("constructs nested steps correctly", func() {
-				logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
+				logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, _, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(teamID).To(Equal(expectedTeamID))
 				Expect(buildID).To(Equal(expectedBuildID))
@@ -450,9 +484,12 @@ var _ = Describe("ExecEngine", func() {
 				Expect(delegate).To(Equal(fakeExecutionDelegate))
 				Expect(privileged).To(Equal(exec.Privileged(false)))
 				Expect(tags).To(Equal(atc.Tags{"some", "task", "tags"}))
-				Expect(configSource).To(Equal(exec.ValidatingConfigSource{exec.FileConfigSource{"some-config-path"}}))
+				Expect(configSource).To(Equal(exec.ValidatingConfigSource{exec.InterpolatedConfigSource{
+					ConfigSource: exec.FileConfigSource{"some-config-path"},
+					Variables:    buildVariables,
+				}}))
 
-				logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, configSource, _, _, _, _, _ = fakeFactory.TaskArgsForCall(1)
+				logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, _, configSource, _, _, _, _, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(logger).NotTo(BeNil())
 				Expect(teamID).To(Equal(expectedTeamID))
 				Expect(buildID).To(Equal(expectedBuildID))
@@ -472,7 +509,10 @@ var _ = Describe("ExecEngine", func() {
 				Expect(delegate).To(Equal(fakeExecutionDelegate))
 				Expect(privileged).To(Equal(exec.Privileged(false)))
 				Expect(tags).To(Equal(atc.Tags{"some", "task", "tags"}))
-				Expect(configSource).To(Equal(exec.ValidatingConfigSource{exec.FileConfigSource{"some-config-path"}}))
+				Expect(configSource).To(Equal(exec.ValidatingConfigSource{exec.InterpolatedConfigSource{
+					ConfigSource: exec.FileConfigSource{"some-config-path"},
+					Variables:    buildVariables,
+				}}))
 			})
 		})
 
@@ -519,13 +559,13 @@ var _ = Describe("ExecEngine", func() {
 			})
 
 			It("constructs nested steps correctly", func() {
-				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
+				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
 				Expect(workerMetadata.Attempt).To(Equal("1"))
-				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(1)
+				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(1)
 				Expect(workerMetadata.Attempt).To(Equal("1"))
-				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(2)
+				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(2)
 				Expect(workerMetadata.Attempt).To(Equal("1"))
-				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(3)
+				_, _, _, _, _, workerMetadata, _, _, _, _, _, _, _, _, _, _ = fakeFactory.TaskArgsForCall(3)
 				Expect(workerMetadata.Attempt).To(Equal("1"))
 			})
 		})
@@ -555,7 +595,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.GetCallCount()).To(Equal(1))
 
-					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, version, _ := fakeFactory.GetArgsForCall(0)
+					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, version, _ := fakeFactory.GetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -615,7 +655,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-					logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, configSource, _, actualInputMapping, actualOutputMapping, _, _ := fakeFactory.TaskArgsForCall(0)
+					logger, teamID, buildID, planID, sourceName, workerMetadata, delegate, privileged, tags, _, configSource, _, actualInputMapping, actualOutputMapping, _, _ := fakeFactory.TaskArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -654,11 +694,51 @@ var _ = Describe("ExecEngine", func() {
 						build.Resume(logger)
 						Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-						_, _, _, _, _, _, _, _, _, _, _, _, _, actualImageArtifactName, _ := fakeFactory.TaskArgsForCall(0)
+						_, _, _, _, _, _, _, _, _, _, _, _, _, _, actualImageArtifactName, _ := fakeFactory.TaskArgsForCall(0)
 						Expect(actualImageArtifactName).To(Equal("some-image-artifact-name"))
 					})
 				})
 
+				Context("when the plan declares artifacts", func() {
+					var fakeOutputSource *workerfakes.FakeArtifactSource
+
+					BeforeEach(func() {
+						taskPlan.Artifacts = []string{"baz", "missing"}
+
+						fakeOutputSource = new(workerfakes.FakeArtifactSource)
+						fakeOutputSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+							return dest.StreamIn(".", nil)
+						}
+
+						taskStepFactory.UsingStub = func(prev exec.Step, repo *worker.ArtifactRepository) exec.Step {
+							repo.RegisterSource("qux", fakeOutputSource)
+							return taskStep
+						}
+
+						fakeArtifactStore.SaveStub = func(int, string, io.Reader) error {
+							Expect(fakeDelegate.FinishCallCount()).To(BeZero())
+							return nil
+						}
+					})
+
+					It("archives the mapped outputs to the artifact store before finishing the build", func() {
+						var err error
+						build, err = execEngine.CreateBuild(logger, dbBuild, plan)
+						Expect(err).NotTo(HaveOccurred())
+
+						build.Resume(logger)
+
+						Expect(fakeOutputSource.StreamToCallCount()).To(Equal(1))
+						Expect(fakeArtifactStore.SaveCallCount()).To(Equal(1))
+
+						buildID, name, _ := fakeArtifactStore.SaveArgsForCall(0)
+						Expect(buildID).To(Equal(expectedBuildID))
+						Expect(name).To(Equal("qux"))
+
+						Expect(fakeDelegate.FinishCallCount()).To(Equal(1))
+					})
+				})
+
 				Context("when the plan contains params and config path", func() {
 					BeforeEach(func() {
 						taskPlan.Params = map[string]interface{}{
@@ -674,10 +754,12 @@ var _ = Describe("ExecEngine", func() {
 						build.Resume(logger)
 						Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-						_, _, _, _, _, _, _, _, _, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
+						_, _, _, _, _, _, _, _, _, _, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
 						vcs, ok := configSource.(exec.ValidatingConfigSource)
 						Expect(ok).To(BeTrue())
-						_, ok = vcs.ConfigSource.(exec.MergedConfigSource)
+						ics, ok := vcs.ConfigSource.(exec.InterpolatedConfigSource)
+						Expect(ok).To(BeTrue())
+						_, ok = ics.ConfigSource.(exec.MergedConfigSource)
 						Expect(ok).To(BeTrue())
 					})
 				})
@@ -699,10 +781,12 @@ var _ = Describe("ExecEngine", func() {
 						build.Resume(logger)
 						Expect(fakeFactory.TaskCallCount()).To(Equal(1))
 
-						_, _, _, _, _, _, _, _, _, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
+						_, _, _, _, _, _, _, _, _, _, configSource, _, _, _, _, _ := fakeFactory.TaskArgsForCall(0)
 						vcs, ok := configSource.(exec.ValidatingConfigSource)
 						Expect(ok).To(BeTrue())
-						_, ok = vcs.ConfigSource.(exec.MergedConfigSource)
+						ics, ok := vcs.ConfigSource.(exec.InterpolatedConfigSource)
+						Expect(ok).To(BeTrue())
+						_, ok = ics.ConfigSource.(exec.MergedConfigSource)
 						Expect(ok).To(BeTrue())
 					})
 				})
@@ -747,7 +831,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.PutCallCount()).To(Equal(1))
 
-					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, params, _ := fakeFactory.PutArgsForCall(0)
+					logger, teamID, buildID, planID, metadata, workerMetadata, delegate, resourceConfig, tags, _, params, _ := fakeFactory.PutArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -781,7 +865,7 @@ var _ = Describe("ExecEngine", func() {
 					build.Resume(logger)
 					Expect(fakeFactory.DependentGetCallCount()).To(Equal(1))
 
-					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _ := fakeFactory.DependentGetArgsForCall(0)
+					logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _ := fakeFactory.DependentGetArgsForCall(0)
 					Expect(logger).NotTo(BeNil())
 					Expect(teamID).To(Equal(expectedTeamID))
 					Expect(buildID).To(Equal(expectedBuildID))
@@ -808,6 +892,88 @@ var _ = Describe("ExecEngine", func() {
 					Expect(originID).To(Equal(event.OriginID(dependentGetPlan.ID)))
 				})
 			})
+
+			Context("that contains an approve step", func() {
+				var fakeApproveDelegate *execfakes.FakeApproveDelegate
+
+				BeforeEach(func() {
+					plan = planFactory.NewPlan(atc.ApprovePlan{
+						Name: "ship-it",
+					})
+
+					fakeApproveDelegate = new(execfakes.FakeApproveDelegate)
+					fakeApproveDelegate.ApprovalNotifierReturns(new(dbngfakes.FakeNotifier), nil)
+					fakeApproveDelegate.ApprovalReturns(exec.Approval{Approved: true, Approver: "some-team"}, true, nil)
+					fakeDelegate.ApproveDelegateReturns(fakeApproveDelegate)
+				})
+
+				It("constructs the approve step with a delegate for the plan", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+
+					Expect(fakeDelegate.ApproveDelegateCallCount()).To(Equal(1))
+					_, approvePlan, originID := fakeDelegate.ApproveDelegateArgsForCall(0)
+					Expect(approvePlan).To(Equal(atc.ApprovePlan{Name: "ship-it"}))
+					Expect(originID).To(Equal(event.OriginID(plan.ID)))
+
+					Expect(fakeApproveDelegate.RequestApprovalCallCount()).To(Equal(1))
+				})
+
+				It("succeeds the build once approved", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+
+					Expect(fakeDelegate.FinishCallCount()).To(Equal(1))
+					_, finishErr, succeeded, aborted := fakeDelegate.FinishArgsForCall(0)
+					Expect(finishErr).NotTo(HaveOccurred())
+					Expect(succeeded).To(Equal(exec.Success(true)))
+					Expect(aborted).To(BeFalse())
+				})
+			})
+
+			Context("that contains a load_var step", func() {
+				var fakeLoadVarDelegate *execfakes.FakeLoadVarDelegate
+
+				BeforeEach(func() {
+					plan = planFactory.NewPlan(atc.LoadVarPlan{
+						Name: "version",
+						File: "some-artifact/version",
+					})
+
+					fakeLoadVarDelegate = new(execfakes.FakeLoadVarDelegate)
+					fakeDelegate.LoadVarDelegateReturns(fakeLoadVarDelegate)
+				})
+
+				It("constructs the load_var step with a delegate for the plan", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+
+					Expect(fakeDelegate.LoadVarDelegateCallCount()).To(Equal(1))
+					_, loadVarPlan, originID := fakeDelegate.LoadVarDelegateArgsForCall(0)
+					Expect(loadVarPlan).To(Equal(atc.LoadVarPlan{Name: "version", File: "some-artifact/version"}))
+					Expect(originID).To(Equal(event.OriginID(plan.ID)))
+				})
+
+				It("errors the build when the artifact does not exist", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+
+					Expect(fakeLoadVarDelegate.FailedCallCount()).To(Equal(1))
+					Expect(fakeLoadVarDelegate.FailedArgsForCall(0)).To(Equal(exec.UnknownArtifactSourceError{SourceName: "some-artifact"}))
+
+					Expect(fakeDelegate.FinishCallCount()).To(Equal(1))
+					_, finishErr, _, _ := fakeDelegate.FinishArgsForCall(0)
+					Expect(finishErr).To(HaveOccurred())
+				})
+			})
 		})
 	})
 
@@ -867,6 +1033,36 @@ var _ = Describe("ExecEngine", func() {
 		})
 	})
 
+	Describe("BuildPlan", func() {
+		var dbBuild *dbngfakes.FakeBuild
+
+		BeforeEach(func() {
+			dbBuild = new(dbngfakes.FakeBuild)
+			dbBuild.EngineReturns("exec.v2")
+			dbBuild.EngineMetadataReturns(`{"Plan": {"id": "47", "task": {"name": "some-task"}}}`)
+		})
+
+		It("returns the plan the build runs", func() {
+			plan, err := engine.BuildPlan(dbBuild)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan).To(Equal(atc.Plan{
+				ID:   "47",
+				Task: &atc.TaskPlan{Name: "some-task"},
+			}))
+		})
+
+		Context("when the build is run by another engine", func() {
+			BeforeEach(func() {
+				dbBuild.EngineReturns("some-other-engine")
+			})
+
+			It("returns an error", func() {
+				_, err := engine.BuildPlan(dbBuild)
+				Expect(err).To(Equal(engine.ErrNotExecBuild))
+			})
+		})
+	})
+
 	Describe("LookupBuild", func() {
 		var dbBuild *dbngfakes.FakeBuild
 
@@ -920,7 +1116,7 @@ var _ = Describe("ExecEngine", func() {
 
 				foundBuild.Resume(logger)
 				Expect(fakeFactory.GetCallCount()).To(Equal(1))
-				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, params, _, _ := fakeFactory.GetArgsForCall(0)
+				logger, teamID, buildID, planID, metadata, sourceName, workerMetadata, delegate, resourceConfig, tags, _, params, _, _ := fakeFactory.GetArgsForCall(0)
 				Expect(logger).NotTo(BeNil())
 				Expect(teamID).To(Equal(expectedTeamID))
 				Expect(buildID).To(Equal(expectedBuildID))
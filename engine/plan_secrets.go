@@ -0,0 +1,94 @@
+package engine
+
+import "github.com/concourse/atc"
+
+// planSecrets returns the sources and params of every step in the plan,
+// including those of the resource types the steps use.
+func planSecrets(plan atc.Plan) []interface{} {
+	secrets := []interface{}{}
+
+	children := []atc.Plan{}
+
+	if plan.Aggregate != nil {
+		children = append(children, *plan.Aggregate...)
+	}
+
+	if plan.InParallel != nil {
+		children = append(children, plan.InParallel.Steps...)
+	}
+
+	if plan.Do != nil {
+		children = append(children, *plan.Do...)
+	}
+
+	if plan.Retry != nil {
+		children = append(children, *plan.Retry...)
+	}
+
+	if plan.Timeout != nil {
+		children = append(children, plan.Timeout.Step)
+	}
+
+	if plan.Try != nil {
+		children = append(children, plan.Try.Step)
+	}
+
+	if plan.Conditional != nil {
+		children = append(children, plan.Conditional.Step)
+	}
+
+	if plan.OnSuccess != nil {
+		children = append(children, plan.OnSuccess.Step, plan.OnSuccess.Next)
+	}
+
+	if plan.OnFailure != nil {
+		children = append(children, plan.OnFailure.Step, plan.OnFailure.Next)
+	}
+
+	if plan.Ensure != nil {
+		children = append(children, plan.Ensure.Step, plan.Ensure.Next)
+	}
+
+	for _, child := range children {
+		secrets = append(secrets, planSecrets(child)...)
+	}
+
+	if plan.Get != nil {
+		secrets = append(secrets, plan.Get.Source, plan.Get.Params)
+		secrets = append(secrets, resourceTypeSecrets(plan.Get.VersionedResourceTypes)...)
+	}
+
+	if plan.DependentGet != nil {
+		secrets = append(secrets, plan.DependentGet.Source, plan.DependentGet.Params)
+		secrets = append(secrets, resourceTypeSecrets(plan.DependentGet.VersionedResourceTypes)...)
+	}
+
+	if plan.Put != nil {
+		secrets = append(secrets, plan.Put.Source, plan.Put.Params)
+		secrets = append(secrets, resourceTypeSecrets(plan.Put.VersionedResourceTypes)...)
+	}
+
+	if plan.Task != nil {
+		secrets = append(secrets, plan.Task.Params)
+		secrets = append(secrets, resourceTypeSecrets(plan.Task.VersionedResourceTypes)...)
+
+		if plan.Task.Config != nil {
+			secrets = append(secrets, plan.Task.Config.Params)
+
+			if plan.Task.Config.ImageResource != nil {
+				secrets = append(secrets, plan.Task.Config.ImageResource.Source)
+			}
+		}
+	}
+
+	return secrets
+}
+
+func resourceTypeSecrets(resourceTypes atc.VersionedResourceTypes) []interface{} {
+	secrets := make([]interface{}, len(resourceTypes))
+	for i, resourceType := range resourceTypes {
+		secrets[i] = resourceType.Source
+	}
+
+	return secrets
+}
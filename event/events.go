@@ -155,3 +155,162 @@ type InitializePut struct {
 
 func (InitializePut) EventType() atc.EventType  { return EventTypeInitializePut }
 func (InitializePut) Version() atc.EventVersion { return "1.0" }
+
+type StartGet struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+}
+
+func (StartGet) EventType() atc.EventType  { return EventTypeStartGet }
+func (StartGet) Version() atc.EventVersion { return "1.0" }
+
+type StartPut struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+}
+
+func (StartPut) EventType() atc.EventType  { return EventTypeStartPut }
+func (StartPut) Version() atc.EventVersion { return "1.0" }
+
+type FetchImage struct {
+	Time     int64  `json:"time"`
+	Duration int64  `json:"duration"` // milliseconds
+	Bytes    int64  `json:"bytes,omitempty"`
+	Origin   Origin `json:"origin"`
+}
+
+func (FetchImage) EventType() atc.EventType  { return EventTypeFetchImage }
+func (FetchImage) Version() atc.EventVersion { return "1.0" }
+
+type StreamInput struct {
+	Time     int64  `json:"time"`
+	Name     string `json:"name"`
+	Duration int64  `json:"duration"` // milliseconds
+	Bytes    int64  `json:"bytes"`
+	Origin   Origin `json:"origin"`
+}
+
+func (StreamInput) EventType() atc.EventType  { return EventTypeStreamInput }
+func (StreamInput) Version() atc.EventVersion { return "1.0" }
+
+type RegisterOutput struct {
+	Time     int64  `json:"time"`
+	Name     string `json:"name"`
+	Duration int64  `json:"duration"` // milliseconds
+	Bytes    int64  `json:"bytes"`
+	Origin   Origin `json:"origin"`
+}
+
+func (RegisterOutput) EventType() atc.EventType  { return EventTypeRegisterOutput }
+func (RegisterOutput) Version() atc.EventVersion { return "1.0" }
+
+type CreateVolume struct {
+	Time     int64  `json:"time"`
+	Name     string `json:"name"`
+	Duration int64  `json:"duration"` // milliseconds
+	Origin   Origin `json:"origin"`
+}
+
+func (CreateVolume) EventType() atc.EventType  { return EventTypeCreateVolume }
+func (CreateVolume) Version() atc.EventVersion { return "1.0" }
+
+type RequestApproval struct {
+	Time   int64  `json:"time"`
+	Name   string `json:"name"`
+	Origin Origin `json:"origin"`
+}
+
+func (RequestApproval) EventType() atc.EventType  { return EventTypeRequestApproval }
+func (RequestApproval) Version() atc.EventVersion { return "1.0" }
+
+type DecideApproval struct {
+	Time     int64  `json:"time"`
+	Name     string `json:"name"`
+	Approved bool   `json:"approved"`
+	Approver string `json:"approver"`
+	Comment  string `json:"comment,omitempty"`
+	Origin   Origin `json:"origin"`
+}
+
+func (DecideApproval) EventType() atc.EventType  { return EventTypeDecideApproval }
+func (DecideApproval) Version() atc.EventVersion { return "1.0" }
+
+type RetryAttempt struct {
+	Time    int64  `json:"time"`
+	Attempt int    `json:"attempt"`
+	Errored bool   `json:"errored"`
+	Error   string `json:"error,omitempty"`
+	Delay   int64  `json:"delay"` // milliseconds
+	Origin  Origin `json:"origin"`
+}
+
+func (RetryAttempt) EventType() atc.EventType  { return EventTypeRetryAttempt }
+func (RetryAttempt) Version() atc.EventVersion { return "1.0" }
+
+type Skipped struct {
+	Time      int64  `json:"time"`
+	Condition string `json:"condition"`
+	Origin    Origin `json:"origin"`
+}
+
+func (Skipped) EventType() atc.EventType  { return EventTypeSkipped }
+func (Skipped) Version() atc.EventVersion { return "1.0" }
+
+type HandOff struct {
+	Time int64 `json:"time"`
+}
+
+func (HandOff) EventType() atc.EventType  { return EventTypeHandOff }
+func (HandOff) Version() atc.EventVersion { return "1.0" }
+
+type BuildStatusChanged struct {
+	Time         int64           `json:"time"`
+	BuildID      int             `json:"build_id"`
+	BuildName    string          `json:"build_name"`
+	JobName      string          `json:"job_name,omitempty"`
+	PipelineName string          `json:"pipeline_name,omitempty"`
+	Status       atc.BuildStatus `json:"status"`
+}
+
+func (BuildStatusChanged) EventType() atc.EventType  { return EventTypeBuildStatusChanged }
+func (BuildStatusChanged) Version() atc.EventVersion { return "1.0" }
+
+type NewResourceVersions struct {
+	PipelineName string        `json:"pipeline_name"`
+	Resource     string        `json:"resource"`
+	Versions     []atc.Version `json:"versions"`
+}
+
+func (NewResourceVersions) EventType() atc.EventType  { return EventTypeNewResourceVersions }
+func (NewResourceVersions) Version() atc.EventVersion { return "1.0" }
+
+type ResourceCheckError struct {
+	PipelineName string `json:"pipeline_name"`
+	Resource     string `json:"resource"`
+	Error        string `json:"error,omitempty"` // empty once the check recovers
+}
+
+func (ResourceCheckError) EventType() atc.EventType  { return EventTypeResourceCheckError }
+func (ResourceCheckError) Version() atc.EventVersion { return "1.0" }
+
+type PipelinePaused struct {
+	PipelineName string `json:"pipeline_name"`
+}
+
+func (PipelinePaused) EventType() atc.EventType  { return EventTypePipelinePaused }
+func (PipelinePaused) Version() atc.EventVersion { return "1.0" }
+
+type PipelineUnpaused struct {
+	PipelineName string `json:"pipeline_name"`
+}
+
+func (PipelineUnpaused) EventType() atc.EventType  { return EventTypePipelineUnpaused }
+func (PipelineUnpaused) Version() atc.EventVersion { return "1.0" }
+
+type PipelineConfigSaved struct {
+	PipelineName string `json:"pipeline_name"`
+	Created      bool   `json:"created"`
+}
+
+func (PipelineConfigSaved) EventType() atc.EventType  { return EventTypePipelineConfigSaved }
+func (PipelineConfigSaved) Version() atc.EventVersion { return "1.0" }
@@ -37,12 +37,29 @@ func init() {
 	registerEvent(StartTask{})
 	registerEvent(FinishTask{})
 	registerEvent(InitializeGet{})
+	registerEvent(StartGet{})
 	registerEvent(FinishGet{})
 	registerEvent(InitializePut{})
+	registerEvent(StartPut{})
 	registerEvent(FinishPut{})
+	registerEvent(FetchImage{})
+	registerEvent(StreamInput{})
+	registerEvent(RegisterOutput{})
+	registerEvent(CreateVolume{})
+	registerEvent(RequestApproval{})
+	registerEvent(DecideApproval{})
+	registerEvent(RetryAttempt{})
+	registerEvent(Skipped{})
+	registerEvent(HandOff{})
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
+	registerEvent(BuildStatusChanged{})
+	registerEvent(NewResourceVersions{})
+	registerEvent(ResourceCheckError{})
+	registerEvent(PipelinePaused{})
+	registerEvent(PipelineUnpaused{})
+	registerEvent(PipelineConfigSaved{})
 
 	// deprecated:
 	registerEvent(FinishV10{})
@@ -21,15 +21,70 @@ const (
 	// get step initializing
 	EventTypeInitializeGet atc.EventType = "initialize-get"
 
+	// get step started running the resource's script
+	EventTypeStartGet atc.EventType = "start-get"
+
 	// finished getting something
 	EventTypeFinishGet atc.EventType = "finish-get"
 
 	// put step initializing
 	EventTypeInitializePut atc.EventType = "initialize-put"
 
+	// put step started running the resource's script
+	EventTypeStartPut atc.EventType = "start-put"
+
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// image for a step's container fetched
+	EventTypeFetchImage atc.EventType = "fetch-image"
+
+	// input streamed into a step's container
+	EventTypeStreamInput atc.EventType = "stream-input"
+
+	// task output registered for use by later steps
+	EventTypeRegisterOutput atc.EventType = "register-output"
+
+	// volume created for a step's container
+	EventTypeCreateVolume atc.EventType = "create-volume"
+
+	// approve step waiting for a team member's decision
+	EventTypeRequestApproval atc.EventType = "request-approval"
+
+	// approve step approved or rejected
+	EventTypeDecideApproval atc.EventType = "decide-approval"
+
+	// attempt of a retry step failed or errored and is about to be retried
+	EventTypeRetryAttempt atc.EventType = "retry-attempt"
+
+	// step skipped because its condition did not hold
+	EventTypeSkipped atc.EventType = "skipped"
+
+	// build released by a draining ATC, to be picked up by another
+	EventTypeHandOff atc.EventType = "hand-off"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
+
+// team-wide events, streamed to everyone watching a team rather than
+// recorded against a single build
+const (
+	// a build in the team started or finished
+	EventTypeBuildStatusChanged atc.EventType = "build-status-changed"
+
+	// a resource's check found new versions
+	EventTypeNewResourceVersions atc.EventType = "new-resource-versions"
+
+	// a resource's check started or stopped failing
+	EventTypeResourceCheckError atc.EventType = "resource-check-error"
+
+	// a pipeline was paused
+	EventTypePipelinePaused atc.EventType = "pipeline-paused"
+
+	// a pipeline was unpaused
+	EventTypePipelineUnpaused atc.EventType = "pipeline-unpaused"
+
+	// a pipeline's config was created or updated
+	EventTypePipelineConfigSaved atc.EventType = "pipeline-config-saved"
+)
@@ -0,0 +1,104 @@
+package exec
+
+import (
+	"os"
+
+	"github.com/concourse/atc/worker"
+)
+
+// Approval is the decision made by a team member on an ApproveStep.
+type Approval struct {
+	Approved bool
+	Approver string
+	Comment  string
+}
+
+// ApproveStep blocks the build until a team member approves or rejects it.
+type ApproveStep struct {
+	delegate ApproveDelegate
+
+	approval Approval
+	decided  bool
+}
+
+// Approve constructs an ApproveStep factory.
+func Approve(delegate ApproveDelegate) ApproveStep {
+	return ApproveStep{
+		delegate: delegate,
+	}
+}
+
+// Using constructs an *ApproveStep.
+func (step ApproveStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
+	return &step
+}
+
+// Run requests approval and waits for a decision to be made.
+//
+// If the decision was already made (i.e. the build is being resumed), Run
+// returns immediately without waiting again.
+//
+// The step can be interrupted while waiting, in which case it returns
+// ErrInterrupted and is considered to have failed.
+func (step *ApproveStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	err := step.delegate.RequestApproval()
+	if err != nil {
+		step.delegate.Failed(err)
+		return err
+	}
+
+	notifier, err := step.delegate.ApprovalNotifier()
+	if err != nil {
+		step.delegate.Failed(err)
+		return err
+	}
+
+	defer notifier.Close()
+
+	close(ready)
+
+	approval, decided, err := step.delegate.Approval()
+	if err != nil {
+		step.delegate.Failed(err)
+		return err
+	}
+
+	if !decided {
+		step.delegate.Waiting()
+	}
+
+	for !decided {
+		select {
+		case <-notifier.Notify():
+			approval, decided, err = step.delegate.Approval()
+			if err != nil {
+				step.delegate.Failed(err)
+				return err
+			}
+
+		case <-signals:
+			return ErrInterrupted
+		}
+	}
+
+	step.approval = approval
+	step.decided = true
+
+	step.delegate.Decided(approval)
+
+	return nil
+}
+
+// Result indicates Success as true if the step was approved.
+//
+// Any other type is ignored.
+func (step *ApproveStep) Result(x interface{}) bool {
+	switch v := x.(type) {
+	case *Success:
+		*v = Success(step.decided && step.approval.Approved)
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,173 @@
+package exec_test
+
+import (
+	"errors"
+	"os"
+
+	"github.com/concourse/atc/dbng/dbngfakes"
+	. "github.com/concourse/atc/exec"
+	"github.com/concourse/atc/exec/execfakes"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApproveStep", func() {
+	var (
+		fakeDelegate *execfakes.FakeApproveDelegate
+		fakeNotifier *dbngfakes.FakeNotifier
+		notify       chan struct{}
+
+		step    Step
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeDelegate = new(execfakes.FakeApproveDelegate)
+
+		notify = make(chan struct{}, 1)
+		fakeNotifier = new(dbngfakes.FakeNotifier)
+		fakeNotifier.NotifyReturns(notify)
+		fakeDelegate.ApprovalNotifierReturns(fakeNotifier, nil)
+	})
+
+	JustBeforeEach(func() {
+		step = Approve(fakeDelegate).Using(nil, nil)
+		process = ifrit.Background(step)
+	})
+
+	It("requests approval", func() {
+		Eventually(fakeDelegate.RequestApprovalCallCount).Should(Equal(1))
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	Context("when no decision has been made yet", func() {
+		It("emits a waiting event and blocks", func() {
+			Eventually(fakeDelegate.WaitingCallCount).Should(Equal(1))
+			Consistently(process.Wait()).ShouldNot(Receive())
+
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+		})
+
+		Context("when the step is approved", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeDelegate.WaitingCallCount).Should(Equal(1))
+
+				fakeDelegate.ApprovalReturns(Approval{
+					Approved: true,
+					Approver: "some-team",
+					Comment:  "ship it",
+				}, true, nil)
+
+				notify <- struct{}{}
+			})
+
+			It("exits successfully", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+
+				var success Success
+				Expect(step.Result(&success)).To(BeTrue())
+				Expect(success).To(BeTrue())
+			})
+
+			It("records the decision", func() {
+				Eventually(process.Wait()).Should(Receive())
+
+				Expect(fakeDelegate.DecidedCallCount()).To(Equal(1))
+				Expect(fakeDelegate.DecidedArgsForCall(0)).To(Equal(Approval{
+					Approved: true,
+					Approver: "some-team",
+					Comment:  "ship it",
+				}))
+			})
+
+			It("closes the notifier", func() {
+				Eventually(process.Wait()).Should(Receive())
+				Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the step is rejected", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeDelegate.WaitingCallCount).Should(Equal(1))
+
+				fakeDelegate.ApprovalReturns(Approval{
+					Approved: false,
+					Approver: "some-team",
+				}, true, nil)
+
+				notify <- struct{}{}
+			})
+
+			It("exits without error but does not succeed", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+
+				var success Success
+				Expect(step.Result(&success)).To(BeTrue())
+				Expect(success).To(BeFalse())
+			})
+		})
+
+		Context("when interrupted", func() {
+			JustBeforeEach(func() {
+				Eventually(fakeDelegate.WaitingCallCount).Should(Equal(1))
+				process.Signal(os.Interrupt)
+			})
+
+			It("exits with ErrInterrupted and does not succeed", func() {
+				Eventually(process.Wait()).Should(Receive(Equal(ErrInterrupted)))
+
+				var success Success
+				Expect(step.Result(&success)).To(BeTrue())
+				Expect(success).To(BeFalse())
+			})
+
+			It("does not record a decision", func() {
+				Eventually(process.Wait()).Should(Receive())
+				Expect(fakeDelegate.DecidedCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the decision was already made", func() {
+		BeforeEach(func() {
+			fakeDelegate.ApprovalReturns(Approval{Approved: true, Approver: "some-team"}, true, nil)
+		})
+
+		It("exits immediately without waiting", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+			Expect(fakeDelegate.WaitingCallCount()).To(BeZero())
+			Expect(fakeDelegate.DecidedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when requesting approval fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeDelegate.RequestApprovalReturns(disaster)
+		})
+
+		It("fails with the error", func() {
+			Eventually(process.Wait()).Should(Receive(Equal(disaster)))
+			Expect(fakeDelegate.FailedCallCount()).To(Equal(1))
+			Expect(fakeDelegate.FailedArgsForCall(0)).To(Equal(disaster))
+		})
+	})
+
+	Context("when looking up the decision fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeDelegate.ApprovalReturns(Approval{}, false, disaster)
+		})
+
+		It("fails with the error", func() {
+			Eventually(process.Wait()).Should(Receive(Equal(disaster)))
+			Expect(fakeDelegate.FailedArgsForCall(0)).To(Equal(disaster))
+		})
+	})
+})
@@ -0,0 +1,71 @@
+package exec
+
+import (
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/blobstore"
+	"github.com/concourse/atc/worker"
+)
+
+// ArchiveArtifacts streams each of the named artifacts out of the repository
+// and into the blob store, so that they outlive the volumes they were
+// produced on.
+//
+// Artifacts that were never registered (e.g. because the task producing them
+// errored) are skipped. A failure to archive one artifact does not prevent the
+// others from being archived; the first error encountered is returned.
+func ArchiveArtifacts(
+	logger lager.Logger,
+	repo *worker.ArtifactRepository,
+	store blobstore.Store,
+	buildID int,
+	names []worker.ArtifactName,
+) error {
+	var archiveErr error
+
+	archived := map[worker.ArtifactName]bool{}
+
+	for _, name := range names {
+		if archived[name] {
+			continue
+		}
+
+		archived[name] = true
+
+		source, found := repo.SourceFor(name)
+		if !found {
+			logger.Info("artifact-not-found", lager.Data{"artifact": name})
+			continue
+		}
+
+		err := source.StreamTo(blobDestination{
+			store:   store,
+			buildID: buildID,
+			name:    string(name),
+		})
+		if err != nil {
+			logger.Error("failed-to-archive-artifact", err, lager.Data{"artifact": name})
+
+			if archiveErr == nil {
+				archiveErr = err
+			}
+
+			continue
+		}
+
+		logger.Debug("archived-artifact", lager.Data{"artifact": name})
+	}
+
+	return archiveErr
+}
+
+type blobDestination struct {
+	store   blobstore.Store
+	buildID int
+	name    string
+}
+
+func (dest blobDestination) StreamIn(path string, tarStream io.Reader) error {
+	return dest.store.Save(dest.buildID, dest.name, tarStream)
+}
@@ -0,0 +1,118 @@
+package exec_test
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
+	. "github.com/concourse/atc/exec"
+	"github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArchiveArtifacts", func() {
+	var (
+		logger    *lagertest.TestLogger
+		repo      *worker.ArtifactRepository
+		fakeStore *blobstorefakes.FakeStore
+
+		someSource  *workerfakes.FakeArtifactSource
+		otherSource *workerfakes.FakeArtifactSource
+
+		names []worker.ArtifactName
+		saved []string
+
+		archiveErr error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		repo = worker.NewArtifactRepository()
+		fakeStore = new(blobstorefakes.FakeStore)
+
+		someSource = new(workerfakes.FakeArtifactSource)
+		someSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+			return dest.StreamIn(".", strings.NewReader("some-tar-stream"))
+		}
+
+		otherSource = new(workerfakes.FakeArtifactSource)
+		otherSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+			return dest.StreamIn(".", strings.NewReader("other-tar-stream"))
+		}
+
+		repo.RegisterSource("some-artifact", someSource)
+		repo.RegisterSource("other-artifact", otherSource)
+		repo.RegisterSource("undeclared-artifact", new(workerfakes.FakeArtifactSource))
+
+		names = []worker.ArtifactName{"some-artifact", "other-artifact"}
+
+		saved = nil
+		fakeStore.SaveStub = func(buildID int, name string, tarStream io.Reader) error {
+			contents, err := ioutil.ReadAll(tarStream)
+			Expect(err).NotTo(HaveOccurred())
+			saved = append(saved, string(contents))
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		archiveErr = ArchiveArtifacts(logger, repo, fakeStore, 42, names)
+	})
+
+	It("saves each named artifact against the build", func() {
+		Expect(archiveErr).NotTo(HaveOccurred())
+		Expect(fakeStore.SaveCallCount()).To(Equal(2))
+
+		buildID, name, _ := fakeStore.SaveArgsForCall(0)
+		Expect(buildID).To(Equal(42))
+		Expect(name).To(Equal("some-artifact"))
+
+		buildID, name, _ = fakeStore.SaveArgsForCall(1)
+		Expect(buildID).To(Equal(42))
+		Expect(name).To(Equal("other-artifact"))
+	})
+
+	It("streams the artifact contents into the store", func() {
+		Expect(saved).To(Equal([]string{"some-tar-stream", "other-tar-stream"}))
+	})
+
+	Context("when a named artifact was never registered", func() {
+		BeforeEach(func() {
+			names = []worker.ArtifactName{"missing-artifact", "other-artifact"}
+		})
+
+		It("skips it and archives the rest", func() {
+			Expect(archiveErr).NotTo(HaveOccurred())
+			Expect(fakeStore.SaveCallCount()).To(Equal(1))
+
+			_, name, _ := fakeStore.SaveArgsForCall(0)
+			Expect(name).To(Equal("other-artifact"))
+		})
+	})
+
+	Context("when streaming an artifact fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			someSource.StreamToReturns(disaster)
+			someSource.StreamToStub = nil
+		})
+
+		It("still archives the remaining artifacts", func() {
+			Expect(fakeStore.SaveCallCount()).To(Equal(1))
+
+			_, name, _ := fakeStore.SaveArgsForCall(0)
+			Expect(name).To(Equal("other-artifact"))
+		})
+
+		It("returns the error", func() {
+			Expect(archiveErr).To(Equal(disaster))
+		})
+	})
+})
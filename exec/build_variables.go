@@ -0,0 +1,205 @@
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/concourse/atc"
+)
+
+var buildVarRegex = regexp.MustCompile(`\(\(\.:([-\w\p{L}]+)((?:\.[-\w\p{L}]+)*)\)\)`)
+
+// UndefinedVarsError is returned when a step refers to build variables that
+// have not been loaded.
+type UndefinedVarsError struct {
+	Names []string
+}
+
+func (err UndefinedVarsError) Error() string {
+	return fmt.Sprintf("undefined build variables: %s", strings.Join(err.Names, ", "))
+}
+
+// BuildVariables are the values loaded by a build's load_var steps, which
+// later steps refer to as ((.:name)), or ((.:name.field)) to reach into a
+// loaded map.
+//
+// A nil *BuildVariables has no variables.
+type BuildVariables struct {
+	lock sync.RWMutex
+	vars map[string]interface{}
+}
+
+func NewBuildVariables() *BuildVariables {
+	return &BuildVariables{
+		vars: map[string]interface{}{},
+	}
+}
+
+// Set sets the named variable, replacing any earlier value.
+func (variables *BuildVariables) Set(name string, value interface{}) {
+	variables.lock.Lock()
+	variables.vars[name] = value
+	variables.lock.Unlock()
+}
+
+// Get returns the value of the named variable, following the given fields
+// into it.
+func (variables *BuildVariables) Get(name string, fields ...string) (interface{}, bool) {
+	if variables == nil {
+		return nil, false
+	}
+
+	variables.lock.RLock()
+	value, found := variables.vars[name]
+	variables.lock.RUnlock()
+
+	if !found {
+		return nil, false
+	}
+
+	for _, field := range fields {
+		nested, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, found = nested[field]
+		if !found {
+			return nil, false
+		}
+	}
+
+	return value, true
+}
+
+// Interpolate replaces each ((.:name)) in the given value, which may be a
+// string or maps and slices of them, as found in sources and params. A string
+// consisting of nothing but a variable is replaced by the value itself;
+// variables within longer strings are interpolated as text, with values
+// other than strings formatted as JSON.
+//
+// If any of the variables have not been set, UndefinedVarsError is returned.
+func (variables *BuildVariables) Interpolate(value interface{}) (interface{}, error) {
+	undefined := map[string]bool{}
+
+	interpolated := variables.interpolate(value, undefined)
+
+	if len(undefined) > 0 {
+		names := []string{}
+		for name := range undefined {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, UndefinedVarsError{Names: names}
+	}
+
+	return interpolated, nil
+}
+
+// InterpolateSource interpolates the variables in a resource's source.
+func (variables *BuildVariables) InterpolateSource(source atc.Source) (atc.Source, error) {
+	if source == nil {
+		return nil, nil
+	}
+
+	interpolated, err := variables.Interpolate(map[string]interface{}(source))
+	if err != nil {
+		return nil, err
+	}
+
+	return atc.Source(interpolated.(map[string]interface{})), nil
+}
+
+// InterpolateParams interpolates the variables in a step's params.
+func (variables *BuildVariables) InterpolateParams(params atc.Params) (atc.Params, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	interpolated, err := variables.Interpolate(map[string]interface{}(params))
+	if err != nil {
+		return nil, err
+	}
+
+	return atc.Params(interpolated.(map[string]interface{})), nil
+}
+
+func (variables *BuildVariables) interpolate(node interface{}, undefined map[string]bool) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		interpolated := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			interpolated[k] = variables.interpolate(v, undefined)
+		}
+
+		return interpolated
+
+	case atc.Source:
+		return variables.interpolate(map[string]interface{}(n), undefined)
+
+	case atc.Params:
+		return variables.interpolate(map[string]interface{}(n), undefined)
+
+	case []interface{}:
+		interpolated := make([]interface{}, len(n))
+		for i, v := range n {
+			interpolated[i] = variables.interpolate(v, undefined)
+		}
+
+		return interpolated
+
+	case string:
+		if match := buildVarRegex.FindStringSubmatch(n); match != nil && match[0] == n {
+			value, found := variables.lookup(match)
+			if !found {
+				undefined[reference(match)] = true
+				return n
+			}
+
+			return value
+		}
+
+		return buildVarRegex.ReplaceAllStringFunc(n, func(ref string) string {
+			match := buildVarRegex.FindStringSubmatch(ref)
+
+			value, found := variables.lookup(match)
+			if !found {
+				undefined[reference(match)] = true
+				return ref
+			}
+
+			if str, ok := value.(string); ok {
+				return str
+			}
+
+			payload, err := json.Marshal(value)
+			if err != nil {
+				return fmt.Sprintf("%v", value)
+			}
+
+			return string(payload)
+		})
+
+	default:
+		return node
+	}
+}
+
+func (variables *BuildVariables) lookup(match []string) (interface{}, bool) {
+	var fields []string
+	if match[2] != "" {
+		fields = strings.Split(match[2][1:], ".")
+	}
+
+	return variables.Get(match[1], fields...)
+}
+
+func reference(match []string) string {
+	return match[1] + match[2]
+}
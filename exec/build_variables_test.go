@@ -0,0 +1,120 @@
+package exec_test
+
+import (
+	"github.com/concourse/atc"
+	. "github.com/concourse/atc/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var variables *BuildVariables
+
+	BeforeEach(func() {
+		variables = NewBuildVariables()
+
+		variables.Set("version", "1.2.3")
+		variables.Set("release", map[string]interface{}{
+			"url": "https://example.com/release.tgz",
+			"tags": []interface{}{
+				"latest",
+				"stable",
+			},
+		})
+	})
+
+	Describe("Get", func() {
+		It("returns the value of the variable", func() {
+			value, found := variables.Get("version")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("1.2.3"))
+		})
+
+		It("follows fields into maps", func() {
+			value, found := variables.Get("release", "url")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("https://example.com/release.tgz"))
+		})
+
+		It("does not find fields of values which are not maps", func() {
+			_, found := variables.Get("version", "major")
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not find variables which are not set", func() {
+			_, found := variables.Get("bogus")
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when there are no variables", func() {
+			BeforeEach(func() {
+				variables = nil
+			})
+
+			It("finds nothing", func() {
+				_, found := variables.Get("version")
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Interpolate", func() {
+		It("replaces a string consisting of nothing but a variable with the value itself", func() {
+			Expect(variables.Interpolate("((.:release.tags))")).To(Equal([]interface{}{"latest", "stable"}))
+		})
+
+		It("interpolates variables within longer strings as text", func() {
+			Expect(variables.Interpolate("v((.:version)) at ((.:release.url))")).To(Equal("v1.2.3 at https://example.com/release.tgz"))
+		})
+
+		It("interpolates values which are not strings within longer strings as JSON", func() {
+			Expect(variables.Interpolate("tags: ((.:release.tags))")).To(Equal(`tags: ["latest","stable"]`))
+		})
+
+		It("interpolates into maps and slices", func() {
+			Expect(variables.Interpolate(map[string]interface{}{
+				"version": "((.:version))",
+				"nested": []interface{}{
+					map[string]interface{}{"url": "((.:release.url))"},
+					true,
+				},
+			})).To(Equal(map[string]interface{}{
+				"version": "1.2.3",
+				"nested": []interface{}{
+					map[string]interface{}{"url": "https://example.com/release.tgz"},
+					true,
+				},
+			}))
+		})
+
+		It("leaves pipeline template params alone", func() {
+			Expect(variables.Interpolate("((version))")).To(Equal("((version))"))
+		})
+
+		It("returns an error naming the variables which are not set", func() {
+			_, err := variables.Interpolate([]interface{}{"((.:bogus))", "a ((.:release.bogus)) b", "((.:bogus))"})
+			Expect(err).To(Equal(UndefinedVarsError{Names: []string{"bogus", "release.bogus"}}))
+		})
+	})
+
+	Describe("InterpolateSource", func() {
+		It("interpolates the source", func() {
+			Expect(variables.InterpolateSource(atc.Source{"tag": "((.:version))"})).To(Equal(atc.Source{"tag": "1.2.3"}))
+		})
+
+		It("leaves a nil source nil", func() {
+			Expect(variables.InterpolateSource(nil)).To(BeNil())
+		})
+	})
+
+	Describe("InterpolateParams", func() {
+		It("interpolates the params", func() {
+			Expect(variables.InterpolateParams(atc.Params{"tag": "((.:version))"})).To(Equal(atc.Params{"tag": "1.2.3"}))
+		})
+
+		It("leaves nil params nil", func() {
+			Expect(variables.InterpolateParams(nil)).To(BeNil())
+		})
+	})
+})
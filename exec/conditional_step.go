@@ -0,0 +1,79 @@
+package exec
+
+import (
+	"os"
+
+	"github.com/concourse/atc/condition"
+	"github.com/concourse/atc/worker"
+)
+
+// ConditionalStep runs another step only if its condition holds once the
+// step is reached.
+type ConditionalStep struct {
+	condition string
+	delegate  ConditionalDelegate
+	step      StepFactory
+	runStep   Step
+	skipped   bool
+}
+
+// Conditional constructs a ConditionalStep factory.
+func Conditional(
+	condition string,
+	delegate ConditionalDelegate,
+	step StepFactory,
+) ConditionalStep {
+	return ConditionalStep{
+		condition: condition,
+		delegate:  delegate,
+		step:      step,
+	}
+}
+
+// Using constructs a *ConditionalStep.
+func (cs ConditionalStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
+	cs.runStep = cs.step.Using(prev, repo)
+	return &cs
+}
+
+// Run evaluates the condition against the delegate's variables. If it holds,
+// the nested step is run. Otherwise the delegate is told that the step was
+// skipped, and nil is returned.
+//
+// An error is returned if the condition cannot be evaluated.
+func (cs *ConditionalStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	cond, err := condition.Parse(cs.condition)
+	if err != nil {
+		return err
+	}
+
+	holds, err := cond.Eval(cs.delegate.Variables())
+	if err != nil {
+		return err
+	}
+
+	if !holds {
+		cs.skipped = true
+		cs.delegate.Skipped()
+		close(ready)
+		return nil
+	}
+
+	return cs.runStep.Run(signals, ready)
+}
+
+// Result indicates Success as true if the step was skipped, so that the steps
+// after it still run. Otherwise it delegates to the nested step.
+func (cs *ConditionalStep) Result(x interface{}) bool {
+	if !cs.skipped {
+		return cs.runStep.Result(x)
+	}
+
+	switch v := x.(type) {
+	case *Success:
+		*v = Success(true)
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,128 @@
+package exec_test
+
+import (
+	"errors"
+
+	"github.com/concourse/atc/condition"
+	. "github.com/concourse/atc/exec"
+	"github.com/tedsuo/ifrit"
+
+	"github.com/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Conditional Step", func() {
+	var (
+		fakeStepFactory *execfakes.FakeStepFactory
+		runStep         *execfakes.FakeStep
+		fakeDelegate    *execfakes.FakeConditionalDelegate
+
+		expression string
+
+		step    Step
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeStepFactory = new(execfakes.FakeStepFactory)
+		runStep = new(execfakes.FakeStep)
+		fakeStepFactory.UsingReturns(runStep)
+
+		fakeDelegate = new(execfakes.FakeConditionalDelegate)
+		fakeDelegate.VariablesReturns(condition.Vars{
+			"build": map[string]interface{}{
+				"trigger": "manual",
+			},
+		})
+
+		expression = `build.trigger == "manual"`
+	})
+
+	JustBeforeEach(func() {
+		step = Conditional(expression, fakeDelegate, fakeStepFactory).Using(nil, nil)
+		process = ifrit.Invoke(step)
+	})
+
+	Context("when the condition holds", func() {
+		It("runs the nested step", func() {
+			Expect(<-process.Wait()).To(Succeed())
+			Expect(runStep.RunCallCount()).To(Equal(1))
+		})
+
+		It("does not tell the delegate the step was skipped", func() {
+			<-process.Wait()
+			Expect(fakeDelegate.SkippedCallCount()).To(BeZero())
+		})
+
+		It("delegates Result to the nested step", func() {
+			<-process.Wait()
+
+			runStep.ResultStub = successResult(false)
+
+			var succeeded Success
+			Expect(step.Result(&succeeded)).To(BeTrue())
+			Expect(bool(succeeded)).To(BeFalse())
+		})
+
+		Context("when the nested step errors", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				runStep.RunReturns(disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(<-process.Wait()).To(Equal(disaster))
+			})
+		})
+	})
+
+	Context("when the condition does not hold", func() {
+		BeforeEach(func() {
+			expression = `build.trigger != "manual"`
+		})
+
+		It("does not run the nested step", func() {
+			Expect(<-process.Wait()).To(Succeed())
+			Expect(runStep.RunCallCount()).To(BeZero())
+		})
+
+		It("tells the delegate the step was skipped", func() {
+			<-process.Wait()
+			Expect(fakeDelegate.SkippedCallCount()).To(Equal(1))
+		})
+
+		It("indicates Success as true", func() {
+			<-process.Wait()
+
+			var succeeded Success
+			Expect(step.Result(&succeeded)).To(BeTrue())
+			Expect(bool(succeeded)).To(BeTrue())
+		})
+
+		It("has no other results", func() {
+			<-process.Wait()
+
+			var info VersionInfo
+			Expect(step.Result(&info)).To(BeFalse())
+			Expect(runStep.ResultCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the condition cannot be evaluated", func() {
+		BeforeEach(func() {
+			expression = `build.trigger =~ build.pattern`
+			fakeDelegate.VariablesReturns(condition.Vars{
+				"build": map[string]interface{}{
+					"pattern": "(",
+				},
+			})
+		})
+
+		It("returns an error without running the nested step", func() {
+			Expect(<-process.Wait()).To(MatchError(ContainSubstring("invalid regular expression")))
+			Expect(runStep.RunCallCount()).To(BeZero())
+		})
+	})
+})
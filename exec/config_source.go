@@ -218,6 +218,83 @@ func (configSource ValidatingConfigSource) Warnings() []string {
 	return configSource.ConfigSource.Warnings()
 }
 
+// InterpolatedConfigSource delegates to another ConfigSource, and interpolates
+// build variables into its task config.
+type InterpolatedConfigSource struct {
+	ConfigSource TaskConfigSource
+	Variables    *BuildVariables
+}
+
+// FetchConfig fetches the config using the underlying ConfigSource, and
+// replaces each ((.:name)) in it with the variable's value. Params are always
+// strings, so values of variables which are not strings are given to them as
+// JSON.
+func (configSource InterpolatedConfigSource) FetchConfig(source *worker.ArtifactRepository) (atc.TaskConfig, error) {
+	config, err := configSource.ConfigSource.FetchConfig(source)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	params := config.Params
+	config.Params = nil
+
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	var structure interface{}
+	err = json.Unmarshal(payload, &structure)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	interpolated, err := configSource.Variables.Interpolate(structure)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	payload, err = json.Marshal(interpolated)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	var interpolatedConfig atc.TaskConfig
+	err = json.Unmarshal(payload, &interpolatedConfig)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("failed to interpolate build variables: %s", err)
+	}
+
+	if params != nil {
+		interpolatedConfig.Params = make(map[string]string, len(params))
+
+		for key, val := range params {
+			interpolatedVal, err := configSource.Variables.Interpolate(val)
+			if err != nil {
+				return atc.TaskConfig{}, err
+			}
+
+			if str, ok := interpolatedVal.(string); ok {
+				interpolatedConfig.Params[key] = str
+				continue
+			}
+
+			bs, err := json.Marshal(interpolatedVal)
+			if err != nil {
+				return atc.TaskConfig{}, err
+			}
+
+			interpolatedConfig.Params[key] = string(bs)
+		}
+	}
+
+	return interpolatedConfig, nil
+}
+
+func (configSource InterpolatedConfigSource) Warnings() []string {
+	return configSource.ConfigSource.Warnings()
+}
+
 // UnknownArtifactSourceError is returned when the worker.ArtifactName specified by the
 // path does not exist in the worker.ArtifactRepository.
 type UnknownArtifactSourceError struct {
@@ -549,4 +549,100 @@ run: {path: a/file}
 			})
 		})
 	})
+
+	Describe("InterpolatedConfigSource", func() {
+		var (
+			fakeConfigSource *execfakes.FakeTaskConfigSource
+			variables        *BuildVariables
+
+			configSource TaskConfigSource
+
+			fetchedConfig atc.TaskConfig
+			fetchErr      error
+		)
+
+		BeforeEach(func() {
+			fakeConfigSource = new(execfakes.FakeTaskConfigSource)
+			variables = NewBuildVariables()
+
+			configSource = InterpolatedConfigSource{
+				ConfigSource: fakeConfigSource,
+				Variables:    variables,
+			}
+		})
+
+		JustBeforeEach(func() {
+			fetchedConfig, fetchErr = configSource.FetchConfig(repo)
+		})
+
+		Context("when the config refers to build variables", func() {
+			BeforeEach(func() {
+				variables.Set("version", "1.2.3")
+				variables.Set("release", map[string]interface{}{
+					"url":    "https://example.com/release.tgz",
+					"number": float64(42),
+				})
+
+				fakeConfigSource.FetchConfigReturns(atc.TaskConfig{
+					Platform:  "some-platform",
+					RootFsUri: "some-image:((.:version))",
+					Params: map[string]string{
+						"VERSION": "((.:version))",
+						"URL":     "((.:release.url))",
+						"NUMBER":  "((.:release.number))",
+						"RELEASE": "((.:release))",
+					},
+					Run: atc.TaskRunConfig{
+						Path: "echo",
+						Args: []string{"v((.:version))"},
+					},
+				}, nil)
+			})
+
+			It("interpolates them", func() {
+				Expect(fetchErr).ToNot(HaveOccurred())
+				Expect(fetchedConfig).To(Equal(atc.TaskConfig{
+					Platform:  "some-platform",
+					RootFsUri: "some-image:1.2.3",
+					Params: map[string]string{
+						"VERSION": "1.2.3",
+						"URL":     "https://example.com/release.tgz",
+						"NUMBER":  "42",
+						"RELEASE": `{"number":42,"url":"https://example.com/release.tgz"}`,
+					},
+					Run: atc.TaskRunConfig{
+						Path: "echo",
+						Args: []string{"v1.2.3"},
+					},
+				}))
+			})
+		})
+
+		Context("when the config refers to build variables that are not set", func() {
+			BeforeEach(func() {
+				fakeConfigSource.FetchConfigReturns(atc.TaskConfig{
+					Platform: "some-platform",
+					Run: atc.TaskRunConfig{
+						Path: "((.:path))",
+					},
+				}, nil)
+			})
+
+			It("returns an error naming them", func() {
+				Expect(fetchErr).To(Equal(UndefinedVarsError{Names: []string{"path"}}))
+			})
+		})
+
+		Context("when fetching the config fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeConfigSource.FetchConfigReturns(atc.TaskConfig{}, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(fetchErr).To(Equal(disaster))
+			})
+		})
+	})
 })
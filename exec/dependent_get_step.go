@@ -19,6 +19,7 @@ type DependentGetStep struct {
 	stepMetadata           StepMetadata
 	session                resource.Session
 	tags                   atc.Tags
+	placement              *atc.WorkerPlacement
 	teamID                 int
 	buildID                int
 	delegate               ResourceDelegate
@@ -35,6 +36,7 @@ func newDependentGetStep(
 	stepMetadata StepMetadata,
 	session resource.Session,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	teamID int,
 	buildID int,
 	delegate ResourceDelegate,
@@ -50,6 +52,7 @@ func newDependentGetStep(
 		stepMetadata:           stepMetadata,
 		session:                session,
 		tags:                   tags,
+		placement:              placement,
 		teamID:                 teamID,
 		buildID:                buildID,
 		delegate:               delegate,
@@ -71,21 +74,15 @@ func (step DependentGetStep) Using(prev Step, repo *worker.ArtifactRepository) S
 		step.resourceConfig,
 		info.Version,
 		step.params,
-		resource.NewResourceInstance(
-			resource.ResourceType(step.resourceConfig.Type),
-			info.Version,
-			step.resourceConfig.Source,
-			step.params,
-			dbng.ForBuild(step.buildID),
-			step.resourceTypes,
-			step.dbResourceCacheFactory,
-		),
 		step.stepMetadata,
 		step.session,
 		step.tags,
+		step.placement,
 		step.teamID,
+		step.buildID,
 		step.delegate,
 		step.resourceFetcher,
 		step.resourceTypes,
+		step.dbResourceCacheFactory,
 	).Using(prev, repo)
 }
@@ -38,6 +38,7 @@ var _ = Describe("DependentGet", func() {
 		params                     atc.Params
 		version                    atc.Version
 		tags                       []string
+		placement                  *atc.WorkerPlacement
 		resourceTypes              atc.VersionedResourceTypes
 
 		inStep *execfakes.FakeStep
@@ -90,6 +91,11 @@ var _ = Describe("DependentGet", func() {
 		version = atc.Version{"some-version": "some-value"}
 
 		tags = []string{"some", "tags"}
+		placement = &atc.WorkerPlacement{
+			Selectors: []atc.WorkerSelector{
+				{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+			},
+		}
 
 		resourceTypes = atc.VersionedResourceTypes{
 			{
@@ -135,6 +141,7 @@ var _ = Describe("DependentGet", func() {
 			getDelegate,
 			resourceConfig,
 			tags,
+			placement,
 			params,
 			resourceTypes,
 		).Using(inStep, repo)
@@ -154,6 +161,8 @@ var _ = Describe("DependentGet", func() {
 				lager.Logger,
 				resource.Session,
 				atc.Tags,
+				*atc.WorkerPlacement,
+				[]string,
 				int,
 				atc.VersionedResourceTypes,
 				resource.ResourceInstance,
@@ -184,12 +193,13 @@ var _ = Describe("DependentGet", func() {
 
 		It("initializes the resource with the correct type and session id, making sure that it is not ephemeral", func() {
 			Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-			_, sid, tags, actualTeamID, actualResourceTypes, cacheID, sm, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+			_, sid, tags, actualPlacement, _, actualTeamID, actualResourceTypes, cacheID, sm, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
 			Expect(sm).To(Equal(stepMetadata))
 			Expect(sid).To(Equal(resource.Session{
 				Metadata: workerMetadata,
 			}))
 			Expect(tags).To(ConsistOf("some", "tags"))
+			Expect(actualPlacement).To(Equal(placement))
 			Expect(actualTeamID).To(Equal(teamID))
 			Expect(cacheID).To(Equal(resource.NewResourceInstance(
 				"some-resource-type",
@@ -88,3 +88,19 @@ func (o *EnsureStep) Result(x interface{}) bool {
 		return false
 	}
 }
+
+// WorkerName forwards to the nested step if it is a WorkerPlacedStep.
+func (o *EnsureStep) WorkerName() (string, bool) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		return placed.WorkerName()
+	}
+
+	return "", false
+}
+
+// AvoidWorkers forwards to the nested step if it is a WorkerPlacedStep.
+func (o *EnsureStep) AvoidWorkers(workerNames []string) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		placed.AvoidWorkers(workerNames)
+	}
+}
@@ -16,3 +16,19 @@ func (reporter errorReporter) Run(signals <-chan os.Signal, ready chan<- struct{
 
 	return err
 }
+
+// WorkerName forwards to the wrapped step if it is a WorkerPlacedStep.
+func (reporter errorReporter) WorkerName() (string, bool) {
+	if placed, ok := reporter.Step.(WorkerPlacedStep); ok {
+		return placed.WorkerName()
+	}
+
+	return "", false
+}
+
+// AvoidWorkers forwards to the wrapped step if it is a WorkerPlacedStep.
+func (reporter errorReporter) AvoidWorkers(workerNames []string) {
+	if placed, ok := reporter.Step.(WorkerPlacedStep); ok {
+		placed.AvoidWorkers(workerNames)
+	}
+}
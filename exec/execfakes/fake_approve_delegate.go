@@ -0,0 +1,285 @@
+// This file was generated by counterfeiter
+package execfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/exec"
+)
+
+type FakeApproveDelegate struct {
+	RequestApprovalStub        func() error
+	requestApprovalMutex       sync.RWMutex
+	requestApprovalArgsForCall []struct{}
+	requestApprovalReturns     struct {
+		result1 error
+	}
+	requestApprovalReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ApprovalStub        func() (exec.Approval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct{}
+	approvalReturns     struct {
+		result1 exec.Approval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 exec.Approval
+		result2 bool
+		result3 error
+	}
+	ApprovalNotifierStub        func() (dbng.Notifier, error)
+	approvalNotifierMutex       sync.RWMutex
+	approvalNotifierArgsForCall []struct{}
+	approvalNotifierReturns     struct {
+		result1 dbng.Notifier
+		result2 error
+	}
+	approvalNotifierReturnsOnCall map[int]struct {
+		result1 dbng.Notifier
+		result2 error
+	}
+	WaitingStub        func()
+	waitingMutex       sync.RWMutex
+	waitingArgsForCall []struct{}
+	DecidedStub        func(exec.Approval)
+	decidedMutex       sync.RWMutex
+	decidedArgsForCall []struct {
+		arg1 exec.Approval
+	}
+	FailedStub        func(error)
+	failedMutex       sync.RWMutex
+	failedArgsForCall []struct {
+		arg1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApproveDelegate) RequestApproval() error {
+	fake.requestApprovalMutex.Lock()
+	ret, specificReturn := fake.requestApprovalReturnsOnCall[len(fake.requestApprovalArgsForCall)]
+	fake.requestApprovalArgsForCall = append(fake.requestApprovalArgsForCall, struct{}{})
+	fake.recordInvocation("RequestApproval", []interface{}{})
+	fake.requestApprovalMutex.Unlock()
+	if fake.RequestApprovalStub != nil {
+		return fake.RequestApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.requestApprovalReturns.result1
+}
+
+func (fake *FakeApproveDelegate) RequestApprovalCallCount() int {
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	return len(fake.requestApprovalArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) RequestApprovalReturns(result1 error) {
+	fake.RequestApprovalStub = nil
+	fake.requestApprovalReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApproveDelegate) RequestApprovalReturnsOnCall(i int, result1 error) {
+	fake.RequestApprovalStub = nil
+	if fake.requestApprovalReturnsOnCall == nil {
+		fake.requestApprovalReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.requestApprovalReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeApproveDelegate) Approval() (exec.Approval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct{}{})
+	fake.recordInvocation("Approval", []interface{}{})
+	fake.approvalMutex.Unlock()
+	if fake.ApprovalStub != nil {
+		return fake.ApprovalStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.approvalReturns.result1, fake.approvalReturns.result2, fake.approvalReturns.result3
+}
+
+func (fake *FakeApproveDelegate) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) ApprovalReturns(result1 exec.Approval, result2 bool, result3 error) {
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 exec.Approval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApproveDelegate) ApprovalReturnsOnCall(i int, result1 exec.Approval, result2 bool, result3 error) {
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 exec.Approval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 exec.Approval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApproveDelegate) ApprovalNotifier() (dbng.Notifier, error) {
+	fake.approvalNotifierMutex.Lock()
+	ret, specificReturn := fake.approvalNotifierReturnsOnCall[len(fake.approvalNotifierArgsForCall)]
+	fake.approvalNotifierArgsForCall = append(fake.approvalNotifierArgsForCall, struct{}{})
+	fake.recordInvocation("ApprovalNotifier", []interface{}{})
+	fake.approvalNotifierMutex.Unlock()
+	if fake.ApprovalNotifierStub != nil {
+		return fake.ApprovalNotifierStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.approvalNotifierReturns.result1, fake.approvalNotifierReturns.result2
+}
+
+func (fake *FakeApproveDelegate) ApprovalNotifierCallCount() int {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return len(fake.approvalNotifierArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) ApprovalNotifierReturns(result1 dbng.Notifier, result2 error) {
+	fake.ApprovalNotifierStub = nil
+	fake.approvalNotifierReturns = struct {
+		result1 dbng.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApproveDelegate) ApprovalNotifierReturnsOnCall(i int, result1 dbng.Notifier, result2 error) {
+	fake.ApprovalNotifierStub = nil
+	if fake.approvalNotifierReturnsOnCall == nil {
+		fake.approvalNotifierReturnsOnCall = make(map[int]struct {
+			result1 dbng.Notifier
+			result2 error
+		})
+	}
+	fake.approvalNotifierReturnsOnCall[i] = struct {
+		result1 dbng.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApproveDelegate) Waiting() {
+	fake.waitingMutex.Lock()
+	fake.waitingArgsForCall = append(fake.waitingArgsForCall, struct{}{})
+	fake.recordInvocation("Waiting", []interface{}{})
+	fake.waitingMutex.Unlock()
+	if fake.WaitingStub != nil {
+		fake.WaitingStub()
+	}
+}
+
+func (fake *FakeApproveDelegate) WaitingCallCount() int {
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	return len(fake.waitingArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) Decided(arg1 exec.Approval) {
+	fake.decidedMutex.Lock()
+	fake.decidedArgsForCall = append(fake.decidedArgsForCall, struct {
+		arg1 exec.Approval
+	}{arg1})
+	fake.recordInvocation("Decided", []interface{}{arg1})
+	fake.decidedMutex.Unlock()
+	if fake.DecidedStub != nil {
+		fake.DecidedStub(arg1)
+	}
+}
+
+func (fake *FakeApproveDelegate) DecidedCallCount() int {
+	fake.decidedMutex.RLock()
+	defer fake.decidedMutex.RUnlock()
+	return len(fake.decidedArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) DecidedArgsForCall(i int) exec.Approval {
+	fake.decidedMutex.RLock()
+	defer fake.decidedMutex.RUnlock()
+	return fake.decidedArgsForCall[i].arg1
+}
+
+func (fake *FakeApproveDelegate) Failed(arg1 error) {
+	fake.failedMutex.Lock()
+	fake.failedArgsForCall = append(fake.failedArgsForCall, struct {
+		arg1 error
+	}{arg1})
+	fake.recordInvocation("Failed", []interface{}{arg1})
+	fake.failedMutex.Unlock()
+	if fake.FailedStub != nil {
+		fake.FailedStub(arg1)
+	}
+}
+
+func (fake *FakeApproveDelegate) FailedCallCount() int {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return len(fake.failedArgsForCall)
+}
+
+func (fake *FakeApproveDelegate) FailedArgsForCall(i int) error {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return fake.failedArgsForCall[i].arg1
+}
+
+func (fake *FakeApproveDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.requestApprovalMutex.RLock()
+	defer fake.requestApprovalMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	fake.waitingMutex.RLock()
+	defer fake.waitingMutex.RUnlock()
+	fake.decidedMutex.RLock()
+	defer fake.decidedMutex.RUnlock()
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeApproveDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.ApproveDelegate = new(FakeApproveDelegate)
@@ -0,0 +1,106 @@
+// This file was generated by counterfeiter
+package execfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc/condition"
+	"github.com/concourse/atc/exec"
+)
+
+type FakeConditionalDelegate struct {
+	VariablesStub        func() condition.Vars
+	variablesMutex       sync.RWMutex
+	variablesArgsForCall []struct{}
+	variablesReturns     struct {
+		result1 condition.Vars
+	}
+	variablesReturnsOnCall map[int]struct {
+		result1 condition.Vars
+	}
+	SkippedStub        func()
+	skippedMutex       sync.RWMutex
+	skippedArgsForCall []struct{}
+	invocations        map[string][][]interface{}
+	invocationsMutex   sync.RWMutex
+}
+
+func (fake *FakeConditionalDelegate) Variables() condition.Vars {
+	fake.variablesMutex.Lock()
+	ret, specificReturn := fake.variablesReturnsOnCall[len(fake.variablesArgsForCall)]
+	fake.variablesArgsForCall = append(fake.variablesArgsForCall, struct{}{})
+	fake.recordInvocation("Variables", []interface{}{})
+	fake.variablesMutex.Unlock()
+	if fake.VariablesStub != nil {
+		return fake.VariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.variablesReturns.result1
+}
+
+func (fake *FakeConditionalDelegate) VariablesCallCount() int {
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	return len(fake.variablesArgsForCall)
+}
+
+func (fake *FakeConditionalDelegate) VariablesReturns(result1 condition.Vars) {
+	fake.VariablesStub = nil
+	fake.variablesReturns = struct {
+		result1 condition.Vars
+	}{result1}
+}
+
+func (fake *FakeConditionalDelegate) VariablesReturnsOnCall(i int, result1 condition.Vars) {
+	fake.VariablesStub = nil
+	if fake.variablesReturnsOnCall == nil {
+		fake.variablesReturnsOnCall = make(map[int]struct {
+			result1 condition.Vars
+		})
+	}
+	fake.variablesReturnsOnCall[i] = struct {
+		result1 condition.Vars
+	}{result1}
+}
+
+func (fake *FakeConditionalDelegate) Skipped() {
+	fake.skippedMutex.Lock()
+	fake.skippedArgsForCall = append(fake.skippedArgsForCall, struct{}{})
+	fake.recordInvocation("Skipped", []interface{}{})
+	fake.skippedMutex.Unlock()
+	if fake.SkippedStub != nil {
+		fake.SkippedStub()
+	}
+}
+
+func (fake *FakeConditionalDelegate) SkippedCallCount() int {
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	return len(fake.skippedArgsForCall)
+}
+
+func (fake *FakeConditionalDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.variablesMutex.RLock()
+	defer fake.variablesMutex.RUnlock()
+	fake.skippedMutex.RLock()
+	defer fake.skippedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeConditionalDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.ConditionalDelegate = new(FakeConditionalDelegate)
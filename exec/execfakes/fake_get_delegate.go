@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/atc/exec"
 	"github.com/concourse/atc/worker"
@@ -13,6 +14,9 @@ type FakeGetDelegate struct {
 	InitializingStub        func()
 	initializingMutex       sync.RWMutex
 	initializingArgsForCall []struct{}
+	StartedStub             func()
+	startedMutex            sync.RWMutex
+	startedArgsForCall      []struct{}
 	CompletedStub           func(exec.ExitStatus, *exec.VersionInfo)
 	completedMutex          sync.RWMutex
 	completedArgsForCall    []struct {
@@ -35,6 +39,26 @@ type FakeGetDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ImageFetchedStub        func(worker.StreamStats)
+	imageFetchedMutex       sync.RWMutex
+	imageFetchedArgsForCall []struct {
+		arg1 worker.StreamStats
+	}
+	VolumeCreatedStub        func(string, time.Duration)
+	volumeCreatedMutex       sync.RWMutex
+	volumeCreatedArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	BuildVariablesStub        func() *exec.BuildVariables
+	buildVariablesMutex       sync.RWMutex
+	buildVariablesArgsForCall []struct{}
+	buildVariablesReturns     struct {
+		result1 *exec.BuildVariables
+	}
+	buildVariablesReturnsOnCall map[int]struct {
+		result1 *exec.BuildVariables
+	}
 	StdoutStub        func() io.Writer
 	stdoutMutex       sync.RWMutex
 	stdoutArgsForCall []struct{}
@@ -73,6 +97,22 @@ func (fake *FakeGetDelegate) InitializingCallCount() int {
 	return len(fake.initializingArgsForCall)
 }
 
+func (fake *FakeGetDelegate) Started() {
+	fake.startedMutex.Lock()
+	fake.startedArgsForCall = append(fake.startedArgsForCall, struct{}{})
+	fake.recordInvocation("Started", []interface{}{})
+	fake.startedMutex.Unlock()
+	if fake.StartedStub != nil {
+		fake.StartedStub()
+	}
+}
+
+func (fake *FakeGetDelegate) StartedCallCount() int {
+	fake.startedMutex.RLock()
+	defer fake.startedMutex.RUnlock()
+	return len(fake.startedArgsForCall)
+}
+
 func (fake *FakeGetDelegate) Completed(arg1 exec.ExitStatus, arg2 *exec.VersionInfo) {
 	fake.completedMutex.Lock()
 	fake.completedArgsForCall = append(fake.completedArgsForCall, struct {
@@ -170,6 +210,95 @@ func (fake *FakeGetDelegate) ImageVersionDeterminedReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeGetDelegate) ImageFetched(arg1 worker.StreamStats) {
+	fake.imageFetchedMutex.Lock()
+	fake.imageFetchedArgsForCall = append(fake.imageFetchedArgsForCall, struct {
+		arg1 worker.StreamStats
+	}{arg1})
+	fake.recordInvocation("ImageFetched", []interface{}{arg1})
+	fake.imageFetchedMutex.Unlock()
+	if fake.ImageFetchedStub != nil {
+		fake.ImageFetchedStub(arg1)
+	}
+}
+
+func (fake *FakeGetDelegate) ImageFetchedCallCount() int {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return len(fake.imageFetchedArgsForCall)
+}
+
+func (fake *FakeGetDelegate) ImageFetchedArgsForCall(i int) worker.StreamStats {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return fake.imageFetchedArgsForCall[i].arg1
+}
+
+func (fake *FakeGetDelegate) VolumeCreated(arg1 string, arg2 time.Duration) {
+	fake.volumeCreatedMutex.Lock()
+	fake.volumeCreatedArgsForCall = append(fake.volumeCreatedArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("VolumeCreated", []interface{}{arg1, arg2})
+	fake.volumeCreatedMutex.Unlock()
+	if fake.VolumeCreatedStub != nil {
+		fake.VolumeCreatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeGetDelegate) VolumeCreatedCallCount() int {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return len(fake.volumeCreatedArgsForCall)
+}
+
+func (fake *FakeGetDelegate) VolumeCreatedArgsForCall(i int) (string, time.Duration) {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return fake.volumeCreatedArgsForCall[i].arg1, fake.volumeCreatedArgsForCall[i].arg2
+}
+
+func (fake *FakeGetDelegate) BuildVariables() *exec.BuildVariables {
+	fake.buildVariablesMutex.Lock()
+	ret, specificReturn := fake.buildVariablesReturnsOnCall[len(fake.buildVariablesArgsForCall)]
+	fake.buildVariablesArgsForCall = append(fake.buildVariablesArgsForCall, struct{}{})
+	fake.recordInvocation("BuildVariables", []interface{}{})
+	fake.buildVariablesMutex.Unlock()
+	if fake.BuildVariablesStub != nil {
+		return fake.BuildVariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.buildVariablesReturns.result1
+}
+
+func (fake *FakeGetDelegate) BuildVariablesCallCount() int {
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
+	return len(fake.buildVariablesArgsForCall)
+}
+
+func (fake *FakeGetDelegate) BuildVariablesReturns(result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	fake.buildVariablesReturns = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
+func (fake *FakeGetDelegate) BuildVariablesReturnsOnCall(i int, result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	if fake.buildVariablesReturnsOnCall == nil {
+		fake.buildVariablesReturnsOnCall = make(map[int]struct {
+			result1 *exec.BuildVariables
+		})
+	}
+	fake.buildVariablesReturnsOnCall[i] = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
 func (fake *FakeGetDelegate) Stdout() io.Writer {
 	fake.stdoutMutex.Lock()
 	ret, specificReturn := fake.stdoutReturnsOnCall[len(fake.stdoutArgsForCall)]
@@ -255,12 +384,20 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.startedMutex.RLock()
+	defer fake.startedMutex.RUnlock()
 	fake.completedMutex.RLock()
 	defer fake.completedMutex.RUnlock()
 	fake.failedMutex.RLock()
 	defer fake.failedMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
 	fake.stderrMutex.RLock()
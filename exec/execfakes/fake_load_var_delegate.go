@@ -0,0 +1,99 @@
+// This file was generated by counterfeiter
+package execfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/atc/exec"
+)
+
+type FakeLoadVarDelegate struct {
+	VarLoadedStub        func(name string, value interface{}, sensitive bool)
+	varLoadedMutex       sync.RWMutex
+	varLoadedArgsForCall []struct {
+		name      string
+		value     interface{}
+		sensitive bool
+	}
+	FailedStub        func(error)
+	failedMutex       sync.RWMutex
+	failedArgsForCall []struct {
+		arg1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLoadVarDelegate) VarLoaded(name string, value interface{}, sensitive bool) {
+	fake.varLoadedMutex.Lock()
+	fake.varLoadedArgsForCall = append(fake.varLoadedArgsForCall, struct {
+		name      string
+		value     interface{}
+		sensitive bool
+	}{name, value, sensitive})
+	fake.recordInvocation("VarLoaded", []interface{}{name, value, sensitive})
+	fake.varLoadedMutex.Unlock()
+	if fake.VarLoadedStub != nil {
+		fake.VarLoadedStub(name, value, sensitive)
+	}
+}
+
+func (fake *FakeLoadVarDelegate) VarLoadedCallCount() int {
+	fake.varLoadedMutex.RLock()
+	defer fake.varLoadedMutex.RUnlock()
+	return len(fake.varLoadedArgsForCall)
+}
+
+func (fake *FakeLoadVarDelegate) VarLoadedArgsForCall(i int) (string, interface{}, bool) {
+	fake.varLoadedMutex.RLock()
+	defer fake.varLoadedMutex.RUnlock()
+	return fake.varLoadedArgsForCall[i].name, fake.varLoadedArgsForCall[i].value, fake.varLoadedArgsForCall[i].sensitive
+}
+
+func (fake *FakeLoadVarDelegate) Failed(arg1 error) {
+	fake.failedMutex.Lock()
+	fake.failedArgsForCall = append(fake.failedArgsForCall, struct {
+		arg1 error
+	}{arg1})
+	fake.recordInvocation("Failed", []interface{}{arg1})
+	fake.failedMutex.Unlock()
+	if fake.FailedStub != nil {
+		fake.FailedStub(arg1)
+	}
+}
+
+func (fake *FakeLoadVarDelegate) FailedCallCount() int {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return len(fake.failedArgsForCall)
+}
+
+func (fake *FakeLoadVarDelegate) FailedArgsForCall(i int) error {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return fake.failedArgsForCall[i].arg1
+}
+
+func (fake *FakeLoadVarDelegate) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.varLoadedMutex.RLock()
+	defer fake.varLoadedMutex.RUnlock()
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLoadVarDelegate) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.LoadVarDelegate = new(FakeLoadVarDelegate)
@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/atc/exec"
 	"github.com/concourse/atc/worker"
@@ -13,6 +14,9 @@ type FakePutDelegate struct {
 	InitializingStub        func()
 	initializingMutex       sync.RWMutex
 	initializingArgsForCall []struct{}
+	StartedStub             func()
+	startedMutex            sync.RWMutex
+	startedArgsForCall      []struct{}
 	CompletedStub           func(exec.ExitStatus, *exec.VersionInfo)
 	completedMutex          sync.RWMutex
 	completedArgsForCall    []struct {
@@ -35,6 +39,26 @@ type FakePutDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ImageFetchedStub        func(worker.StreamStats)
+	imageFetchedMutex       sync.RWMutex
+	imageFetchedArgsForCall []struct {
+		arg1 worker.StreamStats
+	}
+	VolumeCreatedStub        func(string, time.Duration)
+	volumeCreatedMutex       sync.RWMutex
+	volumeCreatedArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	BuildVariablesStub        func() *exec.BuildVariables
+	buildVariablesMutex       sync.RWMutex
+	buildVariablesArgsForCall []struct{}
+	buildVariablesReturns     struct {
+		result1 *exec.BuildVariables
+	}
+	buildVariablesReturnsOnCall map[int]struct {
+		result1 *exec.BuildVariables
+	}
 	StdoutStub        func() io.Writer
 	stdoutMutex       sync.RWMutex
 	stdoutArgsForCall []struct{}
@@ -53,6 +77,39 @@ type FakePutDelegate struct {
 	stderrReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	InputStreamedStub        func(worker.ArtifactName, worker.StreamStats)
+	inputStreamedMutex       sync.RWMutex
+	inputStreamedArgsForCall []struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}
+	StartingStub        func() (bool, error)
+	startingMutex       sync.RWMutex
+	startingArgsForCall []struct{}
+	startingReturns     struct {
+		result1 bool
+		result2 error
+	}
+	startingReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FinishedStub        func()
+	finishedMutex       sync.RWMutex
+	finishedArgsForCall []struct{}
+	ResumeStub          func() (exec.PutCheckpoint, bool, error)
+	resumeMutex         sync.RWMutex
+	resumeArgsForCall   []struct{}
+	resumeReturns       struct {
+		result1 exec.PutCheckpoint
+		result2 bool
+		result3 error
+	}
+	resumeReturnsOnCall map[int]struct {
+		result1 exec.PutCheckpoint
+		result2 bool
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -73,6 +130,22 @@ func (fake *FakePutDelegate) InitializingCallCount() int {
 	return len(fake.initializingArgsForCall)
 }
 
+func (fake *FakePutDelegate) Started() {
+	fake.startedMutex.Lock()
+	fake.startedArgsForCall = append(fake.startedArgsForCall, struct{}{})
+	fake.recordInvocation("Started", []interface{}{})
+	fake.startedMutex.Unlock()
+	if fake.StartedStub != nil {
+		fake.StartedStub()
+	}
+}
+
+func (fake *FakePutDelegate) StartedCallCount() int {
+	fake.startedMutex.RLock()
+	defer fake.startedMutex.RUnlock()
+	return len(fake.startedArgsForCall)
+}
+
 func (fake *FakePutDelegate) Completed(arg1 exec.ExitStatus, arg2 *exec.VersionInfo) {
 	fake.completedMutex.Lock()
 	fake.completedArgsForCall = append(fake.completedArgsForCall, struct {
@@ -170,6 +243,95 @@ func (fake *FakePutDelegate) ImageVersionDeterminedReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakePutDelegate) ImageFetched(arg1 worker.StreamStats) {
+	fake.imageFetchedMutex.Lock()
+	fake.imageFetchedArgsForCall = append(fake.imageFetchedArgsForCall, struct {
+		arg1 worker.StreamStats
+	}{arg1})
+	fake.recordInvocation("ImageFetched", []interface{}{arg1})
+	fake.imageFetchedMutex.Unlock()
+	if fake.ImageFetchedStub != nil {
+		fake.ImageFetchedStub(arg1)
+	}
+}
+
+func (fake *FakePutDelegate) ImageFetchedCallCount() int {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return len(fake.imageFetchedArgsForCall)
+}
+
+func (fake *FakePutDelegate) ImageFetchedArgsForCall(i int) worker.StreamStats {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return fake.imageFetchedArgsForCall[i].arg1
+}
+
+func (fake *FakePutDelegate) VolumeCreated(arg1 string, arg2 time.Duration) {
+	fake.volumeCreatedMutex.Lock()
+	fake.volumeCreatedArgsForCall = append(fake.volumeCreatedArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("VolumeCreated", []interface{}{arg1, arg2})
+	fake.volumeCreatedMutex.Unlock()
+	if fake.VolumeCreatedStub != nil {
+		fake.VolumeCreatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePutDelegate) VolumeCreatedCallCount() int {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return len(fake.volumeCreatedArgsForCall)
+}
+
+func (fake *FakePutDelegate) VolumeCreatedArgsForCall(i int) (string, time.Duration) {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return fake.volumeCreatedArgsForCall[i].arg1, fake.volumeCreatedArgsForCall[i].arg2
+}
+
+func (fake *FakePutDelegate) BuildVariables() *exec.BuildVariables {
+	fake.buildVariablesMutex.Lock()
+	ret, specificReturn := fake.buildVariablesReturnsOnCall[len(fake.buildVariablesArgsForCall)]
+	fake.buildVariablesArgsForCall = append(fake.buildVariablesArgsForCall, struct{}{})
+	fake.recordInvocation("BuildVariables", []interface{}{})
+	fake.buildVariablesMutex.Unlock()
+	if fake.BuildVariablesStub != nil {
+		return fake.BuildVariablesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.buildVariablesReturns.result1
+}
+
+func (fake *FakePutDelegate) BuildVariablesCallCount() int {
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
+	return len(fake.buildVariablesArgsForCall)
+}
+
+func (fake *FakePutDelegate) BuildVariablesReturns(result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	fake.buildVariablesReturns = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
+func (fake *FakePutDelegate) BuildVariablesReturnsOnCall(i int, result1 *exec.BuildVariables) {
+	fake.BuildVariablesStub = nil
+	if fake.buildVariablesReturnsOnCall == nil {
+		fake.buildVariablesReturnsOnCall = make(map[int]struct {
+			result1 *exec.BuildVariables
+		})
+	}
+	fake.buildVariablesReturnsOnCall[i] = struct {
+		result1 *exec.BuildVariables
+	}{result1}
+}
+
 func (fake *FakePutDelegate) Stdout() io.Writer {
 	fake.stdoutMutex.Lock()
 	ret, specificReturn := fake.stdoutReturnsOnCall[len(fake.stdoutArgsForCall)]
@@ -250,21 +412,167 @@ func (fake *FakePutDelegate) StderrReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakePutDelegate) InputStreamed(arg1 worker.ArtifactName, arg2 worker.StreamStats) {
+	fake.inputStreamedMutex.Lock()
+	fake.inputStreamedArgsForCall = append(fake.inputStreamedArgsForCall, struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}{arg1, arg2})
+	fake.recordInvocation("InputStreamed", []interface{}{arg1, arg2})
+	fake.inputStreamedMutex.Unlock()
+	if fake.InputStreamedStub != nil {
+		fake.InputStreamedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakePutDelegate) InputStreamedCallCount() int {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	return len(fake.inputStreamedArgsForCall)
+}
+
+func (fake *FakePutDelegate) InputStreamedArgsForCall(i int) (worker.ArtifactName, worker.StreamStats) {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	return fake.inputStreamedArgsForCall[i].arg1, fake.inputStreamedArgsForCall[i].arg2
+}
+
+func (fake *FakePutDelegate) Starting() (bool, error) {
+	fake.startingMutex.Lock()
+	ret, specificReturn := fake.startingReturnsOnCall[len(fake.startingArgsForCall)]
+	fake.startingArgsForCall = append(fake.startingArgsForCall, struct{}{})
+	fake.recordInvocation("Starting", []interface{}{})
+	fake.startingMutex.Unlock()
+	if fake.StartingStub != nil {
+		return fake.StartingStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.startingReturns.result1, fake.startingReturns.result2
+}
+
+func (fake *FakePutDelegate) StartingCallCount() int {
+	fake.startingMutex.RLock()
+	defer fake.startingMutex.RUnlock()
+	return len(fake.startingArgsForCall)
+}
+
+func (fake *FakePutDelegate) StartingReturns(result1 bool, result2 error) {
+	fake.StartingStub = nil
+	fake.startingReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePutDelegate) StartingReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.StartingStub = nil
+	if fake.startingReturnsOnCall == nil {
+		fake.startingReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.startingReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePutDelegate) Finished() {
+	fake.finishedMutex.Lock()
+	fake.finishedArgsForCall = append(fake.finishedArgsForCall, struct{}{})
+	fake.recordInvocation("Finished", []interface{}{})
+	fake.finishedMutex.Unlock()
+	if fake.FinishedStub != nil {
+		fake.FinishedStub()
+	}
+}
+
+func (fake *FakePutDelegate) FinishedCallCount() int {
+	fake.finishedMutex.RLock()
+	defer fake.finishedMutex.RUnlock()
+	return len(fake.finishedArgsForCall)
+}
+
+func (fake *FakePutDelegate) Resume() (exec.PutCheckpoint, bool, error) {
+	fake.resumeMutex.Lock()
+	ret, specificReturn := fake.resumeReturnsOnCall[len(fake.resumeArgsForCall)]
+	fake.resumeArgsForCall = append(fake.resumeArgsForCall, struct{}{})
+	fake.recordInvocation("Resume", []interface{}{})
+	fake.resumeMutex.Unlock()
+	if fake.ResumeStub != nil {
+		return fake.ResumeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.resumeReturns.result1, fake.resumeReturns.result2, fake.resumeReturns.result3
+}
+
+func (fake *FakePutDelegate) ResumeCallCount() int {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return len(fake.resumeArgsForCall)
+}
+
+func (fake *FakePutDelegate) ResumeReturns(result1 exec.PutCheckpoint, result2 bool, result3 error) {
+	fake.ResumeStub = nil
+	fake.resumeReturns = struct {
+		result1 exec.PutCheckpoint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePutDelegate) ResumeReturnsOnCall(i int, result1 exec.PutCheckpoint, result2 bool, result3 error) {
+	fake.ResumeStub = nil
+	if fake.resumeReturnsOnCall == nil {
+		fake.resumeReturnsOnCall = make(map[int]struct {
+			result1 exec.PutCheckpoint
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resumeReturnsOnCall[i] = struct {
+		result1 exec.PutCheckpoint
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.startedMutex.RLock()
+	defer fake.startedMutex.RUnlock()
 	fake.completedMutex.RLock()
 	defer fake.completedMutex.RUnlock()
 	fake.failedMutex.RLock()
 	defer fake.failedMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	fake.buildVariablesMutex.RLock()
+	defer fake.buildVariablesMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
 	fake.stderrMutex.RLock()
 	defer fake.stderrMutex.RUnlock()
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	fake.startingMutex.RLock()
+	defer fake.startingMutex.RUnlock()
+	fake.finishedMutex.RLock()
+	defer fake.finishedMutex.RUnlock()
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/exec"
@@ -40,6 +41,34 @@ type FakeTaskDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ImageFetchedStub        func(worker.StreamStats)
+	imageFetchedMutex       sync.RWMutex
+	imageFetchedArgsForCall []struct {
+		arg1 worker.StreamStats
+	}
+	VolumeCreatedStub        func(string, time.Duration)
+	volumeCreatedMutex       sync.RWMutex
+	volumeCreatedArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	InputStreamedStub        func(worker.ArtifactName, worker.StreamStats)
+	inputStreamedMutex       sync.RWMutex
+	inputStreamedArgsForCall []struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}
+	OutputRegisteredStub        func(worker.ArtifactName, worker.StreamStats)
+	outputRegisteredMutex       sync.RWMutex
+	outputRegisteredArgsForCall []struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}
+	AnnotatedStub        func(map[string]string)
+	annotatedMutex       sync.RWMutex
+	annotatedArgsForCall []struct {
+		arg1 map[string]string
+	}
 	StdoutStub        func() io.Writer
 	stdoutMutex       sync.RWMutex
 	stdoutArgsForCall []struct{}
@@ -198,6 +227,129 @@ func (fake *FakeTaskDelegate) ImageVersionDeterminedReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeTaskDelegate) ImageFetched(arg1 worker.StreamStats) {
+	fake.imageFetchedMutex.Lock()
+	fake.imageFetchedArgsForCall = append(fake.imageFetchedArgsForCall, struct {
+		arg1 worker.StreamStats
+	}{arg1})
+	fake.recordInvocation("ImageFetched", []interface{}{arg1})
+	fake.imageFetchedMutex.Unlock()
+	if fake.ImageFetchedStub != nil {
+		fake.ImageFetchedStub(arg1)
+	}
+}
+
+func (fake *FakeTaskDelegate) ImageFetchedCallCount() int {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return len(fake.imageFetchedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) ImageFetchedArgsForCall(i int) worker.StreamStats {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return fake.imageFetchedArgsForCall[i].arg1
+}
+
+func (fake *FakeTaskDelegate) VolumeCreated(arg1 string, arg2 time.Duration) {
+	fake.volumeCreatedMutex.Lock()
+	fake.volumeCreatedArgsForCall = append(fake.volumeCreatedArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("VolumeCreated", []interface{}{arg1, arg2})
+	fake.volumeCreatedMutex.Unlock()
+	if fake.VolumeCreatedStub != nil {
+		fake.VolumeCreatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) VolumeCreatedCallCount() int {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return len(fake.volumeCreatedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) VolumeCreatedArgsForCall(i int) (string, time.Duration) {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return fake.volumeCreatedArgsForCall[i].arg1, fake.volumeCreatedArgsForCall[i].arg2
+}
+
+func (fake *FakeTaskDelegate) InputStreamed(arg1 worker.ArtifactName, arg2 worker.StreamStats) {
+	fake.inputStreamedMutex.Lock()
+	fake.inputStreamedArgsForCall = append(fake.inputStreamedArgsForCall, struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}{arg1, arg2})
+	fake.recordInvocation("InputStreamed", []interface{}{arg1, arg2})
+	fake.inputStreamedMutex.Unlock()
+	if fake.InputStreamedStub != nil {
+		fake.InputStreamedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) InputStreamedCallCount() int {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	return len(fake.inputStreamedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) InputStreamedArgsForCall(i int) (worker.ArtifactName, worker.StreamStats) {
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	return fake.inputStreamedArgsForCall[i].arg1, fake.inputStreamedArgsForCall[i].arg2
+}
+
+func (fake *FakeTaskDelegate) OutputRegistered(arg1 worker.ArtifactName, arg2 worker.StreamStats) {
+	fake.outputRegisteredMutex.Lock()
+	fake.outputRegisteredArgsForCall = append(fake.outputRegisteredArgsForCall, struct {
+		arg1 worker.ArtifactName
+		arg2 worker.StreamStats
+	}{arg1, arg2})
+	fake.recordInvocation("OutputRegistered", []interface{}{arg1, arg2})
+	fake.outputRegisteredMutex.Unlock()
+	if fake.OutputRegisteredStub != nil {
+		fake.OutputRegisteredStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) OutputRegisteredCallCount() int {
+	fake.outputRegisteredMutex.RLock()
+	defer fake.outputRegisteredMutex.RUnlock()
+	return len(fake.outputRegisteredArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) OutputRegisteredArgsForCall(i int) (worker.ArtifactName, worker.StreamStats) {
+	fake.outputRegisteredMutex.RLock()
+	defer fake.outputRegisteredMutex.RUnlock()
+	return fake.outputRegisteredArgsForCall[i].arg1, fake.outputRegisteredArgsForCall[i].arg2
+}
+
+func (fake *FakeTaskDelegate) Annotated(arg1 map[string]string) {
+	fake.annotatedMutex.Lock()
+	fake.annotatedArgsForCall = append(fake.annotatedArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	fake.recordInvocation("Annotated", []interface{}{arg1})
+	fake.annotatedMutex.Unlock()
+	if fake.AnnotatedStub != nil {
+		fake.AnnotatedStub(arg1)
+	}
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCallCount() int {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	return len(fake.annotatedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) AnnotatedArgsForCall(i int) map[string]string {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	return fake.annotatedArgsForCall[i].arg1
+}
+
 func (fake *FakeTaskDelegate) Stdout() io.Writer {
 	fake.stdoutMutex.Lock()
 	ret, specificReturn := fake.stdoutReturnsOnCall[len(fake.stdoutArgsForCall)]
@@ -291,6 +443,16 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.failedMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	fake.inputStreamedMutex.RLock()
+	defer fake.inputStreamedMutex.RUnlock()
+	fake.outputRegisteredMutex.RLock()
+	defer fake.outputRegisteredMutex.RUnlock()
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
 	fake.stderrMutex.RLock()
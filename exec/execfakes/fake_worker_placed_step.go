@@ -0,0 +1,250 @@
+// This file was generated by counterfeiter
+package execfakes
+
+import (
+	"os"
+	"sync"
+
+	"github.com/concourse/atc/exec"
+)
+
+type FakeWorkerPlacedStep struct {
+	RunStub        func(signals <-chan os.Signal, ready chan<- struct{}) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}
+	runReturns struct {
+		result1 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResultStub        func(interface{}) bool
+	resultMutex       sync.RWMutex
+	resultArgsForCall []struct {
+		arg1 interface{}
+	}
+	resultReturns struct {
+		result1 bool
+	}
+	resultReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	WorkerNameStub        func() (string, bool)
+	workerNameMutex       sync.RWMutex
+	workerNameArgsForCall []struct{}
+	workerNameReturns     struct {
+		result1 string
+		result2 bool
+	}
+	workerNameReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+	}
+	AvoidWorkersStub        func([]string)
+	avoidWorkersMutex       sync.RWMutex
+	avoidWorkersArgsForCall []struct {
+		arg1 []string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWorkerPlacedStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}{signals, ready})
+	fake.recordInvocation("Run", []interface{}{signals, ready})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(signals, ready)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runReturns.result1
+}
+
+func (fake *FakeWorkerPlacedStep) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeWorkerPlacedStep) RunArgsForCall(i int) (<-chan os.Signal, chan<- struct{}) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.runArgsForCall[i].signals, fake.runArgsForCall[i].ready
+}
+
+func (fake *FakeWorkerPlacedStep) RunReturns(result1 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerPlacedStep) RunReturnsOnCall(i int, result1 error) {
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerPlacedStep) Result(arg1 interface{}) bool {
+	fake.resultMutex.Lock()
+	ret, specificReturn := fake.resultReturnsOnCall[len(fake.resultArgsForCall)]
+	fake.resultArgsForCall = append(fake.resultArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	fake.recordInvocation("Result", []interface{}{arg1})
+	fake.resultMutex.Unlock()
+	if fake.ResultStub != nil {
+		return fake.ResultStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.resultReturns.result1
+}
+
+func (fake *FakeWorkerPlacedStep) ResultCallCount() int {
+	fake.resultMutex.RLock()
+	defer fake.resultMutex.RUnlock()
+	return len(fake.resultArgsForCall)
+}
+
+func (fake *FakeWorkerPlacedStep) ResultArgsForCall(i int) interface{} {
+	fake.resultMutex.RLock()
+	defer fake.resultMutex.RUnlock()
+	return fake.resultArgsForCall[i].arg1
+}
+
+func (fake *FakeWorkerPlacedStep) ResultReturns(result1 bool) {
+	fake.ResultStub = nil
+	fake.resultReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkerPlacedStep) ResultReturnsOnCall(i int, result1 bool) {
+	fake.ResultStub = nil
+	if fake.resultReturnsOnCall == nil {
+		fake.resultReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.resultReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkerPlacedStep) WorkerName() (string, bool) {
+	fake.workerNameMutex.Lock()
+	ret, specificReturn := fake.workerNameReturnsOnCall[len(fake.workerNameArgsForCall)]
+	fake.workerNameArgsForCall = append(fake.workerNameArgsForCall, struct{}{})
+	fake.recordInvocation("WorkerName", []interface{}{})
+	fake.workerNameMutex.Unlock()
+	if fake.WorkerNameStub != nil {
+		return fake.WorkerNameStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.workerNameReturns.result1, fake.workerNameReturns.result2
+}
+
+func (fake *FakeWorkerPlacedStep) WorkerNameCallCount() int {
+	fake.workerNameMutex.RLock()
+	defer fake.workerNameMutex.RUnlock()
+	return len(fake.workerNameArgsForCall)
+}
+
+func (fake *FakeWorkerPlacedStep) WorkerNameReturns(result1 string, result2 bool) {
+	fake.WorkerNameStub = nil
+	fake.workerNameReturns = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerPlacedStep) WorkerNameReturnsOnCall(i int, result1 string, result2 bool) {
+	fake.WorkerNameStub = nil
+	if fake.workerNameReturnsOnCall == nil {
+		fake.workerNameReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+		})
+	}
+	fake.workerNameReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerPlacedStep) AvoidWorkers(arg1 []string) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.avoidWorkersMutex.Lock()
+	fake.avoidWorkersArgsForCall = append(fake.avoidWorkersArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("AvoidWorkers", []interface{}{arg1Copy})
+	fake.avoidWorkersMutex.Unlock()
+	if fake.AvoidWorkersStub != nil {
+		fake.AvoidWorkersStub(arg1)
+	}
+}
+
+func (fake *FakeWorkerPlacedStep) AvoidWorkersCallCount() int {
+	fake.avoidWorkersMutex.RLock()
+	defer fake.avoidWorkersMutex.RUnlock()
+	return len(fake.avoidWorkersArgsForCall)
+}
+
+func (fake *FakeWorkerPlacedStep) AvoidWorkersArgsForCall(i int) []string {
+	fake.avoidWorkersMutex.RLock()
+	defer fake.avoidWorkersMutex.RUnlock()
+	return fake.avoidWorkersArgsForCall[i].arg1
+}
+
+func (fake *FakeWorkerPlacedStep) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	fake.resultMutex.RLock()
+	defer fake.resultMutex.RUnlock()
+	fake.workerNameMutex.RLock()
+	defer fake.workerNameMutex.RUnlock()
+	fake.avoidWorkersMutex.RLock()
+	defer fake.avoidWorkersMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeWorkerPlacedStep) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ exec.WorkerPlacedStep = new(FakeWorkerPlacedStep)
@@ -2,10 +2,12 @@ package exec
 
 import (
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/condition"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/worker"
 )
@@ -26,6 +28,7 @@ type Factory interface {
 		GetDelegate,
 		atc.ResourceConfig,
 		atc.Tags,
+		*atc.WorkerPlacement,
 		atc.Params,
 		atc.Version,
 		atc.VersionedResourceTypes,
@@ -42,6 +45,7 @@ type Factory interface {
 		PutDelegate,
 		atc.ResourceConfig,
 		atc.Tags,
+		*atc.WorkerPlacement,
 		atc.Params,
 		atc.VersionedResourceTypes,
 	) StepFactory
@@ -59,6 +63,7 @@ type Factory interface {
 		GetDelegate,
 		atc.ResourceConfig,
 		atc.Tags,
+		*atc.WorkerPlacement,
 		atc.Params,
 		atc.VersionedResourceTypes,
 	) StepFactory
@@ -74,6 +79,7 @@ type Factory interface {
 		TaskDelegate,
 		Privileged,
 		atc.Tags,
+		*atc.WorkerPlacement,
 		TaskConfigSource,
 		atc.VersionedResourceTypes,
 		map[string]string,
@@ -101,6 +107,13 @@ type TaskDelegate interface {
 	Failed(error)
 
 	ImageVersionDetermined(worker.ResourceCacheIdentifier) error
+	ImageFetched(worker.StreamStats)
+	VolumeCreated(string, time.Duration)
+
+	InputStreamed(worker.ArtifactName, worker.StreamStats)
+	OutputRegistered(worker.ArtifactName, worker.StreamStats)
+
+	Annotated(map[string]string)
 
 	Stdout() io.Writer
 	Stderr() io.Writer
@@ -110,11 +123,18 @@ type TaskDelegate interface {
 // behavior.
 type ResourceDelegate interface {
 	Initializing()
+	Started()
 
 	Completed(ExitStatus, *VersionInfo)
 	Failed(error)
 
 	ImageVersionDetermined(worker.ResourceCacheIdentifier) error
+	ImageFetched(worker.StreamStats)
+	VolumeCreated(string, time.Duration)
+
+	// BuildVariables are interpolated into the resource's source and the
+	// step's params.
+	BuildVariables() *BuildVariables
 
 	Stdout() io.Writer
 	Stderr() io.Writer
@@ -134,6 +154,68 @@ type GetDelegate interface {
 // behavior.
 type PutDelegate interface {
 	ResourceDelegate
+
+	InputStreamed(worker.ArtifactName, worker.StreamStats)
+
+	// Starting is called before the put does anything, and returns false if
+	// the build is about to be aborted, in which case the put does not run.
+	Starting() (bool, error)
+
+	// Finished is called once a put that was allowed to start is over,
+	// however it ended.
+	Finished()
+
+	// Resume returns the checkpoint of an earlier run of the put in the same
+	// build, if it completed before the build was handed off to another ATC.
+	// A resumed put is not run again.
+	Resume() (PutCheckpoint, bool, error)
+}
+
+// PutCheckpoint is what a completed put leaves behind for resuming its build.
+type PutCheckpoint struct {
+	ExitStatus  ExitStatus   `json:"exit_status"`
+	VersionInfo *VersionInfo `json:"version_info,omitempty"`
+}
+
+//go:generate counterfeiter . RetryDelegate
+
+// RetryDelegate is used to record why the attempts of a RetryStep were
+// retried.
+type RetryDelegate interface {
+	// Retrying is called when an attempt is to be retried after the delay. A
+	// nil error means the attempt ran to completion but failed.
+	Retrying(attempt int, err error, delay time.Duration)
+}
+
+//go:generate counterfeiter . ConditionalDelegate
+
+// ConditionalDelegate provides the variables a ConditionalStep's condition is
+// evaluated against, and records when the step is skipped.
+type ConditionalDelegate interface {
+	Variables() condition.Vars
+	Skipped()
+}
+
+//go:generate counterfeiter . LoadVarDelegate
+
+// LoadVarDelegate is used to record the variables loaded by a LoadVarStep.
+type LoadVarDelegate interface {
+	VarLoaded(name string, value interface{}, sensitive bool)
+	Failed(error)
+}
+
+//go:generate counterfeiter . ApproveDelegate
+
+// ApproveDelegate is used to record events related to an ApproveStep's runtime
+// behavior, and to look up the decision made on it.
+type ApproveDelegate interface {
+	RequestApproval() error
+	Approval() (Approval, bool, error)
+	ApprovalNotifier() (dbng.Notifier, error)
+
+	Waiting()
+	Decided(Approval)
+	Failed(error)
 }
 
 // Privileged is used to indicate whether the given step should run with
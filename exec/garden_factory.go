@@ -46,6 +46,7 @@ func (factory *gardenFactory) DependentGet(
 	delegate GetDelegate,
 	resourceConfig atc.ResourceConfig,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	params atc.Params,
 	resourceTypes atc.VersionedResourceTypes,
 ) StepFactory {
@@ -59,6 +60,7 @@ func (factory *gardenFactory) DependentGet(
 			Metadata: workerMetadata,
 		},
 		tags,
+		placement,
 		teamID,
 		buildID,
 		delegate,
@@ -79,6 +81,7 @@ func (factory *gardenFactory) Get(
 	delegate GetDelegate,
 	resourceConfig atc.ResourceConfig,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	params atc.Params,
 	version atc.Version,
 	resourceTypes atc.VersionedResourceTypes,
@@ -90,24 +93,18 @@ func (factory *gardenFactory) Get(
 		resourceConfig,
 		version,
 		params,
-		resource.NewResourceInstance(
-			resource.ResourceType(resourceConfig.Type),
-			version,
-			resourceConfig.Source,
-			params,
-			dbng.ForBuild(buildID),
-			resourceTypes,
-			factory.dbResourceCacheFactory,
-		),
 		stepMetadata,
 		resource.Session{
 			Metadata: workerMetadata,
 		},
 		tags,
+		placement,
 		teamID,
+		buildID,
 		delegate,
 		factory.resourceFetcher,
 		resourceTypes,
+		factory.dbResourceCacheFactory,
 	)
 }
 
@@ -121,6 +118,7 @@ func (factory *gardenFactory) Put(
 	delegate PutDelegate,
 	resourceConfig atc.ResourceConfig,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	params atc.Params,
 	resourceTypes atc.VersionedResourceTypes,
 ) StepFactory {
@@ -134,6 +132,7 @@ func (factory *gardenFactory) Put(
 			Metadata: workerMetadata,
 		},
 		tags,
+		placement,
 		teamID,
 		buildID,
 		planID,
@@ -153,6 +152,7 @@ func (factory *gardenFactory) Task(
 	delegate TaskDelegate,
 	privileged Privileged,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	configSource TaskConfigSource,
 	resourceTypes atc.VersionedResourceTypes,
 	inputMapping map[string]string,
@@ -166,6 +166,7 @@ func (factory *gardenFactory) Task(
 		logger,
 		workerMetadata,
 		tags,
+		placement,
 		teamID,
 		buildID,
 		planID,
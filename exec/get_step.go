@@ -10,6 +10,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/resource"
 	"github.com/concourse/atc/worker"
 )
@@ -17,24 +18,31 @@ import (
 // GetStep will fetch a version of a resource on a worker that supports the
 // resource type.
 type GetStep struct {
-	logger           lager.Logger
-	sourceName       worker.ArtifactName
-	resourceConfig   atc.ResourceConfig
-	version          atc.Version
-	params           atc.Params
-	resourceInstance resource.ResourceInstance
-	stepMetadata     StepMetadata
-	session          resource.Session
-	tags             atc.Tags
-	teamID           int
-	delegate         GetDelegate
-	resourceFetcher  resource.Fetcher
-	resourceTypes    atc.VersionedResourceTypes
+	logger                 lager.Logger
+	sourceName             worker.ArtifactName
+	resourceConfig         atc.ResourceConfig
+	version                atc.Version
+	params                 atc.Params
+	stepMetadata           StepMetadata
+	session                resource.Session
+	tags                   atc.Tags
+	placement              *atc.WorkerPlacement
+	teamID                 int
+	buildID                int
+	delegate               GetDelegate
+	resourceFetcher        resource.Fetcher
+	resourceTypes          atc.VersionedResourceTypes
+	dbResourceCacheFactory dbng.ResourceCacheFactory
 
 	repository *worker.ArtifactRepository
 
+	resourceInstance resource.ResourceInstance
+
 	fetchSource resource.FetchSource
 
+	avoidWorkers []string
+	workerName   string
+
 	succeeded bool
 }
 
@@ -44,29 +52,33 @@ func newGetStep(
 	resourceConfig atc.ResourceConfig,
 	version atc.Version,
 	params atc.Params,
-	resourceInstance resource.ResourceInstance,
 	stepMetadata StepMetadata,
 	session resource.Session,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	teamID int,
+	buildID int,
 	delegate GetDelegate,
 	resourceFetcher resource.Fetcher,
 	resourceTypes atc.VersionedResourceTypes,
+	dbResourceCacheFactory dbng.ResourceCacheFactory,
 ) GetStep {
 	return GetStep{
-		logger:           logger,
-		sourceName:       sourceName,
-		resourceConfig:   resourceConfig,
-		version:          version,
-		params:           params,
-		resourceInstance: resourceInstance,
-		stepMetadata:     stepMetadata,
-		session:          session,
-		tags:             tags,
-		teamID:           teamID,
-		delegate:         delegate,
-		resourceFetcher:  resourceFetcher,
-		resourceTypes:    resourceTypes,
+		logger:                 logger,
+		sourceName:             sourceName,
+		resourceConfig:         resourceConfig,
+		version:                version,
+		params:                 params,
+		stepMetadata:           stepMetadata,
+		session:                session,
+		tags:                   tags,
+		placement:              placement,
+		teamID:                 teamID,
+		buildID:                buildID,
+		delegate:               delegate,
+		resourceFetcher:        resourceFetcher,
+		resourceTypes:          resourceTypes,
+		dbResourceCacheFactory: dbResourceCacheFactory,
 	}
 }
 
@@ -102,24 +114,56 @@ func (step GetStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
 // If the worker has a VolumeManager but did not have the cache initially, the
 // fetched ArtifactSource is initialized, thus warming the worker's cache.
 //
+// The delegate is notified that the step has started once the fetch script is
+// running, or once the cache has been found.
+//
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
+//
+// Build variables are interpolated into the resource's source and the step's
+// params before anything else, so the cache is that of the values the
+// resource is actually fetched with.
 func (step *GetStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	step.delegate.Initializing()
 
+	variables := step.delegate.BuildVariables()
+
+	source, err := variables.InterpolateSource(step.resourceConfig.Source)
+	if err != nil {
+		return err
+	}
+
+	params, err := variables.InterpolateParams(step.params)
+	if err != nil {
+		return err
+	}
+
+	step.resourceInstance = resource.NewResourceInstance(
+		resource.ResourceType(step.resourceConfig.Type),
+		step.version,
+		source,
+		params,
+		dbng.ForBuild(step.buildID),
+		step.resourceTypes,
+		step.dbResourceCacheFactory,
+	)
+
 	resourceDefinition := &getStepResource{
-		source:       step.resourceConfig.Source,
+		source:       source,
 		resourceType: resource.ResourceType(step.resourceConfig.Type),
 		delegate:     step.delegate,
-		params:       step.params,
+		params:       params,
 		version:      step.version,
 	}
 
-	var err error
+	started := notifyStarted(ready, step.delegate.Started)
+
 	step.fetchSource, err = step.resourceFetcher.Fetch(
 		step.logger,
 		step.session,
 		step.tags,
+		step.placement,
+		step.avoidWorkers,
 		step.teamID,
 		step.resourceTypes,
 		step.resourceInstance,
@@ -127,9 +171,13 @@ func (step *GetStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 		step.delegate,
 		resourceDefinition,
 		signals,
-		ready,
+		started.Ready(),
 	)
 
+	started.Stop()
+
+	step.workerName = resourceDefinition.workerName
+
 	if err, ok := err.(resource.ErrResourceScriptFailed); ok {
 		step.logger.Error("get-run-resource-script-failed", err)
 		step.delegate.Completed(ExitStatus(err.ExitStatus), nil)
@@ -146,6 +194,18 @@ func (step *GetStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 	return nil
 }
 
+// WorkerName returns the worker the resource was fetched on, if it got that
+// far. It is not known when the resource was found in a cache.
+func (step *GetStep) WorkerName() (string, bool) {
+	return step.workerName, step.workerName != ""
+}
+
+// AvoidWorkers fetches the resource on another worker than the given ones, if
+// any other is suitable.
+func (step *GetStep) AvoidWorkers(workerNames []string) {
+	step.avoidWorkers = workerNames
+}
+
 // Result indicates Success as true if the script completed successfully (or
 // didn't have to run) and everything else worked fine.
 //
@@ -177,9 +237,16 @@ func (step *GetStep) VolumeOn(worker worker.Worker) (worker.Volume, bool, error)
 	return step.resourceInstance.FindInitializedOn(step.logger.Session("volume-on"), worker)
 }
 
-// StreamTo streams the resource's data to the destination.
+// StreamTo streams the resource's data to the destination. If the resource's
+// volume is known, the destination may pull it directly from its worker.
 func (step *GetStep) StreamTo(destination worker.ArtifactDestination) error {
-	out, err := step.fetchSource.VersionedSource().StreamOut(".")
+	versionedSource := step.fetchSource.VersionedSource()
+
+	if volume := versionedSource.Volume(); volume != nil {
+		return worker.StreamVolumeTo(step.logger, volume, destination)
+	}
+
+	out, err := versionedSource.StreamOut(".")
 	if err != nil {
 		return err
 	}
@@ -225,6 +292,8 @@ type getStepResource struct {
 	source       atc.Source
 	params       atc.Params
 	version      atc.Version
+
+	workerName string
 }
 
 func (d *getStepResource) IOConfig() resource.IOConfig {
@@ -250,7 +319,11 @@ func (d *getStepResource) ResourceType() resource.ResourceType {
 	return d.resourceType
 }
 
+// LockName is asked for once a worker has been chosen to fetch the resource
+// on, so the worker is remembered for retries to avoid if the fetch fails.
 func (d *getStepResource) LockName(workerName string) (string, error) {
+	d.workerName = workerName
+
 	id := &getStepLockID{
 		Type:       d.resourceType,
 		Version:    d.version,
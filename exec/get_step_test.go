@@ -47,6 +47,7 @@ var _ = Describe("Get", func() {
 		params         atc.Params
 		version        atc.Version
 		tags           []string
+		placement      *atc.WorkerPlacement
 		resourceTypes  atc.VersionedResourceTypes
 
 		inStep Step
@@ -89,6 +90,11 @@ var _ = Describe("Get", func() {
 		}
 
 		tags = []string{"some", "tags"}
+		placement = &atc.WorkerPlacement{
+			Selectors: []atc.WorkerSelector{
+				{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+			},
+		}
 		params = atc.Params{"some-param": "some-value"}
 
 		version = atc.Version{"some-version": "some-value"}
@@ -130,6 +136,7 @@ var _ = Describe("Get", func() {
 			getDelegate,
 			resourceConfig,
 			tags,
+			placement,
 			params,
 			version,
 			resourceTypes,
@@ -150,6 +157,8 @@ var _ = Describe("Get", func() {
 				lager.Logger,
 				resource.Session,
 				atc.Tags,
+				*atc.WorkerPlacement,
+				[]string,
 				int,
 				atc.VersionedResourceTypes,
 				resource.ResourceInstance,
@@ -169,9 +178,53 @@ var _ = Describe("Get", func() {
 		})
 	})
 
+	Context("when the fetch script starts running", func() {
+		BeforeEach(func() {
+			fakeResourceFetcher.FetchStub = func(
+				_ lager.Logger,
+				_ resource.Session,
+				_ atc.Tags,
+				_ *atc.WorkerPlacement,
+				_ []string,
+				_ int,
+				_ atc.VersionedResourceTypes,
+				_ resource.ResourceInstance,
+				_ resource.Metadata,
+				_ worker.ImageFetchingDelegate,
+				_ resource.ResourceOptions,
+				_ <-chan os.Signal,
+				ready chan<- struct{},
+			) (resource.FetchSource, error) {
+				close(ready)
+				return fakeFetchSource, nil
+			}
+		})
+
+		It("calls the Started method on the delegate before completing", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+			Expect(getDelegate.StartedCallCount()).To(Equal(1))
+			Expect(getDelegate.CompletedCallCount()).To(Equal(1))
+		})
+
+		It("becomes ready", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+		})
+	})
+
+	Context("when the fetch fails before the script starts", func() {
+		BeforeEach(func() {
+			fakeResourceFetcher.FetchReturns(nil, errors.New("nope"))
+		})
+
+		It("does not call the Started method on the delegate", func() {
+			Eventually(process.Wait()).Should(Receive(HaveOccurred()))
+			Expect(getDelegate.StartedCallCount()).To(BeZero())
+		})
+	})
+
 	It("initializes the resource with the correct type and session id, making sure that it is not ephemeral", func() {
 		Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-		_, sid, tags, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+		_, sid, tags, actualPlacement, _, actualTeamID, actualResourceTypes, resourceInstance, sm, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
 		Expect(sm).To(Equal(stepMetadata))
 		Expect(sid).To(Equal(resource.Session{
 			Metadata: dbng.ContainerMetadata{
@@ -182,6 +235,7 @@ var _ = Describe("Get", func() {
 			},
 		}))
 		Expect(tags).To(ConsistOf("some", "tags"))
+		Expect(actualPlacement).To(Equal(placement))
 		Expect(actualTeamID).To(Equal(teamID))
 		Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 			"some-resource-type",
@@ -204,6 +258,117 @@ var _ = Describe("Get", func() {
 		Expect(resourceOptions.LockName("fake-worker")).To(Equal(expectedLockName))
 	})
 
+	Context("when retried on a different worker", func() {
+		var placedStep WorkerPlacedStep
+
+		BeforeEach(func() {
+			fakeResourceFetcher.FetchStub = func(
+				_ lager.Logger,
+				_ resource.Session,
+				_ atc.Tags,
+				_ *atc.WorkerPlacement,
+				_ []string,
+				_ int,
+				_ atc.VersionedResourceTypes,
+				_ resource.ResourceInstance,
+				_ resource.Metadata,
+				_ worker.ImageFetchingDelegate,
+				resourceOptions resource.ResourceOptions,
+				_ <-chan os.Signal,
+				_ chan<- struct{},
+			) (resource.FetchSource, error) {
+				_, err := resourceOptions.LockName("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+
+				return nil, resource.ErrResourceScriptFailed{ExitStatus: 1}
+			}
+		})
+
+		JustBeforeEach(func() {
+			Eventually(process.Wait()).Should(Receive())
+
+			var ok bool
+			placedStep, ok = factory.Get(
+				lagertest.NewTestLogger("test"),
+				teamID,
+				42,
+				atc.PlanID("some-plan-id"),
+				stepMetadata,
+				sourceName,
+				workerMetadata,
+				getDelegate,
+				resourceConfig,
+				tags,
+				placement,
+				params,
+				version,
+				resourceTypes,
+			).Using(inStep, repo).(WorkerPlacedStep)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fetches the resource avoiding the given workers", func() {
+			placedStep.AvoidWorkers([]string{"failed-worker"})
+
+			Expect(placedStep.Run(make(chan os.Signal), make(chan struct{}))).To(Succeed())
+
+			Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(2))
+			_, _, _, _, avoidWorkers, _, _, _, _, _, _, _, _ := fakeResourceFetcher.FetchArgsForCall(1)
+			Expect(avoidWorkers).To(Equal([]string{"failed-worker"}))
+		})
+
+		It("reports the worker the resource was fetched on, even if the fetch failed", func() {
+			_, found := placedStep.WorkerName()
+			Expect(found).To(BeFalse())
+
+			Expect(placedStep.Run(make(chan os.Signal), make(chan struct{}))).To(Succeed())
+
+			workerName, found := placedStep.WorkerName()
+			Expect(found).To(BeTrue())
+			Expect(workerName).To(Equal("some-worker"))
+		})
+	})
+
+	Context("when the source and params refer to build variables", func() {
+		BeforeEach(func() {
+			variables := NewBuildVariables()
+			variables.Set("branch", "release/1.2")
+			variables.Set("tag", "1.2.3")
+			getDelegate.BuildVariablesReturns(variables)
+
+			resourceConfig.Source = atc.Source{"branch": "((.:branch))"}
+			params = atc.Params{"tag": "v((.:tag))"}
+		})
+
+		It("fetches the resource with them interpolated", func() {
+			Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
+			_, _, _, _, _, _, _, resourceInstance, _, _, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+			Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
+				"some-resource-type",
+				version,
+				atc.Source{"branch": "release/1.2"},
+				atc.Params{"tag": "v1.2.3"},
+				dbng.ForBuild(42),
+				resourceTypes,
+				fakeDBResourceCacheFactory,
+			)))
+			Expect(resourceOptions.Source()).To(Equal(atc.Source{"branch": "release/1.2"}))
+			Expect(resourceOptions.Params()).To(Equal(atc.Params{"tag": "v1.2.3"}))
+		})
+	})
+
+	Context("when the source refers to build variables that are not set", func() {
+		BeforeEach(func() {
+			resourceConfig.Source = atc.Source{"branch": "((.:branch))"}
+		})
+
+		It("errors without fetching", func() {
+			Eventually(process.Wait()).Should(Receive(Equal(UndefinedVarsError{Names: []string{"branch"}})))
+			Expect(fakeResourceFetcher.FetchCallCount()).To(BeZero())
+			Expect(getDelegate.FailedCallCount()).To(Equal(1))
+		})
+	})
+
 	Context("when fetching resource succeeds", func() {
 		BeforeEach(func() {
 			fakeResourceFetcher.FetchReturns(fakeFetchSource, nil)
@@ -0,0 +1,200 @@
+package exec
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/concourse/atc/worker"
+	"github.com/tedsuo/ifrit"
+)
+
+// InParallel constructs a Step that will run the steps in parallel, no more
+// than limit of them at once. A limit of zero runs all of them at once.
+//
+// If failFast is true, the first step to fail or error interrupts the ones
+// still running, and the ones not yet started are never run.
+func InParallel(steps []StepFactory, limit int, failFast bool) StepFactory {
+	return inParallel{
+		steps:    steps,
+		limit:    limit,
+		failFast: failFast,
+	}
+}
+
+type inParallel struct {
+	steps    []StepFactory
+	limit    int
+	failFast bool
+}
+
+// Using delegates to each StepFactory and returns an *InParallelStep.
+func (factory inParallel) Using(prev Step, repo *worker.ArtifactRepository) Step {
+	step := &InParallelStep{
+		Limit:    factory.limit,
+		FailFast: factory.failFast,
+	}
+
+	for _, s := range factory.steps {
+		step.Steps = append(step.Steps, s.Using(prev, repo))
+	}
+
+	return step
+}
+
+// InParallelStep is a step of steps to run in parallel, with bounded
+// concurrency.
+type InParallelStep struct {
+	Steps    []Step
+	Limit    int
+	FailFast bool
+
+	started []bool
+}
+
+type inParallelExit struct {
+	index int
+	err   error
+}
+
+// Run starts as many steps as the limit allows, starting the next one each
+// time one exits. It is ready immediately, as steps beyond the limit cannot
+// be ready until they are started.
+//
+// Any signal received is propagated to the running steps, no more are
+// started, and ErrInterrupted is returned once they have exited.
+//
+// Otherwise it waits for every step it started to exit, and their errors (if
+// any) are aggregated and returned as a single error. When failing fast, the
+// errors of the steps it interrupted are left out.
+func (step *InParallelStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	close(ready)
+
+	limit := step.Limit
+	if limit <= 0 || limit > len(step.Steps) {
+		limit = len(step.Steps)
+	}
+
+	step.started = make([]bool, len(step.Steps))
+
+	processes := []ifrit.Process{}
+	exited := make(chan inParallelExit, len(step.Steps))
+
+	next := 0
+	running := 0
+	stopped := false
+	interrupted := false
+
+	var errorMessages []string
+
+	for {
+		for !stopped && running < limit && next < len(step.Steps) {
+			index := next
+
+			process := ifrit.Background(step.Steps[index])
+			processes = append(processes, process)
+			step.started[index] = true
+
+			go func() {
+				exited <- inParallelExit{index, <-process.Wait()}
+			}()
+
+			next++
+			running++
+		}
+
+		if running == 0 {
+			break
+		}
+
+		select {
+		case sig := <-signals:
+			interrupted = true
+			stopped = true
+
+			for _, process := range processes {
+				process.Signal(sig)
+			}
+
+		case exit := <-exited:
+			running--
+
+			if !step.failed(exit) {
+				continue
+			}
+
+			if exit.err != nil && !(stopped && exit.err == ErrInterrupted) {
+				errorMessages = append(errorMessages, exit.err.Error())
+			}
+
+			if step.FailFast && !stopped {
+				stopped = true
+
+				for _, process := range processes {
+					process.Signal(os.Interrupt)
+				}
+			}
+		}
+	}
+
+	if interrupted {
+		return ErrInterrupted
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("steps failed:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+func (step *InParallelStep) failed(exit inParallelExit) bool {
+	if exit.err != nil {
+		return true
+	}
+
+	var succeeded Success
+	return step.Steps[exit.index].Result(&succeeded) && !bool(succeeded)
+}
+
+// Result indicates Success as true if all of the steps were run and indicate
+// Success as true, or if there were no steps at all. If none of the steps can
+// indicate Success, it will return false and not indicate success itself.
+//
+// All other result types are ignored, and Result will return false.
+func (step *InParallelStep) Result(x interface{}) bool {
+	success, ok := x.(*Success)
+	if !ok {
+		return false
+	}
+
+	if len(step.Steps) == 0 {
+		*success = Success(true)
+		return true
+	}
+
+	succeeded := true
+	anyIndicated := false
+	for i, s := range step.Steps {
+		if i >= len(step.started) || !step.started[i] {
+			succeeded = false
+			continue
+		}
+
+		var stepSucceeded Success
+		if !s.Result(&stepSucceeded) {
+			continue
+		}
+
+		anyIndicated = true
+		succeeded = succeeded && bool(stepSucceeded)
+	}
+
+	if !anyIndicated {
+		return false
+	}
+
+	*success = Success(succeeded)
+
+	return true
+}
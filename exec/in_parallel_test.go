@@ -0,0 +1,250 @@
+package exec_test
+
+import (
+	"errors"
+	"os"
+
+	. "github.com/concourse/atc/exec"
+	"github.com/tedsuo/ifrit"
+
+	"github.com/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InParallel", func() {
+	var (
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+		fakeStepC *execfakes.FakeStep
+
+		limit    int
+		failFast bool
+
+		step    Step
+		process ifrit.Process
+	)
+
+	// blockingStep runs until it is signalled or released, returning the
+	// given error once released.
+	blockingStep := func(started chan<- string, name string, release <-chan error) *execfakes.FakeStep {
+		fakeStep := new(execfakes.FakeStep)
+		fakeStep.RunStub = func(signals <-chan os.Signal, ready chan<- struct{}) error {
+			close(ready)
+			started <- name
+
+			select {
+			case <-signals:
+				return ErrInterrupted
+			case err := <-release:
+				return err
+			}
+		}
+		fakeStep.ResultStub = successResult(true)
+		return fakeStep
+	}
+
+	BeforeEach(func() {
+		limit = 0
+		failFast = false
+	})
+
+	JustBeforeEach(func() {
+		factoryA := new(execfakes.FakeStepFactory)
+		factoryA.UsingReturns(fakeStepA)
+		factoryB := new(execfakes.FakeStepFactory)
+		factoryB.UsingReturns(fakeStepB)
+		factoryC := new(execfakes.FakeStepFactory)
+		factoryC.UsingReturns(fakeStepC)
+
+		step = InParallel([]StepFactory{factoryA, factoryB, factoryC}, limit, failFast).Using(nil, nil)
+		process = ifrit.Background(step)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Kill)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	Context("when every step succeeds", func() {
+		BeforeEach(func() {
+			fakeStepA = new(execfakes.FakeStep)
+			fakeStepA.ResultStub = successResult(true)
+			fakeStepB = new(execfakes.FakeStep)
+			fakeStepB.ResultStub = successResult(true)
+			fakeStepC = new(execfakes.FakeStep)
+			fakeStepC.ResultStub = successResult(true)
+		})
+
+		It("runs all of them and succeeds", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepB.RunCallCount()).To(Equal(1))
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+
+			var succeeded Success
+			Expect(step.Result(&succeeded)).To(BeTrue())
+			Expect(bool(succeeded)).To(BeTrue())
+		})
+	})
+
+	Context("with blocking steps", func() {
+		var (
+			started  chan string
+			releaseA chan error
+			releaseB chan error
+			releaseC chan error
+		)
+
+		BeforeEach(func() {
+			started = make(chan string, 3)
+			releaseA = make(chan error, 1)
+			releaseB = make(chan error, 1)
+			releaseC = make(chan error, 1)
+
+			fakeStepA = blockingStep(started, "a", releaseA)
+			fakeStepB = blockingStep(started, "b", releaseB)
+			fakeStepC = blockingStep(started, "c", releaseC)
+		})
+
+		Context("when there is no limit", func() {
+			It("starts every step at once", func() {
+				Eventually(started).Should(Receive())
+				Eventually(started).Should(Receive())
+				Eventually(started).Should(Receive())
+			})
+		})
+
+		Context("when there is a limit", func() {
+			BeforeEach(func() {
+				limit = 2
+			})
+
+			It("starts no more than the limit at once", func() {
+				Eventually(started).Should(Receive(Equal("a")))
+				Eventually(started).Should(Receive(Equal("b")))
+				Consistently(started).ShouldNot(Receive())
+
+				releaseA <- nil
+
+				Eventually(started).Should(Receive(Equal("c")))
+
+				releaseB <- nil
+				releaseC <- nil
+
+				var err error
+				Eventually(process.Wait()).Should(Receive(&err))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the errors of the steps that errored", func() {
+				Eventually(started).Should(Receive())
+				Eventually(started).Should(Receive())
+
+				releaseA <- errors.New("nope")
+				releaseB <- nil
+
+				Eventually(started).Should(Receive())
+				releaseC <- nil
+
+				var err error
+				Eventually(process.Wait()).Should(Receive(&err))
+				Expect(err).To(MatchError(ContainSubstring("nope")))
+			})
+
+			Context("when interrupted", func() {
+				It("propagates the signal to the running steps, starts no more, and returns ErrInterrupted", func() {
+					Eventually(started).Should(Receive())
+					Eventually(started).Should(Receive())
+
+					process.Signal(os.Interrupt)
+
+					var err error
+					Eventually(process.Wait()).Should(Receive(&err))
+					Expect(err).To(Equal(ErrInterrupted))
+
+					Expect(fakeStepC.RunCallCount()).To(BeZero())
+
+					var succeeded Success
+					Expect(step.Result(&succeeded)).To(BeTrue())
+					Expect(bool(succeeded)).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when failing fast", func() {
+			BeforeEach(func() {
+				limit = 2
+				failFast = true
+			})
+
+			Context("when a step errors", func() {
+				It("interrupts the running steps and starts no more", func() {
+					Eventually(started).Should(Receive())
+					Eventually(started).Should(Receive())
+
+					releaseA <- errors.New("nope")
+
+					var err error
+					Eventually(process.Wait()).Should(Receive(&err))
+					Expect(err).To(MatchError("steps failed:\nnope"))
+
+					Expect(fakeStepC.RunCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when a step fails", func() {
+				BeforeEach(func() {
+					fakeStepA.ResultStub = successResult(false)
+				})
+
+				It("interrupts the running steps without erroring", func() {
+					Eventually(started).Should(Receive())
+					Eventually(started).Should(Receive())
+
+					releaseA <- nil
+
+					var err error
+					Eventually(process.Wait()).Should(Receive(&err))
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeStepC.RunCallCount()).To(BeZero())
+
+					var succeeded Success
+					Expect(step.Result(&succeeded)).To(BeTrue())
+					Expect(bool(succeeded)).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when not failing fast and a step fails", func() {
+			BeforeEach(func() {
+				limit = 2
+				fakeStepA.ResultStub = successResult(false)
+			})
+
+			It("keeps running the other steps", func() {
+				Eventually(started).Should(Receive())
+				Eventually(started).Should(Receive())
+
+				releaseA <- nil
+
+				Eventually(started).Should(Receive(Equal("c")))
+
+				releaseB <- nil
+				releaseC <- nil
+
+				var err error
+				Eventually(process.Wait()).Should(Receive(&err))
+				Expect(err).NotTo(HaveOccurred())
+
+				var succeeded Success
+				Expect(step.Result(&succeeded)).To(BeTrue())
+				Expect(bool(succeeded)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,203 @@
+package exec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/worker"
+	"github.com/concourse/baggageclaim"
+	"gopkg.in/yaml.v2"
+)
+
+// LoadVarStep reads a file out of an artifact into a build variable.
+type LoadVarStep struct {
+	name      string
+	file      string
+	format    string
+	sensitive bool
+	delegate  LoadVarDelegate
+
+	repository *worker.ArtifactRepository
+
+	succeeded bool
+}
+
+// LoadVar constructs a LoadVarStep factory.
+func LoadVar(
+	name string,
+	file string,
+	format string,
+	sensitive bool,
+	delegate LoadVarDelegate,
+) LoadVarStep {
+	return LoadVarStep{
+		name:      name,
+		file:      file,
+		format:    format,
+		sensitive: sensitive,
+		delegate:  delegate,
+	}
+}
+
+// Using finishes construction of the LoadVarStep and returns a *LoadVarStep.
+// If the *LoadVarStep errors, its error is reported to the delegate.
+func (step LoadVarStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
+	step.repository = repo
+
+	return errorReporter{
+		Step:          &step,
+		ReportFailure: step.delegate.Failed,
+	}
+}
+
+// Run reads the file and parses it according to the step's format, handing
+// the value to the delegate to be set as a build variable.
+//
+// The path must be in the format SOURCE_NAME/FILE/PATH, as with a task's
+// config file. If no format was given, it is determined by the file's
+// extension: .json files are parsed as JSON, .yml and .yaml files as YAML,
+// and anything else is read as a string with surrounding whitespace trimmed.
+func (step *LoadVarStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	close(ready)
+
+	segs := strings.SplitN(step.file, "/", 2)
+	if len(segs) != 2 {
+		return UnspecifiedArtifactSourceError{step.file}
+	}
+
+	sourceName := worker.ArtifactName(segs[0])
+	filePath := segs[1]
+
+	source, found := step.repository.SourceFor(sourceName)
+	if !found {
+		return UnknownArtifactSourceError{sourceName}
+	}
+
+	stream, err := source.StreamFile(filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return fmt.Errorf("file '%s/%s' not found", sourceName, filePath)
+		}
+		return err
+	}
+
+	defer stream.Close()
+
+	payload, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return err
+	}
+
+	value, err := step.parse(payload)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %s", step.file, err)
+	}
+
+	step.delegate.VarLoaded(step.name, value, step.sensitive)
+
+	step.succeeded = true
+
+	return nil
+}
+
+// Result indicates Success as true if the variable was loaded.
+//
+// All other types are ignored.
+func (step *LoadVarStep) Result(x interface{}) bool {
+	switch v := x.(type) {
+	case *Success:
+		*v = Success(step.succeeded)
+		return true
+
+	default:
+		return false
+	}
+}
+
+func (step *LoadVarStep) parse(payload []byte) (interface{}, error) {
+	format := step.format
+	if format == "" {
+		switch filepath.Ext(step.file) {
+		case ".json":
+			format = atc.LoadVarFormatJSON
+		case ".yml", ".yaml":
+			format = atc.LoadVarFormatYAML
+		default:
+			format = atc.LoadVarFormatTrim
+		}
+	}
+
+	switch format {
+	case atc.LoadVarFormatRaw:
+		return string(payload), nil
+
+	case atc.LoadVarFormatTrim:
+		return strings.TrimSpace(string(payload)), nil
+
+	case atc.LoadVarFormatJSON:
+		var value interface{}
+		err := json.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return value, nil
+
+	case atc.LoadVarFormatYAML:
+		var value interface{}
+		err := yaml.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, err
+		}
+
+		return stringKeys(value)
+
+	default:
+		return nil, fmt.Errorf("unknown format '%s'", format)
+	}
+}
+
+// stringKeys converts the maps decoded from YAML to have string keys, so that
+// they can be reached into and marshalled as JSON.
+func stringKeys(node interface{}) (interface{}, error) {
+	switch n := node.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			str, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("non-string key: %v", k)
+			}
+
+			sub, err := stringKeys(v)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[str] = sub
+		}
+
+		return converted, nil
+
+	case []interface{}:
+		converted := make([]interface{}, len(n))
+		for i, v := range n {
+			sub, err := stringKeys(v)
+			if err != nil {
+				return nil, err
+			}
+
+			converted[i] = sub
+		}
+
+		return converted, nil
+
+	default:
+		return node, nil
+	}
+}
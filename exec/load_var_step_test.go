@@ -0,0 +1,223 @@
+package exec_test
+
+import (
+	"errors"
+
+	. "github.com/concourse/atc/exec"
+	"github.com/concourse/atc/exec/execfakes"
+	"github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/workerfakes"
+	"github.com/concourse/baggageclaim"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		fakeDelegate       *execfakes.FakeLoadVarDelegate
+		fakeArtifactSource *workerfakes.FakeArtifactSource
+		repo               *worker.ArtifactRepository
+
+		file      string
+		format    string
+		sensitive bool
+
+		streamedOut *gbytes.Buffer
+
+		step    Step
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeDelegate = new(execfakes.FakeLoadVarDelegate)
+
+		fakeArtifactSource = new(workerfakes.FakeArtifactSource)
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-artifact", fakeArtifactSource)
+
+		file = "some-artifact/version"
+		format = ""
+		sensitive = false
+
+		streamedOut = gbytes.BufferWithBytes([]byte("1.2.3\n"))
+		fakeArtifactSource.StreamFileReturns(streamedOut, nil)
+	})
+
+	JustBeforeEach(func() {
+		step = LoadVar("some-var", file, format, sensitive, fakeDelegate).Using(nil, repo)
+		process = ifrit.Invoke(step)
+	})
+
+	It("streams the file out of the artifact", func() {
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+
+		Expect(fakeArtifactSource.StreamFileArgsForCall(0)).To(Equal("version"))
+		Expect(streamedOut.Closed()).To(BeTrue())
+	})
+
+	It("loads the file's trimmed contents into the variable", func() {
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+
+		Expect(fakeDelegate.VarLoadedCallCount()).To(Equal(1))
+		name, value, loadedSensitive := fakeDelegate.VarLoadedArgsForCall(0)
+		Expect(name).To(Equal("some-var"))
+		Expect(value).To(Equal("1.2.3"))
+		Expect(loadedSensitive).To(BeFalse())
+	})
+
+	It("succeeds", func() {
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+
+		var succeeded Success
+		Expect(step.Result(&succeeded)).To(BeTrue())
+		Expect(bool(succeeded)).To(BeTrue())
+	})
+
+	Context("when the value is sensitive", func() {
+		BeforeEach(func() {
+			sensitive = true
+		})
+
+		It("tells the delegate", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+
+			_, _, loadedSensitive := fakeDelegate.VarLoadedArgsForCall(0)
+			Expect(loadedSensitive).To(BeTrue())
+		})
+	})
+
+	Context("when the format is raw", func() {
+		BeforeEach(func() {
+			format = "raw"
+		})
+
+		It("loads the contents as-is", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+
+			_, value, _ := fakeDelegate.VarLoadedArgsForCall(0)
+			Expect(value).To(Equal("1.2.3\n"))
+		})
+	})
+
+	Context("when the file is JSON", func() {
+		BeforeEach(func() {
+			file = "some-artifact/release.json"
+			fakeArtifactSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(`{"version":"1.2.3","build":42}`)), nil)
+		})
+
+		It("parses it", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+
+			_, value, _ := fakeDelegate.VarLoadedArgsForCall(0)
+			Expect(value).To(Equal(map[string]interface{}{
+				"version": "1.2.3",
+				"build":   float64(42),
+			}))
+		})
+
+		Context("when it is malformed", func() {
+			BeforeEach(func() {
+				fakeArtifactSource.StreamFileReturns(gbytes.BufferWithBytes([]byte(`{`)), nil)
+			})
+
+			It("errors and reports the failure", func() {
+				var err error
+				Eventually(process.Wait()).Should(Receive(&err))
+				Expect(err).To(MatchError(ContainSubstring("failed to load some-artifact/release.json")))
+
+				Expect(fakeDelegate.FailedCallCount()).To(Equal(1))
+				Expect(fakeDelegate.VarLoadedCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the file is YAML", func() {
+		BeforeEach(func() {
+			file = "some-artifact/release.yml"
+			fakeArtifactSource.StreamFileReturns(gbytes.BufferWithBytes([]byte("version: 1.2.3\ntags: [latest]\nnested: {a: b}\n")), nil)
+		})
+
+		It("parses it, with string keys", func() {
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+
+			_, value, _ := fakeDelegate.VarLoadedArgsForCall(0)
+			Expect(value).To(Equal(map[string]interface{}{
+				"version": "1.2.3",
+				"tags":    []interface{}{"latest"},
+				"nested":  map[string]interface{}{"a": "b"},
+			}))
+		})
+
+		Context("when the format is given as trim", func() {
+			BeforeEach(func() {
+				format = "trim"
+			})
+
+			It("does not parse it", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+
+				_, value, _ := fakeDelegate.VarLoadedArgsForCall(0)
+				Expect(value).To(Equal("version: 1.2.3\ntags: [latest]\nnested: {a: b}"))
+			})
+		})
+	})
+
+	Context("when the path does not indicate an artifact source", func() {
+		BeforeEach(func() {
+			file = "version"
+		})
+
+		It("errors", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+			Expect(err).To(Equal(UnspecifiedArtifactSourceError{"version"}))
+
+			Expect(fakeDelegate.FailedArgsForCall(0)).To(Equal(err))
+		})
+	})
+
+	Context("when the artifact source cannot be found", func() {
+		BeforeEach(func() {
+			file = "bogus/version"
+		})
+
+		It("errors", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+			Expect(err).To(Equal(UnknownArtifactSourceError{"bogus"}))
+		})
+	})
+
+	Context("when the file is not found", func() {
+		BeforeEach(func() {
+			fakeArtifactSource.StreamFileReturns(nil, baggageclaim.ErrFileNotFound)
+		})
+
+		It("errors", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+			Expect(err).To(MatchError("file 'some-artifact/version' not found"))
+		})
+	})
+
+	Context("when streaming the file fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeArtifactSource.StreamFileReturns(nil, disaster)
+		})
+
+		It("errors and does not succeed", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+			Expect(err).To(Equal(disaster))
+
+			var succeeded Success
+			Expect(step.Result(&succeeded)).To(BeTrue())
+			Expect(bool(succeeded)).To(BeFalse())
+		})
+	})
+})
@@ -82,3 +82,19 @@ func (o *OnFailureStep) Result(x interface{}) bool {
 		return false
 	}
 }
+
+// WorkerName forwards to the nested step if it is a WorkerPlacedStep.
+func (o *OnFailureStep) WorkerName() (string, bool) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		return placed.WorkerName()
+	}
+
+	return "", false
+}
+
+// AvoidWorkers forwards to the nested step if it is a WorkerPlacedStep.
+func (o *OnFailureStep) AvoidWorkers(workerNames []string) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		placed.AvoidWorkers(workerNames)
+	}
+}
@@ -89,3 +89,19 @@ func (o *OnSuccessStep) Result(x interface{}) bool {
 		return false
 	}
 }
+
+// WorkerName forwards to the nested step if it is a WorkerPlacedStep.
+func (o *OnSuccessStep) WorkerName() (string, bool) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		return placed.WorkerName()
+	}
+
+	return "", false
+}
+
+// AvoidWorkers forwards to the nested step if it is a WorkerPlacedStep.
+func (o *OnSuccessStep) AvoidWorkers(workerNames []string) {
+	if placed, ok := o.step.(WorkerPlacedStep); ok {
+		placed.AvoidWorkers(workerNames)
+	}
+}
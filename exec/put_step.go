@@ -20,6 +20,7 @@ type PutStep struct {
 	stepMetadata    StepMetadata
 	session         resource.Session
 	tags            atc.Tags
+	placement       *atc.WorkerPlacement
 	teamID          int
 	buildID         int
 	planID          atc.PlanID
@@ -31,9 +32,12 @@ type PutStep struct {
 
 	resource resource.Resource
 
-	versionedSource resource.VersionedSource
+	versionInfo VersionInfo
 
 	succeeded bool
+
+	avoidWorkers []string
+	workerName   string
 }
 
 func newPutStep(
@@ -43,6 +47,7 @@ func newPutStep(
 	stepMetadata StepMetadata,
 	session resource.Session,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	teamID int,
 	buildID int,
 	planID atc.PlanID,
@@ -57,6 +62,7 @@ func newPutStep(
 		stepMetadata:    stepMetadata,
 		session:         session,
 		tags:            tags,
+		placement:       placement,
 		teamID:          teamID,
 		buildID:         buildID,
 		planID:          planID,
@@ -84,24 +90,83 @@ func (step PutStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
 // the container, using volumes if possible, and streaming content over if not.
 //
 // The resource's put script is then invoked. The PutStep is ready as soon as
-// the resource's script starts, and signals will be forwarded to the script.
+// the resource's script starts, at which point the delegate is notified that
+// the step has started, and signals will be forwarded to the script.
+//
+// Inputs which had to be streamed in are reported to the delegate along with
+// how long they took and how large they were.
+//
+// Build variables are interpolated into the resource's source and the step's
+// params before the container is created.
+//
+// The step is interrupted without doing anything if the delegate does not
+// allow it to start, e.g. because the build is about to be aborted.
+//
+// If the put already completed before its build was handed off to this ATC,
+// the result it left behind is used instead of running it again.
 func (step *PutStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	checkpoint, resumed, err := step.delegate.Resume()
+	if err != nil {
+		return err
+	}
+
+	if resumed {
+		step.logger.Info("already-completed", lager.Data{"exit-status": checkpoint.ExitStatus})
+
+		if checkpoint.VersionInfo != nil {
+			step.versionInfo = *checkpoint.VersionInfo
+		}
+
+		step.succeeded = checkpoint.ExitStatus == 0
+		return nil
+	}
+
+	starting, err := step.delegate.Starting()
+	if err != nil {
+		return err
+	}
+
+	if !starting {
+		return ErrInterrupted
+	}
+
+	defer step.delegate.Finished()
+
 	step.delegate.Initializing()
 
+	variables := step.delegate.BuildVariables()
+
+	source, err := variables.InterpolateSource(step.resourceConfig.Source)
+	if err != nil {
+		return err
+	}
+
+	params, err := variables.InterpolateParams(step.params)
+	if err != nil {
+		return err
+	}
+
 	containerSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: step.resourceConfig.Type,
 			Privileged:   true,
 		},
-		Tags:   step.tags,
-		TeamID: step.teamID,
-		Env:    step.stepMetadata.Env(),
+		Tags:      step.tags,
+		TeamID:    step.teamID,
+		Env:       step.stepMetadata.Env(),
+		Placement: step.placement,
+
+		AvoidWorkers: step.avoidWorkers,
 	}
 
 	for name, source := range step.repository.AsMap() {
 		containerSpec.Inputs = append(containerSpec.Inputs, &putInputSource{
-			name:   name,
-			source: resourceSource{source},
+			name: name,
+			source: streamReportingSource{
+				ArtifactSource: resourceSource{source},
+				name:           name,
+				report:         step.delegate.InputStreamed,
+			},
 		})
 	}
 
@@ -120,18 +185,23 @@ func (step *PutStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 	}
 
 	step.resource = putResource
+	step.workerName = putResource.Container().WorkerName()
+
+	started := notifyStarted(ready, step.delegate.Started)
 
-	step.versionedSource, err = step.resource.Put(
+	versionedSource, err := step.resource.Put(
 		resource.IOConfig{
 			Stdout: step.delegate.Stdout(),
 			Stderr: step.delegate.Stderr(),
 		},
-		step.resourceConfig.Source,
-		step.params,
+		source,
+		params,
 		signals,
-		ready,
+		started.Ready(),
 	)
 
+	started.Stop()
+
 	if err, ok := err.(resource.ErrResourceScriptFailed); ok {
 		step.delegate.Completed(ExitStatus(err.ExitStatus), nil)
 		return nil
@@ -145,15 +215,29 @@ func (step *PutStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 		return err
 	}
 
+	step.versionInfo = VersionInfo{
+		Version:  versionedSource.Version(),
+		Metadata: versionedSource.Metadata(),
+	}
+
 	step.succeeded = true
-	step.delegate.Completed(ExitStatus(0), &VersionInfo{
-		Version:  step.versionedSource.Version(),
-		Metadata: step.versionedSource.Metadata(),
-	})
+	step.delegate.Completed(ExitStatus(0), &step.versionInfo)
 
 	return nil
 }
 
+// WorkerName returns the worker the step's container was placed on, if it
+// got that far.
+func (step *PutStep) WorkerName() (string, bool) {
+	return step.workerName, step.workerName != ""
+}
+
+// AvoidWorkers places the step's container on another worker than the given
+// ones, if any other is suitable.
+func (step *PutStep) AvoidWorkers(workerNames []string) {
+	step.avoidWorkers = workerNames
+}
+
 // Result indicates Success as true if the script completed with exit status 0.
 //
 // It also indicates VersionInfo returned by the script.
@@ -165,10 +249,7 @@ func (step *PutStep) Result(x interface{}) bool {
 		*v = Success(step.succeeded)
 		return true
 	case *VersionInfo:
-		*v = VersionInfo{
-			Version:  step.versionedSource.Version(),
-			Metadata: step.versionedSource.Metadata(),
-		}
+		*v = step.versionInfo
 		return true
 
 	default:
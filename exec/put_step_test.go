@@ -2,7 +2,10 @@ package exec_test
 
 import (
 	"errors"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
@@ -58,6 +61,7 @@ var _ = Describe("GardenFactory", func() {
 			resourceConfig atc.ResourceConfig
 			params         atc.Params
 			tags           []string
+			placement      *atc.WorkerPlacement
 			resourceTypes  atc.VersionedResourceTypes
 
 			inStep *execfakes.FakeStep
@@ -71,6 +75,7 @@ var _ = Describe("GardenFactory", func() {
 			putDelegate = new(execfakes.FakePutDelegate)
 			putDelegate.StdoutReturns(stdoutBuf)
 			putDelegate.StderrReturns(stderrBuf)
+			putDelegate.StartingReturns(true, nil)
 
 			resourceConfig = atc.ResourceConfig{
 				Name:   "some-resource",
@@ -80,6 +85,11 @@ var _ = Describe("GardenFactory", func() {
 
 			params = atc.Params{"some-param": "some-value"}
 			tags = []string{"some", "tags"}
+			placement = &atc.WorkerPlacement{
+				Selectors: []atc.WorkerSelector{
+					{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+				},
+			}
 
 			inStep = new(execfakes.FakeStep)
 			repo = worker.NewArtifactRepository()
@@ -107,6 +117,7 @@ var _ = Describe("GardenFactory", func() {
 				putDelegate,
 				resourceConfig,
 				tags,
+				placement,
 				params,
 				resourceTypes,
 			).Using(inStep, repo)
@@ -141,6 +152,10 @@ var _ = Describe("GardenFactory", func() {
 					fakeResource = new(resourcefakes.FakeResource)
 					fakeResourceFactory.NewPutResourceReturns(fakeResource, nil)
 
+					fakeContainer := new(workerfakes.FakeContainer)
+					fakeContainer.WorkerNameReturns("some-worker")
+					fakeResource.ContainerReturns(fakeContainer)
+
 					fakeVersionedSource = new(resourcefakes.FakeVersionedSource)
 					fakeVersionedSource.VersionReturns(atc.Version{"some": "version"})
 					fakeVersionedSource.MetadataReturns([]atc.MetadataField{{"some", "metadata"}})
@@ -164,6 +179,7 @@ var _ = Describe("GardenFactory", func() {
 						Privileged:   true,
 					}))
 					Expect(containerSpec.Tags).To(Equal([]string{"some", "tags"}))
+					Expect(containerSpec.Placement).To(Equal(placement))
 					Expect(containerSpec.TeamID).To(Equal(123))
 					Expect(containerSpec.Env).To(Equal([]string{"a=1", "b=2"}))
 					Expect(containerSpec.Inputs).To(HaveLen(3))
@@ -188,6 +204,44 @@ var _ = Describe("GardenFactory", func() {
 					Expect(putParams).To(Equal(params))
 				})
 
+				It("records that the put started and finished", func() {
+					Eventually(process.Wait()).Should(Receive())
+					Expect(putDelegate.StartingCallCount()).To(Equal(1))
+					Expect(putDelegate.FinishedCallCount()).To(Equal(1))
+				})
+
+				Context("when the source and params refer to build variables", func() {
+					BeforeEach(func() {
+						variables := NewBuildVariables()
+						variables.Set("bucket", "some-bucket")
+						variables.Set("release", map[string]interface{}{"file": "release.tgz"})
+						putDelegate.BuildVariablesReturns(variables)
+
+						resourceConfig.Source = atc.Source{"bucket": "((.:bucket))"}
+						params = atc.Params{"file": "out/((.:release.file))"}
+					})
+
+					It("puts the resource with them interpolated", func() {
+						Expect(fakeResource.PutCallCount()).To(Equal(1))
+
+						_, putSource, putParams, _, _ := fakeResource.PutArgsForCall(0)
+						Expect(putSource).To(Equal(atc.Source{"bucket": "some-bucket"}))
+						Expect(putParams).To(Equal(atc.Params{"file": "out/release.tgz"}))
+					})
+				})
+
+				Context("when the params refer to build variables that are not set", func() {
+					BeforeEach(func() {
+						params = atc.Params{"file": "((.:file))"}
+					})
+
+					It("errors without creating the put resource", func() {
+						Eventually(process.Wait()).Should(Receive(Equal(UndefinedVarsError{Names: []string{"file"}})))
+						Expect(fakeResourceFactory.NewPutResourceCallCount()).To(BeZero())
+						Expect(putDelegate.FailedCallCount()).To(Equal(1))
+					})
+				})
+
 				It("puts the resource with the io config forwarded", func() {
 					Expect(fakeResource.PutCallCount()).To(Equal(1))
 
@@ -200,6 +254,63 @@ var _ = Describe("GardenFactory", func() {
 					Expect(fakeResource.PutCallCount()).To(Equal(1))
 				})
 
+				Describe("streaming the inputs in", func() {
+					var fakeDestination *workerfakes.FakeArtifactDestination
+
+					BeforeEach(func() {
+						fakeDestination = new(workerfakes.FakeArtifactDestination)
+						fakeDestination.StreamInStub = func(path string, tarStream io.Reader) error {
+							_, err := ioutil.ReadAll(tarStream)
+							return err
+						}
+
+						fakeSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+							return dest.StreamIn(".", strings.NewReader("some-tar-stream"))
+						}
+					})
+
+					It("reports the streamed input to the delegate", func() {
+						_, _, _, _, _, containerSpec, _, _ := fakeResourceFactory.NewPutResourceArgsForCall(0)
+
+						for _, input := range containerSpec.Inputs {
+							if input.Name() == "some-source" {
+								Expect(input.Source().StreamTo(fakeDestination)).To(Succeed())
+							}
+						}
+
+						Expect(fakeSource.StreamToCallCount()).To(Equal(1))
+
+						Expect(putDelegate.InputStreamedCallCount()).To(Equal(1))
+						name, stats := putDelegate.InputStreamedArgsForCall(0)
+						Expect(name).To(Equal(worker.ArtifactName("some-source")))
+						Expect(stats.Bytes).To(Equal(int64(len("some-tar-stream"))))
+					})
+				})
+
+				Context("when the put script starts running", func() {
+					BeforeEach(func() {
+						fakeResource.PutStub = func(
+							ioConfig resource.IOConfig,
+							source atc.Source,
+							params atc.Params,
+							signals <-chan os.Signal,
+							ready chan<- struct{},
+						) (resource.VersionedSource, error) {
+							close(ready)
+							return fakeVersionedSource, nil
+						}
+					})
+
+					It("calls the Started method on the delegate", func() {
+						Eventually(process.Wait()).Should(Receive(BeNil()))
+						Expect(putDelegate.StartedCallCount()).To(Equal(1))
+					})
+
+					It("becomes ready", func() {
+						Eventually(process.Ready()).Should(BeClosed())
+					})
+				})
+
 				It("reports the created version info", func() {
 					var info VersionInfo
 					Expect(step.Result(&info)).To(BeTrue())
@@ -348,6 +459,100 @@ var _ = Describe("GardenFactory", func() {
 					Expect(putDelegate.FailedCallCount()).To(Equal(1))
 					Expect(putDelegate.FailedArgsForCall(0)).To(Equal(disaster))
 				})
+
+				It("records that the put finished", func() {
+					Eventually(process.Wait()).Should(Receive(Equal(disaster)))
+					Expect(putDelegate.FinishedCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the delegate does not allow the put to start", func() {
+			BeforeEach(func() {
+				putDelegate.StartingReturns(false, nil)
+			})
+
+			It("is interrupted without doing anything", func() {
+				Eventually(process.Wait()).Should(Receive(Equal(ErrInterrupted)))
+
+				Expect(putDelegate.InitializingCallCount()).To(BeZero())
+				Expect(fakeResourceFactory.NewPutResourceCallCount()).To(BeZero())
+				Expect(putDelegate.FinishedCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when checking whether the put may start fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				putDelegate.StartingReturns(false, disaster)
+			})
+
+			It("exits with the failure without doing anything", func() {
+				Eventually(process.Wait()).Should(Receive(Equal(disaster)))
+				Expect(fakeResourceFactory.NewPutResourceCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the put already completed before the build was handed off", func() {
+			BeforeEach(func() {
+				putDelegate.ResumeReturns(PutCheckpoint{
+					ExitStatus: 0,
+					VersionInfo: &VersionInfo{
+						Version:  atc.Version{"some": "version"},
+						Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+					},
+				}, true, nil)
+			})
+
+			It("does not run the put again", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+
+				Expect(putDelegate.StartingCallCount()).To(BeZero())
+				Expect(fakeResourceFactory.NewPutResourceCallCount()).To(BeZero())
+				Expect(putDelegate.CompletedCallCount()).To(BeZero())
+			})
+
+			It("reports the result it left behind", func() {
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+
+				var success Success
+				Expect(step.Result(&success)).To(BeTrue())
+				Expect(success).To(BeTrue())
+
+				var info VersionInfo
+				Expect(step.Result(&info)).To(BeTrue())
+				Expect(info).To(Equal(VersionInfo{
+					Version:  atc.Version{"some": "version"},
+					Metadata: []atc.MetadataField{{Name: "some", Value: "metadata"}},
+				}))
+			})
+
+			Context("when the put had failed", func() {
+				BeforeEach(func() {
+					putDelegate.ResumeReturns(PutCheckpoint{ExitStatus: 1}, true, nil)
+				})
+
+				It("does not succeed", func() {
+					Eventually(process.Wait()).Should(Receive(BeNil()))
+
+					var success Success
+					Expect(step.Result(&success)).To(BeTrue())
+					Expect(success).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when looking for an earlier run of the put fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				putDelegate.ResumeReturns(PutCheckpoint{}, false, disaster)
+			})
+
+			It("exits with the failure without doing anything", func() {
+				Eventually(process.Wait()).Should(Receive(Equal(disaster)))
+				Expect(putDelegate.StartingCallCount()).To(BeZero())
 			})
 		})
 	})
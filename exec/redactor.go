@@ -0,0 +1,233 @@
+package exec
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/concourse/atc"
+)
+
+// Redactor scrubs the secrets a build uses from the output of its steps.
+// Secrets can be registered at any point, e.g. once a task's config has been
+// fetched, and apply to everything written from then on.
+type Redactor struct {
+	rules atc.RedactionRules
+
+	lock     sync.RWMutex
+	secrets  map[string]bool
+	replacer *strings.Replacer
+	matcher  *secretMatcher
+}
+
+func NewRedactor(rules atc.RedactionRules) *Redactor {
+	return &Redactor{
+		rules:   rules,
+		secrets: map[string]bool{},
+	}
+}
+
+// Register collects the secrets in the given values, which may be strings or
+// maps and slices of them, as found in sources and params. Values under keys
+// the rules ignore are skipped.
+func (redactor *Redactor) Register(values ...interface{}) {
+	if redactor.rules.Disabled {
+		return
+	}
+
+	redactor.lock.Lock()
+	defer redactor.lock.Unlock()
+
+	before := len(redactor.secrets)
+
+	for _, value := range values {
+		redactor.register(value)
+	}
+
+	if len(redactor.secrets) == before {
+		return
+	}
+
+	secrets := make([]string, 0, len(redactor.secrets))
+	for secret := range redactor.secrets {
+		secrets = append(secrets, secret)
+	}
+
+	// longest first, so that a secret containing another is replaced whole
+	sort.Sort(longestFirst(secrets))
+
+	placeholder := redactor.rules.EffectivePlaceholder()
+
+	pairs := make([]string, 0, len(secrets)*2)
+	for _, secret := range secrets {
+		pairs = append(pairs, secret, placeholder)
+	}
+
+	redactor.replacer = strings.NewReplacer(pairs...)
+	redactor.matcher = newSecretMatcher(secrets)
+}
+
+func (redactor *Redactor) register(value interface{}) {
+	switch v := value.(type) {
+	case string:
+		redactor.registerString(v)
+
+	case []interface{}:
+		for _, elem := range v {
+			redactor.register(elem)
+		}
+
+	case map[string]interface{}:
+		for key, elem := range v {
+			if !redactor.rules.Ignores(key) {
+				redactor.register(elem)
+			}
+		}
+
+	case map[interface{}]interface{}:
+		for key, elem := range v {
+			if name, ok := key.(string); ok && redactor.rules.Ignores(name) {
+				continue
+			}
+
+			redactor.register(elem)
+		}
+
+	case map[string]string:
+		for key, elem := range v {
+			if !redactor.rules.Ignores(key) {
+				redactor.registerString(elem)
+			}
+		}
+
+	case atc.Source:
+		redactor.register(map[string]interface{}(v))
+
+	case atc.Params:
+		redactor.register(map[string]interface{}(v))
+	}
+}
+
+func (redactor *Redactor) registerString(secret string) {
+	minLength := redactor.rules.EffectiveMinLength()
+
+	if len(secret) >= minLength {
+		redactor.secrets[secret] = true
+	}
+
+	// multi-line secrets such as private keys are often printed a line at a
+	// time, or re-indented, so each line is a secret too
+	if strings.Contains(secret, "\n") {
+		for _, line := range strings.Split(secret, "\n") {
+			line = strings.TrimSpace(line)
+			if len(line) >= minLength {
+				redactor.secrets[line] = true
+			}
+		}
+	}
+}
+
+// Redact replaces every registered secret in the text.
+func (redactor *Redactor) Redact(text string) string {
+	redactor.lock.RLock()
+	defer redactor.lock.RUnlock()
+
+	if redactor.replacer == nil {
+		return text
+	}
+
+	return redactor.replacer.Replace(text)
+}
+
+// Writer returns a writer that redacts everything written to it before
+// passing it on to dest.
+func (redactor *Redactor) Writer(dest io.Writer) *RedactingWriter {
+	return &RedactingWriter{
+		redactor: redactor,
+		dest:     dest,
+	}
+}
+
+// holdBack returns where to cut the raw, unredacted text so that everything
+// after the cut could be the start of a secret continued by the next write.
+// The cut never runs through a secret found in the text, which the replacer
+// would otherwise miss. Only as much as the longest secret is ever held back
+// for the first reason, and the text is scanned once whatever the number of
+// secrets.
+func (redactor *Redactor) holdBack(text string) int {
+	redactor.lock.RLock()
+	defer redactor.lock.RUnlock()
+
+	if redactor.matcher == nil {
+		return len(text)
+	}
+
+	matches, held := redactor.matcher.scan(text)
+
+	cut := len(text) - held
+
+	// matches are in order of where they end, and the cut only moves back, so
+	// once a match ends before the cut every earlier one does too
+	for i := len(matches) - 1; i >= 0 && matches[i].end > cut; i-- {
+		if matches[i].start < cut {
+			cut = matches[i].start
+		}
+	}
+
+	return cut
+}
+
+// RedactingWriter redacts secrets from output, including secrets split across
+// writes. Output that might be the start of a secret is held back, unredacted,
+// until the next write shows otherwise, or until Flush is called.
+type RedactingWriter struct {
+	redactor *Redactor
+	dest     io.Writer
+
+	held string
+}
+
+func (writer *RedactingWriter) Write(data []byte) (int, error) {
+	text := writer.held + string(data)
+
+	cut := writer.redactor.holdBack(text)
+
+	writer.held = text[cut:]
+
+	if cut == 0 {
+		return len(data), nil
+	}
+
+	_, err := io.WriteString(writer.dest, writer.redactor.Redact(text[:cut]))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(data), nil
+}
+
+// Flush writes out anything held back, as no more output will follow it.
+func (writer *RedactingWriter) Flush() error {
+	if writer.held == "" {
+		return nil
+	}
+
+	held := writer.held
+	writer.held = ""
+
+	_, err := io.WriteString(writer.dest, writer.redactor.Redact(held))
+	return err
+}
+
+type longestFirst []string
+
+func (s longestFirst) Len() int      { return len(s) }
+func (s longestFirst) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s longestFirst) Less(i, j int) bool {
+	if len(s[i]) != len(s[j]) {
+		return len(s[i]) > len(s[j])
+	}
+
+	return s[i] < s[j]
+}
@@ -0,0 +1,280 @@
+package exec_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/atc"
+	. "github.com/concourse/atc/exec"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Redactor", func() {
+	var (
+		rules    atc.RedactionRules
+		redactor *Redactor
+	)
+
+	BeforeEach(func() {
+		rules = atc.RedactionRules{}
+	})
+
+	JustBeforeEach(func() {
+		redactor = NewRedactor(rules)
+		redactor.Register(
+			atc.Source{
+				"uri":      "https://example.com/repo.git",
+				"password": "hunter2",
+				"nested": map[string]interface{}{
+					"keys": []interface{}{"some-access-key", 42},
+				},
+			},
+			atc.Params{"token": "abc"},
+			map[string]string{"TASK_SECRET": "s3cr3t-param"},
+		)
+	})
+
+	Describe("Redact", func() {
+		It("replaces every registered secret", func() {
+			Expect(redactor.Redact("login with hunter2 and some-access-key to https://example.com/repo.git")).To(Equal(
+				"login with ((redacted)) and ((redacted)) to ((redacted))",
+			))
+
+			Expect(redactor.Redact("TASK_SECRET=s3cr3t-param")).To(Equal("TASK_SECRET=((redacted))"))
+		})
+
+		It("leaves values shorter than the minimum length alone", func() {
+			Expect(redactor.Redact("abc")).To(Equal("abc"))
+		})
+
+		It("replaces a secret containing another secret whole", func() {
+			redactor.Register("hunter2-and-more")
+			Expect(redactor.Redact("x hunter2-and-more x hunter2")).To(Equal("x ((redacted)) x ((redacted))"))
+		})
+
+		Context("with a multi-line secret", func() {
+			JustBeforeEach(func() {
+				redactor.Register(atc.Source{
+					"private_key": "-----BEGIN KEY-----\nline-one-of-key\nline-two-of-key\n-----END KEY-----\n",
+				})
+			})
+
+			It("redacts it whole", func() {
+				Expect(redactor.Redact("key:\n-----BEGIN KEY-----\nline-one-of-key\nline-two-of-key\n-----END KEY-----\ndone")).To(Equal(
+					"key:\n((redacted))done",
+				))
+			})
+
+			It("redacts each of its lines when printed differently", func() {
+				Expect(redactor.Redact("  line-one-of-key\r\n  line-two-of-key\r\n")).To(Equal(
+					"  ((redacted))\r\n  ((redacted))\r\n",
+				))
+			})
+		})
+
+		Context("when the rules ignore some keys", func() {
+			BeforeEach(func() {
+				rules.IgnoreKeys = []string{"uri"}
+			})
+
+			It("does not treat their values as secret", func() {
+				Expect(redactor.Redact("cloning https://example.com/repo.git with hunter2")).To(Equal(
+					"cloning https://example.com/repo.git with ((redacted))",
+				))
+			})
+		})
+
+		Context("when the rules set a minimum length", func() {
+			BeforeEach(func() {
+				rules.MinLength = 8
+			})
+
+			It("only redacts secrets at least that long", func() {
+				Expect(redactor.Redact("hunter2 some-access-key")).To(Equal("hunter2 ((redacted))"))
+			})
+		})
+
+		Context("when the rules set a placeholder", func() {
+			BeforeEach(func() {
+				rules.Placeholder = "***"
+			})
+
+			It("uses it", func() {
+				Expect(redactor.Redact("password: hunter2")).To(Equal("password: ***"))
+			})
+		})
+
+		Context("when redaction is disabled", func() {
+			BeforeEach(func() {
+				rules.Disabled = true
+			})
+
+			It("leaves everything alone", func() {
+				Expect(redactor.Redact("password: hunter2")).To(Equal("password: hunter2"))
+			})
+		})
+	})
+
+	Describe("Writer", func() {
+		var (
+			dest   *gbytes.Buffer
+			writer *RedactingWriter
+		)
+
+		JustBeforeEach(func() {
+			dest = gbytes.NewBuffer()
+			writer = redactor.Writer(dest)
+		})
+
+		It("redacts secrets within a write", func() {
+			n, err := writer.Write([]byte("password: hunter2\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(n).To(Equal(len("password: hunter2\n")))
+
+			Expect(string(dest.Contents())).To(Equal("password: ((redacted))\n"))
+		})
+
+		It("redacts secrets split across writes", func() {
+			for _, chunk := range []string{"password: hun", "te", "r2, key: some-acc", "ess-key\n"} {
+				_, err := writer.Write([]byte(chunk))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(string(dest.Contents())).To(Equal("password: ((redacted)), key: ((redacted))\n"))
+		})
+
+		It("holds back output only while it could be the start of a secret", func() {
+			_, err := writer.Write([]byte("hunting for hun"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(dest.Contents())).To(Equal("hunting for "))
+
+			_, err = writer.Write([]byte("gry hippos\n"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(dest.Contents())).To(Equal("hunting for hungry hippos\n"))
+		})
+
+		It("only holds back the end of the output", func() {
+			_, err := writer.Write([]byte("hun, some-acc and s3cr3t are not secrets, but hun"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(dest.Contents())).To(Equal("hun, some-acc and s3cr3t are not secrets, but "))
+		})
+
+		Context("with many secrets registered", func() {
+			JustBeforeEach(func() {
+				secrets := []interface{}{}
+				for i := 0; i < 1000; i++ {
+					secrets = append(secrets, fmt.Sprintf("generated-secret-%04d", i))
+				}
+
+				redactor.Register(secrets...)
+			})
+
+			It("redacts each of them split across writes", func() {
+				for _, chunk := range []string{"first: generated-secret-0", "042, second: generated-", "secret-0999\n"} {
+					_, err := writer.Write([]byte(chunk))
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				Expect(string(dest.Contents())).To(Equal("first: ((redacted)), second: ((redacted))\n"))
+			})
+		})
+
+		It("writes out held back output when flushed", func() {
+			_, err := writer.Write([]byte("the end: hunt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(dest.Contents())).To(Equal("the end: "))
+
+			err = writer.Flush()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(dest.Contents())).To(Equal("the end: hunt"))
+		})
+
+		It("redacts multi-line output written a line at a time", func() {
+			redactor.Register("-----BEGIN KEY-----\nline-one-of-key\n-----END KEY-----")
+
+			for _, chunk := range []string{"-----BEGIN KEY-----\n", "line-one-", "of-key\n", "-----END KEY-----\n"} {
+				_, err := writer.Write([]byte(chunk))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(string(dest.Contents())).To(Equal("((redacted))\n"))
+		})
+
+		It("redacts lines of a multi-line secret printed on their own", func() {
+			redactor.Register("-----BEGIN KEY-----\nline-one-of-key\n-----END KEY-----")
+
+			for _, chunk := range []string{"  line-one-", "of-key\n", "  -----END KEY-----\n"} {
+				_, err := writer.Write([]byte(chunk))
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			Expect(string(dest.Contents())).To(Equal("  ((redacted))\n  ((redacted))\n"))
+		})
+
+		Context("when a secret is the start of a longer secret", func() {
+			JustBeforeEach(func() {
+				redactor.Register("abcd", "abcdefgh")
+			})
+
+			It("redacts the longer secret split across writes whole", func() {
+				for _, chunk := range []string{"key: abcd", "efgh\n"} {
+					_, err := writer.Write([]byte(chunk))
+					Expect(err).NotTo(HaveOccurred())
+				}
+
+				Expect(string(dest.Contents())).To(Equal("key: ((redacted))\n"))
+			})
+
+			It("redacts the shorter secret once the output shows it is not the longer one", func() {
+				_, err := writer.Write([]byte("key: abcd"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(dest.Contents())).To(Equal("key: "))
+
+				_, err = writer.Write([]byte("xyz\n"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(dest.Contents())).To(Equal("key: ((redacted))xyz\n"))
+			})
+
+			It("redacts held back output when flushed", func() {
+				_, err := writer.Write([]byte("key: abcd"))
+				Expect(err).NotTo(HaveOccurred())
+
+				err = writer.Flush()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(dest.Contents())).To(Equal("key: ((redacted))"))
+			})
+		})
+
+		Context("when the destination fails", func() {
+			It("returns the error", func() {
+				disaster := errors.New("nope")
+
+				writer := redactor.Writer(failingWriter{disaster})
+
+				_, err := writer.Write([]byte("some output\n"))
+				Expect(err).To(Equal(disaster))
+			})
+		})
+
+		Context("when nothing is registered", func() {
+			It("passes output straight through", func() {
+				writer := NewRedactor(atc.RedactionRules{}).Writer(dest)
+
+				_, err := writer.Write([]byte("password: hun"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(dest.Contents())).To(Equal("password: hun"))
+			})
+		})
+	})
+})
+
+type failingWriter struct {
+	err error
+}
+
+func (writer failingWriter) Write([]byte) (int, error) {
+	return 0, writer.err
+}
@@ -2,7 +2,9 @@ package exec
 
 import (
 	"os"
+	"time"
 
+	"code.cloudfoundry.org/clock"
 	"github.com/concourse/atc/worker"
 )
 
@@ -21,21 +23,101 @@ func (stepFactory Retry) Using(prev Step, repo *worker.ArtifactRepository) Step
 	return retry
 }
 
+// RetryPolicy configures how a RetryStep moves from one attempt to the next.
+// The zero value retries immediately, whatever the outcome.
+type RetryPolicy struct {
+	// Delay is how long to wait before the second attempt.
+	Delay time.Duration
+
+	// Exponential doubles the delay after each attempt, up to MaxDelay if it
+	// is non-zero.
+	Exponential bool
+	MaxDelay    time.Duration
+
+	// ErroredOnly retries attempts which errored, but not those which ran to
+	// completion and failed.
+	ErroredOnly bool
+
+	// DifferentWorker places each attempt on a different worker than the ones
+	// earlier attempts ran on, if possible.
+	DifferentWorker bool
+}
+
+// RetryWithPolicy constructs a Step that runs the attempts according to the
+// policy, telling the delegate about each attempt that is retried.
+func RetryWithPolicy(attempts Retry, policy RetryPolicy, delegate RetryDelegate, clock clock.Clock) StepFactory {
+	return retryWithPolicy{
+		attempts: attempts,
+		policy:   policy,
+		delegate: delegate,
+		clock:    clock,
+	}
+}
+
+type retryWithPolicy struct {
+	attempts Retry
+	policy   RetryPolicy
+	delegate RetryDelegate
+	clock    clock.Clock
+}
+
+// Using constructs a *RetryStep.
+func (stepFactory retryWithPolicy) Using(prev Step, repo *worker.ArtifactRepository) Step {
+	retry := stepFactory.attempts.Using(prev, repo).(*RetryStep)
+	retry.Policy = stepFactory.policy
+	retry.Delegate = stepFactory.delegate
+	retry.Clock = stepFactory.clock
+	return retry
+}
+
+//go:generate counterfeiter . WorkerPlacedStep
+
+// WorkerPlacedStep is implemented by steps which run in a container of their
+// own, so that a RetryStep can move later attempts off the workers that
+// earlier attempts ran on.
+type WorkerPlacedStep interface {
+	Step
+
+	// WorkerName returns the worker the step's container was placed on, if it
+	// got that far.
+	WorkerName() (string, bool)
+
+	// AvoidWorkers asks the step to place its container on another worker
+	// than the given ones, if any other is suitable.
+	AvoidWorkers([]string)
+}
+
 // RetryStep is a step that will run the steps in order until one of them
 // succeeds.
 type RetryStep struct {
 	Attempts    []Step
 	LastAttempt Step
+
+	Policy   RetryPolicy
+	Delegate RetryDelegate
+	Clock    clock.Clock
 }
 
 // Run iterates through each step, stopping once a step succeeds. If all steps
 // fail, the RetryStep will fail.
+//
+// Between attempts it waits for the delay configured by the policy, which can
+// be interrupted. If the policy only retries errors, an attempt which fails
+// ends the step.
 func (step *RetryStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
 	close(ready)
 
 	var attemptErr error
 
-	for _, attempt := range step.Attempts {
+	delay := step.Policy.Delay
+	avoidWorkers := []string{}
+
+	for i, attempt := range step.Attempts {
+		placed, isPlaced := attempt.(WorkerPlacedStep)
+		if isPlaced && step.Policy.DifferentWorker {
+			placed.AvoidWorkers(avoidWorkers)
+		}
+
 		step.LastAttempt = attempt
 
 		var succeeded Success
@@ -44,13 +126,45 @@ func (step *RetryStep) Run(signals <-chan os.Signal, ready chan<- struct{}) erro
 			return attemptErr
 		}
 
-		if attemptErr != nil {
-			continue
+		if attemptErr == nil {
+			if attempt.Result(&succeeded) && bool(succeeded) {
+				break
+			}
+
+			if step.Policy.ErroredOnly {
+				break
+			}
 		}
 
-		if attempt.Result(&succeeded) && bool(succeeded) {
+		if i == len(step.Attempts)-1 {
 			break
 		}
+
+		if isPlaced {
+			if workerName, found := placed.WorkerName(); found {
+				avoidWorkers = append(avoidWorkers, workerName)
+			}
+		}
+
+		if step.Delegate != nil {
+			step.Delegate.Retrying(i+1, attemptErr, delay)
+		}
+
+		if delay > 0 {
+			select {
+			case <-step.Clock.After(delay):
+			case <-signals:
+				return ErrInterrupted
+			}
+
+			if step.Policy.Exponential {
+				delay *= 2
+
+				if step.Policy.MaxDelay > 0 && delay > step.Policy.MaxDelay {
+					delay = step.Policy.MaxDelay
+				}
+			}
+		}
 	}
 
 	return attemptErr
@@ -3,7 +3,9 @@ package exec_test
 import (
 	"errors"
 	"os"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/concourse/atc/exec"
 	"github.com/tedsuo/ifrit"
 
@@ -343,4 +345,199 @@ var _ = Describe("Retry Step", func() {
 			})
 		})
 	})
+
+	Context("with a retry policy", func() {
+		var (
+			policy       RetryPolicy
+			fakeDelegate *execfakes.FakeRetryDelegate
+			fakeClock    *fakeclock.FakeClock
+
+			placed1Step *execfakes.FakeWorkerPlacedStep
+			placed2Step *execfakes.FakeWorkerPlacedStep
+
+			process ifrit.Process
+		)
+
+		BeforeEach(func() {
+			policy = RetryPolicy{}
+			fakeDelegate = new(execfakes.FakeRetryDelegate)
+			fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+			placed1Step = new(execfakes.FakeWorkerPlacedStep)
+			placed1Step.WorkerNameReturns("worker-1", true)
+			attempt1Factory.UsingReturns(placed1Step)
+
+			placed2Step = new(execfakes.FakeWorkerPlacedStep)
+			placed2Step.WorkerNameReturns("worker-2", true)
+			attempt2Factory.UsingReturns(placed2Step)
+		})
+
+		JustBeforeEach(func() {
+			stepFactory = RetryWithPolicy(
+				Retry{attempt1Factory, attempt2Factory, attempt3Factory},
+				policy,
+				fakeDelegate,
+				fakeClock,
+			)
+
+			step = stepFactory.Using(nil, nil)
+			process = ifrit.Invoke(step)
+		})
+
+		Context("when attempt 1 errors, and attempt 2 succeeds", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				placed1Step.RunReturns(disaster)
+				placed2Step.ResultStub = successResult(true)
+			})
+
+			It("tells the delegate about the retry", func() {
+				Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(1))
+				attempt, err, delay := fakeDelegate.RetryingArgsForCall(0)
+				Expect(attempt).To(Equal(1))
+				Expect(err).To(Equal(disaster))
+				Expect(delay).To(BeZero())
+			})
+
+			It("does not avoid any workers", func() {
+				Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+				Expect(placed1Step.AvoidWorkersCallCount()).To(BeZero())
+				Expect(placed2Step.AvoidWorkersCallCount()).To(BeZero())
+			})
+
+			Context("when the policy asks for a different worker", func() {
+				BeforeEach(func() {
+					policy.DifferentWorker = true
+				})
+
+				It("avoids the workers of earlier attempts", func() {
+					Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+					Expect(placed1Step.AvoidWorkersArgsForCall(0)).To(BeEmpty())
+					Expect(placed2Step.AvoidWorkersArgsForCall(0)).To(Equal([]string{"worker-1"}))
+				})
+
+				Context("when each attempt has a timeout", func() {
+					BeforeEach(func() {
+						placed1Factory := new(execfakes.FakeStepFactory)
+						placed1Factory.UsingReturns(placed1Step)
+						attempt1Factory.UsingReturns(Timeout(placed1Factory, "1h", fakeClock).Using(nil, nil))
+
+						placed2Factory := new(execfakes.FakeStepFactory)
+						placed2Factory.UsingReturns(placed2Step)
+						attempt2Factory.UsingReturns(Timeout(placed2Factory, "1h", fakeClock).Using(nil, nil))
+					})
+
+					It("avoids the workers of earlier attempts through the timeouts", func() {
+						Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+						Expect(placed1Step.AvoidWorkersArgsForCall(0)).To(BeEmpty())
+						Expect(placed2Step.AvoidWorkersArgsForCall(0)).To(Equal([]string{"worker-1"}))
+					})
+				})
+			})
+		})
+
+		Context("when attempt 1 fails, and attempt 2 succeeds", func() {
+			BeforeEach(func() {
+				placed1Step.ResultStub = successResult(false)
+				placed2Step.ResultStub = successResult(true)
+			})
+
+			It("retries, telling the delegate there was no error", func() {
+				Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+				Expect(placed2Step.RunCallCount()).To(Equal(1))
+
+				_, err, _ := fakeDelegate.RetryingArgsForCall(0)
+				Expect(err).To(BeNil())
+			})
+
+			Context("when the policy only retries errors", func() {
+				BeforeEach(func() {
+					policy.ErroredOnly = true
+				})
+
+				It("does not retry", func() {
+					Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+					Expect(placed1Step.RunCallCount()).To(Equal(1))
+					Expect(placed2Step.RunCallCount()).To(BeZero())
+					Expect(fakeDelegate.RetryingCallCount()).To(BeZero())
+				})
+
+				It("delegates Result to attempt 1", func() {
+					<-process.Wait()
+
+					var succeeded Success
+					Expect(step.Result(&succeeded)).To(BeTrue())
+					Expect(bool(succeeded)).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when every attempt fails and the policy has a delay", func() {
+			BeforeEach(func() {
+				policy.Delay = time.Second
+				placed1Step.ResultStub = successResult(false)
+				placed2Step.ResultStub = successResult(false)
+				attempt3Step.ResultStub = successResult(false)
+			})
+
+			It("waits between attempts", func() {
+				Consistently(placed2Step.RunCallCount).Should(BeZero())
+
+				fakeClock.WaitForWatcherAndIncrement(time.Second)
+				Eventually(placed2Step.RunCallCount).Should(Equal(1))
+
+				fakeClock.WaitForWatcherAndIncrement(time.Second)
+				Eventually(attempt3Step.RunCallCount).Should(Equal(1))
+
+				Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+				Expect(fakeDelegate.RetryingCallCount()).To(Equal(2))
+				_, _, delay := fakeDelegate.RetryingArgsForCall(1)
+				Expect(delay).To(Equal(time.Second))
+			})
+
+			Context("when the backoff is exponential", func() {
+				BeforeEach(func() {
+					policy.Exponential = true
+					policy.MaxDelay = 90 * time.Second
+					policy.Delay = time.Minute
+				})
+
+				It("doubles the delay up to the max delay", func() {
+					fakeClock.WaitForWatcherAndIncrement(time.Minute)
+					Eventually(placed2Step.RunCallCount).Should(Equal(1))
+
+					fakeClock.WaitForWatcherAndIncrement(time.Minute)
+					Consistently(attempt3Step.RunCallCount).Should(BeZero())
+
+					fakeClock.WaitForWatcherAndIncrement(30 * time.Second)
+					Eventually(attempt3Step.RunCallCount).Should(Equal(1))
+
+					Expect(<-process.Wait()).ToNot(HaveOccurred())
+
+					_, _, delay := fakeDelegate.RetryingArgsForCall(1)
+					Expect(delay).To(Equal(90 * time.Second))
+				})
+			})
+
+			Context("when interrupted while waiting", func() {
+				It("returns ErrInterrupted without running the next attempt", func() {
+					Eventually(fakeDelegate.RetryingCallCount).Should(Equal(1))
+
+					process.Signal(os.Interrupt)
+
+					Expect(<-process.Wait()).To(Equal(ErrInterrupted))
+					Expect(placed2Step.RunCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
 })
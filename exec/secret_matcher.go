@@ -0,0 +1,125 @@
+package exec
+
+// secretMatcher is an Aho-Corasick automaton over a set of secrets. It finds
+// every secret in a text, and how much of the end of the text could be the
+// start of one, in a single pass however many secrets there are.
+type secretMatcher struct {
+	nodes []matcherNode
+}
+
+type matcherNode struct {
+	next  map[byte]int
+	fail  int
+	depth int
+
+	// longest is the length of the longest secret ending at this node, either
+	// here or at the end of its fail chain, or 0 if none does.
+	longest int
+}
+
+// secretMatch is where a secret was found in a text, as the half-open range
+// [start, end).
+type secretMatch struct {
+	start int
+	end   int
+}
+
+func newSecretMatcher(secrets []string) *secretMatcher {
+	matcher := &secretMatcher{
+		nodes: []matcherNode{{next: map[byte]int{}}},
+	}
+
+	for _, secret := range secrets {
+		node := 0
+		for i := 0; i < len(secret); i++ {
+			child, found := matcher.nodes[node].next[secret[i]]
+			if !found {
+				child = len(matcher.nodes)
+				matcher.nodes = append(matcher.nodes, matcherNode{
+					next:  map[byte]int{},
+					depth: matcher.nodes[node].depth + 1,
+				})
+				matcher.nodes[node].next[secret[i]] = child
+			}
+
+			node = child
+		}
+
+		matcher.nodes[node].longest = len(secret)
+	}
+
+	// link each node to the node for its longest proper suffix, breadth first
+	// so that the links of shallower nodes are always in place
+	queue := []int{}
+	for _, child := range matcher.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for b, child := range matcher.nodes[node].next {
+			fail := matcher.nodes[node].fail
+			for {
+				if next, found := matcher.nodes[fail].next[b]; found {
+					matcher.nodes[child].fail = next
+					break
+				}
+
+				if fail == 0 {
+					break
+				}
+
+				fail = matcher.nodes[fail].fail
+			}
+
+			failLongest := matcher.nodes[matcher.nodes[child].fail].longest
+			if failLongest > matcher.nodes[child].longest {
+				matcher.nodes[child].longest = failLongest
+			}
+
+			queue = append(queue, child)
+		}
+	}
+
+	return matcher
+}
+
+// scan returns the longest secret found ending at each position of the text,
+// in order, along with the length of the longest suffix of the text that
+// could be continued into a secret by more text.
+func (matcher *secretMatcher) scan(text string) ([]secretMatch, int) {
+	var matches []secretMatch
+
+	node := 0
+	for i := 0; i < len(text); i++ {
+		for {
+			if next, found := matcher.nodes[node].next[text[i]]; found {
+				node = next
+				break
+			}
+
+			if node == 0 {
+				break
+			}
+
+			node = matcher.nodes[node].fail
+		}
+
+		if longest := matcher.nodes[node].longest; longest > 0 {
+			matches = append(matches, secretMatch{
+				start: i + 1 - longest,
+				end:   i + 1,
+			})
+		}
+	}
+
+	// the node reached is the longest suffix that begins a secret; it only
+	// needs holding back if some secret carries on past it
+	for node != 0 && len(matcher.nodes[node].next) == 0 {
+		node = matcher.nodes[node].fail
+	}
+
+	return matches, matcher.nodes[node].depth
+}
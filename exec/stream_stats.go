@@ -0,0 +1,85 @@
+package exec
+
+import (
+	"time"
+
+	"github.com/concourse/atc/worker"
+)
+
+// streamReportingSource wraps an ArtifactSource so that streaming it into a
+// container is timed and its size is reported. Sources that are found on the
+// worker as a volume are never streamed, and so are never reported.
+type streamReportingSource struct {
+	worker.ArtifactSource
+
+	name   worker.ArtifactName
+	report func(worker.ArtifactName, worker.StreamStats)
+}
+
+func (source streamReportingSource) StreamTo(dest worker.ArtifactDestination) error {
+	counter := &worker.CountingDestination{Destination: dest}
+
+	start := time.Now()
+
+	err := source.ArtifactSource.StreamTo(counter)
+	if err != nil {
+		return err
+	}
+
+	source.report(source.name, worker.StreamStats{
+		Duration: time.Since(start),
+		Bytes:    counter.Bytes(),
+		P2P:      counter.P2P(),
+	})
+
+	return nil
+}
+
+// startedNotifier stands in for a step's ready channel when running a
+// resource script, calling started before passing readiness on to the
+// original channel.
+type startedNotifier struct {
+	ready  chan struct{}
+	done   chan struct{}
+	exited chan struct{}
+}
+
+func notifyStarted(ready chan<- struct{}, started func()) *startedNotifier {
+	notifier := &startedNotifier{
+		ready:  make(chan struct{}),
+		done:   make(chan struct{}),
+		exited: make(chan struct{}),
+	}
+
+	go func() {
+		defer close(notifier.exited)
+
+		select {
+		case <-notifier.ready:
+		case <-notifier.done:
+			select {
+			case <-notifier.ready:
+			default:
+				return
+			}
+		}
+
+		started()
+		close(ready)
+	}()
+
+	return notifier
+}
+
+// Ready returns the channel to hand to the resource in place of the step's
+// ready channel.
+func (notifier *startedNotifier) Ready() chan<- struct{} {
+	return notifier.ready
+}
+
+// Stop waits for any pending notification to be delivered. It must be called
+// once the resource has returned.
+func (notifier *startedNotifier) Stop() {
+	close(notifier.done)
+	<-notifier.exited
+}
@@ -2,6 +2,7 @@ package exec
 
 import (
 	"archive/tar"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -20,6 +21,15 @@ import (
 const taskProcessID = "task"
 const taskExitStatusPropertyName = "concourse:exit-status"
 
+// TaskAnnotationsFileEnv names the environment variable telling a task where
+// to write its annotations: a JSON object whose keys are annotation names.
+// String values are stored as-is; any other value is stored as its JSON
+// encoding.
+const TaskAnnotationsFileEnv = "CONCOURSE_ANNOTATIONS_FILE"
+
+const taskAnnotationsFileName = ".concourse-annotations.json"
+const maxTaskAnnotationsSize = 64 * 1024
+
 // MissingInputsError is returned when any of the task's required inputs are
 // missing.
 type MissingInputsError struct {
@@ -46,6 +56,7 @@ type TaskStep struct {
 	logger            lager.Logger
 	metadata          dbng.ContainerMetadata
 	tags              atc.Tags
+	placement         *atc.WorkerPlacement
 	teamID            int
 	buildID           int
 	planID            atc.PlanID
@@ -64,12 +75,16 @@ type TaskStep struct {
 	process garden.Process
 
 	exitStatus int
+
+	avoidWorkers []string
+	workerName   string
 }
 
 func newTaskStep(
 	logger lager.Logger,
 	metadata dbng.ContainerMetadata,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
 	teamID int,
 	buildID int,
 	planID atc.PlanID,
@@ -88,6 +103,7 @@ func newTaskStep(
 		logger:            logger,
 		metadata:          metadata,
 		tags:              tags,
+		placement:         placement,
 		teamID:            teamID,
 		buildID:           buildID,
 		planID:            planID,
@@ -121,7 +137,8 @@ func (step TaskStep) Using(prev Step, repo *worker.ArtifactRepository) Step {
 // available on the worker will be streamed in to the container.
 //
 // If any inputs are not available in the worker.ArtifactRepository, MissingInputsError
-// is returned.
+// is returned. Inputs which had to be streamed in are reported to the delegate
+// along with how long they took and how large they were.
 //
 // Once all the inputs are satisfies, the task's script will be executed, and
 // the RunStep indicates that it's ready, and any signals will be forwarded to
@@ -168,6 +185,8 @@ func (step *TaskStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 		return err
 	}
 
+	step.workerName = container.WorkerName()
+
 	exitStatusProp, err := container.Property(taskExitStatusPropertyName)
 	if err == nil {
 		step.logger.Info("already-exited", lager.Data{"status": exitStatusProp})
@@ -194,7 +213,7 @@ func (step *TaskStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 
 			Path: config.Run.Path,
 			Args: config.Run.Args,
-			Env:  step.envForParams(config.Params),
+			Env:  append(step.envForParams(config.Params), TaskAnnotationsFileEnv+"="+step.annotationsPath()),
 
 			Dir: path.Join(step.artifactsRoot, config.Run.Dir),
 			TTY: &garden.TTYSpec{},
@@ -236,6 +255,7 @@ func (step *TaskStep) Run(signals <-chan os.Signal, ready chan<- struct{}) error
 		}
 
 		step.registerSource(config, container)
+		step.collectAnnotations(container)
 
 		step.exitStatus = processStatus
 
@@ -270,11 +290,14 @@ func (step *TaskStep) containerSpec(config atc.TaskConfig) (worker.ContainerSpec
 	containerSpec := worker.ContainerSpec{
 		Platform:  config.Platform,
 		Tags:      step.tags,
+		Placement: step.placement,
 		TeamID:    step.teamID,
 		ImageSpec: imageSpec,
 		User:      config.Run.User,
 		Dir:       step.artifactsRoot,
 
+		AvoidWorkers: step.avoidWorkers,
+
 		Inputs:  []worker.InputSource{},
 		Outputs: worker.OutputPaths{},
 	}
@@ -293,9 +316,13 @@ func (step *TaskStep) containerSpec(config atc.TaskConfig) (worker.ContainerSpec
 		}
 
 		containerSpec.Inputs = append(containerSpec.Inputs, &taskInputSource{
-			name:          worker.ArtifactName(inputName),
-			config:        input,
-			source:        source,
+			name:   worker.ArtifactName(inputName),
+			config: input,
+			source: streamReportingSource{
+				ArtifactSource: source,
+				name:           worker.ArtifactName(inputName),
+				report:         step.delegate.InputStreamed,
+			},
 			artifactsRoot: step.artifactsRoot,
 		})
 	}
@@ -327,13 +354,85 @@ func (step *TaskStep) registerSource(config atc.TaskConfig, container worker.Con
 
 		for _, mount := range volumeMounts {
 			if mount.MountPath == outputPath {
+				start := step.clock.Now()
+
+				// measuring the output takes a trip to its worker, which is most
+				// of what registering it costs
+				size, err := mount.Volume.SizeInBytes()
+				if err != nil {
+					step.logger.Error("failed-to-get-output-size", err, lager.Data{"output": outputName})
+				}
+
 				source := newVolumeSource(step.logger, mount.Volume)
 				step.repo.RegisterSource(worker.ArtifactName(outputName), source)
+
+				step.delegate.OutputRegistered(worker.ArtifactName(outputName), worker.StreamStats{
+					Duration: step.clock.Since(start),
+					Bytes:    size,
+				})
 			}
 		}
 	}
 }
 
+func (step *TaskStep) annotationsPath() string {
+	return path.Join(step.artifactsRoot, taskAnnotationsFileName)
+}
+
+// collectAnnotations reports the annotations the task wrote, if any, to the
+// delegate. Malformed annotations are reported on stderr rather than failing
+// the task.
+func (step *TaskStep) collectAnnotations(container worker.Container) {
+	out, err := container.StreamOut(garden.StreamOutSpec{
+		Path: step.annotationsPath(),
+	})
+	if err != nil {
+		step.logger.Debug("no-annotations", lager.Data{"error": err.Error()})
+		return
+	}
+
+	defer out.Close()
+
+	tarReader := tar.NewReader(out)
+
+	_, err = tarReader.Next()
+	if err != nil {
+		step.logger.Debug("no-annotations", lager.Data{"error": err.Error()})
+		return
+	}
+
+	var raw map[string]json.RawMessage
+	err = json.NewDecoder(io.LimitReader(tarReader, maxTaskAnnotationsSize)).Decode(&raw)
+	if err != nil {
+		fmt.Fprintf(step.delegate.Stderr(), "failed to parse annotations: %s\n", err)
+		return
+	}
+
+	annotations := make(map[string]string, len(raw))
+	for name, value := range raw {
+		var str string
+		if json.Unmarshal(value, &str) == nil {
+			annotations[name] = str
+		} else {
+			annotations[name] = string(value)
+		}
+	}
+
+	step.delegate.Annotated(annotations)
+}
+
+// WorkerName returns the worker the step's container was placed on, if it
+// got that far.
+func (step *TaskStep) WorkerName() (string, bool) {
+	return step.workerName, step.workerName != ""
+}
+
+// AvoidWorkers places the step's container on another worker than the given
+// ones, if any other is suitable.
+func (step *TaskStep) AvoidWorkers(workerNames []string) {
+	step.avoidWorkers = workerNames
+}
+
 // Result indicates Success as true if the script's exit status was 0.
 //
 // It also indicates ExitStatus as the exit status of the script.
@@ -380,14 +479,7 @@ func newVolumeSource(
 }
 
 func (src *volumeSource) StreamTo(destination worker.ArtifactDestination) error {
-	out, err := src.volume.StreamOut(".")
-	if err != nil {
-		return err
-	}
-
-	defer out.Close()
-
-	return destination.StreamIn(".", out)
+	return worker.StreamVolumeTo(src.logger, src.volume, destination)
 }
 
 func (src *volumeSource) StreamFile(filename string) (io.ReadCloser, error) {
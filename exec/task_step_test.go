@@ -59,6 +59,7 @@ var _ = Describe("GardenFactory", func() {
 			taskDelegate  *execfakes.FakeTaskDelegate
 			privileged    Privileged
 			tags          []string
+			placement     *atc.WorkerPlacement
 			teamID        int
 			configSource  *execfakes.FakeTaskConfigSource
 			resourceTypes atc.VersionedResourceTypes
@@ -79,6 +80,11 @@ var _ = Describe("GardenFactory", func() {
 
 			privileged = false
 			tags = []string{"step", "tags"}
+			placement = &atc.WorkerPlacement{
+				Selectors: []atc.WorkerSelector{
+					{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+				},
+			}
 			teamID = 123
 			configSource = new(execfakes.FakeTaskConfigSource)
 
@@ -118,6 +124,7 @@ var _ = Describe("GardenFactory", func() {
 				taskDelegate,
 				privileged,
 				tags,
+				placement,
 				configSource,
 				resourceTypes,
 				inputMapping,
@@ -158,6 +165,7 @@ var _ = Describe("GardenFactory", func() {
 				BeforeEach(func() {
 					fakeContainer = new(workerfakes.FakeContainer)
 					fakeContainer.HandleReturns("some-handle")
+					fakeContainer.StreamOutReturns(nil, errors.New("no annotations"))
 					fakeWorkerClient.FindOrCreateBuildContainerReturns(fakeContainer, nil)
 				})
 
@@ -181,9 +189,10 @@ var _ = Describe("GardenFactory", func() {
 					Expect(delegate).To(Equal(taskDelegate))
 
 					Expect(spec).To(Equal(worker.ContainerSpec{
-						Platform: "some-platform",
-						Tags:     []string{"step", "tags"},
-						TeamID:   123,
+						Platform:  "some-platform",
+						Tags:      []string{"step", "tags"},
+						Placement: placement,
+						TeamID:    123,
 						ImageSpec: worker.ImageSpec{
 							ImageURL: "some-image",
 							ImageResource: &atc.ImageResource{
@@ -387,7 +396,7 @@ var _ = Describe("GardenFactory", func() {
 						Expect(spec.ID).To(Equal("task"))
 						Expect(spec.Path).To(Equal("ls"))
 						Expect(spec.Args).To(Equal([]string{"some", "args"}))
-						Expect(spec.Env).To(Equal([]string{"SOME=params"}))
+						Expect(spec.Env).To(Equal([]string{"SOME=params", "CONCOURSE_ANNOTATIONS_FILE=/tmp/build/a1f5c0c1/.concourse-annotations.json"}))
 						Expect(spec.Dir).To(Equal("/tmp/build/a1f5c0c1"))
 						Expect(spec.User).To(BeEmpty())
 						Expect(spec.TTY).To(Equal(&garden.TTYSpec{}))
@@ -467,15 +476,41 @@ var _ = Describe("GardenFactory", func() {
 									switch input.Name() {
 									case "some-input":
 										Expect(input.DestinationPath()).To(Equal("/tmp/build/a1f5c0c1/some-input-configured-path"))
-										Expect(input.Source()).To(Equal(inputSource))
+										Expect(input.Source().StreamTo(new(workerfakes.FakeArtifactDestination))).To(Succeed())
+										Expect(inputSource.StreamToCallCount()).To(Equal(1))
 									case "some-other-input":
 										Expect(input.DestinationPath()).To(Equal("/tmp/build/a1f5c0c1/some-other-input"))
-										Expect(input.Source()).To(Equal(otherInputSource))
+										Expect(input.Source().StreamTo(new(workerfakes.FakeArtifactDestination))).To(Succeed())
+										Expect(otherInputSource.StreamToCallCount()).To(Equal(1))
 									default:
 										panic("unknown input: " + input.Name())
 									}
 								}
 							})
+
+							It("reports inputs streamed into the container to the delegate", func() {
+								inputSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+									return dest.StreamIn(".", strings.NewReader("some-tar-stream"))
+								}
+
+								fakeDestination := new(workerfakes.FakeArtifactDestination)
+								fakeDestination.StreamInStub = func(path string, tarStream io.Reader) error {
+									_, err := ioutil.ReadAll(tarStream)
+									return err
+								}
+
+								_, _, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateBuildContainerArgsForCall(0)
+								for _, input := range spec.Inputs {
+									if input.Name() == "some-input" {
+										Expect(input.Source().StreamTo(fakeDestination)).To(Succeed())
+									}
+								}
+
+								Expect(taskDelegate.InputStreamedCallCount()).To(Equal(1))
+								name, stats := taskDelegate.InputStreamedArgsForCall(0)
+								Expect(name).To(Equal(worker.ArtifactName("some-input")))
+								Expect(stats.Bytes).To(Equal(int64(len("some-tar-stream"))))
+							})
 						})
 
 						Context("when any of the inputs are missing", func() {
@@ -528,7 +563,8 @@ var _ = Describe("GardenFactory", func() {
 								_, _, _, _, _, _, spec, _ := fakeWorkerClient.FindOrCreateBuildContainerArgsForCall(0)
 								Expect(spec.Inputs).To(HaveLen(1))
 								Expect(spec.Inputs[0].Name()).To(Equal(worker.ArtifactName("remapped-input-src")))
-								Expect(spec.Inputs[0].Source()).To(Equal(remappedInputSource))
+								Expect(spec.Inputs[0].Source().StreamTo(new(workerfakes.FakeArtifactDestination))).To(Succeed())
+								Expect(remappedInputSource.StreamToCallCount()).To(Equal(1))
 								Expect(spec.Inputs[0].DestinationPath()).To(Equal("/tmp/build/a1f5c0c1/remapped-input"))
 								Eventually(process.Wait()).Should(Receive(BeNil()))
 							})
@@ -944,6 +980,10 @@ var _ = Describe("GardenFactory", func() {
 
 							fakeVolume := new(workerfakes.FakeVolume)
 							fakeVolume.HandleReturns("some-handle")
+							fakeVolume.SizeInBytesStub = func() (int64, error) {
+								fakeClock.Increment(2 * time.Second)
+								return 4096, nil
+							}
 
 							fakeContainer.VolumeMountsReturns([]worker.VolumeMount{
 								worker.VolumeMount{
@@ -964,6 +1004,16 @@ var _ = Describe("GardenFactory", func() {
 							sourceMap := repo.AsMap()
 							Expect(sourceMap).To(ConsistOf(artifactSource))
 						})
+
+						It("reports the registered output to the delegate", func() {
+							Expect(taskDelegate.OutputRegisteredCallCount()).To(Equal(1))
+							name, stats := taskDelegate.OutputRegisteredArgsForCall(0)
+							Expect(name).To(Equal(worker.ArtifactName("specific-remapped-output")))
+							Expect(stats).To(Equal(worker.StreamStats{
+								Duration: 2 * time.Second,
+								Bytes:    4096,
+							}))
+						})
 					})
 
 					Context("when an image artifact name is specified", func() {
@@ -1157,6 +1207,82 @@ var _ = Describe("GardenFactory", func() {
 							Expect(sourceMap).To(BeEmpty())
 						})
 
+						It("looks for annotations in the working directory", func() {
+							Eventually(process.Wait()).Should(Receive(BeNil()))
+
+							Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+							Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+								Path: "/tmp/build/a1f5c0c1/.concourse-annotations.json",
+							}))
+						})
+
+						It("does not report annotations when the task wrote none", func() {
+							Eventually(process.Wait()).Should(Receive(BeNil()))
+
+							Expect(taskDelegate.AnnotatedCallCount()).To(BeZero())
+						})
+
+						Context("when the task wrote annotations", func() {
+							var annotationsContent string
+
+							BeforeEach(func() {
+								annotationsContent = `{"coverage":"87.5","tests":120,"link":"https://example.com"}`
+
+								fakeContainer.StreamOutStub = func(garden.StreamOutSpec) (io.ReadCloser, error) {
+									defer GinkgoRecover()
+
+									tarBuffer := gbytes.NewBuffer()
+									tarWriter := tar.NewWriter(tarBuffer)
+
+									err := tarWriter.WriteHeader(&tar.Header{
+										Name: ".concourse-annotations.json",
+										Mode: 0644,
+										Size: int64(len(annotationsContent)),
+									})
+									Expect(err).NotTo(HaveOccurred())
+
+									_, err = tarWriter.Write([]byte(annotationsContent))
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(tarWriter.Close()).To(Succeed())
+
+									return tarBuffer, nil
+								}
+							})
+
+							It("reports them to the delegate, keeping non-string values as JSON", func() {
+								Eventually(process.Wait()).Should(Receive(BeNil()))
+
+								Expect(taskDelegate.AnnotatedCallCount()).To(Equal(1))
+								Expect(taskDelegate.AnnotatedArgsForCall(0)).To(Equal(map[string]string{
+									"coverage": "87.5",
+									"tests":    "120",
+									"link":     "https://example.com",
+								}))
+							})
+
+							Context("when the annotations are malformed", func() {
+								BeforeEach(func() {
+									annotationsContent = `["not", "an", "object"]`
+								})
+
+								It("still succeeds", func() {
+									Eventually(process.Wait()).Should(Receive(BeNil()))
+
+									var success Success
+									Expect(step.Result(&success)).To(BeTrue())
+									Expect(bool(success)).To(BeTrue())
+								})
+
+								It("reports the problem on stderr instead of annotating", func() {
+									Eventually(process.Wait()).Should(Receive(BeNil()))
+
+									Expect(stderrBuf).To(gbytes.Say("failed to parse annotations"))
+									Expect(taskDelegate.AnnotatedCallCount()).To(BeZero())
+								})
+							})
+						})
+
 						Context("when saving the exit status succeeds", func() {
 							BeforeEach(func() {
 								fakeContainer.SetPropertyReturns(nil)
@@ -99,3 +99,19 @@ func (ts *TimeoutStep) Result(x interface{}) bool {
 	}
 	return false
 }
+
+// WorkerName forwards to the nested step if it is a WorkerPlacedStep.
+func (ts *TimeoutStep) WorkerName() (string, bool) {
+	if placed, ok := ts.runStep.(WorkerPlacedStep); ok {
+		return placed.WorkerName()
+	}
+
+	return "", false
+}
+
+// AvoidWorkers forwards to the nested step if it is a WorkerPlacedStep.
+func (ts *TimeoutStep) AvoidWorkers(workerNames []string) {
+	if placed, ok := ts.runStep.(WorkerPlacedStep); ok {
+		placed.AvoidWorkers(workerNames)
+	}
+}
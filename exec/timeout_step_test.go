@@ -45,6 +45,30 @@ var _ = Describe("Timeout Step", func() {
 		process = ifrit.Background(step)
 	})
 
+	Context("when the nested step is placed on a worker", func() {
+		var placedStep *execfakes.FakeWorkerPlacedStep
+
+		BeforeEach(func() {
+			placedStep = new(execfakes.FakeWorkerPlacedStep)
+			placedStep.WorkerNameReturns("some-worker", true)
+			fakeStepFactoryStep.UsingReturns(placedStep)
+		})
+
+		It("forwards the worker placement to it", func() {
+			<-process.Wait()
+
+			placed, ok := step.(WorkerPlacedStep)
+			Expect(ok).To(BeTrue())
+
+			placed.AvoidWorkers([]string{"some-failed-worker"})
+			Expect(placedStep.AvoidWorkersArgsForCall(0)).To(Equal([]string{"some-failed-worker"}))
+
+			workerName, found := placed.WorkerName()
+			Expect(found).To(BeTrue())
+			Expect(workerName).To(Equal("some-worker"))
+		})
+	})
+
 	Context("when the duration is invalid", func() {
 		BeforeEach(func() {
 			timeoutDuration = "nope"
@@ -2,6 +2,7 @@ package buildreaper
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/db"
 )
 
@@ -20,6 +21,7 @@ type buildReaper struct {
 	logger            lager.Logger
 	db                BuildReaperDB
 	pipelineDBFactory db.PipelineDBFactory
+	artifactStore     blobstore.Store
 	batchSize         int
 }
 
@@ -27,12 +29,14 @@ func NewBuildReaper(
 	logger lager.Logger,
 	db BuildReaperDB,
 	pipelineDBFactory db.PipelineDBFactory,
+	artifactStore blobstore.Store,
 	batchSize int,
 ) BuildReaper {
 	return &buildReaper{
 		logger:            logger,
 		db:                db,
 		pipelineDBFactory: pipelineDBFactory,
+		artifactStore:     artifactStore,
 		batchSize:         batchSize,
 	}
 }
@@ -143,6 +147,14 @@ func (br *buildReaper) Run() error {
 				return err
 			}
 
+			if br.artifactStore != nil {
+				err = br.artifactStore.DeleteBuilds(buildIDsToDelete)
+				if err != nil {
+					br.logger.Error("could-not-delete-build-artifacts", err)
+					return err
+				}
+			}
+
 			err = pipelineDB.UpdateFirstLoggedBuildID(job.Job.Name, buildIDsToDelete[len(buildIDsToDelete)-1]+1)
 			if err != nil {
 				br.logger.Error("could-not-update-first-logged-build-id", err)
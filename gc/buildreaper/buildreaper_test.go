@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
 	"github.com/concourse/atc/db"
 	"github.com/concourse/atc/db/dbfakes"
 	. "github.com/concourse/atc/gc/buildreaper"
@@ -20,12 +21,14 @@ var _ = Describe("BuildReaper", func() {
 		buildReaper           BuildReaper
 		fakeBuildReaperDB     *buildreaperfakes.FakeBuildReaperDB
 		fakePipelineDBFactory *dbfakes.FakePipelineDBFactory
+		fakeArtifactStore     *blobstorefakes.FakeStore
 		batchSize             int
 	)
 
 	BeforeEach(func() {
 		fakeBuildReaperDB = new(buildreaperfakes.FakeBuildReaperDB)
 		fakePipelineDBFactory = new(dbfakes.FakePipelineDBFactory)
+		fakeArtifactStore = new(blobstorefakes.FakeStore)
 		batchSize = 5
 	})
 
@@ -35,6 +38,7 @@ var _ = Describe("BuildReaper", func() {
 			buildReaperLogger,
 			fakeBuildReaperDB,
 			fakePipelineDBFactory,
+			fakeArtifactStore,
 			batchSize,
 		)
 	})
@@ -113,6 +117,15 @@ var _ = Describe("BuildReaper", func() {
 						Expect(actualBuildIDs).To(ConsistOf(6, 7, 8, 9, 10))
 					})
 
+					It("deletes the artifacts of the reaped builds", func() {
+						err := buildReaper.Run()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeArtifactStore.DeleteBuildsCallCount()).To(Equal(1))
+						actualBuildIDs := fakeArtifactStore.DeleteBuildsArgsForCall(0)
+						Expect(actualBuildIDs).To(ConsistOf(6, 7, 8, 9, 10))
+					})
+
 					It("updates FirstLoggedBuildID to n+1, n = latest reaped build ID", func() {
 						err := buildReaper.Run()
 						Expect(err).NotTo(HaveOccurred())
@@ -145,6 +158,27 @@ var _ = Describe("BuildReaper", func() {
 					})
 				})
 
+				Context("when deleting build artifacts fails", func() {
+					var disaster error
+
+					BeforeEach(func() {
+						disaster = errors.New("major malfunction")
+
+						fakeArtifactStore.DeleteBuildsReturns(disaster)
+					})
+
+					It("returns the error", func() {
+						err := buildReaper.Run()
+						Expect(err).To(Equal(disaster))
+					})
+
+					It("does not update first logged build id", func() {
+						buildReaper.Run()
+
+						Expect(fakePipelineDB.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when updating first logged build id fails", func() {
 					var disaster error
 
@@ -0,0 +1,72 @@
+package gcng
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/blobstore"
+	"github.com/concourse/atc/dbng"
+)
+
+type cacheStoreCollector struct {
+	logger       lager.Logger
+	cacheFactory dbng.ResourceCacheFactory
+	cacheStore   blobstore.CacheStore
+}
+
+// NewCacheStoreCollector returns a Collector which evicts the contents saved
+// in the cache store for resource caches that have since been cleaned up. If
+// the cache store is nil there is nothing to collect.
+func NewCacheStoreCollector(
+	logger lager.Logger,
+	cacheFactory dbng.ResourceCacheFactory,
+	cacheStore blobstore.CacheStore,
+) Collector {
+	return &cacheStoreCollector{
+		logger:       logger.Session("cache-store-collector"),
+		cacheFactory: cacheFactory,
+		cacheStore:   cacheStore,
+	}
+}
+
+func (csc *cacheStoreCollector) Run() error {
+	if csc.cacheStore == nil {
+		return nil
+	}
+
+	saved, err := csc.cacheStore.List()
+	if err != nil {
+		csc.logger.Error("failed-to-list-cache-store", err)
+		return err
+	}
+
+	existing, err := csc.cacheFactory.ExistingResourceCacheIDs(saved)
+	if err != nil {
+		csc.logger.Error("failed-to-find-existing-resource-caches", err)
+		return err
+	}
+
+	stillExists := map[int]bool{}
+	for _, id := range existing {
+		stillExists[id] = true
+	}
+
+	evict := []int{}
+	for _, id := range saved {
+		if !stillExists[id] {
+			evict = append(evict, id)
+		}
+	}
+
+	if len(evict) == 0 {
+		return nil
+	}
+
+	err = csc.cacheStore.Delete(evict)
+	if err != nil {
+		csc.logger.Error("failed-to-evict-from-cache-store", err)
+		return err
+	}
+
+	csc.logger.Debug("evicted", lager.Data{"resource-caches": evict})
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package gcng_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/gcng"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheStoreCollector", func() {
+	var (
+		dir        string
+		cacheStore blobstore.CacheStore
+		collector  gcng.Collector
+
+		usedResourceCache *dbng.UsedResourceCache
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "cache-store")
+		Expect(err).NotTo(HaveOccurred())
+
+		cacheStore = blobstore.NewFileCacheStore(dir)
+		collector = gcng.NewCacheStoreCollector(logger, resourceCacheFactory, cacheStore)
+
+		usedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+			logger,
+			dbng.ForBuild(defaultBuild.ID()),
+			"some-base-type",
+			atc.Version{"some": "version"},
+			atc.Source{
+				"some": "source",
+			},
+			nil,
+			atc.VersionedResourceTypes{},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cacheStore.Save(usedResourceCache.ID, strings.NewReader("some-tar-stream"))).To(Succeed())
+		Expect(cacheStore.Save(usedResourceCache.ID+1000, strings.NewReader("some-tar-stream"))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("evicts the contents of resource caches that no longer exist", func() {
+		Expect(collector.Run()).To(Succeed())
+
+		saved, err := cacheStore.List()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(saved).To(Equal([]int{usedResourceCache.ID}))
+	})
+
+	Context("when there is no cache store", func() {
+		BeforeEach(func() {
+			collector = gcng.NewCacheStoreCollector(logger, resourceCacheFactory, nil)
+		})
+
+		It("does nothing", func() {
+			Expect(collector.Run()).To(Succeed())
+
+			saved, err := cacheStore.List()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved).To(HaveLen(2))
+		})
+	})
+})
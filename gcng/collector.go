@@ -18,6 +18,8 @@ type aggregateCollector struct {
 	resourceCacheCollector     Collector
 	volumeCollector            Collector
 	containerCollector         Collector
+	cacheStoreCollector        Collector
+	teamEventCollector         Collector
 }
 
 func NewCollector(
@@ -30,6 +32,8 @@ func NewCollector(
 	resourceCaches Collector,
 	volumes Collector,
 	containers Collector,
+	cacheStore Collector,
+	teamEvents Collector,
 ) Collector {
 	return &aggregateCollector{
 		logger:                     logger,
@@ -41,6 +45,8 @@ func NewCollector(
 		resourceCacheCollector:     resourceCaches,
 		volumeCollector:            volumes,
 		containerCollector:         containers,
+		cacheStoreCollector:        cacheStore,
+		teamEventCollector:         teamEvents,
 	}
 }
 
@@ -87,5 +93,15 @@ func (c *aggregateCollector) Run() error {
 		c.logger.Error("volume-collector", err)
 	}
 
+	err = c.cacheStoreCollector.Run()
+	if err != nil {
+		c.logger.Error("cache-store-collector", err)
+	}
+
+	err = c.teamEventCollector.Run()
+	if err != nil {
+		c.logger.Error("team-event-collector", err)
+	}
+
 	return nil
 }
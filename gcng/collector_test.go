@@ -24,6 +24,8 @@ var _ = Describe("Aggregate Collector", func() {
 		fakeResourceCacheCollector     *gcngfakes.FakeCollector
 		fakeVolumeCollector            *gcngfakes.FakeCollector
 		fakeContainerCollector         *gcngfakes.FakeCollector
+		fakeCacheStoreCollector        *gcngfakes.FakeCollector
+		fakeTeamEventCollector         *gcngfakes.FakeCollector
 
 		err      error
 		disaster error
@@ -39,6 +41,8 @@ var _ = Describe("Aggregate Collector", func() {
 		fakeResourceCacheCollector = new(gcngfakes.FakeCollector)
 		fakeVolumeCollector = new(gcngfakes.FakeCollector)
 		fakeContainerCollector = new(gcngfakes.FakeCollector)
+		fakeCacheStoreCollector = new(gcngfakes.FakeCollector)
+		fakeTeamEventCollector = new(gcngfakes.FakeCollector)
 
 		subject = NewCollector(
 			logger,
@@ -50,6 +54,8 @@ var _ = Describe("Aggregate Collector", func() {
 			fakeResourceCacheCollector,
 			fakeVolumeCollector,
 			fakeContainerCollector,
+			fakeCacheStoreCollector,
+			fakeTeamEventCollector,
 		)
 
 		disaster = errors.New("disaster")
@@ -81,6 +87,7 @@ var _ = Describe("Aggregate Collector", func() {
 				Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 				Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 				Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+				Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 			})
 
 		})
@@ -106,6 +113,7 @@ var _ = Describe("Aggregate Collector", func() {
 					Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 					Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 					Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+					Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 				})
 			})
 
@@ -130,6 +138,7 @@ var _ = Describe("Aggregate Collector", func() {
 						Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 						Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 						Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+						Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 					})
 				})
 
@@ -154,6 +163,7 @@ var _ = Describe("Aggregate Collector", func() {
 							Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 							Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 							Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+							Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 						})
 					})
 
@@ -178,6 +188,7 @@ var _ = Describe("Aggregate Collector", func() {
 								Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 								Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 								Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+								Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 							})
 						})
 
@@ -202,6 +213,7 @@ var _ = Describe("Aggregate Collector", func() {
 									Expect(fakeResourceConfigCollector.RunCallCount()).To(Equal(1))
 									Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 									Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+									Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 								})
 							})
 
@@ -226,6 +238,7 @@ var _ = Describe("Aggregate Collector", func() {
 										Expect(fakeResourceConfigCollector.RunCallCount()).To(Equal(1))
 										Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 										Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
+										Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
 									})
 								})
 
@@ -250,6 +263,43 @@ var _ = Describe("Aggregate Collector", func() {
 											Expect(fakeResourceConfigCollector.RunCallCount()).To(Equal(1))
 											Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 											Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
+											Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
+										})
+									})
+
+									Context("when the container collector succeeds", func() {
+										It("attempts to collect the cache store", func() {
+											Expect(fakeCacheStoreCollector.RunCallCount()).To(Equal(1))
+										})
+
+										Context("when the cache store collector errors", func() {
+											BeforeEach(func() {
+												fakeCacheStoreCollector.RunReturns(disaster)
+											})
+
+											It("does not return an error", func() {
+												Expect(err).NotTo(HaveOccurred())
+											})
+
+											It("collects the team events", func() {
+												Expect(fakeTeamEventCollector.RunCallCount()).To(Equal(1))
+											})
+										})
+
+										Context("when the cache store collector succeeds", func() {
+											It("collects the team events", func() {
+												Expect(fakeTeamEventCollector.RunCallCount()).To(Equal(1))
+											})
+
+											Context("when the team event collector errors", func() {
+												BeforeEach(func() {
+													fakeTeamEventCollector.RunReturns(disaster)
+												})
+
+												It("does not return an error", func() {
+													Expect(err).NotTo(HaveOccurred())
+												})
+											})
 										})
 									})
 								})
@@ -66,6 +66,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 					},
 					0,
 					dbng.PipelineNoChange,
+					"",
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
@@ -474,6 +475,7 @@ var _ = Describe("ResourceCacheUseCollector", func() {
 							},
 							0,
 							dbng.PipelineUnpaused,
+							"",
 						)
 
 						Expect(err).ToNot(HaveOccurred())
@@ -68,6 +68,7 @@ var _ = Describe("ResourceConfigUseCollector", func() {
 					},
 					0,
 					dbng.PipelineNoChange,
+					"",
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
@@ -344,6 +345,7 @@ var _ = Describe("ResourceConfigUseCollector", func() {
 							},
 							0,
 							dbng.PipelineUnpaused,
+							"",
 						)
 
 						Expect(err).ToNot(HaveOccurred())
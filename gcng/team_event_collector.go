@@ -0,0 +1,44 @@
+package gcng
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+)
+
+type teamEventCollector struct {
+	logger      lager.Logger
+	teamFactory dbng.TeamFactory
+	retention   time.Duration
+}
+
+// NewTeamEventCollector returns a Collector which deletes team events once
+// they are older than the retention period. Subscribers only need events for
+// as long as it takes them to reconnect, so they are not kept any longer.
+func NewTeamEventCollector(
+	logger lager.Logger,
+	teamFactory dbng.TeamFactory,
+	retention time.Duration,
+) Collector {
+	return &teamEventCollector{
+		logger:      logger,
+		teamFactory: teamFactory,
+		retention:   retention,
+	}
+}
+
+func (tec *teamEventCollector) Run() error {
+	logger := tec.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := tec.teamFactory.ReapTeamEvents(tec.retention)
+	if err != nil {
+		logger.Error("failed-to-reap-team-events", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package gcng_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/gcng"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamEventCollector", func() {
+	var (
+		teamEventCollector gcng.Collector
+		fakeTeamFactory    *dbngfakes.FakeTeamFactory
+	)
+
+	BeforeEach(func() {
+		fakeTeamFactory = new(dbngfakes.FakeTeamFactory)
+
+		teamEventCollector = gcng.NewTeamEventCollector(
+			lagertest.NewTestLogger("team-event-collector"),
+			fakeTeamFactory,
+			6*time.Hour,
+		)
+	})
+
+	Describe("Run", func() {
+		It("reaps the team events older than the retention period", func() {
+			err := teamEventCollector.Run()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeTeamFactory.ReapTeamEventsCallCount()).To(Equal(1))
+			Expect(fakeTeamFactory.ReapTeamEventsArgsForCall(0)).To(Equal(6 * time.Hour))
+		})
+
+		It("returns an error if reaping fails", func() {
+			disaster := errors.New("disaster")
+			fakeTeamFactory.ReapTeamEventsReturns(disaster)
+
+			err := teamEventCollector.Run()
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
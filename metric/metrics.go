@@ -12,6 +12,11 @@ import (
 var DatabaseQueries = Meter(0)
 var DatabaseConnections = &Gauge{}
 
+// ImageCacheHits and ImageCacheMisses count image resource fetches for which
+// the worker did and did not already have the image.
+var ImageCacheHits = Meter(0)
+var ImageCacheMisses = Meter(0)
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -134,6 +139,64 @@ func (event WorkerContainers) Emit(logger lager.Logger) {
 	)
 }
 
+type WorkerProbeFailureRate struct {
+	WorkerName  string
+	FailureRate float64
+}
+
+func (event WorkerProbeFailureRate) Emit(logger lager.Logger) {
+	state := "ok"
+	if event.FailureRate > 0 {
+		state = "warning"
+	}
+
+	emit(
+		logger.Session("worker-probe-failure-rate", lager.Data{
+			"worker":       event.WorkerName,
+			"failure-rate": event.FailureRate,
+		}),
+		goryman.Event{
+			Service: "worker probe failure rate",
+			Metric:  event.FailureRate,
+			State:   state,
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
+type WorkerStateTransition struct {
+	WorkerName string
+	FromState  string
+	ToState    string
+}
+
+func (event WorkerStateTransition) Emit(logger lager.Logger) {
+	state := "ok"
+	if event.ToState == "quarantined" {
+		state = "critical"
+	}
+
+	emit(
+		logger.Session("worker-state-transition", lager.Data{
+			"worker": event.WorkerName,
+			"from":   event.FromState,
+			"to":     event.ToState,
+		}),
+		goryman.Event{
+			Service: "worker state transition",
+			Metric:  1,
+			State:   state,
+			Attributes: map[string]string{
+				"worker":     event.WorkerName,
+				"from_state": event.FromState,
+				"to_state":   event.ToState,
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	PipelineName string
 	JobName      string
@@ -196,6 +259,84 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type VolumeStreamed struct {
+	PipelineName string
+	JobName      string
+	BuildName    string
+	BuildID      int
+	Bytes        int64
+	Duration     time.Duration
+	P2P          bool
+}
+
+func (event VolumeStreamed) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("volume-streamed", lager.Data{
+			"pipeline":   event.PipelineName,
+			"job":        event.JobName,
+			"build-name": event.BuildName,
+			"build-id":   event.BuildID,
+			"bytes":      event.Bytes,
+			"duration":   event.Duration.String(),
+			"p2p":        event.P2P,
+		}),
+		goryman.Event{
+			Service: "volume streamed (bytes)",
+			Metric:  event.Bytes,
+			State:   "ok",
+			Attributes: map[string]string{
+				"pipeline":   event.PipelineName,
+				"job":        event.JobName,
+				"build_name": event.BuildName,
+				"build_id":   strconv.Itoa(event.BuildID),
+				"p2p":        strconv.FormatBool(event.P2P),
+			},
+		},
+	)
+}
+
+type ImagePrewarmed struct {
+	WorkerName   string
+	ResourceType string
+	Duration     time.Duration
+}
+
+func (event ImagePrewarmed) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("image-prewarmed", lager.Data{
+			"worker":        event.WorkerName,
+			"resource-type": event.ResourceType,
+			"duration":      event.Duration.String(),
+		}),
+		goryman.Event{
+			Service: "image prewarmed duration",
+			Metric:  ms(event.Duration),
+			State:   "ok",
+			Attributes: map[string]string{
+				"worker":        event.WorkerName,
+				"resource_type": event.ResourceType,
+			},
+		},
+	)
+}
+
+type BuildsQueued struct {
+	Count int
+}
+
+func (event BuildsQueued) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("builds-queued", lager.Data{
+			"count": event.Count,
+		}),
+		goryman.Event{
+			Service: "builds queued",
+			Metric:  event.Count,
+			State:   "ok",
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -40,6 +40,25 @@ func PeriodicallyEmit(logger lager.Logger, interval time.Duration) {
 			},
 		)
 
+		imageCacheHits := ImageCacheHits.Delta()
+		imageCacheMisses := ImageCacheMisses.Delta()
+
+		if imageFetches := imageCacheHits + imageCacheMisses; imageFetches > 0 {
+			hitRate := float64(imageCacheHits) / float64(imageFetches)
+
+			emit(
+				tLog.Session("image-cache-hit-rate", lager.Data{
+					"hits":   imageCacheHits,
+					"misses": imageCacheMisses,
+				}),
+				goryman.Event{
+					Service: "image cache hit rate",
+					Metric:  hitRate,
+					State:   "ok",
+				},
+			)
+		}
+
 		var memStats runtime.MemStats
 		runtime.ReadMemStats(&memStats)
 
@@ -0,0 +1,144 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type NotificationConfig struct {
+	Name string `json:"name"`
+
+	Webhook *WebhookNotification `json:"webhook,omitempty"`
+	Email   *EmailNotification   `json:"email,omitempty"`
+
+	Filter NotificationFilter `json:"filter,omitempty"`
+}
+
+type WebhookNotification struct {
+	URL string `json:"url"`
+}
+
+type EmailNotification struct {
+	From string   `json:"from,omitempty"`
+	To   []string `json:"to"`
+}
+
+// NotificationFilter narrows the builds a notification is delivered for. Each
+// empty field matches everything.
+type NotificationFilter struct {
+	Pipelines   []string          `json:"pipelines,omitempty"`
+	Jobs        []string          `json:"jobs,omitempty"`
+	Transitions []BuildTransition `json:"transitions,omitempty"`
+}
+
+type BuildTransition string
+
+const (
+	// the build succeeded after the previous build of the job failed or errored
+	TransitionFixed BuildTransition = "fixed"
+	// the build failed or errored after the previous build of the job succeeded
+	TransitionBroken BuildTransition = "broken"
+
+	TransitionSucceeded BuildTransition = "succeeded"
+	TransitionFailed    BuildTransition = "failed"
+	TransitionErrored   BuildTransition = "errored"
+	TransitionAborted   BuildTransition = "aborted"
+)
+
+var validTransitions = []BuildTransition{
+	TransitionFixed,
+	TransitionBroken,
+	TransitionSucceeded,
+	TransitionFailed,
+	TransitionErrored,
+	TransitionAborted,
+}
+
+type NotificationDelivery struct {
+	Notification string `json:"notification"`
+	Attempt      int    `json:"attempt"`
+	Delivered    bool   `json:"delivered"`
+	Error        string `json:"error,omitempty"`
+	Time         int64  `json:"time"`
+}
+
+func ValidateNotifications(configs []NotificationConfig) error {
+	errorMessages := []string{}
+
+	names := map[string]bool{}
+
+	for i, config := range configs {
+		identifier := fmt.Sprintf("notifications[%d]", i)
+		if config.Name != "" {
+			identifier = fmt.Sprintf("notifications.%s", config.Name)
+		}
+
+		if config.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if names[config.Name] {
+			errorMessages = append(errorMessages, identifier+" appears more than once")
+		}
+
+		names[config.Name] = true
+
+		switch {
+		case config.Webhook != nil && config.Email != nil:
+			errorMessages = append(errorMessages, identifier+" specifies both a webhook and an email")
+		case config.Webhook != nil:
+			if config.Webhook.URL == "" {
+				errorMessages = append(errorMessages, identifier+" has a webhook with no url")
+			} else if err := ValidateWebhookURL(config.Webhook.URL); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid webhook url: %s", identifier, err))
+			}
+		case config.Email != nil:
+			if len(config.Email.To) == 0 {
+				errorMessages = append(errorMessages, identifier+" has an email with no recipients")
+			}
+		default:
+			errorMessages = append(errorMessages, identifier+" specifies neither a webhook nor an email")
+		}
+
+		for _, transition := range config.Filter.Transitions {
+			if !transition.valid() {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s filters on an unknown transition '%s'", identifier, transition))
+			}
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New("invalid notifications:\n\t" + strings.Join(errorMessages, "\n\t"))
+	}
+
+	return nil
+}
+
+// ValidateWebhookURL checks that a webhook URL is an absolute http or https
+// URL, so that deliveries cannot be made to arbitrary schemes.
+func ValidateWebhookURL(rawURL string) error {
+	webhookURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https, not '%s'", webhookURL.Scheme)
+	}
+
+	if webhookURL.Hostname() == "" {
+		return errors.New("missing host")
+	}
+
+	return nil
+}
+
+func (transition BuildTransition) valid() bool {
+	for _, t := range validTransitions {
+		if t == transition {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package atc_test
+
+import (
+	. "github.com/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateNotifications", func() {
+	var configs []NotificationConfig
+
+	BeforeEach(func() {
+		configs = []NotificationConfig{
+			{
+				Name:    "some-webhook",
+				Webhook: &WebhookNotification{URL: "https://example.com/hook"},
+				Filter: NotificationFilter{
+					Pipelines:   []string{"some-pipeline"},
+					Transitions: []BuildTransition{TransitionFixed, TransitionBroken},
+				},
+			},
+			{
+				Name:  "some-email",
+				Email: &EmailNotification{To: []string{"team@example.com"}},
+			},
+		}
+	})
+
+	It("accepts valid notifications", func() {
+		Expect(ValidateNotifications(configs)).To(Succeed())
+	})
+
+	It("rejects a notification with no name", func() {
+		configs[0].Name = ""
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications[0] has no name"))
+	})
+
+	It("rejects duplicate names", func() {
+		configs[1].Name = "some-webhook"
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook appears more than once"))
+	})
+
+	It("rejects a notification with neither a webhook nor an email", func() {
+		configs[0].Webhook = nil
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook specifies neither a webhook nor an email"))
+	})
+
+	It("rejects a notification with both a webhook and an email", func() {
+		configs[0].Email = &EmailNotification{To: []string{"team@example.com"}}
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook specifies both a webhook and an email"))
+	})
+
+	It("rejects a webhook with no url", func() {
+		configs[0].Webhook.URL = ""
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook has a webhook with no url"))
+	})
+
+	It("rejects a webhook with a url that is not http or https", func() {
+		configs[0].Webhook.URL = "file:///etc/passwd"
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook has an invalid webhook url: scheme must be http or https, not 'file'"))
+	})
+
+	It("rejects a webhook with a relative url", func() {
+		configs[0].Webhook.URL = "/hook"
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook has an invalid webhook url"))
+	})
+
+	It("rejects a webhook with no host", func() {
+		configs[0].Webhook.URL = "http:///hook"
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook has an invalid webhook url: missing host"))
+	})
+
+	It("rejects an email with no recipients", func() {
+		configs[1].Email.To = nil
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-email has an email with no recipients"))
+	})
+
+	It("rejects unknown transitions", func() {
+		configs[0].Filter.Transitions = []BuildTransition{"exploded"}
+
+		err := ValidateNotifications(configs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("notifications.some-webhook filters on an unknown transition 'exploded'"))
+	})
+})
@@ -0,0 +1,145 @@
+package notifications
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/concourse/atc"
+)
+
+type emailSender struct {
+	smtpAddr    string
+	defaultFrom string
+	timeout     time.Duration
+}
+
+// NewEmailSender returns a Sender which relays the message through the SMTP
+// server at smtpAddr. The server is expected to be a local relay (or a stand-in
+// for one) and is not authenticated against. Delivering a message, from
+// dialing the server to quitting, must complete within timeout.
+func NewEmailSender(smtpAddr string, defaultFrom string, timeout time.Duration) Sender {
+	return &emailSender{
+		smtpAddr:    smtpAddr,
+		defaultFrom: defaultFrom,
+		timeout:     timeout,
+	}
+}
+
+func (s *emailSender) Send(config atc.NotificationConfig, message Message) error {
+	from := config.Email.From
+	if from == "" {
+		from = s.defaultFrom
+	}
+
+	return s.sendMail(from, config.Email.To, s.render(from, config.Email.To, message))
+}
+
+// sendMail is smtp.SendMail, but bounded by the sender's timeout so that an
+// unresponsive server cannot hold up delivery indefinitely.
+func (s *emailSender) sendMail(from string, to []string, msg []byte) error {
+	host, _, err := net.SplitHostPort(s.smtpAddr)
+	if err != nil {
+		return err
+	}
+
+	conn, err := net.DialTimeout("tcp", s.smtpAddr, s.timeout)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	err = conn.SetDeadline(time.Now().Add(s.timeout))
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: host})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.Mail(from)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range to {
+		err = client.Rcpt(addr)
+		if err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(msg)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+func (s *emailSender) render(from string, to []string, message Message) []byte {
+	buf := new(bytes.Buffer)
+
+	fmt.Fprintf(buf, "From: %s\r\n", from)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", subject(message))
+	fmt.Fprintf(buf, "Content-Type: text/plain; charset=utf-8\r\n")
+	fmt.Fprintf(buf, "\r\n")
+
+	fmt.Fprintf(buf, "%s\r\n", subject(message))
+	fmt.Fprintf(buf, "\r\n")
+
+	if len(message.Transitions) > 0 {
+		transitions := make([]string, len(message.Transitions))
+		for i, transition := range message.Transitions {
+			transitions[i] = string(transition)
+		}
+
+		fmt.Fprintf(buf, "transitions: %s\r\n", strings.Join(transitions, ", "))
+	}
+
+	fmt.Fprintf(buf, "%s\r\n", message.URL)
+
+	return buf.Bytes()
+}
+
+func subject(message Message) string {
+	if message.JobName == "" {
+		return fmt.Sprintf("[%s] build #%d %s", message.TeamName, message.BuildID, message.Status)
+	}
+
+	return fmt.Sprintf(
+		"[%s] %s/%s #%s %s",
+		message.TeamName,
+		message.PipelineName,
+		message.JobName,
+		message.BuildName,
+		message.Status,
+	)
+}
@@ -0,0 +1,214 @@
+package notifications_test
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/concourse/atc"
+	. "github.com/concourse/atc/notifications"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EmailSender", func() {
+	var (
+		smtpServer *fakeSMTPServer
+		sender     Sender
+
+		config  atc.NotificationConfig
+		message Message
+
+		sendErr error
+	)
+
+	BeforeEach(func() {
+		smtpServer = newFakeSMTPServer()
+		sender = NewEmailSender(smtpServer.Addr(), "concourse@localhost", time.Second)
+
+		config = atc.NotificationConfig{
+			Name: "some-email",
+			Email: &atc.EmailNotification{
+				To: []string{"dev@example.com", "ops@example.com"},
+			},
+		}
+
+		message = Message{
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			BuildID:      42,
+			BuildName:    "7",
+			Status:       atc.StatusSucceeded,
+			Transitions:  []atc.BuildTransition{atc.TransitionSucceeded, atc.TransitionFixed},
+			URL:          "https://ci.example.com/builds/42",
+		}
+	})
+
+	AfterEach(func() {
+		smtpServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		sendErr = sender.Send(config, message)
+	})
+
+	It("relays the message to each recipient", func() {
+		Expect(sendErr).NotTo(HaveOccurred())
+
+		var mail receivedMail
+		Eventually(smtpServer.Mail).Should(Receive(&mail))
+
+		Expect(mail.From).To(Equal("concourse@localhost"))
+		Expect(mail.To).To(Equal([]string{"dev@example.com", "ops@example.com"}))
+		Expect(mail.Data).To(ContainSubstring("Subject: [some-team] some-pipeline/some-job #7 succeeded"))
+		Expect(mail.Data).To(ContainSubstring("transitions: succeeded, fixed"))
+		Expect(mail.Data).To(ContainSubstring("https://ci.example.com/builds/42"))
+	})
+
+	Context("when the notification specifies a sender", func() {
+		BeforeEach(func() {
+			config.Email.From = "ci@example.com"
+		})
+
+		It("sends from that address", func() {
+			var mail receivedMail
+			Eventually(smtpServer.Mail).Should(Receive(&mail))
+			Expect(mail.From).To(Equal("ci@example.com"))
+		})
+	})
+
+	Context("when the SMTP server is unreachable", func() {
+		BeforeEach(func() {
+			smtpServer.Close()
+		})
+
+		It("returns an error", func() {
+			Expect(sendErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when the SMTP server never responds", func() {
+		var listener net.Listener
+
+		BeforeEach(func() {
+			var err error
+			listener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			sender = NewEmailSender(listener.Addr().String(), "concourse@localhost", 100*time.Millisecond)
+		})
+
+		AfterEach(func() {
+			listener.Close()
+		})
+
+		It("gives up once the timeout elapses", func() {
+			Expect(sendErr).To(HaveOccurred())
+		})
+	})
+})
+
+type receivedMail struct {
+	From string
+	To   []string
+	Data string
+}
+
+// fakeSMTPServer speaks just enough SMTP to accept a single unauthenticated
+// message per connection.
+type fakeSMTPServer struct {
+	listener net.Listener
+	mail     chan receivedMail
+}
+
+func newFakeSMTPServer() *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	server := &fakeSMTPServer{
+		listener: listener,
+		mail:     make(chan receivedMail, 10),
+	}
+
+	go server.serve()
+
+	return server
+}
+
+func (s *fakeSMTPServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeSMTPServer) Mail() <-chan receivedMail {
+	return s.mail
+}
+
+func (s *fakeSMTPServer) Close() {
+	s.listener.Close()
+}
+
+func (s *fakeSMTPServer) serve() {
+	defer GinkgoRecover()
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTPServer) handle(conn net.Conn) {
+	defer GinkgoRecover()
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) {
+		text.PrintfLine(format, args...)
+	}
+
+	var mail receivedMail
+
+	reply("220 localhost fake smtp")
+
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			mail.From = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			reply("250 ok")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			mail.To = append(mail.To, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			reply("250 ok")
+		case command == "DATA":
+			reply("354 go ahead")
+
+			data, err := text.ReadDotLines()
+			if err != nil {
+				return
+			}
+
+			mail.Data = strings.Join(data, "\n")
+			s.mail <- mail
+			reply("250 ok")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 unrecognized command: %s", line)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// This file was generated by counterfeiter
+package notificationsfakes
+
+import (
+	"os"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/notifications"
+)
+
+type FakeNotifier struct {
+	RunStub        func(signals <-chan os.Signal, ready chan<- struct{}) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}
+	runReturns struct {
+		result1 error
+	}
+	runReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BuildFinishedStub        func(lager.Logger, dbng.Build, atc.BuildStatus)
+	buildFinishedMutex       sync.RWMutex
+	buildFinishedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 dbng.Build
+		arg3 atc.BuildStatus
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeNotifier) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	fake.runMutex.Lock()
+	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}{signals, ready})
+	fake.recordInvocation("Run", []interface{}{signals, ready})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(signals, ready)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.runReturns.result1
+}
+
+func (fake *FakeNotifier) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeNotifier) RunArgsForCall(i int) (<-chan os.Signal, chan<- struct{}) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.runArgsForCall[i].signals, fake.runArgsForCall[i].ready
+}
+
+func (fake *FakeNotifier) RunReturns(result1 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifier) RunReturnsOnCall(i int, result1 error) {
+	fake.RunStub = nil
+	if fake.runReturnsOnCall == nil {
+		fake.runReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeNotifier) BuildFinished(arg1 lager.Logger, arg2 dbng.Build, arg3 atc.BuildStatus) {
+	fake.buildFinishedMutex.Lock()
+	fake.buildFinishedArgsForCall = append(fake.buildFinishedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 dbng.Build
+		arg3 atc.BuildStatus
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("BuildFinished", []interface{}{arg1, arg2, arg3})
+	fake.buildFinishedMutex.Unlock()
+	if fake.BuildFinishedStub != nil {
+		fake.BuildFinishedStub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeNotifier) BuildFinishedCallCount() int {
+	fake.buildFinishedMutex.RLock()
+	defer fake.buildFinishedMutex.RUnlock()
+	return len(fake.buildFinishedArgsForCall)
+}
+
+func (fake *FakeNotifier) BuildFinishedArgsForCall(i int) (lager.Logger, dbng.Build, atc.BuildStatus) {
+	fake.buildFinishedMutex.RLock()
+	defer fake.buildFinishedMutex.RUnlock()
+	return fake.buildFinishedArgsForCall[i].arg1, fake.buildFinishedArgsForCall[i].arg2, fake.buildFinishedArgsForCall[i].arg3
+}
+
+func (fake *FakeNotifier) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	fake.buildFinishedMutex.RLock()
+	defer fake.buildFinishedMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeNotifier) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ notifications.Notifier = new(FakeNotifier)
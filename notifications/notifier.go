@@ -0,0 +1,371 @@
+package notifications
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/web"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/rata"
+)
+
+// pollInterval is how often idle workers check the queue for notifications
+// that have become due, such as retries or those queued by other ATCs.
+const pollInterval = 5 * time.Second
+
+var ErrNotificationRemoved = errors.New("notification was removed from the team before it could be delivered")
+
+//go:generate counterfeiter . Notifier
+
+// Notifier delivers the notifications of finished builds. Notifications are
+// queued in the database and delivered in the background while the Notifier
+// is running, so that a slow or unreachable endpoint never holds up the
+// build, and pending deliveries survive the ATC restarting.
+type Notifier interface {
+	ifrit.Runner
+
+	BuildFinished(lager.Logger, dbng.Build, atc.BuildStatus)
+}
+
+type notifier struct {
+	logger      lager.Logger
+	teamFactory dbng.TeamFactory
+	queue       dbng.NotificationQueue
+	sender      Sender
+	clock       clock.Clock
+
+	externalURL   string
+	attempts      int
+	retryInterval time.Duration
+	workers       int
+	lease         time.Duration
+
+	wake chan struct{}
+}
+
+// NewNotifier returns a Notifier which evaluates the team's notifications
+// against each finished build, delivering them from the given number of
+// workers. Failed deliveries are retried up to attempts times, doubling
+// retryInterval between each attempt, and every attempt is recorded against
+// the build.
+//
+// A delivery that has not finished within the lease, e.g. because the ATC
+// went away while making it, is attempted again. The lease should be longer
+// than a single delivery may take.
+func NewNotifier(
+	logger lager.Logger,
+	teamFactory dbng.TeamFactory,
+	queue dbng.NotificationQueue,
+	sender Sender,
+	clock clock.Clock,
+	externalURL string,
+	attempts int,
+	retryInterval time.Duration,
+	workers int,
+	lease time.Duration,
+) Notifier {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+
+	return &notifier{
+		logger:      logger,
+		teamFactory: teamFactory,
+		queue:       queue,
+		sender:      sender,
+		clock:       clock,
+
+		externalURL:   externalURL,
+		attempts:      attempts,
+		retryInterval: retryInterval,
+		workers:       workers,
+		lease:         lease,
+
+		wake: make(chan struct{}, workers),
+	}
+}
+
+// BuildFinished queues the team's notifications which match the build.
+func (n *notifier) BuildFinished(logger lager.Logger, build dbng.Build, status atc.BuildStatus) {
+	configs, err := n.teamFactory.GetByID(build.TeamID()).Notifications()
+	if err != nil {
+		logger.Error("failed-to-get-notifications", err)
+		return
+	}
+
+	if len(configs) == 0 {
+		return
+	}
+
+	transitions, err := n.transitions(build, status)
+	if err != nil {
+		logger.Error("failed-to-get-previous-status", err)
+		return
+	}
+
+	message := Message{
+		TeamName:     build.TeamName(),
+		PipelineName: build.PipelineName(),
+		JobName:      build.JobName(),
+		BuildID:      build.ID(),
+		BuildName:    build.Name(),
+		Status:       status,
+		Transitions:  transitions,
+		URL:          n.externalURL + buildPath(build),
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		logger.Error("failed-to-encode-message", err)
+		return
+	}
+
+	for _, config := range configs {
+		if !matches(config.Filter, message) {
+			continue
+		}
+
+		err := n.queue.Enqueue(build.ID(), config.Name, payload)
+		if err != nil {
+			logger.Error("failed-to-queue-notification", err, lager.Data{"notification": config.Name})
+			continue
+		}
+
+		select {
+		case n.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (n *notifier) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := n.logger.Session("run")
+
+	stop := make(chan struct{})
+	wg := new(sync.WaitGroup)
+
+	for i := 0; i < n.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+
+				if n.deliverNext(logger) {
+					continue
+				}
+
+				timer := n.clock.NewTimer(pollInterval)
+
+				select {
+				case <-timer.C():
+				case <-n.wake:
+					timer.Stop()
+				case <-stop:
+					timer.Stop()
+					return
+				}
+			}
+		}()
+	}
+
+	close(ready)
+
+	<-signals
+
+	close(stop)
+	wg.Wait()
+
+	return nil
+}
+
+// deliverNext attempts the delivery of a notification that is due, and
+// returns whether there was one.
+func (n *notifier) deliverNext(logger lager.Logger) bool {
+	pending, found, err := n.queue.Claim(n.lease)
+	if err != nil {
+		logger.Error("failed-to-claim-notification", err)
+		return false
+	}
+
+	if !found {
+		return false
+	}
+
+	logger = logger.Session("deliver", lager.Data{
+		"build-id":     pending.BuildID,
+		"notification": pending.Notification,
+	})
+
+	attempt := pending.Attempts + 1
+
+	configs, err := n.teamFactory.GetByID(pending.TeamID).Notifications()
+	if err != nil {
+		// left claimed, so that it is attempted again once the lease expires
+		logger.Error("failed-to-get-notifications", err)
+		return true
+	}
+
+	config, found := findNotification(configs, pending.Notification)
+	if !found {
+		err = n.queue.Complete(pending, dbng.NotificationDelivery{
+			Notification: pending.Notification,
+			Attempt:      attempt,
+			Delivered:    false,
+			Error:        ErrNotificationRemoved.Error(),
+		})
+		if err != nil {
+			logger.Error("failed-to-complete-notification", err)
+		}
+
+		return true
+	}
+
+	var message Message
+	err = json.Unmarshal(pending.Message, &message)
+	if err != nil {
+		logger.Error("failed-to-decode-message", err)
+		return true
+	}
+
+	sendErr := n.sender.Send(config, message)
+
+	delivery := dbng.NotificationDelivery{
+		Notification: config.Name,
+		Attempt:      attempt,
+		Delivered:    sendErr == nil,
+	}
+
+	if sendErr != nil {
+		logger.Error("failed-to-send", sendErr, lager.Data{"attempt": attempt})
+		delivery.Error = sendErr.Error()
+	}
+
+	if sendErr == nil || attempt >= n.attempts {
+		err = n.queue.Complete(pending, delivery)
+	} else {
+		err = n.queue.Retry(pending, delivery, n.retryInterval<<uint(attempt-1))
+	}
+
+	if err != nil {
+		logger.Error("failed-to-save-delivery", err)
+	}
+
+	return true
+}
+
+func (n *notifier) transitions(build dbng.Build, status atc.BuildStatus) ([]atc.BuildTransition, error) {
+	transitions := []atc.BuildTransition{atc.BuildTransition(status)}
+
+	previous, found, err := build.PreviousStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return transitions, nil
+	}
+
+	previouslyBroken := previous == dbng.BuildStatusFailed || previous == dbng.BuildStatusErrored
+	broken := status == atc.StatusFailed || status == atc.StatusErrored
+
+	if previouslyBroken && status == atc.StatusSucceeded {
+		transitions = append(transitions, atc.TransitionFixed)
+	}
+
+	if previous == dbng.BuildStatusSucceeded && broken {
+		transitions = append(transitions, atc.TransitionBroken)
+	}
+
+	return transitions, nil
+}
+
+func findNotification(configs []atc.NotificationConfig, name string) (atc.NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return atc.NotificationConfig{}, false
+}
+
+func matches(filter atc.NotificationFilter, message Message) bool {
+	if len(filter.Pipelines) > 0 && !contains(filter.Pipelines, message.PipelineName) {
+		return false
+	}
+
+	if len(filter.Jobs) > 0 && !contains(filter.Jobs, message.JobName) {
+		return false
+	}
+
+	if len(filter.Transitions) > 0 {
+		for _, wanted := range filter.Transitions {
+			for _, transition := range message.Transitions {
+				if wanted == transition {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	return true
+}
+
+func contains(names []string, name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func buildPath(build dbng.Build) string {
+	var path string
+	var err error
+
+	if build.JobName() == "" && build.PipelineName() == "" {
+		path, err = web.Routes.CreatePathForRoute(web.GetJoblessBuild, rata.Params{
+			"build_id": strconv.Itoa(build.ID()),
+		})
+	} else {
+		path, err = web.Routes.CreatePathForRoute(web.GetBuild, rata.Params{
+			"job":           build.JobName(),
+			"build":         build.Name(),
+			"pipeline_name": build.PipelineName(),
+			"team_name":     build.TeamName(),
+		})
+	}
+
+	if err != nil {
+		return ""
+	}
+
+	return path
+}
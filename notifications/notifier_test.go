@@ -0,0 +1,443 @@
+package notifications_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	. "github.com/concourse/atc/notifications"
+	"github.com/concourse/atc/notifications/notificationsfakes"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/ginkgomon"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		fakeTeamFactory *dbngfakes.FakeTeamFactory
+		fakeTeam        *dbngfakes.FakeTeam
+		fakeQueue       *dbngfakes.FakeNotificationQueue
+		fakeSender      *notificationsfakes.FakeSender
+		fakeClock       *fakeclock.FakeClock
+
+		notifier Notifier
+
+		config atc.NotificationConfig
+	)
+
+	BeforeEach(func() {
+		fakeTeamFactory = new(dbngfakes.FakeTeamFactory)
+		fakeTeam = new(dbngfakes.FakeTeam)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakeQueue = new(dbngfakes.FakeNotificationQueue)
+		fakeSender = new(notificationsfakes.FakeSender)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		config = atc.NotificationConfig{
+			Name:    "some-hook",
+			Webhook: &atc.WebhookNotification{URL: "https://hooks.example.com"},
+		}
+
+		notifier = NewNotifier(
+			lagertest.NewTestLogger("test"),
+			fakeTeamFactory,
+			fakeQueue,
+			fakeSender,
+			fakeClock,
+			"https://ci.example.com",
+			3,
+			time.Second,
+			1,
+			time.Minute,
+		)
+	})
+
+	Describe("BuildFinished", func() {
+		var (
+			fakeBuild *dbngfakes.FakeBuild
+			status    atc.BuildStatus
+		)
+
+		BeforeEach(func() {
+			fakeBuild = new(dbngfakes.FakeBuild)
+			fakeBuild.IDReturns(42)
+			fakeBuild.NameReturns("7")
+			fakeBuild.TeamIDReturns(1)
+			fakeBuild.TeamNameReturns("some-team")
+			fakeBuild.PipelineNameReturns("some-pipeline")
+			fakeBuild.JobNameReturns("some-job")
+
+			status = atc.StatusSucceeded
+		})
+
+		JustBeforeEach(func() {
+			notifier.BuildFinished(lagertest.NewTestLogger("test"), fakeBuild, status)
+		})
+
+		queuedMessage := func() Message {
+			Expect(fakeQueue.EnqueueCallCount()).To(Equal(1))
+
+			_, _, payload := fakeQueue.EnqueueArgsForCall(0)
+
+			var message Message
+			Expect(json.Unmarshal(payload, &message)).To(Succeed())
+
+			return message
+		}
+
+		itDoesNotQueueAnything := func() {
+			It("does not queue anything", func() {
+				Expect(fakeQueue.EnqueueCallCount()).To(BeZero())
+			})
+		}
+
+		Context("when the team has no notifications", func() {
+			itDoesNotQueueAnything()
+
+			It("looks up the build's team", func() {
+				Expect(fakeTeamFactory.GetByIDCallCount()).To(Equal(1))
+				Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(1))
+			})
+		})
+
+		Context("when getting the notifications fails", func() {
+			BeforeEach(func() {
+				fakeTeam.NotificationsReturns(nil, errors.New("nope"))
+			})
+
+			itDoesNotQueueAnything()
+		})
+
+		Context("when the team has notifications", func() {
+			BeforeEach(func() {
+				fakeTeam.NotificationsReturns([]atc.NotificationConfig{config}, nil)
+			})
+
+			It("queues a message describing the build", func() {
+				Expect(fakeQueue.EnqueueCallCount()).To(Equal(1))
+
+				buildID, notification, _ := fakeQueue.EnqueueArgsForCall(0)
+				Expect(buildID).To(Equal(42))
+				Expect(notification).To(Equal("some-hook"))
+
+				Expect(queuedMessage()).To(Equal(Message{
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					JobName:      "some-job",
+					BuildID:      42,
+					BuildName:    "7",
+					Status:       atc.StatusSucceeded,
+					Transitions:  []atc.BuildTransition{atc.TransitionSucceeded},
+					URL:          "https://ci.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/7",
+				}))
+			})
+
+			It("does not send anything itself", func() {
+				Expect(fakeSender.SendCallCount()).To(BeZero())
+			})
+
+			Context("when the build is one-off", func() {
+				BeforeEach(func() {
+					fakeBuild.PipelineNameReturns("")
+					fakeBuild.JobNameReturns("")
+				})
+
+				It("links to the jobless build page", func() {
+					Expect(queuedMessage().URL).To(Equal("https://ci.example.com/builds/42"))
+				})
+
+				Context("when the notification is filtered by pipeline", func() {
+					BeforeEach(func() {
+						config.Filter.Pipelines = []string{"some-pipeline"}
+						fakeTeam.NotificationsReturns([]atc.NotificationConfig{config}, nil)
+					})
+
+					itDoesNotQueueAnything()
+				})
+			})
+
+			Context("when the previous build failed", func() {
+				BeforeEach(func() {
+					fakeBuild.PreviousStatusReturns(dbng.BuildStatusFailed, true, nil)
+				})
+
+				It("reports the build as fixed", func() {
+					Expect(queuedMessage().Transitions).To(Equal([]atc.BuildTransition{
+						atc.TransitionSucceeded,
+						atc.TransitionFixed,
+					}))
+				})
+			})
+
+			Context("when the previous build succeeded and this one errored", func() {
+				BeforeEach(func() {
+					status = atc.StatusErrored
+					fakeBuild.PreviousStatusReturns(dbng.BuildStatusSucceeded, true, nil)
+				})
+
+				It("reports the build as broken", func() {
+					Expect(queuedMessage().Transitions).To(Equal([]atc.BuildTransition{
+						atc.TransitionErrored,
+						atc.TransitionBroken,
+					}))
+				})
+			})
+
+			Context("when getting the previous status fails", func() {
+				BeforeEach(func() {
+					fakeBuild.PreviousStatusReturns("", false, errors.New("nope"))
+				})
+
+				itDoesNotQueueAnything()
+			})
+
+			Context("when the notification only matches other jobs", func() {
+				BeforeEach(func() {
+					config.Filter.Jobs = []string{"other-job"}
+					fakeTeam.NotificationsReturns([]atc.NotificationConfig{config}, nil)
+				})
+
+				itDoesNotQueueAnything()
+			})
+
+			Context("when the notification only matches the broken transition", func() {
+				BeforeEach(func() {
+					config.Filter.Transitions = []atc.BuildTransition{atc.TransitionBroken}
+					fakeTeam.NotificationsReturns([]atc.NotificationConfig{config}, nil)
+				})
+
+				Context("and the build was not broken", func() {
+					itDoesNotQueueAnything()
+				})
+
+				Context("and the build broke", func() {
+					BeforeEach(func() {
+						status = atc.StatusFailed
+						fakeBuild.PreviousStatusReturns(dbng.BuildStatusSucceeded, true, nil)
+					})
+
+					It("queues the message", func() {
+						Expect(fakeQueue.EnqueueCallCount()).To(Equal(1))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var (
+			process ifrit.Process
+
+			pending dbng.PendingNotification
+			message Message
+		)
+
+		BeforeEach(func() {
+			message = Message{
+				TeamName:  "some-team",
+				BuildID:   42,
+				BuildName: "7",
+				Status:    atc.StatusFailed,
+				URL:       "https://ci.example.com/builds/42",
+			}
+
+			payload, err := json.Marshal(message)
+			Expect(err).NotTo(HaveOccurred())
+
+			pending = dbng.PendingNotification{
+				ID:           1,
+				BuildID:      42,
+				TeamID:       1,
+				Notification: "some-hook",
+				Message:      payload,
+			}
+
+			fakeTeam.NotificationsReturns([]atc.NotificationConfig{config}, nil)
+		})
+
+		JustBeforeEach(func() {
+			process = ginkgomon.Invoke(notifier)
+		})
+
+		AfterEach(func() {
+			ginkgomon.Interrupt(process)
+		})
+
+		Context("when a notification is due", func() {
+			BeforeEach(func() {
+				fakeQueue.ClaimReturnsOnCall(0, pending, true, nil)
+			})
+
+			It("claims it for the lease", func() {
+				Eventually(fakeQueue.ClaimCallCount).Should(BeNumerically(">=", 1))
+				Expect(fakeQueue.ClaimArgsForCall(0)).To(Equal(time.Minute))
+			})
+
+			It("sends the queued message using the team's notification", func() {
+				Eventually(fakeSender.SendCallCount).Should(Equal(1))
+
+				sentConfig, sentMessage := fakeSender.SendArgsForCall(0)
+				Expect(sentConfig).To(Equal(config))
+				Expect(sentMessage).To(Equal(message))
+
+				Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(1))
+			})
+
+			It("completes it, recording the delivery", func() {
+				Eventually(fakeQueue.CompleteCallCount).Should(Equal(1))
+
+				completed, delivery := fakeQueue.CompleteArgsForCall(0)
+				Expect(completed).To(Equal(pending))
+				Expect(delivery).To(Equal(dbng.NotificationDelivery{
+					Notification: "some-hook",
+					Attempt:      1,
+					Delivered:    true,
+				}))
+			})
+
+			Context("when sending fails", func() {
+				BeforeEach(func() {
+					fakeSender.SendReturns(errors.New("connection refused"))
+				})
+
+				It("retries it after the retry interval, recording the attempt", func() {
+					Eventually(fakeQueue.RetryCallCount).Should(Equal(1))
+
+					retried, delivery, delay := fakeQueue.RetryArgsForCall(0)
+					Expect(retried).To(Equal(pending))
+					Expect(delivery).To(Equal(dbng.NotificationDelivery{
+						Notification: "some-hook",
+						Attempt:      1,
+						Delivered:    false,
+						Error:        "connection refused",
+					}))
+					Expect(delay).To(Equal(time.Second))
+
+					Expect(fakeQueue.CompleteCallCount()).To(BeZero())
+				})
+
+				Context("on a later attempt", func() {
+					BeforeEach(func() {
+						pending.Attempts = 1
+						fakeQueue.ClaimReturnsOnCall(0, pending, true, nil)
+					})
+
+					It("doubles the retry interval", func() {
+						Eventually(fakeQueue.RetryCallCount).Should(Equal(1))
+
+						_, delivery, delay := fakeQueue.RetryArgsForCall(0)
+						Expect(delivery.Attempt).To(Equal(2))
+						Expect(delay).To(Equal(2 * time.Second))
+					})
+				})
+
+				Context("on the last attempt", func() {
+					BeforeEach(func() {
+						pending.Attempts = 2
+						fakeQueue.ClaimReturnsOnCall(0, pending, true, nil)
+					})
+
+					It("gives up, recording the attempt", func() {
+						Eventually(fakeQueue.CompleteCallCount).Should(Equal(1))
+
+						_, delivery := fakeQueue.CompleteArgsForCall(0)
+						Expect(delivery).To(Equal(dbng.NotificationDelivery{
+							Notification: "some-hook",
+							Attempt:      3,
+							Delivered:    false,
+							Error:        "connection refused",
+						}))
+
+						Expect(fakeQueue.RetryCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the notification has been removed from the team", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationsReturns([]atc.NotificationConfig{}, nil)
+				})
+
+				It("completes it without sending anything", func() {
+					Eventually(fakeQueue.CompleteCallCount).Should(Equal(1))
+
+					_, delivery := fakeQueue.CompleteArgsForCall(0)
+					Expect(delivery.Delivered).To(BeFalse())
+					Expect(delivery.Error).To(Equal(ErrNotificationRemoved.Error()))
+
+					Expect(fakeSender.SendCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the team's notifications fails", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationsReturns(nil, errors.New("nope"))
+				})
+
+				It("leaves it claimed, to be attempted again once the lease expires", func() {
+					Eventually(fakeTeam.NotificationsCallCount).Should(Equal(1))
+					Consistently(fakeQueue.CompleteCallCount).Should(BeZero())
+					Expect(fakeQueue.RetryCallCount()).To(BeZero())
+					Expect(fakeSender.SendCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when no notification is due", func() {
+			It("checks again after the poll interval", func() {
+				Eventually(fakeQueue.ClaimCallCount).Should(Equal(1))
+				Consistently(fakeQueue.ClaimCallCount).Should(Equal(1))
+
+				fakeQueue.ClaimReturns(pending, true, nil)
+				fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+
+				Eventually(fakeSender.SendCallCount).Should(BeNumerically(">=", 1))
+			})
+
+			It("checks again as soon as a build's notification is queued", func() {
+				Eventually(fakeQueue.ClaimCallCount).Should(Equal(1))
+
+				fakeBuild := new(dbngfakes.FakeBuild)
+				fakeBuild.TeamIDReturns(1)
+				notifier.BuildFinished(lagertest.NewTestLogger("test"), fakeBuild, atc.StatusSucceeded)
+
+				Eventually(fakeQueue.ClaimCallCount).Should(Equal(2))
+			})
+		})
+
+		Context("when a delivery is slow", func() {
+			var unblock chan struct{}
+
+			BeforeEach(func() {
+				fakeQueue.ClaimReturnsOnCall(0, pending, true, nil)
+
+				unblock = make(chan struct{})
+				fakeSender.SendStub = func(atc.NotificationConfig, Message) error {
+					<-unblock
+					return nil
+				}
+			})
+
+			It("finishes it before exiting", func() {
+				Eventually(fakeSender.SendCallCount).Should(Equal(1))
+
+				process.Signal(os.Interrupt)
+				Consistently(process.Wait()).ShouldNot(Receive())
+
+				close(unblock)
+				Eventually(process.Wait()).Should(Receive())
+
+				Expect(fakeQueue.CompleteCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
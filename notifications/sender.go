@@ -0,0 +1,52 @@
+package notifications
+
+import (
+	"errors"
+
+	"github.com/concourse/atc"
+)
+
+var ErrNoNotificationTarget = errors.New("notification has neither a webhook nor an email configured")
+
+// Message describes a finished build to the recipients of a notification.
+type Message struct {
+	TeamName     string                `json:"team_name"`
+	PipelineName string                `json:"pipeline_name,omitempty"`
+	JobName      string                `json:"job_name,omitempty"`
+	BuildID      int                   `json:"build_id"`
+	BuildName    string                `json:"build_name"`
+	Status       atc.BuildStatus       `json:"status"`
+	Transitions  []atc.BuildTransition `json:"transitions"`
+	URL          string                `json:"url"`
+}
+
+//go:generate counterfeiter . Sender
+
+type Sender interface {
+	Send(atc.NotificationConfig, Message) error
+}
+
+type sender struct {
+	webhook Sender
+	email   Sender
+}
+
+// NewSender returns a Sender which delivers each notification to its
+// configured webhook or email recipients.
+func NewSender(webhook Sender, email Sender) Sender {
+	return &sender{
+		webhook: webhook,
+		email:   email,
+	}
+}
+
+func (s *sender) Send(config atc.NotificationConfig, message Message) error {
+	switch {
+	case config.Webhook != nil:
+		return s.webhook.Send(config, message)
+	case config.Email != nil:
+		return s.email.Send(config, message)
+	default:
+		return ErrNoNotificationTarget
+	}
+}
@@ -0,0 +1,53 @@
+package notifications_test
+
+import (
+	"github.com/concourse/atc"
+	. "github.com/concourse/atc/notifications"
+	"github.com/concourse/atc/notifications/notificationsfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sender", func() {
+	var (
+		fakeWebhookSender *notificationsfakes.FakeSender
+		fakeEmailSender   *notificationsfakes.FakeSender
+
+		sender Sender
+	)
+
+	BeforeEach(func() {
+		fakeWebhookSender = new(notificationsfakes.FakeSender)
+		fakeEmailSender = new(notificationsfakes.FakeSender)
+
+		sender = NewSender(fakeWebhookSender, fakeEmailSender)
+	})
+
+	It("sends webhook notifications via the webhook sender", func() {
+		err := sender.Send(atc.NotificationConfig{
+			Name:    "some-hook",
+			Webhook: &atc.WebhookNotification{URL: "https://hooks.example.com"},
+		}, Message{BuildID: 42})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeWebhookSender.SendCallCount()).To(Equal(1))
+		Expect(fakeEmailSender.SendCallCount()).To(BeZero())
+	})
+
+	It("sends email notifications via the email sender", func() {
+		err := sender.Send(atc.NotificationConfig{
+			Name:  "some-email",
+			Email: &atc.EmailNotification{To: []string{"dev@example.com"}},
+		}, Message{BuildID: 42})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeEmailSender.SendCallCount()).To(Equal(1))
+		Expect(fakeWebhookSender.SendCallCount()).To(BeZero())
+	})
+
+	It("fails when the notification has no target", func() {
+		err := sender.Send(atc.NotificationConfig{Name: "nowhere"}, Message{BuildID: 42})
+		Expect(err).To(Equal(ErrNoNotificationTarget))
+	})
+})
@@ -0,0 +1,120 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/concourse/atc"
+)
+
+type UnexpectedResponseError struct {
+	StatusCode int
+}
+
+func (err UnexpectedResponseError) Error() string {
+	return fmt.Sprintf("webhook responded with unexpected status: %d", err.StatusCode)
+}
+
+type DeniedAddressError struct {
+	Host string
+	IP   net.IP
+}
+
+func (err DeniedAddressError) Error() string {
+	return fmt.Sprintf("webhook host %s resolves to denied address %s", err.Host, err.IP)
+}
+
+type webhookSender struct {
+	httpClient *http.Client
+}
+
+// NewWebhookSender returns a Sender which POSTs the message as JSON to the
+// notification's webhook URL, giving up after the timeout.
+//
+// Webhooks are never delivered to an address within deniedNetworks, such as
+// the ATC's own network or a cloud metadata endpoint. Addresses are checked
+// after the host is resolved, including when following redirects.
+func NewWebhookSender(timeout time.Duration, deniedNetworks []*net.IPNet) Sender {
+	dialer := &deniedNetworksDialer{
+		dialer:         &net.Dialer{Timeout: timeout},
+		deniedNetworks: deniedNetworks,
+	}
+
+	return &webhookSender{
+		httpClient: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+			},
+		},
+	}
+}
+
+func (s *webhookSender) Send(config atc.NotificationConfig, message Message) error {
+	err := atc.ValidateWebhookURL(config.Webhook.URL)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.httpClient.Post(config.Webhook.URL, "application/json", bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return UnexpectedResponseError{StatusCode: response.StatusCode}
+	}
+
+	return nil
+}
+
+type deniedNetworksDialer struct {
+	dialer         *net.Dialer
+	deniedNetworks []*net.IPNet
+}
+
+// DialContext resolves the host itself and dials the resolved address, so
+// that the address checked is the one connected to.
+func (d *deniedNetworksDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, addr := range addrs {
+		for _, denied := range d.deniedNetworks {
+			if denied.Contains(addr.IP) {
+				return nil, DeniedAddressError{Host: host, IP: addr.IP}
+			}
+		}
+	}
+
+	dialErr := fmt.Errorf("no addresses found for webhook host %s", host)
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, dialErr = d.dialer.DialContext(ctx, network, net.JoinHostPort(addr.IP.String(), port))
+		if dialErr == nil {
+			return conn, nil
+		}
+	}
+
+	return nil, dialErr
+}
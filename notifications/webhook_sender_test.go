@@ -0,0 +1,115 @@
+package notifications_test
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/concourse/atc"
+	. "github.com/concourse/atc/notifications"
+	"github.com/onsi/gomega/ghttp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookSender", func() {
+	var (
+		server *ghttp.Server
+		sender Sender
+
+		config  atc.NotificationConfig
+		message Message
+
+		sendErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		sender = NewWebhookSender(time.Second, nil)
+
+		config = atc.NotificationConfig{
+			Name:    "some-hook",
+			Webhook: &atc.WebhookNotification{URL: server.URL() + "/hook"},
+		}
+
+		message = Message{
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			BuildID:      42,
+			BuildName:    "7",
+			Status:       atc.StatusFailed,
+			Transitions:  []atc.BuildTransition{atc.TransitionFailed, atc.TransitionBroken},
+			URL:          "https://ci.example.com/builds/42",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		sendErr = sender.Send(config, message)
+	})
+
+	Context("when the webhook accepts the message", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/hook"),
+				ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+				ghttp.VerifyJSON(`{
+					"team_name": "some-team",
+					"pipeline_name": "some-pipeline",
+					"job_name": "some-job",
+					"build_id": 42,
+					"build_name": "7",
+					"status": "failed",
+					"transitions": ["failed", "broken"],
+					"url": "https://ci.example.com/builds/42"
+				}`),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+		})
+
+		It("succeeds", func() {
+			Expect(sendErr).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Context("when the webhook responds with a non-2xx status", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusBadGateway, nil))
+		})
+
+		It("returns an error", func() {
+			Expect(sendErr).To(Equal(UnexpectedResponseError{StatusCode: http.StatusBadGateway}))
+		})
+	})
+
+	Context("when the webhook url is not http or https", func() {
+		BeforeEach(func() {
+			config.Webhook.URL = "gopher://" + server.Addr() + "/hook"
+		})
+
+		It("returns an error without sending anything", func() {
+			Expect(sendErr).To(MatchError(ContainSubstring("scheme must be http or https")))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when the webhook resolves to a denied network", func() {
+		BeforeEach(func() {
+			_, loopback, err := net.ParseCIDR("127.0.0.0/8")
+			Expect(err).NotTo(HaveOccurred())
+
+			sender = NewWebhookSender(time.Second, []*net.IPNet{loopback})
+		})
+
+		It("returns an error without sending anything", func() {
+			Expect(sendErr).To(MatchError(ContainSubstring("resolves to denied address 127.0.0.1")))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package atc
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+type PipelineConfigVersion struct {
+	Version   int    `json:"version"`
+	Author    string `json:"author,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type PipelineConfigDiff struct {
+	From int `json:"from"`
+	To   int `json:"to"`
+
+	Groups        ConfigDiff `json:"groups"`
+	Resources     ConfigDiff `json:"resources"`
+	ResourceTypes ConfigDiff `json:"resource_types"`
+	Jobs          ConfigDiff `json:"jobs"`
+}
+
+// ConfigDiff names the things of one kind (e.g. jobs) that were added,
+// removed or changed between two configs.
+type ConfigDiff struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Changed []string `json:"changed"`
+}
+
+// DiffConfigs compares two configs by the names of their groups, resources,
+// resource types and jobs. Anything present in both but configured
+// differently is considered changed.
+//
+// A config loaded from the database has been through JSON while one submitted
+// by a user has been decoded from YAML, so their free-form values (e.g. params
+// and sources) are compared as JSON, not by their Go types.
+func DiffConfigs(from Config, to Config) PipelineConfigDiff {
+	var fromGroups, toGroups []namedConfig
+	for _, group := range from.Groups {
+		fromGroups = append(fromGroups, namedConfig{group.Name, group})
+	}
+	for _, group := range to.Groups {
+		toGroups = append(toGroups, namedConfig{group.Name, group})
+	}
+
+	var fromResources, toResources []namedConfig
+	for _, resource := range from.Resources {
+		fromResources = append(fromResources, namedConfig{resource.Name, resource})
+	}
+	for _, resource := range to.Resources {
+		toResources = append(toResources, namedConfig{resource.Name, resource})
+	}
+
+	var fromResourceTypes, toResourceTypes []namedConfig
+	for _, resourceType := range from.ResourceTypes {
+		fromResourceTypes = append(fromResourceTypes, namedConfig{resourceType.Name, resourceType})
+	}
+	for _, resourceType := range to.ResourceTypes {
+		toResourceTypes = append(toResourceTypes, namedConfig{resourceType.Name, resourceType})
+	}
+
+	var fromJobs, toJobs []namedConfig
+	for _, job := range from.Jobs {
+		fromJobs = append(fromJobs, namedConfig{job.Name, job})
+	}
+	for _, job := range to.Jobs {
+		toJobs = append(toJobs, namedConfig{job.Name, job})
+	}
+
+	return PipelineConfigDiff{
+		Groups:        diffNamedConfigs(fromGroups, toGroups),
+		Resources:     diffNamedConfigs(fromResources, toResources),
+		ResourceTypes: diffNamedConfigs(fromResourceTypes, toResourceTypes),
+		Jobs:          diffNamedConfigs(fromJobs, toJobs),
+	}
+}
+
+type namedConfig struct {
+	name   string
+	config interface{}
+}
+
+func diffNamedConfigs(from []namedConfig, to []namedConfig) ConfigDiff {
+	diff := ConfigDiff{
+		Added:   []string{},
+		Removed: []string{},
+		Changed: []string{},
+	}
+
+	fromByName := map[string]interface{}{}
+	for _, c := range from {
+		fromByName[c.name] = c.config
+	}
+
+	toByName := map[string]interface{}{}
+	for _, c := range to {
+		toByName[c.name] = c.config
+
+		old, found := fromByName[c.name]
+		if !found {
+			diff.Added = append(diff.Added, c.name)
+		} else if !reflect.DeepEqual(normalizeConfig(old), normalizeConfig(c.config)) {
+			diff.Changed = append(diff.Changed, c.name)
+		}
+	}
+
+	for _, c := range from {
+		if _, found := toByName[c.name]; !found {
+			diff.Removed = append(diff.Removed, c.name)
+		}
+	}
+
+	return diff
+}
+
+func normalizeConfig(config interface{}) interface{} {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return config
+	}
+
+	var normalized interface{}
+	err = json.Unmarshal(payload, &normalized)
+	if err != nil {
+		return config
+	}
+
+	return normalized
+}
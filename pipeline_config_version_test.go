@@ -0,0 +1,118 @@
+package atc_test
+
+import (
+	. "github.com/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffConfigs", func() {
+	var from, to Config
+
+	BeforeEach(func() {
+		from = Config{
+			Groups: GroupConfigs{
+				{Name: "some-group", Jobs: []string{"some-job", "removed-job"}},
+			},
+			Resources: ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: Source{"uri": "some-uri"}},
+				{Name: "unchanged-resource", Type: "time"},
+			},
+			ResourceTypes: ResourceTypes{
+				{Name: "some-type", Type: "docker-image"},
+			},
+			Jobs: JobConfigs{
+				{Name: "some-job", Serial: true},
+				{Name: "removed-job"},
+			},
+		}
+
+		to = Config{
+			Groups: GroupConfigs{
+				{Name: "some-group", Jobs: []string{"some-job", "added-job"}},
+			},
+			Resources: ResourceConfigs{
+				{Name: "some-resource", Type: "git", Source: Source{"uri": "some-other-uri"}},
+				{Name: "unchanged-resource", Type: "time"},
+				{Name: "added-resource", Type: "s3"},
+			},
+			Jobs: JobConfigs{
+				{Name: "added-job"},
+				{Name: "some-job", Serial: true},
+			},
+		}
+	})
+
+	It("names what was added, removed and changed", func() {
+		Expect(DiffConfigs(from, to)).To(Equal(PipelineConfigDiff{
+			Groups: ConfigDiff{
+				Added:   []string{},
+				Removed: []string{},
+				Changed: []string{"some-group"},
+			},
+			Resources: ConfigDiff{
+				Added:   []string{"added-resource"},
+				Removed: []string{},
+				Changed: []string{"some-resource"},
+			},
+			ResourceTypes: ConfigDiff{
+				Added:   []string{},
+				Removed: []string{"some-type"},
+				Changed: []string{},
+			},
+			Jobs: ConfigDiff{
+				Added:   []string{"added-job"},
+				Removed: []string{"removed-job"},
+				Changed: []string{},
+			},
+		}))
+	})
+
+	It("finds no differences between identical configs", func() {
+		diff := DiffConfigs(from, from)
+		Expect(diff.Jobs.Added).To(BeEmpty())
+		Expect(diff.Jobs.Removed).To(BeEmpty())
+		Expect(diff.Jobs.Changed).To(BeEmpty())
+		Expect(diff.Resources.Changed).To(BeEmpty())
+	})
+
+	It("finds no differences between a stored config and the same config decoded from YAML", func() {
+		from.Jobs = JobConfigs{
+			{
+				Name: "some-job",
+				Plan: PlanSequence{
+					{
+						Task: "some-task",
+						Params: Params{
+							"count":  float64(3),
+							"nested": map[string]interface{}{"depth": float64(1)},
+						},
+					},
+				},
+			},
+		}
+
+		to.Jobs = JobConfigs{
+			{
+				Name: "some-job",
+				Plan: PlanSequence{
+					{
+						Task: "some-task",
+						Params: Params{
+							"count":  3,
+							"nested": map[string]interface{}{"depth": 1},
+						},
+					},
+				},
+			},
+		}
+
+		from.Resources[0].Source = Source{"uri": "some-uri", "depth": float64(1)}
+		to.Resources[0].Source = Source{"uri": "some-uri", "depth": 1}
+
+		diff := DiffConfigs(from, to)
+		Expect(diff.Jobs.Changed).To(BeEmpty())
+		Expect(diff.Resources.Changed).To(BeEmpty())
+	})
+})
@@ -0,0 +1,20 @@
+package atc
+
+type PipelineTemplate struct {
+	Name      string                     `json:"name"`
+	Template  string                     `json:"template"`
+	Instances []PipelineTemplateInstance `json:"instances"`
+}
+
+type PipelineTemplateInstance struct {
+	PipelineName string                 `json:"pipeline_name"`
+	Params       map[string]interface{} `json:"params"`
+}
+
+// PipelineTemplateInstanceResult is the outcome of rendering a template into
+// one of its pipelines and saving the result.
+type PipelineTemplateInstanceResult struct {
+	PipelineName string    `json:"pipeline_name"`
+	Errors       []string  `json:"errors,omitempty"`
+	Warnings     []Warning `json:"warnings,omitempty"`
+}
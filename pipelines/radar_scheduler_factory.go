@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/atc/radar"
 	"github.com/concourse/atc/resource"
 	"github.com/concourse/atc/scheduler"
+	"github.com/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/atc/scheduler/factory"
 	"github.com/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/atc/scheduler/inputmapper/inputconfig"
@@ -28,17 +29,20 @@ type radarSchedulerFactory struct {
 	resourceFactory resource.ResourceFactory
 	interval        time.Duration
 	engine          engine.Engine
+	buildQueue      buildqueue.Queue
 }
 
 func NewRadarSchedulerFactory(
 	resourceFactory resource.ResourceFactory,
 	interval time.Duration,
 	engine engine.Engine,
+	buildQueue buildqueue.Queue,
 ) RadarSchedulerFactory {
 	return &radarSchedulerFactory{
 		resourceFactory: resourceFactory,
 		interval:        interval,
 		engine:          engine,
+		buildQueue:      buildQueue,
 	}
 }
 
@@ -72,6 +76,12 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipelineDB db.PipelineDB, dbPip
 			scanner,
 			inputMapper,
 			rsf.engine,
+			rsf.buildQueue,
+		),
+		Superseder: scheduler.NewSuperseder(
+			dbPipeline,
+			rsf.engine,
+			clock.NewClock(),
 		),
 		Scanner: scanner,
 	}
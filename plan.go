@@ -16,6 +16,13 @@ type Plan struct {
 	DependentGet *DependentGetPlan `json:"dependent_get,omitempty"`
 	Timeout      *TimeoutPlan      `json:"timeout,omitempty"`
 	Retry        *RetryPlan        `json:"retry,omitempty"`
+	Approve      *ApprovePlan      `json:"approve,omitempty"`
+	Conditional  *ConditionalPlan  `json:"conditional,omitempty"`
+	InParallel   *InParallelPlan   `json:"in_parallel,omitempty"`
+	LoadVar      *LoadVarPlan      `json:"load_var,omitempty"`
+
+	// RetryPolicy accompanies Retry, configuring how its attempts are run.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
 }
 
 type PlanID string
@@ -28,6 +35,8 @@ type DependentGetPlan struct {
 	Tags     Tags   `json:"tags,omitempty"`
 	Source   Source `json:"source"`
 
+	Placement *WorkerPlacement `json:"placement,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -40,6 +49,8 @@ func (plan DependentGetPlan) GetPlan() GetPlan {
 		Tags:     plan.Tags,
 		Params:   plan.Params,
 
+		Placement: plan.Placement,
+
 		VersionedResourceTypes: plan.VersionedResourceTypes,
 	}
 }
@@ -64,12 +75,23 @@ type TimeoutPlan struct {
 	Duration string `json:"duration"`
 }
 
+type ConditionalPlan struct {
+	Step      Plan   `json:"step"`
+	Condition string `json:"condition"`
+}
+
 type TryPlan struct {
 	Step Plan `json:"step"`
 }
 
 type AggregatePlan []Plan
 
+type InParallelPlan struct {
+	Steps    []Plan `json:"steps"`
+	Limit    int    `json:"limit,omitempty"`
+	FailFast bool   `json:"fail_fast,omitempty"`
+}
+
 type DoPlan []Plan
 
 type GetPlan struct {
@@ -81,6 +103,8 @@ type GetPlan struct {
 	Version  Version `json:"version,omitempty"`
 	Tags     Tags    `json:"tags,omitempty"`
 
+	Placement *WorkerPlacement `json:"placement,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
@@ -92,14 +116,17 @@ type PutPlan struct {
 	Params   Params `json:"params,omitempty"`
 	Tags     Tags   `json:"tags,omitempty"`
 
+	Placement *WorkerPlacement `json:"placement,omitempty"`
+
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
-	Privileged bool `json:"privileged"`
-	Tags       Tags `json:"tags,omitempty"`
+	Privileged bool             `json:"privileged"`
+	Tags       Tags             `json:"tags,omitempty"`
+	Placement  *WorkerPlacement `json:"placement,omitempty"`
 
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
@@ -108,8 +135,30 @@ type TaskPlan struct {
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
+	Artifacts         []string          `json:"artifacts,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type RetryPlan []Plan
+
+// RetryPolicy configures the delay between the attempts of a RetryPlan, which
+// outcomes are retried, and where the attempts are placed.
+type RetryPolicy struct {
+	Delay           string `json:"delay,omitempty"`
+	Backoff         string `json:"backoff,omitempty"`
+	MaxDelay        string `json:"max_delay,omitempty"`
+	ErroredOnly     bool   `json:"errored_only,omitempty"`
+	DifferentWorker bool   `json:"different_worker,omitempty"`
+}
+
+type ApprovePlan struct {
+	Name string `json:"name"`
+}
+
+type LoadVarPlan struct {
+	Name      string `json:"name"`
+	File      string `json:"file"`
+	Format    string `json:"format,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
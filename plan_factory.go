@@ -49,6 +49,14 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case ApprovePlan:
+		plan.Approve = &t
+	case ConditionalPlan:
+		plan.Conditional = &t
+	case InParallelPlan:
+		plan.InParallel = &t
+	case LoadVarPlan:
+		plan.LoadVar = &t
 	default:
 		panic(fmt.Sprintf("don't know how to construct plan from %T", step))
 	}
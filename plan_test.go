@@ -240,6 +240,60 @@ var _ = Describe("Plan", func() {
 						},
 					},
 				},
+
+				atc.Plan{
+					ID: "26",
+					Approve: &atc.ApprovePlan{
+						Name: "name",
+					},
+				},
+
+				atc.Plan{
+					ID: "27",
+					Conditional: &atc.ConditionalPlan{
+						Condition: "build.trigger == 'manual'",
+						Step: atc.Plan{
+							ID: "28",
+							Task: &atc.TaskPlan{
+								Name:       "name",
+								ConfigPath: "some/config/path.yml",
+								Config: &atc.TaskConfig{
+									Params: map[string]string{"some": "secret"},
+								},
+							},
+						},
+					},
+				},
+
+				atc.Plan{
+					ID: "29",
+					InParallel: &atc.InParallelPlan{
+						Steps: []atc.Plan{
+							atc.Plan{
+								ID: "30",
+								Task: &atc.TaskPlan{
+									Name:       "name",
+									ConfigPath: "some/config/path.yml",
+									Config: &atc.TaskConfig{
+										Params: map[string]string{"some": "secret"},
+									},
+								},
+							},
+						},
+						Limit:    1,
+						FailFast: true,
+					},
+				},
+
+				atc.Plan{
+					ID: "31",
+					LoadVar: &atc.LoadVarPlan{
+						Name:      "name",
+						File:      "some/file.json",
+						Format:    "json",
+						Sensitive: true,
+					},
+				},
 			},
 		}
 
@@ -413,6 +467,50 @@ var _ = Describe("Plan", func() {
           }
         }
       ]
+    },
+    {
+      "id": "26",
+      "approve": {
+        "name": "name"
+      }
+    },
+    {
+      "id": "27",
+      "conditional": {
+        "step": {
+          "id": "28",
+          "task": {
+            "name": "name",
+            "privileged": false
+          }
+        },
+        "condition": "build.trigger == 'manual'"
+      }
+    },
+    {
+      "id": "29",
+      "in_parallel": {
+        "steps": [
+          {
+            "id": "30",
+            "task": {
+              "name": "name",
+              "privileged": false
+            }
+          }
+        ],
+        "limit": 1,
+        "fail_fast": true
+      }
+    },
+    {
+      "id": "31",
+      "load_var": {
+        "name": "name",
+        "file": "some/file.json",
+        "format": "json",
+        "sensitive": true
+      }
     }
   ]
 }
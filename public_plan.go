@@ -18,6 +18,12 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout      *json.RawMessage `json:"timeout,omitempty"`
 		Retry        *json.RawMessage `json:"retry,omitempty"`
+		Approve      *json.RawMessage `json:"approve,omitempty"`
+		Conditional  *json.RawMessage `json:"conditional,omitempty"`
+		InParallel   *json.RawMessage `json:"in_parallel,omitempty"`
+		LoadVar      *json.RawMessage `json:"load_var,omitempty"`
+
+		RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
 	}
 
 	public.ID = plan.ID
@@ -70,6 +76,24 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Approve != nil {
+		public.Approve = plan.Approve.Public()
+	}
+
+	if plan.Conditional != nil {
+		public.Conditional = plan.Conditional.Public()
+	}
+
+	if plan.InParallel != nil {
+		public.InParallel = plan.InParallel.Public()
+	}
+
+	if plan.LoadVar != nil {
+		public.LoadVar = plan.LoadVar.Public()
+	}
+
+	public.RetryPolicy = plan.RetryPolicy
+
 	return enc(public)
 }
 
@@ -83,6 +107,24 @@ func (plan AggregatePlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan InParallelPlan) Public() *json.RawMessage {
+	steps := make([]*json.RawMessage, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = plan.Steps[i].Public()
+	}
+
+	return enc(struct {
+		Steps    []*json.RawMessage `json:"steps"`
+		Limit    int                `json:"limit,omitempty"`
+		FailFast bool               `json:"fail_fast,omitempty"`
+	}{
+		Steps:    steps,
+		Limit:    plan.Limit,
+		FailFast: plan.FailFast,
+	})
+}
+
 func (plan DoPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -181,6 +223,16 @@ func (plan TimeoutPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan ConditionalPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Step      *json.RawMessage `json:"step"`
+		Condition string           `json:"condition"`
+	}{
+		Step:      plan.Step.Public(),
+		Condition: plan.Condition,
+	})
+}
+
 func (plan TryPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step *json.RawMessage `json:"step"`
@@ -199,6 +251,28 @@ func (plan RetryPlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan ApprovePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
+func (plan LoadVarPlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name      string `json:"name"`
+		File      string `json:"file"`
+		Format    string `json:"format,omitempty"`
+		Sensitive bool   `json:"sensitive,omitempty"`
+	}{
+		Name:      plan.Name,
+		File:      plan.File,
+		Format:    plan.Format,
+		Sensitive: plan.Sensitive,
+	})
+}
+
 func enc(public interface{}) *json.RawMessage {
 	enc, _ := json.Marshal(public)
 	return (*json.RawMessage)(&enc)
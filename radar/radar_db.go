@@ -20,7 +20,6 @@ type RadarDB interface {
 	PauseResource(resourceName string) error
 	UnpauseResource(resourceName string) error
 
-	SaveResourceVersions(atc.ResourceConfig, []atc.Version) error
 	SaveResourceTypeVersion(atc.ResourceType, atc.Version) error
 	SetResourceCheckError(resource db.SavedResource, err error) error
 }
@@ -110,18 +110,6 @@ type FakeRadarDB struct {
 	unpauseResourceReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SaveResourceVersionsStub        func(atc.ResourceConfig, []atc.Version) error
-	saveResourceVersionsMutex       sync.RWMutex
-	saveResourceVersionsArgsForCall []struct {
-		arg1 atc.ResourceConfig
-		arg2 []atc.Version
-	}
-	saveResourceVersionsReturns struct {
-		result1 error
-	}
-	saveResourceVersionsReturnsOnCall map[int]struct {
-		result1 error
-	}
 	SaveResourceTypeVersionStub        func(atc.ResourceType, atc.Version) error
 	saveResourceTypeVersionMutex       sync.RWMutex
 	saveResourceTypeVersionArgsForCall []struct {
@@ -542,60 +530,6 @@ func (fake *FakeRadarDB) UnpauseResourceReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeRadarDB) SaveResourceVersions(arg1 atc.ResourceConfig, arg2 []atc.Version) error {
-	var arg2Copy []atc.Version
-	if arg2 != nil {
-		arg2Copy = make([]atc.Version, len(arg2))
-		copy(arg2Copy, arg2)
-	}
-	fake.saveResourceVersionsMutex.Lock()
-	ret, specificReturn := fake.saveResourceVersionsReturnsOnCall[len(fake.saveResourceVersionsArgsForCall)]
-	fake.saveResourceVersionsArgsForCall = append(fake.saveResourceVersionsArgsForCall, struct {
-		arg1 atc.ResourceConfig
-		arg2 []atc.Version
-	}{arg1, arg2Copy})
-	fake.recordInvocation("SaveResourceVersions", []interface{}{arg1, arg2Copy})
-	fake.saveResourceVersionsMutex.Unlock()
-	if fake.SaveResourceVersionsStub != nil {
-		return fake.SaveResourceVersionsStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1
-	}
-	return fake.saveResourceVersionsReturns.result1
-}
-
-func (fake *FakeRadarDB) SaveResourceVersionsCallCount() int {
-	fake.saveResourceVersionsMutex.RLock()
-	defer fake.saveResourceVersionsMutex.RUnlock()
-	return len(fake.saveResourceVersionsArgsForCall)
-}
-
-func (fake *FakeRadarDB) SaveResourceVersionsArgsForCall(i int) (atc.ResourceConfig, []atc.Version) {
-	fake.saveResourceVersionsMutex.RLock()
-	defer fake.saveResourceVersionsMutex.RUnlock()
-	return fake.saveResourceVersionsArgsForCall[i].arg1, fake.saveResourceVersionsArgsForCall[i].arg2
-}
-
-func (fake *FakeRadarDB) SaveResourceVersionsReturns(result1 error) {
-	fake.SaveResourceVersionsStub = nil
-	fake.saveResourceVersionsReturns = struct {
-		result1 error
-	}{result1}
-}
-
-func (fake *FakeRadarDB) SaveResourceVersionsReturnsOnCall(i int, result1 error) {
-	fake.SaveResourceVersionsStub = nil
-	if fake.saveResourceVersionsReturnsOnCall == nil {
-		fake.saveResourceVersionsReturnsOnCall = make(map[int]struct {
-			result1 error
-		})
-	}
-	fake.saveResourceVersionsReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
-}
-
 func (fake *FakeRadarDB) SaveResourceTypeVersion(arg1 atc.ResourceType, arg2 atc.Version) error {
 	fake.saveResourceTypeVersionMutex.Lock()
 	ret, specificReturn := fake.saveResourceTypeVersionReturnsOnCall[len(fake.saveResourceTypeVersionArgsForCall)]
@@ -713,8 +647,6 @@ func (fake *FakeRadarDB) Invocations() map[string][][]interface{} {
 	defer fake.pauseResourceMutex.RUnlock()
 	fake.unpauseResourceMutex.RLock()
 	defer fake.unpauseResourceMutex.RUnlock()
-	fake.saveResourceVersionsMutex.RLock()
-	defer fake.saveResourceVersionsMutex.RUnlock()
 	fake.saveResourceTypeVersionMutex.RLock()
 	defer fake.saveResourceTypeVersionMutex.RUnlock()
 	fake.setResourceCheckErrorMutex.RLock()
@@ -297,7 +297,7 @@ func (scanner *resourceScanner) scan(
 		"total":    len(newVersions),
 	})
 
-	err = scanner.db.SaveResourceVersions(atc.ResourceConfig{
+	err = scanner.dbPipeline.SaveResourceVersions(atc.ResourceConfig{
 		Name: savedResource.Name(),
 		Type: savedResource.Type(),
 	}, newVersions)
@@ -283,9 +283,9 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("saves them all, in order", func() {
-					Eventually(fakeRadarDB.SaveResourceVersionsCallCount).Should(Equal(1))
+					Eventually(fakeDBPipeline.SaveResourceVersionsCallCount).Should(Equal(1))
 
-					resourceConfig, versions := fakeRadarDB.SaveResourceVersionsArgsForCall(0)
+					resourceConfig, versions := fakeDBPipeline.SaveResourceVersionsArgsForCall(0)
 					Expect(resourceConfig).To(Equal(atc.ResourceConfig{
 						Name: "some-resource",
 						Type: "git",
@@ -300,7 +300,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				Context("when saving versions fails", func() {
 					BeforeEach(func() {
-						fakeRadarDB.SaveResourceVersionsReturns(errors.New("failed"))
+						fakeDBPipeline.SaveResourceVersionsReturns(errors.New("failed"))
 					})
 
 					It("does not return an error", func() {
@@ -607,7 +607,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("does not save it", func() {
-						Expect(fakeRadarDB.SaveResourceVersionsCallCount()).To(Equal(0))
+						Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(0))
 					})
 				})
 			})
@@ -645,9 +645,9 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("saves them all, in order", func() {
-					Expect(fakeRadarDB.SaveResourceVersionsCallCount()).To(Equal(1))
+					Expect(fakeDBPipeline.SaveResourceVersionsCallCount()).To(Equal(1))
 
-					resourceConfig, versions := fakeRadarDB.SaveResourceVersionsArgsForCall(0)
+					resourceConfig, versions := fakeDBPipeline.SaveResourceVersionsArgsForCall(0)
 					Expect(resourceConfig).To(Equal(atc.ResourceConfig{
 						Name: "some-resource",
 						Type: "git",
@@ -0,0 +1,76 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	DefaultRedactionMinLength   = 4
+	DefaultRedactionPlaceholder = "((redacted))"
+)
+
+// RedactionRules control how the secrets used by a team's builds are scrubbed
+// from their logs. The zero value redacts every secret with the defaults.
+type RedactionRules struct {
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Values shorter than this are left alone, as they would match too much
+	// ordinary output. Defaults to DefaultRedactionMinLength.
+	MinLength int `json:"min_length,omitempty"`
+
+	// Keys in sources and params whose values are never secret, e.g. uri or
+	// branch.
+	IgnoreKeys []string `json:"ignore_keys,omitempty"`
+
+	// Text each secret is replaced with. Defaults to
+	// DefaultRedactionPlaceholder.
+	Placeholder string `json:"placeholder,omitempty"`
+}
+
+func (rules RedactionRules) EffectiveMinLength() int {
+	if rules.MinLength == 0 {
+		return DefaultRedactionMinLength
+	}
+
+	return rules.MinLength
+}
+
+func (rules RedactionRules) EffectivePlaceholder() string {
+	if rules.Placeholder == "" {
+		return DefaultRedactionPlaceholder
+	}
+
+	return rules.Placeholder
+}
+
+func (rules RedactionRules) Ignores(key string) bool {
+	for _, ignored := range rules.IgnoreKeys {
+		if ignored == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ValidateRedactionRules(rules RedactionRules) error {
+	errorMessages := []string{}
+
+	if rules.MinLength < 0 {
+		errorMessages = append(errorMessages, "min_length must not be negative")
+	}
+
+	for i, key := range rules.IgnoreKeys {
+		if key == "" {
+			errorMessages = append(errorMessages, fmt.Sprintf("ignore_keys[%d] is empty", i))
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return errors.New(strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/worker"
 )
 
@@ -16,6 +17,8 @@ type FetchSourceProviderFactory interface {
 		session Session,
 		metadata Metadata,
 		tags atc.Tags,
+		placement *atc.WorkerPlacement,
+		avoidWorkers []string,
 		teamID int,
 		resourceTypes atc.VersionedResourceTypes,
 		resourceInstance ResourceInstance,
@@ -41,11 +44,16 @@ type FetchSource interface {
 
 type fetchSourceProviderFactory struct {
 	workerClient worker.Client
+	cacheStore   blobstore.CacheStore
 }
 
-func NewFetchSourceProviderFactory(workerClient worker.Client) FetchSourceProviderFactory {
+func NewFetchSourceProviderFactory(
+	workerClient worker.Client,
+	cacheStore blobstore.CacheStore,
+) FetchSourceProviderFactory {
 	return &fetchSourceProviderFactory{
 		workerClient: workerClient,
+		cacheStore:   cacheStore,
 	}
 }
 
@@ -54,6 +62,8 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 	session Session,
 	metadata Metadata,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
+	avoidWorkers []string,
 	teamID int,
 	resourceTypes atc.VersionedResourceTypes,
 	resourceInstance ResourceInstance,
@@ -65,12 +75,15 @@ func (f *fetchSourceProviderFactory) NewFetchSourceProvider(
 		session:               session,
 		metadata:              metadata,
 		tags:                  tags,
+		placement:             placement,
+		avoidWorkers:          avoidWorkers,
 		teamID:                teamID,
 		resourceTypes:         resourceTypes,
 		resourceInstance:      resourceInstance,
 		resourceOptions:       resourceOptions,
 		imageFetchingDelegate: imageFetchingDelegate,
 		workerClient:          f.workerClient,
+		cacheStore:            f.cacheStore,
 	}
 }
 
@@ -79,12 +92,15 @@ type fetchSourceProvider struct {
 	session               Session
 	metadata              Metadata
 	tags                  atc.Tags
+	placement             *atc.WorkerPlacement
+	avoidWorkers          []string
 	teamID                int
 	resourceTypes         atc.VersionedResourceTypes
 	resourceInstance      ResourceInstance
 	resourceOptions       ResourceOptions
 	workerClient          worker.Client
 	imageFetchingDelegate worker.ImageFetchingDelegate
+	cacheStore            blobstore.CacheStore
 }
 
 func (f *fetchSourceProvider) Get() (FetchSource, error) {
@@ -92,6 +108,8 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		ResourceType: string(f.resourceOptions.ResourceType()),
 		Tags:         f.tags,
 		TeamID:       f.teamID,
+		Placement:    f.placement,
+		AvoidWorkers: f.avoidWorkers,
 	}
 
 	chosenWorker, err := f.workerClient.Satisfying(resourceSpec, f.resourceTypes)
@@ -111,6 +129,7 @@ func (f *fetchSourceProvider) Get() (FetchSource, error) {
 		f.session,
 		f.metadata,
 		f.imageFetchingDelegate,
+		f.cacheStore,
 	), nil
 }
 
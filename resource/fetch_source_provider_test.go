@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
 	. "github.com/concourse/atc/resource"
 	"github.com/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/atc/worker"
@@ -20,6 +21,7 @@ var _ = Describe("FetchSourceProvider", func() {
 		fakeWorkerClient          *workerfakes.FakeClient
 		fetchSourceProvider       FetchSourceProvider
 		fakeImageFetchingDelegate *workerfakes.FakeImageFetchingDelegate
+		fakeCacheStore            *blobstorefakes.FakeCacheStore
 
 		logger           lager.Logger
 		resourceOptions  *resourcefakes.FakeResourceOptions
@@ -27,16 +29,25 @@ var _ = Describe("FetchSourceProvider", func() {
 		metadata         = EmptyMetadata{}
 		session          = Session{}
 		tags             atc.Tags
+		placement        *atc.WorkerPlacement
+		avoidWorkers     []string
 		resourceTypes    atc.VersionedResourceTypes
 		teamID           = 3
 	)
 
 	BeforeEach(func() {
 		fakeWorkerClient = new(workerfakes.FakeClient)
-		fetchSourceProviderFactory := NewFetchSourceProviderFactory(fakeWorkerClient)
+		fakeCacheStore = new(blobstorefakes.FakeCacheStore)
+		fetchSourceProviderFactory := NewFetchSourceProviderFactory(fakeWorkerClient, fakeCacheStore)
 		logger = lagertest.NewTestLogger("test")
 		resourceInstance = new(resourcefakes.FakeResourceInstance)
 		tags = atc.Tags{"some", "tags"}
+		placement = &atc.WorkerPlacement{
+			Preferences: []atc.WorkerSelector{
+				{Key: "disk", Operator: atc.WorkerSelectorExists},
+			},
+		}
+		avoidWorkers = []string{"some-failed-worker"}
 		resourceTypes = atc.VersionedResourceTypes{
 			{
 				ResourceType: atc.ResourceType{
@@ -56,6 +67,8 @@ var _ = Describe("FetchSourceProvider", func() {
 			session,
 			metadata,
 			tags,
+			placement,
+			avoidWorkers,
 			teamID,
 			resourceTypes,
 			resourceInstance,
@@ -74,6 +87,8 @@ var _ = Describe("FetchSourceProvider", func() {
 				ResourceType: "some-resource-type",
 				Tags:         tags,
 				TeamID:       teamID,
+				Placement:    placement,
+				AvoidWorkers: avoidWorkers,
 			}))
 			Expect(actualResourceTypes).To(Equal(resourceTypes))
 		})
@@ -101,6 +116,7 @@ var _ = Describe("FetchSourceProvider", func() {
 					session,
 					metadata,
 					fakeImageFetchingDelegate,
+					fakeCacheStore,
 				)
 				Expect(source).To(Equal(expectedSource))
 			})
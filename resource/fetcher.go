@@ -24,6 +24,8 @@ type Fetcher interface {
 		logger lager.Logger,
 		session Session,
 		tags atc.Tags,
+		placement *atc.WorkerPlacement,
+		avoidWorkers []string,
 		teamID int,
 		resourceTypes atc.VersionedResourceTypes,
 		resourceInstance ResourceInstance,
@@ -68,6 +70,8 @@ func (f *fetcher) Fetch(
 	logger lager.Logger,
 	session Session,
 	tags atc.Tags,
+	placement *atc.WorkerPlacement,
+	avoidWorkers []string,
 	teamID int,
 	resourceTypes atc.VersionedResourceTypes,
 	resourceInstance ResourceInstance,
@@ -82,6 +86,8 @@ func (f *fetcher) Fetch(
 		session,
 		metadata,
 		tags,
+		placement,
+		avoidWorkers,
 		teamID,
 		resourceTypes,
 		resourceInstance,
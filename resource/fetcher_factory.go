@@ -3,6 +3,7 @@ package resource
 import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/db/lock"
 	"github.com/concourse/atc/worker"
 )
@@ -22,22 +23,25 @@ type LockDB interface {
 func NewFetcherFactory(
 	db LockDB,
 	clock clock.Clock,
+	cacheStore blobstore.CacheStore,
 ) FetcherFactory {
 	return &fetcherFactory{
-		db:    db,
-		clock: clock,
+		db:         db,
+		clock:      clock,
+		cacheStore: cacheStore,
 	}
 }
 
 type fetcherFactory struct {
-	db    LockDB
-	clock clock.Clock
+	db         LockDB
+	clock      clock.Clock
+	cacheStore blobstore.CacheStore
 }
 
 func (f *fetcherFactory) FetcherFor(workerClient worker.Client) Fetcher {
 	return NewFetcher(
 		f.clock,
 		f.db,
-		NewFetchSourceProviderFactory(workerClient),
+		NewFetchSourceProviderFactory(workerClient, f.cacheStore),
 	)
 }
@@ -57,6 +57,8 @@ var _ = Describe("Fetcher", func() {
 			lagertest.NewTestLogger("test"),
 			Session{},
 			atc.Tags{},
+			nil,
+			nil,
 			teamID,
 			atc.VersionedResourceTypes{},
 			new(resourcefakes.FakeResourceInstance),
@@ -18,6 +18,8 @@ type ResourceInstance interface {
 	FindInitializedOn(lager.Logger, worker.Client) (worker.Volume, bool, error)
 	CreateOn(lager.Logger, worker.Client) (worker.Volume, error)
 
+	FindOrCreateResourceCache(lager.Logger) (*dbng.UsedResourceCache, error)
+
 	ResourceCacheIdentifier() worker.ResourceCacheIdentifier
 }
 
@@ -55,8 +57,8 @@ func (instance resourceInstance) ResourceUser() dbng.ResourceUser {
 	return instance.resourceUser
 }
 
-func (instance resourceInstance) CreateOn(logger lager.Logger, workerClient worker.Client) (worker.Volume, error) {
-	resourceCache, err := instance.dbResourceCacheFactory.FindOrCreateResourceCache(
+func (instance resourceInstance) FindOrCreateResourceCache(logger lager.Logger) (*dbng.UsedResourceCache, error) {
+	return instance.dbResourceCacheFactory.FindOrCreateResourceCache(
 		logger,
 		instance.resourceUser,
 		string(instance.resourceTypeName),
@@ -65,6 +67,10 @@ func (instance resourceInstance) CreateOn(logger lager.Logger, workerClient work
 		instance.params,
 		instance.resourceTypes,
 	)
+}
+
+func (instance resourceInstance) CreateOn(logger lager.Logger, workerClient worker.Client) (worker.Volume, error) {
+	resourceCache, err := instance.FindOrCreateResourceCache(logger)
 	if err != nil {
 		return nil, err
 	}
@@ -80,15 +86,7 @@ func (instance resourceInstance) CreateOn(logger lager.Logger, workerClient work
 }
 
 func (instance resourceInstance) FindInitializedOn(logger lager.Logger, workerClient worker.Client) (worker.Volume, bool, error) {
-	resourceCache, err := instance.dbResourceCacheFactory.FindOrCreateResourceCache(
-		logger,
-		instance.resourceUser,
-		string(instance.resourceTypeName),
-		instance.version,
-		instance.source,
-		instance.params,
-		instance.resourceTypes,
-	)
+	resourceCache, err := instance.FindOrCreateResourceCache(logger)
 	if err != nil {
 		logger.Error("failed-to-find-or-initialized-volume-resource-cache-for-build", err)
 		return nil, false, err
@@ -1,10 +1,12 @@
 package resource
 
 import (
+	"fmt"
 	"os"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
 	"github.com/concourse/atc/worker"
 )
 
@@ -20,6 +22,7 @@ type resourceInstanceFetchSource struct {
 	session               Session
 	metadata              Metadata
 	imageFetchingDelegate worker.ImageFetchingDelegate
+	cacheStore            blobstore.CacheStore
 }
 
 func NewResourceInstanceFetchSource(
@@ -33,6 +36,7 @@ func NewResourceInstanceFetchSource(
 	session Session,
 	metadata Metadata,
 	imageFetchingDelegate worker.ImageFetchingDelegate,
+	cacheStore blobstore.CacheStore,
 ) FetchSource {
 	return &resourceInstanceFetchSource{
 		logger:                logger,
@@ -45,6 +49,7 @@ func NewResourceInstanceFetchSource(
 		session:               session,
 		metadata:              metadata,
 		imageFetchingDelegate: imageFetchingDelegate,
+		cacheStore:            cacheStore,
 	}
 }
 
@@ -95,6 +100,31 @@ func (s *resourceInstanceFetchSource) Initialize(signals <-chan os.Signal, ready
 		return err
 	}
 
+	if s.cacheStore != nil {
+		imported, err := s.importFromCacheStore(sLog, volume)
+		if err != nil {
+			sLog.Error("failed-to-import-from-cache-store", err)
+			return err
+		}
+
+		if imported {
+			err = volume.Initialize()
+			if err != nil {
+				sLog.Error("failed-to-initialize-cache", err)
+				return err
+			}
+
+			ioConfig := s.resourceOptions.IOConfig()
+			if ioConfig.Stdout != nil {
+				fmt.Fprintf(ioConfig.Stdout, "using version of resource found in cluster cache\n")
+			}
+
+			s.versionedSource = NewGetVersionedSource(volume, s.resourceOptions.Version(), nil)
+			close(ready)
+			return nil
+		}
+	}
+
 	container, err := s.createContainerForVolume(volume)
 	if err != nil {
 		sLog.Error("failed-to-create-container", err)
@@ -126,6 +156,70 @@ func (s *resourceInstanceFetchSource) Initialize(signals <-chan os.Signal, ready
 		return err
 	}
 
+	if s.cacheStore != nil {
+		err = s.exportToCacheStore(sLog, volume)
+		if err != nil {
+			sLog.Error("failed-to-export-to-cache-store", err)
+		}
+	}
+
+	return nil
+}
+
+// importFromCacheStore streams the contents saved for the resource cache, if
+// any, into the volume. The volume is left uninitialized. If the store cannot
+// be read the resource is fetched as usual, but once contents have been
+// streamed into the volume it can no longer be fetched into.
+func (s *resourceInstanceFetchSource) importFromCacheStore(logger lager.Logger, volume worker.Volume) (bool, error) {
+	resourceCache, err := s.resourceInstance.FindOrCreateResourceCache(logger)
+	if err != nil {
+		return false, err
+	}
+
+	tarStream, found, err := s.cacheStore.Open(resourceCache.ID)
+	if err != nil {
+		logger.Error("failed-to-open-cache-store", err)
+		return false, nil
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	defer tarStream.Close()
+
+	err = volume.StreamIn(".", tarStream)
+	if err != nil {
+		return false, err
+	}
+
+	logger.Debug("imported-from-cache-store", lager.Data{"resource-cache": resourceCache.ID})
+
+	return true, nil
+}
+
+// exportToCacheStore saves the contents of the initialized volume for the
+// resource cache, so that other workers may import them.
+func (s *resourceInstanceFetchSource) exportToCacheStore(logger lager.Logger, volume worker.Volume) error {
+	resourceCache, err := s.resourceInstance.FindOrCreateResourceCache(logger)
+	if err != nil {
+		return err
+	}
+
+	tarStream, err := volume.StreamOut(".")
+	if err != nil {
+		return err
+	}
+
+	defer tarStream.Close()
+
+	err = s.cacheStore.Save(resourceCache.ID, tarStream)
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("exported-to-cache-store", lager.Data{"resource-cache": resourceCache.ID})
+
 	return nil
 }
 
@@ -2,12 +2,18 @@ package resource_test
 
 import (
 	"errors"
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"code.cloudfoundry.org/garden"
 	gfakes "code.cloudfoundry.org/garden/gardenfakes"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/atc"
+	"github.com/concourse/atc/blobstore"
+	"github.com/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/atc/dbng"
 	. "github.com/concourse/atc/resource"
 	"github.com/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/atc/worker/workerfakes"
@@ -25,13 +31,15 @@ var _ = Describe("VolumeFetchSource", func() {
 		fakeVolume           *workerfakes.FakeVolume
 		fakeResourceInstance *resourcefakes.FakeResourceInstance
 		fakeWorker           *workerfakes.FakeWorker
+		fakeCacheStore       *blobstorefakes.FakeCacheStore
 
+		logger  *lagertest.TestLogger
 		signals <-chan os.Signal
 		ready   chan<- struct{}
 	)
 
 	BeforeEach(func() {
-		logger := lagertest.NewTestLogger("test")
+		logger = lagertest.NewTestLogger("test")
 		fakeContainer = new(workerfakes.FakeContainer)
 		resourceOptions = new(resourcefakes.FakeResourceOptions)
 		signals = make(<-chan os.Signal)
@@ -57,6 +65,15 @@ var _ = Describe("VolumeFetchSource", func() {
 		fakeVolume = new(workerfakes.FakeVolume)
 		fakeResourceInstance = new(resourcefakes.FakeResourceInstance)
 		fakeResourceInstance.CreateOnReturns(fakeVolume, nil)
+		fakeCacheStore = nil
+	})
+
+	JustBeforeEach(func() {
+		var cacheStore blobstore.CacheStore
+		if fakeCacheStore != nil {
+			cacheStore = fakeCacheStore
+		}
+
 		fetchSource = NewResourceInstanceFetchSource(
 			logger,
 			fakeResourceInstance,
@@ -68,6 +85,7 @@ var _ = Describe("VolumeFetchSource", func() {
 			Session{},
 			EmptyMetadata{},
 			new(workerfakes.FakeImageFetchingDelegate),
+			cacheStore,
 		)
 	})
 
@@ -177,6 +195,115 @@ var _ = Describe("VolumeFetchSource", func() {
 					Expect(initErr).To(Equal(disaster))
 				})
 			})
+
+			Context("when a cluster cache store is configured", func() {
+				BeforeEach(func() {
+					fakeCacheStore = new(blobstorefakes.FakeCacheStore)
+					fakeResourceInstance.FindOrCreateResourceCacheReturns(&dbng.UsedResourceCache{ID: 42}, nil)
+				})
+
+				Context("when the store has the contents of the resource cache", func() {
+					var streamedIn string
+
+					BeforeEach(func() {
+						fakeCacheStore.OpenReturns(ioutil.NopCloser(strings.NewReader("some-tar-stream")), true, nil)
+
+						fakeVolume.StreamInStub = func(path string, tarStream io.Reader) error {
+							contents, err := ioutil.ReadAll(tarStream)
+							Expect(err).NotTo(HaveOccurred())
+							streamedIn = string(contents)
+							return nil
+						}
+					})
+
+					It("imports them into the volume instead of fetching", func() {
+						Expect(initErr).NotTo(HaveOccurred())
+
+						Expect(fakeCacheStore.OpenArgsForCall(0)).To(Equal(42))
+						Expect(fakeVolume.StreamInCallCount()).To(Equal(1))
+						path, _ := fakeVolume.StreamInArgsForCall(0)
+						Expect(path).To(Equal("."))
+						Expect(streamedIn).To(Equal("some-tar-stream"))
+
+						Expect(fakeWorker.CreateResourceGetContainerCallCount()).To(BeZero())
+						Expect(fakeContainer.RunCallCount()).To(BeZero())
+					})
+
+					It("initializes cache and sets versioned source", func() {
+						Expect(initErr).NotTo(HaveOccurred())
+						Expect(fakeVolume.InitializeCallCount()).To(Equal(1))
+						Expect(fetchSource.VersionedSource()).NotTo(BeNil())
+					})
+
+					It("does not export them again", func() {
+						Expect(fakeCacheStore.SaveCallCount()).To(BeZero())
+					})
+
+					Context("when streaming them in fails", func() {
+						var disaster error
+
+						BeforeEach(func() {
+							disaster = errors.New("nope")
+							fakeVolume.StreamInStub = nil
+							fakeVolume.StreamInReturns(disaster)
+						})
+
+						It("returns the error without initializing cache", func() {
+							Expect(initErr).To(Equal(disaster))
+							Expect(fakeVolume.InitializeCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the store cannot be read", func() {
+					BeforeEach(func() {
+						fakeCacheStore.OpenReturns(nil, false, errors.New("nope"))
+					})
+
+					It("fetches versioned source", func() {
+						Expect(initErr).NotTo(HaveOccurred())
+						Expect(fakeContainer.RunCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when the store does not have the contents of the resource cache", func() {
+					BeforeEach(func() {
+						fakeCacheStore.OpenReturns(nil, false, nil)
+						fakeVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-tar-stream")), nil)
+					})
+
+					It("fetches versioned source", func() {
+						Expect(initErr).NotTo(HaveOccurred())
+						Expect(fakeContainer.RunCallCount()).To(Equal(1))
+					})
+
+					It("exports the fetched contents to the store", func() {
+						Expect(initErr).NotTo(HaveOccurred())
+
+						Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+						Expect(fakeVolume.StreamOutArgsForCall(0)).To(Equal("."))
+
+						Expect(fakeCacheStore.SaveCallCount()).To(Equal(1))
+						resourceCacheID, tarStream := fakeCacheStore.SaveArgsForCall(0)
+						Expect(resourceCacheID).To(Equal(42))
+
+						contents, err := ioutil.ReadAll(tarStream)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(contents)).To(Equal("some-tar-stream"))
+					})
+
+					Context("when exporting fails", func() {
+						BeforeEach(func() {
+							fakeCacheStore.SaveReturns(errors.New("disk full"))
+						})
+
+						It("does not fail the fetch", func() {
+							Expect(initErr).NotTo(HaveOccurred())
+							Expect(fetchSource.VersionedSource()).NotTo(BeNil())
+						})
+					})
+				})
+			})
 		})
 	})
 })
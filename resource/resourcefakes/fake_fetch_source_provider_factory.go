@@ -11,13 +11,15 @@ import (
 )
 
 type FakeFetchSourceProviderFactory struct {
-	NewFetchSourceProviderStub        func(logger lager.Logger, session resource.Session, metadata resource.Metadata, tags atc.Tags, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, resourceOptions resource.ResourceOptions, imageFetchingDelegate worker.ImageFetchingDelegate) resource.FetchSourceProvider
+	NewFetchSourceProviderStub        func(logger lager.Logger, session resource.Session, metadata resource.Metadata, tags atc.Tags, placement *atc.WorkerPlacement, avoidWorkers []string, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, resourceOptions resource.ResourceOptions, imageFetchingDelegate worker.ImageFetchingDelegate) resource.FetchSourceProvider
 	newFetchSourceProviderMutex       sync.RWMutex
 	newFetchSourceProviderArgsForCall []struct {
 		logger                lager.Logger
 		session               resource.Session
 		metadata              resource.Metadata
 		tags                  atc.Tags
+		placement             *atc.WorkerPlacement
+		avoidWorkers          []string
 		teamID                int
 		resourceTypes         atc.VersionedResourceTypes
 		resourceInstance      resource.ResourceInstance
@@ -34,7 +36,12 @@ type FakeFetchSourceProviderFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(logger lager.Logger, session resource.Session, metadata resource.Metadata, tags atc.Tags, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, resourceOptions resource.ResourceOptions, imageFetchingDelegate worker.ImageFetchingDelegate) resource.FetchSourceProvider {
+func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(logger lager.Logger, session resource.Session, metadata resource.Metadata, tags atc.Tags, placement *atc.WorkerPlacement, avoidWorkers []string, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, resourceOptions resource.ResourceOptions, imageFetchingDelegate worker.ImageFetchingDelegate) resource.FetchSourceProvider {
+	var avoidWorkersCopy []string
+	if avoidWorkers != nil {
+		avoidWorkersCopy = make([]string, len(avoidWorkers))
+		copy(avoidWorkersCopy, avoidWorkers)
+	}
 	fake.newFetchSourceProviderMutex.Lock()
 	ret, specificReturn := fake.newFetchSourceProviderReturnsOnCall[len(fake.newFetchSourceProviderArgsForCall)]
 	fake.newFetchSourceProviderArgsForCall = append(fake.newFetchSourceProviderArgsForCall, struct {
@@ -42,16 +49,18 @@ func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProvider(logger lager.
 		session               resource.Session
 		metadata              resource.Metadata
 		tags                  atc.Tags
+		placement             *atc.WorkerPlacement
+		avoidWorkers          []string
 		teamID                int
 		resourceTypes         atc.VersionedResourceTypes
 		resourceInstance      resource.ResourceInstance
 		resourceOptions       resource.ResourceOptions
 		imageFetchingDelegate worker.ImageFetchingDelegate
-	}{logger, session, metadata, tags, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate})
-	fake.recordInvocation("NewFetchSourceProvider", []interface{}{logger, session, metadata, tags, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate})
+	}{logger, session, metadata, tags, placement, avoidWorkersCopy, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate})
+	fake.recordInvocation("NewFetchSourceProvider", []interface{}{logger, session, metadata, tags, placement, avoidWorkersCopy, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate})
 	fake.newFetchSourceProviderMutex.Unlock()
 	if fake.NewFetchSourceProviderStub != nil {
-		return fake.NewFetchSourceProviderStub(logger, session, metadata, tags, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate)
+		return fake.NewFetchSourceProviderStub(logger, session, metadata, tags, placement, avoidWorkers, teamID, resourceTypes, resourceInstance, resourceOptions, imageFetchingDelegate)
 	}
 	if specificReturn {
 		return ret.result1
@@ -65,10 +74,10 @@ func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderCallCount() in
 	return len(fake.newFetchSourceProviderArgsForCall)
 }
 
-func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderArgsForCall(i int) (lager.Logger, resource.Session, resource.Metadata, atc.Tags, int, atc.VersionedResourceTypes, resource.ResourceInstance, resource.ResourceOptions, worker.ImageFetchingDelegate) {
+func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderArgsForCall(i int) (lager.Logger, resource.Session, resource.Metadata, atc.Tags, *atc.WorkerPlacement, []string, int, atc.VersionedResourceTypes, resource.ResourceInstance, resource.ResourceOptions, worker.ImageFetchingDelegate) {
 	fake.newFetchSourceProviderMutex.RLock()
 	defer fake.newFetchSourceProviderMutex.RUnlock()
-	return fake.newFetchSourceProviderArgsForCall[i].logger, fake.newFetchSourceProviderArgsForCall[i].session, fake.newFetchSourceProviderArgsForCall[i].metadata, fake.newFetchSourceProviderArgsForCall[i].tags, fake.newFetchSourceProviderArgsForCall[i].teamID, fake.newFetchSourceProviderArgsForCall[i].resourceTypes, fake.newFetchSourceProviderArgsForCall[i].resourceInstance, fake.newFetchSourceProviderArgsForCall[i].resourceOptions, fake.newFetchSourceProviderArgsForCall[i].imageFetchingDelegate
+	return fake.newFetchSourceProviderArgsForCall[i].logger, fake.newFetchSourceProviderArgsForCall[i].session, fake.newFetchSourceProviderArgsForCall[i].metadata, fake.newFetchSourceProviderArgsForCall[i].tags, fake.newFetchSourceProviderArgsForCall[i].placement, fake.newFetchSourceProviderArgsForCall[i].avoidWorkers, fake.newFetchSourceProviderArgsForCall[i].teamID, fake.newFetchSourceProviderArgsForCall[i].resourceTypes, fake.newFetchSourceProviderArgsForCall[i].resourceInstance, fake.newFetchSourceProviderArgsForCall[i].resourceOptions, fake.newFetchSourceProviderArgsForCall[i].imageFetchingDelegate
 }
 
 func (fake *FakeFetchSourceProviderFactory) NewFetchSourceProviderReturns(result1 resource.FetchSourceProvider) {
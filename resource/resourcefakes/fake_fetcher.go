@@ -12,12 +12,14 @@ import (
 )
 
 type FakeFetcher struct {
-	FetchStub        func(logger lager.Logger, session resource.Session, tags atc.Tags, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, metadata resource.Metadata, imageFetchingDelegate worker.ImageFetchingDelegate, resourceOptions resource.ResourceOptions, signals <-chan os.Signal, ready chan<- struct{}) (resource.FetchSource, error)
+	FetchStub        func(logger lager.Logger, session resource.Session, tags atc.Tags, placement *atc.WorkerPlacement, avoidWorkers []string, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, metadata resource.Metadata, imageFetchingDelegate worker.ImageFetchingDelegate, resourceOptions resource.ResourceOptions, signals <-chan os.Signal, ready chan<- struct{}) (resource.FetchSource, error)
 	fetchMutex       sync.RWMutex
 	fetchArgsForCall []struct {
 		logger                lager.Logger
 		session               resource.Session
 		tags                  atc.Tags
+		placement             *atc.WorkerPlacement
+		avoidWorkers          []string
 		teamID                int
 		resourceTypes         atc.VersionedResourceTypes
 		resourceInstance      resource.ResourceInstance
@@ -39,13 +41,20 @@ type FakeFetcher struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeFetcher) Fetch(logger lager.Logger, session resource.Session, tags atc.Tags, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, metadata resource.Metadata, imageFetchingDelegate worker.ImageFetchingDelegate, resourceOptions resource.ResourceOptions, signals <-chan os.Signal, ready chan<- struct{}) (resource.FetchSource, error) {
+func (fake *FakeFetcher) Fetch(logger lager.Logger, session resource.Session, tags atc.Tags, placement *atc.WorkerPlacement, avoidWorkers []string, teamID int, resourceTypes atc.VersionedResourceTypes, resourceInstance resource.ResourceInstance, metadata resource.Metadata, imageFetchingDelegate worker.ImageFetchingDelegate, resourceOptions resource.ResourceOptions, signals <-chan os.Signal, ready chan<- struct{}) (resource.FetchSource, error) {
+	var avoidWorkersCopy []string
+	if avoidWorkers != nil {
+		avoidWorkersCopy = make([]string, len(avoidWorkers))
+		copy(avoidWorkersCopy, avoidWorkers)
+	}
 	fake.fetchMutex.Lock()
 	ret, specificReturn := fake.fetchReturnsOnCall[len(fake.fetchArgsForCall)]
 	fake.fetchArgsForCall = append(fake.fetchArgsForCall, struct {
 		logger                lager.Logger
 		session               resource.Session
 		tags                  atc.Tags
+		placement             *atc.WorkerPlacement
+		avoidWorkers          []string
 		teamID                int
 		resourceTypes         atc.VersionedResourceTypes
 		resourceInstance      resource.ResourceInstance
@@ -54,11 +63,11 @@ func (fake *FakeFetcher) Fetch(logger lager.Logger, session resource.Session, ta
 		resourceOptions       resource.ResourceOptions
 		signals               <-chan os.Signal
 		ready                 chan<- struct{}
-	}{logger, session, tags, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready})
-	fake.recordInvocation("Fetch", []interface{}{logger, session, tags, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready})
+	}{logger, session, tags, placement, avoidWorkersCopy, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready})
+	fake.recordInvocation("Fetch", []interface{}{logger, session, tags, placement, avoidWorkersCopy, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready})
 	fake.fetchMutex.Unlock()
 	if fake.FetchStub != nil {
-		return fake.FetchStub(logger, session, tags, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready)
+		return fake.FetchStub(logger, session, tags, placement, avoidWorkers, teamID, resourceTypes, resourceInstance, metadata, imageFetchingDelegate, resourceOptions, signals, ready)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -72,10 +81,10 @@ func (fake *FakeFetcher) FetchCallCount() int {
 	return len(fake.fetchArgsForCall)
 }
 
-func (fake *FakeFetcher) FetchArgsForCall(i int) (lager.Logger, resource.Session, atc.Tags, int, atc.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate, resource.ResourceOptions, <-chan os.Signal, chan<- struct{}) {
+func (fake *FakeFetcher) FetchArgsForCall(i int) (lager.Logger, resource.Session, atc.Tags, *atc.WorkerPlacement, []string, int, atc.VersionedResourceTypes, resource.ResourceInstance, resource.Metadata, worker.ImageFetchingDelegate, resource.ResourceOptions, <-chan os.Signal, chan<- struct{}) {
 	fake.fetchMutex.RLock()
 	defer fake.fetchMutex.RUnlock()
-	return fake.fetchArgsForCall[i].logger, fake.fetchArgsForCall[i].session, fake.fetchArgsForCall[i].tags, fake.fetchArgsForCall[i].teamID, fake.fetchArgsForCall[i].resourceTypes, fake.fetchArgsForCall[i].resourceInstance, fake.fetchArgsForCall[i].metadata, fake.fetchArgsForCall[i].imageFetchingDelegate, fake.fetchArgsForCall[i].resourceOptions, fake.fetchArgsForCall[i].signals, fake.fetchArgsForCall[i].ready
+	return fake.fetchArgsForCall[i].logger, fake.fetchArgsForCall[i].session, fake.fetchArgsForCall[i].tags, fake.fetchArgsForCall[i].placement, fake.fetchArgsForCall[i].avoidWorkers, fake.fetchArgsForCall[i].teamID, fake.fetchArgsForCall[i].resourceTypes, fake.fetchArgsForCall[i].resourceInstance, fake.fetchArgsForCall[i].metadata, fake.fetchArgsForCall[i].imageFetchingDelegate, fake.fetchArgsForCall[i].resourceOptions, fake.fetchArgsForCall[i].signals, fake.fetchArgsForCall[i].ready
 }
 
 func (fake *FakeFetcher) FetchReturns(result1 resource.FetchSource, result2 error) {
@@ -50,6 +50,19 @@ type FakeResourceInstance struct {
 		result1 worker.Volume
 		result2 error
 	}
+	FindOrCreateResourceCacheStub        func(lager.Logger) (*dbng.UsedResourceCache, error)
+	findOrCreateResourceCacheMutex       sync.RWMutex
+	findOrCreateResourceCacheArgsForCall []struct {
+		arg1 lager.Logger
+	}
+	findOrCreateResourceCacheReturns struct {
+		result1 *dbng.UsedResourceCache
+		result2 error
+	}
+	findOrCreateResourceCacheReturnsOnCall map[int]struct {
+		result1 *dbng.UsedResourceCache
+		result2 error
+	}
 	ResourceCacheIdentifierStub        func() worker.ResourceCacheIdentifier
 	resourceCacheIdentifierMutex       sync.RWMutex
 	resourceCacheIdentifierArgsForCall []struct{}
@@ -210,6 +223,57 @@ func (fake *FakeResourceInstance) CreateOnReturnsOnCall(i int, result1 worker.Vo
 	}{result1, result2}
 }
 
+func (fake *FakeResourceInstance) FindOrCreateResourceCache(arg1 lager.Logger) (*dbng.UsedResourceCache, error) {
+	fake.findOrCreateResourceCacheMutex.Lock()
+	ret, specificReturn := fake.findOrCreateResourceCacheReturnsOnCall[len(fake.findOrCreateResourceCacheArgsForCall)]
+	fake.findOrCreateResourceCacheArgsForCall = append(fake.findOrCreateResourceCacheArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("FindOrCreateResourceCache", []interface{}{arg1})
+	fake.findOrCreateResourceCacheMutex.Unlock()
+	if fake.FindOrCreateResourceCacheStub != nil {
+		return fake.FindOrCreateResourceCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.findOrCreateResourceCacheReturns.result1, fake.findOrCreateResourceCacheReturns.result2
+}
+
+func (fake *FakeResourceInstance) FindOrCreateResourceCacheCallCount() int {
+	fake.findOrCreateResourceCacheMutex.RLock()
+	defer fake.findOrCreateResourceCacheMutex.RUnlock()
+	return len(fake.findOrCreateResourceCacheArgsForCall)
+}
+
+func (fake *FakeResourceInstance) FindOrCreateResourceCacheArgsForCall(i int) lager.Logger {
+	fake.findOrCreateResourceCacheMutex.RLock()
+	defer fake.findOrCreateResourceCacheMutex.RUnlock()
+	return fake.findOrCreateResourceCacheArgsForCall[i].arg1
+}
+
+func (fake *FakeResourceInstance) FindOrCreateResourceCacheReturns(result1 *dbng.UsedResourceCache, result2 error) {
+	fake.FindOrCreateResourceCacheStub = nil
+	fake.findOrCreateResourceCacheReturns = struct {
+		result1 *dbng.UsedResourceCache
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceInstance) FindOrCreateResourceCacheReturnsOnCall(i int, result1 *dbng.UsedResourceCache, result2 error) {
+	fake.FindOrCreateResourceCacheStub = nil
+	if fake.findOrCreateResourceCacheReturnsOnCall == nil {
+		fake.findOrCreateResourceCacheReturnsOnCall = make(map[int]struct {
+			result1 *dbng.UsedResourceCache
+			result2 error
+		})
+	}
+	fake.findOrCreateResourceCacheReturnsOnCall[i] = struct {
+		result1 *dbng.UsedResourceCache
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceInstance) ResourceCacheIdentifier() worker.ResourceCacheIdentifier {
 	fake.resourceCacheIdentifierMutex.Lock()
 	ret, specificReturn := fake.resourceCacheIdentifierReturnsOnCall[len(fake.resourceCacheIdentifierArgsForCall)]
@@ -259,6 +323,8 @@ func (fake *FakeResourceInstance) Invocations() map[string][][]interface{} {
 	defer fake.findInitializedOnMutex.RUnlock()
 	fake.createOnMutex.RLock()
 	defer fake.createOnMutex.RUnlock()
+	fake.findOrCreateResourceCacheMutex.RLock()
+	defer fake.findOrCreateResourceCacheMutex.RUnlock()
 	fake.resourceCacheIdentifierMutex.RLock()
 	defer fake.resourceCacheIdentifierMutex.RUnlock()
 	return fake.invocations
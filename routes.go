@@ -6,6 +6,15 @@ const (
 	SaveConfig = "SaveConfig"
 	GetConfig  = "GetConfig"
 
+	ListPipelineConfigVersions = "ListPipelineConfigVersions"
+	GetPipelineConfigDiff      = "GetPipelineConfigDiff"
+	RollbackPipelineConfig     = "RollbackPipelineConfig"
+
+	ListPipelineTemplates        = "ListPipelineTemplates"
+	GetPipelineTemplate          = "GetPipelineTemplate"
+	SavePipelineTemplate         = "SavePipelineTemplate"
+	SavePipelineTemplateInstance = "SavePipelineTemplateInstance"
+
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
 	CreateBuild         = "CreateBuild"
@@ -13,8 +22,21 @@ const (
 	BuildEvents         = "BuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	ApproveBuildStep    = "ApproveBuildStep"
 	GetBuildPreparation = "GetBuildPreparation"
 
+	ListBuildArtifacts    = "ListBuildArtifacts"
+	DownloadBuildArtifact = "DownloadBuildArtifact"
+
+	ListBuildNotifications = "ListBuildNotifications"
+
+	ListBuildAnnotations = "ListBuildAnnotations"
+	ListJobAnnotations   = "ListJobAnnotations"
+
+	SearchBuildLogs = "SearchBuildLogs"
+
+	TeamEvents = "TeamEvents"
+
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
 	ListJobs       = "ListJobs"
@@ -58,6 +80,7 @@ const (
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
+	DrainWorker     = "DrainWorker"
 	PruneWorker     = "PruneWorker"
 	HeartbeatWorker = "HeartbeatWorker"
 	ListWorkers     = "ListWorkers"
@@ -82,11 +105,27 @@ const (
 	ListTeams   = "ListTeams"
 	SetTeam     = "SetTeam"
 	DestroyTeam = "DestroyTeam"
+
+	GetTeamNotifications = "GetTeamNotifications"
+	SetTeamNotifications = "SetTeamNotifications"
+
+	GetTeamRedactionRules = "GetTeamRedactionRules"
+	SetTeamRedactionRules = "SetTeamRedactionRules"
+
+	GetTeamScheduling = "GetTeamScheduling"
+	SetTeamScheduling = "SetTeamScheduling"
 )
 
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListPipelineConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/diff", Method: "GET", Name: GetPipelineConfigDiff},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version/rollback", Method: "PUT", Name: RollbackPipelineConfig},
+	{Path: "/api/v1/teams/:team_name/templates", Method: "GET", Name: ListPipelineTemplates},
+	{Path: "/api/v1/teams/:team_name/templates/:template_name", Method: "GET", Name: GetPipelineTemplate},
+	{Path: "/api/v1/teams/:team_name/templates/:template_name", Method: "PUT", Name: SavePipelineTemplate},
+	{Path: "/api/v1/teams/:team_name/templates/:template_name/instances/:pipeline_name", Method: "PUT", Name: SavePipelineTemplateInstance},
 
 	{Path: "/api/v1/builds", Method: "POST", Name: CreateBuild},
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
@@ -95,13 +134,21 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/steps/:plan_id/approval", Method: "PUT", Name: ApproveBuildStep},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
+	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/artifacts/:artifact_name", Method: "GET", Name: DownloadBuildArtifact},
+	{Path: "/api/v1/builds/:build_id/notifications", Method: "GET", Name: ListBuildNotifications},
+	{Path: "/api/v1/builds/:build_id/annotations", Method: "GET", Name: ListBuildAnnotations},
+	{Path: "/api/v1/teams/:team_name/builds/search", Method: "GET", Name: SearchBuildLogs},
+	{Path: "/api/v1/teams/:team_name/events", Method: "GET", Name: TeamEvents},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name", Method: "GET", Name: GetJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "GET", Name: ListJobBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/annotations/:annotation_name", Method: "GET", Name: ListJobAnnotations},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -141,6 +188,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers", Method: "POST", Name: RegisterWorker},
 	{Path: "/api/v1/workers/:worker_name/land", Method: "PUT", Name: LandWorker},
 	{Path: "/api/v1/workers/:worker_name/retire", Method: "PUT", Name: RetireWorker},
+	{Path: "/api/v1/workers/:worker_name/drain", Method: "PUT", Name: DrainWorker},
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
@@ -164,4 +212,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams", Method: "GET", Name: ListTeams},
 	{Path: "/api/v1/teams/:team_name", Method: "PUT", Name: SetTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "GET", Name: GetTeamNotifications},
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "PUT", Name: SetTeamNotifications},
+	{Path: "/api/v1/teams/:team_name/redaction-rules", Method: "GET", Name: GetTeamRedactionRules},
+	{Path: "/api/v1/teams/:team_name/redaction-rules", Method: "PUT", Name: SetTeamRedactionRules},
+	{Path: "/api/v1/teams/:team_name/scheduling", Method: "GET", Name: GetTeamScheduling},
+	{Path: "/api/v1/teams/:team_name/scheduling", Method: "PUT", Name: SetTeamScheduling},
 })
@@ -0,0 +1,106 @@
+// This file was generated by counterfeiter
+package buildqueuefakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/scheduler/buildqueue"
+)
+
+type FakeQueue struct {
+	AdmitStub        func(logger lager.Logger, build dbng.Build, jobConfig atc.JobConfig) (bool, error)
+	admitMutex       sync.RWMutex
+	admitArgsForCall []struct {
+		logger    lager.Logger
+		build     dbng.Build
+		jobConfig atc.JobConfig
+	}
+	admitReturns struct {
+		result1 bool
+		result2 error
+	}
+	admitReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeQueue) Admit(logger lager.Logger, build dbng.Build, jobConfig atc.JobConfig) (bool, error) {
+	fake.admitMutex.Lock()
+	ret, specificReturn := fake.admitReturnsOnCall[len(fake.admitArgsForCall)]
+	fake.admitArgsForCall = append(fake.admitArgsForCall, struct {
+		logger    lager.Logger
+		build     dbng.Build
+		jobConfig atc.JobConfig
+	}{logger, build, jobConfig})
+	fake.recordInvocation("Admit", []interface{}{logger, build, jobConfig})
+	fake.admitMutex.Unlock()
+	if fake.AdmitStub != nil {
+		return fake.AdmitStub(logger, build, jobConfig)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.admitReturns.result1, fake.admitReturns.result2
+}
+
+func (fake *FakeQueue) AdmitCallCount() int {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return len(fake.admitArgsForCall)
+}
+
+func (fake *FakeQueue) AdmitArgsForCall(i int) (lager.Logger, dbng.Build, atc.JobConfig) {
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return fake.admitArgsForCall[i].logger, fake.admitArgsForCall[i].build, fake.admitArgsForCall[i].jobConfig
+}
+
+func (fake *FakeQueue) AdmitReturns(result1 bool, result2 error) {
+	fake.AdmitStub = nil
+	fake.admitReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) AdmitReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.AdmitStub = nil
+	if fake.admitReturnsOnCall == nil {
+		fake.admitReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.admitReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeQueue) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitMutex.RLock()
+	defer fake.admitMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeQueue) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ buildqueue.Queue = new(FakeQueue)
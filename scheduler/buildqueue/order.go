@@ -0,0 +1,83 @@
+package buildqueue
+
+import (
+	"sort"
+
+	"github.com/concourse/atc/dbng"
+)
+
+// Order returns the entries in the order in which they should start, given
+// the number of builds each team already has running.
+//
+// Builds of a higher priority always come first. Teams with builds of equal
+// priority take turns in proportion to their weight, with the team using the
+// least of its share going next; ties go to the oldest build.
+func Order(entries []dbng.BuildQueueEntry, active map[int]int) []dbng.BuildQueueEntry {
+	usage := map[int]int{}
+	for teamID, count := range active {
+		usage[teamID] = count
+	}
+
+	teams := []int{}
+	byTeam := map[int][]dbng.BuildQueueEntry{}
+	for _, entry := range entries {
+		if _, found := byTeam[entry.TeamID]; !found {
+			teams = append(teams, entry.TeamID)
+		}
+
+		byTeam[entry.TeamID] = append(byTeam[entry.TeamID], entry)
+	}
+
+	for _, teamID := range teams {
+		sort.Stable(byPriority(byTeam[teamID]))
+	}
+
+	ordered := make([]dbng.BuildQueueEntry, 0, len(entries))
+	for len(ordered) < len(entries) {
+		next := -1
+		for _, teamID := range teams {
+			if len(byTeam[teamID]) == 0 {
+				continue
+			}
+
+			if next == -1 || goesBefore(byTeam[teamID][0], byTeam[next][0], usage) {
+				next = teamID
+			}
+		}
+
+		ordered = append(ordered, byTeam[next][0])
+		byTeam[next] = byTeam[next][1:]
+		usage[next]++
+	}
+
+	return ordered
+}
+
+func goesBefore(a dbng.BuildQueueEntry, b dbng.BuildQueueEntry, usage map[int]int) bool {
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+
+	// compare usage[a]/weight(a) against usage[b]/weight(b) without dividing
+	shareA := usage[a.TeamID] * weight(b)
+	shareB := usage[b.TeamID] * weight(a)
+	if shareA != shareB {
+		return shareA < shareB
+	}
+
+	return a.BuildID < b.BuildID
+}
+
+func weight(entry dbng.BuildQueueEntry) int {
+	if entry.Weight < 1 {
+		return 1
+	}
+
+	return entry.Weight
+}
+
+type byPriority []dbng.BuildQueueEntry
+
+func (entries byPriority) Len() int           { return len(entries) }
+func (entries byPriority) Swap(i, j int)      { entries[i], entries[j] = entries[j], entries[i] }
+func (entries byPriority) Less(i, j int) bool { return entries[i].Priority > entries[j].Priority }
@@ -0,0 +1,85 @@
+package buildqueue_test
+
+import (
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/scheduler/buildqueue"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Order", func() {
+	buildIDs := func(entries []dbng.BuildQueueEntry) []int {
+		ids := []int{}
+		for _, entry := range entries {
+			ids = append(ids, entry.BuildID)
+		}
+
+		return ids
+	}
+
+	It("orders the builds of a single team oldest first", func() {
+		ordered := buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+			{BuildID: 2, TeamID: 1, Weight: 1},
+			{BuildID: 3, TeamID: 1, Weight: 1},
+		}, map[int]int{})
+
+		Expect(buildIDs(ordered)).To(Equal([]int{1, 2, 3}))
+	})
+
+	It("puts builds of a higher priority first", func() {
+		ordered := buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+			{BuildID: 2, TeamID: 2, Weight: 1, Priority: 10},
+			{BuildID: 3, TeamID: 1, Weight: 1, Priority: 5},
+		}, map[int]int{})
+
+		Expect(buildIDs(ordered)).To(Equal([]int{2, 3, 1}))
+	})
+
+	It("takes turns between teams, rather than letting the first team to queue go first", func() {
+		ordered := buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+			{BuildID: 2, TeamID: 1, Weight: 1},
+			{BuildID: 3, TeamID: 1, Weight: 1},
+			{BuildID: 4, TeamID: 2, Weight: 1},
+			{BuildID: 5, TeamID: 2, Weight: 1},
+		}, map[int]int{})
+
+		Expect(buildIDs(ordered)).To(Equal([]int{1, 4, 2, 5, 3}))
+	})
+
+	It("accounts for the builds each team already has running", func() {
+		ordered := buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+			{BuildID: 2, TeamID: 1, Weight: 1},
+			{BuildID: 3, TeamID: 2, Weight: 1},
+		}, map[int]int{1: 2})
+
+		Expect(buildIDs(ordered)).To(Equal([]int{3, 1, 2}))
+	})
+
+	It("shares capacity between teams in proportion to their weight", func() {
+		ordered := buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 2},
+			{BuildID: 2, TeamID: 1, Weight: 2},
+			{BuildID: 3, TeamID: 1, Weight: 2},
+			{BuildID: 4, TeamID: 1, Weight: 2},
+			{BuildID: 5, TeamID: 2, Weight: 1},
+			{BuildID: 6, TeamID: 2, Weight: 1},
+		}, map[int]int{})
+
+		Expect(buildIDs(ordered)).To(Equal([]int{1, 5, 2, 3, 6, 4}))
+	})
+
+	It("does not modify the given usage", func() {
+		active := map[int]int{1: 1}
+
+		buildqueue.Order([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+		}, active)
+
+		Expect(active).To(Equal(map[int]int{1: 1}))
+	})
+})
@@ -0,0 +1,98 @@
+package buildqueue
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/metric"
+)
+
+//go:generate counterfeiter . Planner
+
+// Planner decides which queued builds may start, admitting as many as there
+// is capacity for across the cluster and numbering the rest.
+//
+// Builds that were admitted but have not started yet hold on to their share
+// of the capacity, so that admitting more builds before they start cannot
+// exceed the maximum.
+type Planner interface {
+	Run() error
+}
+
+func NewPlanner(
+	logger lager.Logger,
+	dbBuildQueue dbng.BuildQueue,
+	maxActiveBuilds int,
+) Planner {
+	return &planner{
+		logger:          logger,
+		dbBuildQueue:    dbBuildQueue,
+		maxActiveBuilds: maxActiveBuilds,
+	}
+}
+
+type planner struct {
+	logger          lager.Logger
+	dbBuildQueue    dbng.BuildQueue
+	maxActiveBuilds int
+}
+
+func (p *planner) Run() error {
+	logger := p.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	entries, err := p.dbBuildQueue.Entries()
+	if err != nil {
+		logger.Error("failed-to-get-queued-builds", err)
+		return err
+	}
+
+	active, err := p.dbBuildQueue.ActiveBuilds()
+	if err != nil {
+		logger.Error("failed-to-get-active-builds", err)
+		return err
+	}
+
+	admittedBuilds, err := p.dbBuildQueue.AdmittedBuilds()
+	if err != nil {
+		logger.Error("failed-to-get-admitted-builds", err)
+		return err
+	}
+
+	for teamID, count := range admittedBuilds {
+		active[teamID] += count
+	}
+
+	activeBuilds := 0
+	for _, count := range active {
+		activeBuilds += count
+	}
+
+	capacity := p.maxActiveBuilds - activeBuilds
+	if capacity < 0 {
+		capacity = 0
+	}
+
+	admitted := []int{}
+	positions := map[int]int{}
+	for i, entry := range Order(entries, active) {
+		if i < capacity {
+			admitted = append(admitted, entry.BuildID)
+		} else {
+			positions[entry.BuildID] = i - capacity + 1
+		}
+	}
+
+	err = p.dbBuildQueue.Update(admitted, positions)
+	if err != nil {
+		logger.Error("failed-to-update-queue", err)
+		return err
+	}
+
+	metric.BuildsQueued{
+		Count: len(positions),
+	}.Emit(logger)
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package buildqueue_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/scheduler/buildqueue"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Planner", func() {
+	var (
+		fakeBuildQueue  *dbngfakes.FakeBuildQueue
+		maxActiveBuilds int
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeBuildQueue = new(dbngfakes.FakeBuildQueue)
+		fakeBuildQueue.EntriesReturns([]dbng.BuildQueueEntry{
+			{BuildID: 1, TeamID: 1, Weight: 1},
+			{BuildID: 2, TeamID: 1, Weight: 1},
+			{BuildID: 3, TeamID: 2, Weight: 1},
+			{BuildID: 4, TeamID: 2, Weight: 1},
+		}, nil)
+		fakeBuildQueue.ActiveBuildsReturns(map[int]int{1: 2, 3: 1}, nil)
+
+		maxActiveBuilds = 5
+	})
+
+	JustBeforeEach(func() {
+		planner := buildqueue.NewPlanner(lagertest.NewTestLogger("test"), fakeBuildQueue, maxActiveBuilds)
+		runErr = planner.Run()
+	})
+
+	It("admits as many builds as there is capacity for, and numbers the rest", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(fakeBuildQueue.UpdateCallCount()).To(Equal(1))
+
+		admitted, positions := fakeBuildQueue.UpdateArgsForCall(0)
+		Expect(admitted).To(Equal([]int{3, 4}))
+		Expect(positions).To(Equal(map[int]int{1: 1, 2: 2}))
+	})
+
+	Context("when builds have been admitted but have not started yet", func() {
+		BeforeEach(func() {
+			fakeBuildQueue.AdmittedBuildsReturns(map[int]int{2: 1}, nil)
+		})
+
+		It("counts them against the capacity", func() {
+			admitted, positions := fakeBuildQueue.UpdateArgsForCall(0)
+			Expect(admitted).To(Equal([]int{3}))
+			Expect(positions).To(Equal(map[int]int{1: 1, 4: 2, 2: 3}))
+		})
+	})
+
+	Context("when more builds are active than are allowed", func() {
+		BeforeEach(func() {
+			maxActiveBuilds = 2
+		})
+
+		It("admits nothing", func() {
+			admitted, positions := fakeBuildQueue.UpdateArgsForCall(0)
+			Expect(admitted).To(BeEmpty())
+			Expect(positions).To(Equal(map[int]int{3: 1, 4: 2, 1: 3, 2: 4}))
+		})
+	})
+
+	Context("when getting the queued builds fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildQueue.EntriesReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+			Expect(fakeBuildQueue.UpdateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the active builds fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildQueue.ActiveBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+			Expect(fakeBuildQueue.UpdateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the admitted builds fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildQueue.AdmittedBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+			Expect(fakeBuildQueue.UpdateCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when updating the queue fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildQueue.UpdateReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
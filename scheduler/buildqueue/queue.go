@@ -0,0 +1,48 @@
+package buildqueue
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+)
+
+//go:generate counterfeiter . Queue
+
+// Queue holds pending builds back until the cluster has capacity to run them.
+//
+// Only builds of jobs are queued. One-off builds are started as soon as they
+// are created, as they have no scheduler to start them later; they still
+// count against the capacity once running.
+type Queue interface {
+	// Admit queues the build, which is otherwise ready to start, and returns
+	// whether it may start now.
+	Admit(logger lager.Logger, build dbng.Build, jobConfig atc.JobConfig) (bool, error)
+}
+
+// NewQueue constructs a Queue which admits builds as the Planner decides. If
+// maxActiveBuilds is 0, builds are not queued, and are always admitted.
+func NewQueue(dbBuildQueue dbng.BuildQueue, maxActiveBuilds int) Queue {
+	return &queue{
+		dbBuildQueue:    dbBuildQueue,
+		maxActiveBuilds: maxActiveBuilds,
+	}
+}
+
+type queue struct {
+	dbBuildQueue    dbng.BuildQueue
+	maxActiveBuilds int
+}
+
+func (q *queue) Admit(logger lager.Logger, build dbng.Build, jobConfig atc.JobConfig) (bool, error) {
+	if q.maxActiveBuilds == 0 {
+		return true, nil
+	}
+
+	admitted, err := q.dbBuildQueue.Enqueue(build.ID(), jobConfig.Priority)
+	if err != nil {
+		logger.Error("failed-to-enqueue-build", err)
+		return false, err
+	}
+
+	return admitted, nil
+}
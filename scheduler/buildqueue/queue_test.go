@@ -0,0 +1,98 @@
+package buildqueue_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/scheduler/buildqueue"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Queue", func() {
+	var (
+		fakeBuildQueue  *dbngfakes.FakeBuildQueue
+		fakeBuild       *dbngfakes.FakeBuild
+		maxActiveBuilds int
+
+		admitted bool
+		admitErr error
+	)
+
+	BeforeEach(func() {
+		fakeBuildQueue = new(dbngfakes.FakeBuildQueue)
+		fakeBuild = new(dbngfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+	})
+
+	JustBeforeEach(func() {
+		queue := buildqueue.NewQueue(fakeBuildQueue, maxActiveBuilds)
+		admitted, admitErr = queue.Admit(lagertest.NewTestLogger("test"), fakeBuild, atc.JobConfig{
+			Name:     "some-job",
+			Priority: 5,
+		})
+	})
+
+	Context("when the number of active builds is unbounded", func() {
+		BeforeEach(func() {
+			maxActiveBuilds = 0
+		})
+
+		It("admits the build without queueing it", func() {
+			Expect(admitErr).NotTo(HaveOccurred())
+			Expect(admitted).To(BeTrue())
+			Expect(fakeBuildQueue.EnqueueCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the number of active builds is bounded", func() {
+		BeforeEach(func() {
+			maxActiveBuilds = 10
+		})
+
+		It("queues the build with the priority of its job", func() {
+			Expect(fakeBuildQueue.EnqueueCallCount()).To(Equal(1))
+			buildID, priority := fakeBuildQueue.EnqueueArgsForCall(0)
+			Expect(buildID).To(Equal(42))
+			Expect(priority).To(Equal(5))
+		})
+
+		Context("when the build has been admitted", func() {
+			BeforeEach(func() {
+				fakeBuildQueue.EnqueueReturns(true, nil)
+			})
+
+			It("admits it", func() {
+				Expect(admitErr).NotTo(HaveOccurred())
+				Expect(admitted).To(BeTrue())
+			})
+		})
+
+		Context("when the build has not been admitted", func() {
+			BeforeEach(func() {
+				fakeBuildQueue.EnqueueReturns(false, nil)
+			})
+
+			It("holds it back", func() {
+				Expect(admitErr).NotTo(HaveOccurred())
+				Expect(admitted).To(BeFalse())
+			})
+		})
+
+		Context("when queueing the build fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeBuildQueue.EnqueueReturns(false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(admitErr).To(Equal(disaster))
+				Expect(admitted).To(BeFalse())
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"github.com/concourse/atc/config"
 	"github.com/concourse/atc/dbng"
 	"github.com/concourse/atc/engine"
+	"github.com/concourse/atc/scheduler/buildqueue"
 	"github.com/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/atc/scheduler/maxinflight"
 )
@@ -35,6 +36,7 @@ func NewBuildStarter(
 	scanner Scanner,
 	inputMapper inputmapper.InputMapper,
 	execEngine engine.Engine,
+	buildQueue buildqueue.Queue,
 ) BuildStarter {
 	return &buildStarter{
 		pipeline:           pipeline,
@@ -43,6 +45,7 @@ func NewBuildStarter(
 		scanner:            scanner,
 		inputMapper:        inputMapper,
 		execEngine:         execEngine,
+		buildQueue:         buildQueue,
 	}
 }
 
@@ -53,6 +56,7 @@ type buildStarter struct {
 	execEngine         engine.Engine
 	scanner            Scanner
 	inputMapper        inputmapper.InputMapper
+	buildQueue         buildqueue.Queue
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -153,6 +157,15 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	admitted, err := s.buildQueue.Admit(logger, nextPendingBuild, jobConfig)
+	if err != nil {
+		return false, err
+	}
+	if !admitted {
+		logger.Debug("waiting-for-capacity")
+		return false, nil
+	}
+
 	updated, err := nextPendingBuild.Schedule()
 	if err != nil {
 		logger.Error("failed-to-update-build-to-scheduled", err)
@@ -180,6 +193,12 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	}
 
 	createdBuild, err := s.execEngine.CreateBuild(logger, nextPendingBuild, plan)
+	if err == engine.ErrDraining {
+		// the build stays pending for another ATC to start
+		logger.Info("draining")
+		return false, nil
+	}
+
 	if err != nil {
 		logger.Error("failed-to-create-build", err)
 		return false, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/atc/engine"
 	"github.com/concourse/atc/engine/enginefakes"
 	"github.com/concourse/atc/scheduler"
+	"github.com/concourse/atc/scheduler/buildqueue/buildqueuefakes"
 	"github.com/concourse/atc/scheduler/inputmapper/inputmapperfakes"
 	"github.com/concourse/atc/scheduler/maxinflight/maxinflightfakes"
 	"github.com/concourse/atc/scheduler/schedulerfakes"
@@ -31,6 +32,7 @@ var _ = Describe("I'm a BuildStarter", func() {
 		fakeInputMapper  *inputmapperfakes.FakeInputMapper
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
 		fakeJob          *dbngfakes.FakeJob
+		fakeBuildQueue   *buildqueuefakes.FakeQueue
 
 		buildStarter scheduler.BuildStarter
 
@@ -46,8 +48,10 @@ var _ = Describe("I'm a BuildStarter", func() {
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
 		fakeJob = new(dbngfakes.FakeJob)
+		fakeBuildQueue = new(buildqueuefakes.FakeQueue)
+		fakeBuildQueue.AdmitReturns(true, nil)
 
-		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine)
+		buildStarter = scheduler.NewBuildStarter(fakePipeline, fakeUpdater, fakeFactory, fakeScanner, fakeInputMapper, fakeEngine, fakeBuildQueue)
 
 		disaster = errors.New("bad thing")
 	})
@@ -571,6 +575,35 @@ var _ = Describe("I'm a BuildStarter", func() {
 						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
 						itUpdatedMaxInFlightForTheFirstBuild()
 					})
+
+					Context("when the build is waiting for capacity", func() {
+						BeforeEach(func() {
+							fakeJob.PausedReturns(false)
+							fakePipeline.JobReturns(fakeJob, true, nil)
+							fakeBuildQueue.AdmitReturns(false, nil)
+						})
+
+						itDoesntReturnAnErrorOrMarkTheBuildAsScheduled()
+						itUpdatedMaxInFlightForTheFirstBuild()
+
+						It("queued the build with its job", func() {
+							Expect(fakeBuildQueue.AdmitCallCount()).To(Equal(1))
+							_, build, actualJobConfig := fakeBuildQueue.AdmitArgsForCall(0)
+							Expect(build.ID()).To(Equal(pendingBuilds[0].ID()))
+							Expect(actualJobConfig).To(Equal(jobConfig))
+						})
+					})
+
+					Context("when queueing the build fails", func() {
+						BeforeEach(func() {
+							fakeJob.PausedReturns(false)
+							fakePipeline.JobReturns(fakeJob, true, nil)
+							fakeBuildQueue.AdmitReturns(false, disaster)
+						})
+
+						itReturnsTheError()
+						itUpdatedMaxInFlightForTheFirstBuild()
+					})
 				})
 			})
 		})
@@ -102,15 +102,15 @@ func (factory *buildFactory) constructPlanFromConfig(
 	var plan atc.Plan
 	var err error
 
-	if planConfig.Attempts == 0 {
+	if planConfig.Attempts == nil || planConfig.Attempts.Count == 0 {
 		plan, err = factory.constructUnhookedPlan(planConfig, resources, resourceTypes, inputs)
 		if err != nil {
 			return atc.Plan{}, err
 		}
 	} else {
-		retryStep := make(atc.RetryPlan, planConfig.Attempts)
+		retryStep := make(atc.RetryPlan, planConfig.Attempts.Count)
 
-		for i := 0; i < planConfig.Attempts; i++ {
+		for i := 0; i < planConfig.Attempts.Count; i++ {
 			attempt, err := factory.constructUnhookedPlan(planConfig, resources, resourceTypes, inputs)
 			if err != nil {
 				return atc.Plan{}, err
@@ -120,15 +120,28 @@ func (factory *buildFactory) constructPlanFromConfig(
 		}
 
 		plan = factory.planFactory.NewPlan(retryStep)
+		plan.RetryPolicy = planConfig.Attempts.RetryPolicy()
 	}
 
-	return factory.applyHooks(constructionParams{
+	plan, err = factory.applyHooks(constructionParams{
 		plan:          plan,
 		hooks:         planConfig.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	if planConfig.If != "" {
+		plan = factory.planFactory.NewPlan(atc.ConditionalPlan{
+			Condition: planConfig.If,
+			Step:      plan,
+		})
+	}
+
+	return plan, nil
 }
 
 func (factory *buildFactory) constructUnhookedPlan(
@@ -173,6 +186,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Tags:     planConfig.Tags,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		}
 
@@ -184,6 +199,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Tags:     planConfig.Tags,
 			Source:   resource.Source,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		}
 
@@ -221,6 +238,8 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Version:  atc.Version(version),
 			Tags:     planConfig.Tags,
 
+			Placement: planConfig.Placement,
+
 			VersionedResourceTypes: resourceTypes,
 		})
 
@@ -231,13 +250,28 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Config:            planConfig.TaskConfig,
 			ConfigPath:        planConfig.TaskConfigPath,
 			Tags:              planConfig.Tags,
+			Placement:         planConfig.Placement,
 			Params:            planConfig.Params,
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
+			Artifacts:         planConfig.Artifacts,
 
 			VersionedResourceTypes: resourceTypes,
 		})
+	case planConfig.Approve != "":
+		plan = factory.planFactory.NewPlan(atc.ApprovePlan{
+			Name: planConfig.Approve,
+		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:      planConfig.LoadVar,
+			File:      planConfig.TaskConfigPath,
+			Format:    planConfig.Format,
+			Sensitive: planConfig.Sensitive,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
@@ -271,6 +305,29 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		plan = factory.planFactory.NewPlan(aggregate)
+
+	case planConfig.InParallel != nil:
+		inParallel := atc.InParallelPlan{
+			Steps:    []atc.Plan{},
+			Limit:    planConfig.InParallel.Limit,
+			FailFast: planConfig.InParallel.FailFast,
+		}
+
+		for _, planConfig := range planConfig.InParallel.Steps {
+			nextStep, err := factory.constructPlanFromConfig(
+				planConfig,
+				resources,
+				resourceTypes,
+				inputs,
+			)
+			if err != nil {
+				return atc.Plan{}, err
+			}
+
+			inParallel.Steps = append(inParallel.Steps, nextStep)
+		}
+
+		plan = factory.planFactory.NewPlan(inParallel)
 	}
 
 	if planConfig.Timeout != "" {
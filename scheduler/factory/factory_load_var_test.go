@@ -0,0 +1,63 @@
+package factory_test
+
+import (
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/scheduler/factory"
+	"github.com/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory LoadVar Step", func() {
+	var (
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+	})
+
+	Context("when there is a load_var step between two tasks", func() {
+		It("builds correctly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "build",
+					},
+					{
+						LoadVar:        "version",
+						TaskConfigPath: "build-output/version.json",
+						Format:         "json",
+						Sensitive:      true,
+					},
+					{
+						Task: "deploy",
+					},
+				},
+			}, nil, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.DoPlan{
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name: "build",
+				}),
+				expectedPlanFactory.NewPlan(atc.LoadVarPlan{
+					Name:      "version",
+					File:      "build-output/version.json",
+					Format:    "json",
+					Sensitive: true,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name: "deploy",
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -41,7 +41,7 @@ var _ = Describe("Factory Retry Step", func() {
 				Plan: atc.PlanSequence{
 					{
 						Task:     "second task",
-						Attempts: 3,
+						Attempts: &atc.AttemptsConfig{Count: 3},
 					},
 				},
 			}, nil, resourceTypes, nil)
@@ -72,7 +72,7 @@ var _ = Describe("Factory Retry Step", func() {
 				Plan: atc.PlanSequence{
 					{
 						Task:     "second task",
-						Attempts: 3,
+						Attempts: &atc.AttemptsConfig{Count: 3},
 						Success: &atc.PlanConfig{
 							Task: "second task",
 						},
@@ -105,4 +105,41 @@ var _ = Describe("Factory Retry Step", func() {
 			Expect(actual).To(testhelpers.MatchPlan(expected))
 		})
 	})
+
+	Context("when there is a task annotated with 'attempts' and a retry policy", func() {
+		It("builds the policy into the retry plan", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "second task",
+						Attempts: &atc.AttemptsConfig{
+							Count:       2,
+							Delay:       "10s",
+							Backoff:     atc.RetryBackoffExponential,
+							ErroredOnly: true,
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "second task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "second task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+			expected.RetryPolicy = &atc.RetryPolicy{
+				Delay:       "10s",
+				Backoff:     atc.RetryBackoffExponential,
+				ErroredOnly: true,
+			}
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
 })
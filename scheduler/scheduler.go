@@ -16,6 +16,7 @@ type Scheduler struct {
 	Pipeline     dbng.Pipeline
 	InputMapper  inputmapper.InputMapper
 	BuildStarter BuildStarter
+	Superseder   Superseder
 	Scanner      Scanner
 }
 
@@ -44,6 +45,22 @@ func (s *Scheduler) Schedule(
 		}
 	}
 
+	for _, jobConfig := range jobConfigs {
+		if !jobConfig.InterruptSuperseded {
+			continue
+		}
+
+		jStart := time.Now()
+		err := s.Superseder.AbortSupersededBuilds(logger, jobConfig)
+		jobSchedulingTime[jobConfig.Name] = jobSchedulingTime[jobConfig.Name] + time.Since(jStart)
+
+		if err != nil {
+			// the builds of other jobs can still be aborted, and the pending
+			// builds of every job must still be started
+			logger.Error("failed-to-abort-superseded-builds", err, lager.Data{"job": jobConfig.Name})
+		}
+	}
+
 	nextPendingBuilds, err := s.Pipeline.GetAllPendingBuilds()
 	if err != nil {
 		logger.Error("failed-to-get-all-next-pending-builds", err)
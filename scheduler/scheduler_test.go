@@ -20,6 +20,7 @@ var _ = Describe("Scheduler", func() {
 		fakePipeline     *dbngfakes.FakePipeline
 		fakeInputMapper  *inputmapperfakes.FakeInputMapper
 		fakeBuildStarter *schedulerfakes.FakeBuildStarter
+		fakeSuperseder   *schedulerfakes.FakeSuperseder
 		fakeScanner      *schedulerfakes.FakeScanner
 
 		scheduler *Scheduler
@@ -31,12 +32,14 @@ var _ = Describe("Scheduler", func() {
 		fakePipeline = new(dbngfakes.FakePipeline)
 		fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
 		fakeBuildStarter = new(schedulerfakes.FakeBuildStarter)
+		fakeSuperseder = new(schedulerfakes.FakeSuperseder)
 		fakeScanner = new(schedulerfakes.FakeScanner)
 
 		scheduler = &Scheduler{
 			Pipeline:     fakePipeline,
 			InputMapper:  fakeInputMapper,
 			BuildStarter: fakeBuildStarter,
+			Superseder:   fakeSuperseder,
 			Scanner:      fakeScanner,
 		}
 
@@ -153,6 +156,56 @@ var _ = Describe("Scheduler", func() {
 					It("didn't create a pending build", func() {
 						Expect(fakePipeline.EnsurePendingBuildExistsCallCount()).To(BeZero())
 					})
+
+					It("does not abort superseded builds", func() {
+						Expect(fakeSuperseder.AbortSupersededBuildsCallCount()).To(BeZero())
+					})
+				})
+			})
+		})
+
+		Context("when a job interrupts superseded builds", func() {
+			BeforeEach(func() {
+				jobConfigs = atc.JobConfigs{
+					{Name: "some-job-1", InterruptSuperseded: true},
+					{Name: "some-job-2"},
+				}
+
+				fakeInputMapper.SaveNextInputMappingReturns(algorithm.InputMapping{}, nil)
+			})
+
+			It("aborts the superseded builds of that job only", func() {
+				Expect(scheduleErr).NotTo(HaveOccurred())
+				Expect(fakeSuperseder.AbortSupersededBuildsCallCount()).To(Equal(1))
+
+				_, actualJobConfig := fakeSuperseder.AbortSupersededBuildsArgsForCall(0)
+				Expect(actualJobConfig).To(Equal(jobConfigs[0]))
+			})
+
+			It("starts pending builds afterwards", func() {
+				Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(2))
+			})
+
+			Context("when aborting superseded builds fails for one job", func() {
+				BeforeEach(func() {
+					jobConfigs = atc.JobConfigs{
+						{Name: "some-job-1", InterruptSuperseded: true},
+						{Name: "some-job-2", InterruptSuperseded: true},
+					}
+
+					fakeSuperseder.AbortSupersededBuildsReturnsOnCall(0, disaster)
+				})
+
+				It("continues with the other jobs", func() {
+					Expect(scheduleErr).NotTo(HaveOccurred())
+					Expect(fakeSuperseder.AbortSupersededBuildsCallCount()).To(Equal(2))
+
+					_, actualJobConfig := fakeSuperseder.AbortSupersededBuildsArgsForCall(1)
+					Expect(actualJobConfig).To(Equal(jobConfigs[1]))
+				})
+
+				It("still starts pending builds", func() {
+					Expect(fakeBuildStarter.TryStartPendingBuildsForJobCallCount()).To(Equal(2))
 				})
 			})
 		})
@@ -0,0 +1,98 @@
+// This file was generated by counterfeiter
+package schedulerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/scheduler"
+)
+
+type FakeSuperseder struct {
+	AbortSupersededBuildsStub        func(logger lager.Logger, jobConfig atc.JobConfig) error
+	abortSupersededBuildsMutex       sync.RWMutex
+	abortSupersededBuildsArgsForCall []struct {
+		logger    lager.Logger
+		jobConfig atc.JobConfig
+	}
+	abortSupersededBuildsReturns struct {
+		result1 error
+	}
+	abortSupersededBuildsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSuperseder) AbortSupersededBuilds(logger lager.Logger, jobConfig atc.JobConfig) error {
+	fake.abortSupersededBuildsMutex.Lock()
+	ret, specificReturn := fake.abortSupersededBuildsReturnsOnCall[len(fake.abortSupersededBuildsArgsForCall)]
+	fake.abortSupersededBuildsArgsForCall = append(fake.abortSupersededBuildsArgsForCall, struct {
+		logger    lager.Logger
+		jobConfig atc.JobConfig
+	}{logger, jobConfig})
+	fake.recordInvocation("AbortSupersededBuilds", []interface{}{logger, jobConfig})
+	fake.abortSupersededBuildsMutex.Unlock()
+	if fake.AbortSupersededBuildsStub != nil {
+		return fake.AbortSupersededBuildsStub(logger, jobConfig)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.abortSupersededBuildsReturns.result1
+}
+
+func (fake *FakeSuperseder) AbortSupersededBuildsCallCount() int {
+	fake.abortSupersededBuildsMutex.RLock()
+	defer fake.abortSupersededBuildsMutex.RUnlock()
+	return len(fake.abortSupersededBuildsArgsForCall)
+}
+
+func (fake *FakeSuperseder) AbortSupersededBuildsArgsForCall(i int) (lager.Logger, atc.JobConfig) {
+	fake.abortSupersededBuildsMutex.RLock()
+	defer fake.abortSupersededBuildsMutex.RUnlock()
+	return fake.abortSupersededBuildsArgsForCall[i].logger, fake.abortSupersededBuildsArgsForCall[i].jobConfig
+}
+
+func (fake *FakeSuperseder) AbortSupersededBuildsReturns(result1 error) {
+	fake.AbortSupersededBuildsStub = nil
+	fake.abortSupersededBuildsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSuperseder) AbortSupersededBuildsReturnsOnCall(i int, result1 error) {
+	fake.AbortSupersededBuildsStub = nil
+	if fake.abortSupersededBuildsReturnsOnCall == nil {
+		fake.abortSupersededBuildsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.abortSupersededBuildsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSuperseder) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.abortSupersededBuildsMutex.RLock()
+	defer fake.abortSupersededBuildsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSuperseder) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.Superseder = new(FakeSuperseder)
@@ -0,0 +1,196 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/config"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/engine"
+)
+
+//go:generate counterfeiter . Superseder
+
+// Superseder aborts the running builds of a job once a newer version of one of
+// their triggering inputs has arrived, so that a build of the newer version
+// can take their place.
+type Superseder interface {
+	AbortSupersededBuilds(logger lager.Logger, jobConfig atc.JobConfig) error
+}
+
+func NewSuperseder(
+	pipeline dbng.Pipeline,
+	execEngine engine.Engine,
+	clock clock.Clock,
+) Superseder {
+	return &superseder{
+		pipeline:   pipeline,
+		execEngine: execEngine,
+		clock:      clock,
+	}
+}
+
+type superseder struct {
+	pipeline   dbng.Pipeline
+	execEngine engine.Engine
+	clock      clock.Clock
+}
+
+func (s *superseder) AbortSupersededBuilds(logger lager.Logger, jobConfig atc.JobConfig) error {
+	logger = logger.Session("abort-superseded-builds", lager.Data{
+		"job": jobConfig.Name,
+	})
+
+	var gracePeriod time.Duration
+	if jobConfig.InterruptGracePeriod != "" {
+		var err error
+		gracePeriod, err = time.ParseDuration(jobConfig.InterruptGracePeriod)
+		if err != nil {
+			logger.Error("failed-to-parse-grace-period", err)
+			return err
+		}
+	}
+
+	nextBuildInputs, found, err := s.pipeline.GetNextBuildInputs(jobConfig.Name)
+	if err != nil {
+		logger.Error("failed-to-get-next-build-inputs", err)
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	builds, err := s.pipeline.InFlightBuilds()
+	if err != nil {
+		logger.Error("failed-to-get-in-flight-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		if build.JobName() != jobConfig.Name || build.Status() != dbng.BuildStatusStarted {
+			continue
+		}
+
+		bLog := logger.Session("build", lager.Data{
+			"build-id":   build.ID(),
+			"build-name": build.Name(),
+		})
+
+		input, superseded, err := s.supersededInput(jobConfig, build, nextBuildInputs)
+		if err != nil {
+			bLog.Error("failed-to-get-build-inputs", err)
+			continue
+		}
+
+		if !superseded {
+			continue
+		}
+
+		s.tryAbort(bLog, build, input, gracePeriod)
+	}
+
+	return nil
+}
+
+// supersededInput returns the first triggering input of the build whose
+// version differs from the one the job's next build will use.
+func (s *superseder) supersededInput(
+	jobConfig atc.JobConfig,
+	build dbng.Build,
+	nextBuildInputs []dbng.BuildInput,
+) (string, bool, error) {
+	buildInputs, _, err := build.Resources()
+	if err != nil {
+		return "", false, err
+	}
+
+	usedVersions := map[string]dbng.ResourceVersion{}
+	for _, input := range buildInputs {
+		usedVersions[input.Name] = input.Version
+	}
+
+	nextVersions := map[string]dbng.ResourceVersion{}
+	for _, input := range nextBuildInputs {
+		nextVersions[input.Name] = input.Version
+	}
+
+	for _, inputConfig := range config.JobInputs(jobConfig) {
+		if !inputConfig.Trigger {
+			continue
+		}
+
+		used, ok := usedVersions[inputConfig.Name]
+		if !ok {
+			continue
+		}
+
+		next, ok := nextVersions[inputConfig.Name]
+		if !ok {
+			continue
+		}
+
+		if !sameVersion(used, next) {
+			return inputConfig.Name, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+func (s *superseder) tryAbort(logger lager.Logger, build dbng.Build, input string, gracePeriod time.Duration) {
+	supersededAt, err := build.Supersede()
+	if err != nil {
+		logger.Error("failed-to-mark-build-as-superseded", err)
+		return
+	}
+
+	if s.clock.Since(supersededAt) < gracePeriod {
+		logger.Debug("within-grace-period")
+		return
+	}
+
+	claimed, err := build.ClaimAbort(fmt.Sprintf("superseded by a newer version of input '%s'", input))
+	if err != nil {
+		logger.Error("failed-to-claim-abort", err)
+		return
+	}
+
+	if !claimed {
+		// interrupting a put may leave the resource half-updated; try again
+		// once it has finished
+		logger.Debug("waiting-for-put-to-finish")
+		return
+	}
+
+	engineBuild, err := s.execEngine.LookupBuild(logger, build)
+	if err != nil {
+		logger.Error("failed-to-lookup-build", err)
+		return
+	}
+
+	err = engineBuild.Abort(logger)
+	if err != nil {
+		logger.Error("failed-to-abort-build", err)
+		return
+	}
+
+	logger.Info("aborted-superseded-build", lager.Data{"input": input})
+}
+
+func sameVersion(a, b dbng.ResourceVersion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if b[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
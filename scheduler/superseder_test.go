@@ -0,0 +1,199 @@
+package scheduler_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/engine/enginefakes"
+	"github.com/concourse/atc/scheduler"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Superseder", func() {
+	var (
+		fakePipeline    *dbngfakes.FakePipeline
+		fakeEngine      *enginefakes.FakeEngine
+		fakeEngineBuild *enginefakes.FakeBuild
+		fakeClock       *fakeclock.FakeClock
+
+		runningBuild *dbngfakes.FakeBuild
+		supersededAt time.Time
+
+		jobConfig atc.JobConfig
+
+		superseder scheduler.Superseder
+		abortErr   error
+
+		disaster error
+	)
+
+	BeforeEach(func() {
+		fakePipeline = new(dbngfakes.FakePipeline)
+		fakeEngine = new(enginefakes.FakeEngine)
+		fakeEngineBuild = new(enginefakes.FakeBuild)
+		fakeEngine.LookupBuildReturns(fakeEngineBuild, nil)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+
+		disaster = errors.New("bad thing")
+
+		jobConfig = atc.JobConfig{
+			Name:                "some-job",
+			InterruptSuperseded: true,
+			Plan: atc.PlanSequence{
+				{Get: "a", Trigger: true},
+				{Get: "b"},
+			},
+		}
+
+		runningBuild = new(dbngfakes.FakeBuild)
+		runningBuild.IDReturns(1)
+		runningBuild.JobNameReturns("some-job")
+		runningBuild.StatusReturns(dbng.BuildStatusStarted)
+		runningBuild.ClaimAbortReturns(true, nil)
+		runningBuild.ResourcesReturns([]dbng.BuildInput{
+			{Name: "a", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v1"}}},
+			{Name: "b", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v1"}}},
+		}, nil, nil)
+
+		supersededAt = fakeClock.Now()
+		runningBuild.SupersedeStub = func() (time.Time, error) {
+			return supersededAt, nil
+		}
+
+		otherJobBuild := new(dbngfakes.FakeBuild)
+		otherJobBuild.JobNameReturns("some-other-job")
+		otherJobBuild.StatusReturns(dbng.BuildStatusStarted)
+
+		pendingBuild := new(dbngfakes.FakeBuild)
+		pendingBuild.JobNameReturns("some-job")
+		pendingBuild.StatusReturns(dbng.BuildStatusPending)
+
+		fakePipeline.InFlightBuildsReturns([]dbng.Build{runningBuild, otherJobBuild, pendingBuild}, nil)
+
+		superseder = scheduler.NewSuperseder(fakePipeline, fakeEngine, fakeClock)
+	})
+
+	JustBeforeEach(func() {
+		abortErr = superseder.AbortSupersededBuilds(lagertest.NewTestLogger("test"), jobConfig)
+	})
+
+	Context("when a newer version of a triggering input is next", func() {
+		BeforeEach(func() {
+			fakePipeline.GetNextBuildInputsReturns([]dbng.BuildInput{
+				{Name: "a", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v2"}}},
+				{Name: "b", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v1"}}},
+			}, true, nil)
+		})
+
+		It("aborts the running build of the job with the reason", func() {
+			Expect(abortErr).NotTo(HaveOccurred())
+
+			Expect(runningBuild.ClaimAbortCallCount()).To(Equal(1))
+			Expect(runningBuild.ClaimAbortArgsForCall(0)).To(Equal("superseded by a newer version of input 'a'"))
+
+			Expect(fakeEngine.LookupBuildCallCount()).To(Equal(1))
+			_, build := fakeEngine.LookupBuildArgsForCall(0)
+			Expect(build).To(Equal(runningBuild))
+			Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+		})
+
+		Context("when the job has a grace period", func() {
+			BeforeEach(func() {
+				jobConfig.InterruptGracePeriod = "1m"
+			})
+
+			It("does not abort the build within the grace period", func() {
+				Expect(runningBuild.SupersedeCallCount()).To(Equal(1))
+				Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+				Expect(runningBuild.ClaimAbortCallCount()).To(BeZero())
+			})
+
+			Context("when the grace period has elapsed", func() {
+				BeforeEach(func() {
+					supersededAt = fakeClock.Now().Add(-time.Minute)
+				})
+
+				It("aborts the build", func() {
+					Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the build is running a put", func() {
+			BeforeEach(func() {
+				runningBuild.ClaimAbortReturns(false, nil)
+			})
+
+			It("does not abort the build", func() {
+				Expect(abortErr).NotTo(HaveOccurred())
+				Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when claiming the abort fails", func() {
+			BeforeEach(func() {
+				runningBuild.ClaimAbortReturns(false, disaster)
+			})
+
+			It("does not abort the build", func() {
+				Expect(abortErr).NotTo(HaveOccurred())
+				Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when only a newer version of a non-triggering input is next", func() {
+		BeforeEach(func() {
+			fakePipeline.GetNextBuildInputsReturns([]dbng.BuildInput{
+				{Name: "a", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v1"}}},
+				{Name: "b", VersionedResource: dbng.VersionedResource{Version: dbng.ResourceVersion{"ref": "v2"}}},
+			}, true, nil)
+		})
+
+		It("does not abort the build", func() {
+			Expect(abortErr).NotTo(HaveOccurred())
+			Expect(runningBuild.SupersedeCallCount()).To(BeZero())
+			Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the next build inputs have not been determined", func() {
+		BeforeEach(func() {
+			fakePipeline.GetNextBuildInputsReturns(nil, false, nil)
+		})
+
+		It("does not abort anything", func() {
+			Expect(abortErr).NotTo(HaveOccurred())
+			Expect(fakePipeline.InFlightBuildsCallCount()).To(BeZero())
+			Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the next build inputs fails", func() {
+		BeforeEach(func() {
+			fakePipeline.GetNextBuildInputsReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(abortErr).To(Equal(disaster))
+		})
+	})
+
+	Context("when getting the in-flight builds fails", func() {
+		BeforeEach(func() {
+			fakePipeline.GetNextBuildInputsReturns([]dbng.BuildInput{}, true, nil)
+			fakePipeline.InFlightBuildsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(abortErr).To(Equal(disaster))
+		})
+	})
+})
@@ -0,0 +1,22 @@
+package atc
+
+import "errors"
+
+const DefaultSchedulingWeight = 1
+
+// TeamScheduling controls how a team's builds are ordered while they wait for
+// capacity. A build's priority is its team's priority plus its job's; builds
+// of a higher priority start first, and teams with builds of equal priority
+// share capacity in proportion to their weight.
+type TeamScheduling struct {
+	Weight   int `json:"weight"`
+	Priority int `json:"priority"`
+}
+
+func ValidateTeamScheduling(scheduling TeamScheduling) error {
+	if scheduling.Weight < 1 {
+		return errors.New("weight must be at least 1")
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"github.com/concourse/atc/condition"
 )
 
 func formatErr(groupName string, err error) string {
@@ -216,6 +218,13 @@ func validateJobs(c Config) ([]Warning, error) {
 			)
 		}
 
+		if job.InterruptGracePeriod != "" {
+			_, err := time.ParseDuration(job.InterruptGracePeriod)
+			if err != nil {
+				errorMessages = append(errorMessages, identifier+fmt.Sprintf(".interrupt_grace_period refers to a duration that could not be parsed ('%s')", job.InterruptGracePeriod))
+			}
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -291,10 +300,22 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		foundTypes.Find("aggregate")
 	}
 
+	if plan.InParallel != nil {
+		foundTypes.Find("in_parallel")
+	}
+
 	if plan.Try != nil {
 		foundTypes.Find("try")
 	}
 
+	if plan.Approve != "" {
+		foundTypes.Find("approve")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
 	if valid, message := foundTypes.IsValid(); !valid {
 		return []Warning{}, []string{message}
 	}
@@ -319,11 +340,23 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
+	case plan.InParallel != nil:
+		if plan.InParallel.Limit < 0 {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.in_parallel has an invalid limit (%d)", identifier, plan.InParallel.Limit))
+		}
+
+		for i, plan := range plan.InParallel.Steps {
+			subIdentifier := fmt.Sprintf("%s.in_parallel[%d]", identifier, i)
+			planWarnings, planErrMessages := validatePlan(c, subIdentifier, plan)
+			warnings = append(warnings, planWarnings...)
+			errorMessages = append(errorMessages, planErrMessages...)
+		}
+
 	case plan.Get != "":
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "artifacts"},
 			plan, identifier)...,
 		)
 
@@ -398,7 +431,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "artifacts"},
 			plan, identifier)...,
 		)
 
@@ -450,6 +483,32 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			plan, identifier)...,
 		)
 
+	case plan.Approve != "":
+		identifier = fmt.Sprintf("%s.approve.%s", identifier, plan.Approve)
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "file", "artifacts"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify a file")
+		}
+
+		switch plan.Format {
+		case "", LoadVarFormatRaw, LoadVarFormatTrim, LoadVarFormatJSON, LoadVarFormatYAML:
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown format ('%s')", identifier, plan.Format))
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "artifacts"},
+			plan, identifier)...,
+		)
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -486,14 +545,97 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		}
 	}
 
-	if plan.Attempts < 0 {
+	if plan.If != "" {
+		_, err := condition.Parse(plan.If)
+		if err != nil {
+			subIdentifier := fmt.Sprintf("%s.if", identifier)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid condition: %s", err))
+		}
+	}
+
+	if plan.Attempts != nil {
 		subIdentifier := fmt.Sprintf("%s.attempts", identifier)
-		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
+		errorMessages = append(errorMessages, validateAttempts(subIdentifier, *plan.Attempts)...)
+	}
+
+	if plan.Placement != nil {
+		for i, selector := range plan.Placement.Selectors {
+			subIdentifier := fmt.Sprintf("%s.placement.selectors[%d]", identifier, i)
+			errorMessages = append(errorMessages, validateWorkerSelector(subIdentifier, selector)...)
+		}
+
+		for i, selector := range plan.Placement.Preferences {
+			subIdentifier := fmt.Sprintf("%s.placement.preferences[%d]", identifier, i)
+			errorMessages = append(errorMessages, validateWorkerSelector(subIdentifier, selector)...)
+		}
 	}
 
 	return warnings, errorMessages
 }
 
+func validateAttempts(identifier string, attempts AttemptsConfig) []string {
+	errorMessages := []string{}
+
+	if attempts.Count < 0 {
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an invalid number of attempts (%d)", attempts.Count))
+	}
+
+	if attempts.RetryPolicy() == nil {
+		return errorMessages
+	}
+
+	if attempts.Count == 0 {
+		errorMessages = append(errorMessages, identifier+" has a retry policy but no count")
+	}
+
+	if attempts.Delay != "" {
+		_, err := time.ParseDuration(attempts.Delay)
+		if err != nil {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has a delay that could not be parsed ('%s')", attempts.Delay))
+		}
+	}
+
+	if attempts.MaxDelay != "" {
+		_, err := time.ParseDuration(attempts.MaxDelay)
+		if err != nil {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has a max_delay that could not be parsed ('%s')", attempts.MaxDelay))
+		}
+	}
+
+	switch attempts.Backoff {
+	case "", RetryBackoffConstant, RetryBackoffExponential:
+	default:
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown backoff ('%s')", attempts.Backoff))
+	}
+
+	return errorMessages
+}
+
+func validateWorkerSelector(identifier string, selector WorkerSelector) []string {
+	errorMessages := []string{}
+
+	if selector.Key == "" {
+		errorMessages = append(errorMessages, identifier+" has no key")
+	}
+
+	switch selector.Operator {
+	case WorkerSelectorIn, WorkerSelectorNotIn:
+		if len(selector.Values) == 0 {
+			errorMessages = append(errorMessages, identifier+fmt.Sprintf(" must specify values for operator '%s'", selector.Operator))
+		}
+
+	case WorkerSelectorExists:
+		if len(selector.Values) != 0 {
+			errorMessages = append(errorMessages, identifier+" must not specify values for operator 'exists'")
+		}
+
+	default:
+		errorMessages = append(errorMessages, identifier+fmt.Sprintf(" has an unknown operator ('%s')", selector.Operator))
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	errorMessages := []string{}
 	foundInapplicableFields := []string{}
@@ -524,6 +666,10 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.TaskConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "artifacts":
+			if len(plan.Artifacts) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
@@ -425,6 +425,20 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has an invalid interrupt_grace_period", func() {
+			BeforeEach(func() {
+				job.InterruptSuperseded = true
+				job.InterruptGracePeriod = "nope"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.interrupt_grace_period refers to a duration that could not be parsed ('nope')"))
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -564,6 +578,40 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an approve plan has resource and task fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approve:    "ship-it",
+						Resource:   "some-resource",
+						Privileged: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].approve.ship-it has invalid fields specified (resource, privileged)"))
+				})
+			})
+
+			Context("when an approve plan is combined with another action", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Approve: "ship-it",
+						Task:    "some-task",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0] has multiple actions specified (approve, task)"))
+				})
+			})
+
 			Context("when a get plan has task-only fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -582,6 +630,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a put plan has artifacts specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:       "some-resource",
+						Artifacts: []string{"some-output"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource has invalid fields specified (artifacts)"))
+				})
+			})
+
 			Context("when a task plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -980,7 +1045,7 @@ var _ = Describe("ValidateConfig", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
 						Put:      "some-resource",
-						Attempts: -1,
+						Attempts: &AttemptsConfig{Count: -1},
 					})
 
 					config.Jobs = append(config.Jobs, job)
@@ -992,6 +1057,130 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an in_parallel step has a negative limit", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						InParallel: &InParallelConfig{
+							Steps: PlanSequence{
+								{Put: "some-resource"},
+								{Get: "some-nonexistent-resource"},
+							},
+							Limit: -1,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].in_parallel has an invalid limit (-1)"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].in_parallel[1].get.some-nonexistent-resource refers to a resource that does not exist"))
+				})
+			})
+
+			Context("when a load_var step is valid", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:        "version",
+						TaskConfigPath: "some-artifact/version.json",
+						Format:         "json",
+						Sensitive:      true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a load_var step has no file, an unknown format and task fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						LoadVar:    "version",
+						Format:     "toml",
+						Privileged: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.version does not specify a file"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.version has an unknown format ('toml')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].load_var.version has invalid fields specified (privileged)"))
+				})
+			})
+			Context("when a plan has an invalid condition", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						If:  `build.trigger == "manual`,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.if has an invalid condition: unterminated string"))
+				})
+			})
+
+			Context("when a retry plan has an invalid retry policy", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Attempts: &AttemptsConfig{
+							Delay:    "soon",
+							Backoff:  "linear",
+							MaxDelay: "later",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.attempts has a retry policy but no count"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.attempts has a delay that could not be parsed ('soon')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.attempts has a max_delay that could not be parsed ('later')"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.attempts has an unknown backoff ('linear')"))
+				})
+			})
+
+			Context("when a plan has invalid placement selectors", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Placement: &WorkerPlacement{
+							Selectors: []WorkerSelector{
+								{Operator: WorkerSelectorIn, Values: []string{"a"}},
+								{Key: "zone", Operator: WorkerSelectorNotIn},
+							},
+							Preferences: []WorkerSelector{
+								{Key: "gpu", Operator: WorkerSelectorExists, Values: []string{"yes"}},
+								{Key: "disk", Operator: "bogus", Values: []string{"ssd"}},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.placement.selectors[0] has no key"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.placement.selectors[1] must specify values for operator 'notin'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.placement.preferences[0] must not specify values for operator 'exists'"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.placement.preferences[1] has an unknown operator ('bogus')"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -22,6 +22,22 @@ type Worker struct {
 	Name      string   `json:"name"`
 	StartTime int64    `json:"start_time"`
 	State     string   `json:"state"`
+
+	Labels map[string]string `json:"labels,omitempty"`
+	Drain  *WorkerDrain      `json:"drain,omitempty"`
+
+	// StreamEncodings are the compression encodings in which the worker's
+	// Baggageclaim can stream volumes, besides gzip, which every worker
+	// supports.
+	StreamEncodings []string `json:"stream_encodings,omitempty"`
+}
+
+// WorkerDrain is the progress of a worker being drained. Once the deadline
+// passes, the builds still running on the worker are aborted.
+type WorkerDrain struct {
+	Deadline            int64 `json:"deadline"`
+	RemainingContainers int   `json:"remaining_containers"`
+	RemainingBuilds     int   `json:"remaining_builds"`
 }
 
 type WorkerResourceType struct {
@@ -1,6 +1,10 @@
 package worker
 
-import "io"
+import (
+	"io"
+	"sync/atomic"
+	"time"
+)
 
 //go:generate counterfeiter . ArtifactDestination
 
@@ -12,3 +16,93 @@ type ArtifactDestination interface {
 	// expand into the destination directory.
 	StreamIn(string, io.Reader) error
 }
+
+// StreamStats describes a transfer of an artifact into a destination.
+type StreamStats struct {
+	Duration time.Duration
+	Bytes    int64
+
+	// P2P is true if the artifact was pulled directly from another worker,
+	// rather than streamed through the ATC.
+	P2P bool
+}
+
+// CountingDestination wraps an ArtifactDestination, keeping track of how many
+// bytes have been streamed into it.
+type CountingDestination struct {
+	Destination ArtifactDestination
+
+	bytes int64
+	p2p   int32
+}
+
+// StreamIn streams to the wrapped destination, counting the bytes read from
+// the tar stream.
+func (dest *CountingDestination) StreamIn(path string, tarStream io.Reader) error {
+	return dest.Destination.StreamIn(path, &countingReader{
+		reader: tarStream,
+		count:  &dest.bytes,
+	})
+}
+
+// StreamInFrom has the wrapped destination pull from the source volume
+// directly, counting the bytes it reports. If the wrapped destination cannot
+// pull volumes, ErrP2PStreamingUnsupported is returned.
+func (dest *CountingDestination) StreamInFrom(path string, source Volume, sourcePath string) (int64, error) {
+	p2p, ok := dest.Destination.(P2PArtifactDestination)
+	if !ok {
+		return 0, ErrP2PStreamingUnsupported
+	}
+
+	n, err := p2p.StreamInFrom(path, source, sourcePath)
+	if err != nil {
+		return 0, err
+	}
+
+	atomic.AddInt64(&dest.bytes, n)
+	atomic.StoreInt32(&dest.p2p, 1)
+
+	return n, nil
+}
+
+// StreamInEncodedFrom has the volume relayed compressed into the wrapped
+// destination, counting the compressed bytes. If the wrapped destination
+// cannot have volumes relayed into it, ErrEncodedStreamingUnsupported is
+// returned.
+func (dest *CountingDestination) StreamInEncodedFrom(path string, source Volume, sourcePath string) (int64, error) {
+	encoded, ok := dest.Destination.(EncodedArtifactDestination)
+	if !ok {
+		return 0, ErrEncodedStreamingUnsupported
+	}
+
+	n, err := encoded.StreamInEncodedFrom(path, source, sourcePath)
+	if err != nil {
+		return 0, err
+	}
+
+	atomic.AddInt64(&dest.bytes, n)
+
+	return n, nil
+}
+
+// Bytes returns the number of bytes streamed in so far.
+func (dest *CountingDestination) Bytes() int64 {
+	return atomic.LoadInt64(&dest.bytes)
+}
+
+// P2P returns whether any of the bytes were pulled directly from another
+// worker.
+func (dest *CountingDestination) P2P() bool {
+	return atomic.LoadInt32(&dest.p2p) == 1
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  *int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	atomic.AddInt64(r.count, int64(n))
+	return n, err
+}
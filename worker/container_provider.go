@@ -40,6 +40,9 @@ type containerProviderFactory struct {
 	certificatesPath            string
 	certificatesSymmlinkedPaths []string
 
+	p2pStreamer    P2PStreamer
+	volumeStreamer VolumeStreamer
+
 	clock clock.Clock
 }
 
@@ -58,6 +61,8 @@ func NewContainerProviderFactory(
 	noProxy string,
 	certificatesPath string,
 	certificatesSymmlinkedPaths []string,
+	p2pStreamer P2PStreamer,
+	volumeStreamer VolumeStreamer,
 	clock clock.Clock,
 ) ContainerProviderFactory {
 	return &containerProviderFactory{
@@ -75,6 +80,8 @@ func NewContainerProviderFactory(
 		noProxy:                     noProxy,
 		certificatesPath:            certificatesPath,
 		certificatesSymmlinkedPaths: certificatesSymmlinkedPaths,
+		p2pStreamer:                 p2pStreamer,
+		volumeStreamer:              volumeStreamer,
 		clock: clock,
 	}
 }
@@ -95,6 +102,8 @@ func (f *containerProviderFactory) ContainerProviderFor(worker Worker) Container
 		noProxy:                     f.noProxy,
 		certificatesPath:            f.certificatesPath,
 		certificatesSymmlinkedPaths: f.certificatesSymmlinkedPaths,
+		p2pStreamer:                 f.p2pStreamer,
+		volumeStreamer:              f.volumeStreamer,
 		clock:  f.clock,
 		worker: worker,
 	}
@@ -168,6 +177,9 @@ type containerProvider struct {
 	certificatesPath            string
 	certificatesSymmlinkedPaths []string
 
+	p2pStreamer    P2PStreamer
+	volumeStreamer VolumeStreamer
+
 	clock clock.Clock
 }
 
@@ -472,6 +484,7 @@ func (p *containerProvider) findOrCreateContainer(
 
 			gardenContainer, err = p.createGardenContainer(
 				logger,
+				delegate,
 				creatingContainer,
 				metadata,
 				spec,
@@ -527,6 +540,7 @@ func (p *containerProvider) constructGardenWorkerContainer(
 
 func (p *containerProvider) createGardenContainer(
 	logger lager.Logger,
+	delegate ImageFetchingDelegate,
 	creatingContainer dbng.CreatingContainer,
 	metadata dbng.ContainerMetadata,
 	spec ContainerSpec,
@@ -536,6 +550,8 @@ func (p *containerProvider) createGardenContainer(
 	volumeMounts := []VolumeMount{}
 
 	if spec.Dir != "" && !p.anyMountTo(spec.Dir, spec.Inputs) {
+		start := p.clock.Now()
+
 		workdirVolume, volumeErr := p.volumeClient.FindOrCreateVolumeForContainer(
 			logger,
 			VolumeSpec{
@@ -550,6 +566,8 @@ func (p *containerProvider) createGardenContainer(
 			return nil, volumeErr
 		}
 
+		delegate.VolumeCreated("workdir", p.clock.Since(start))
+
 		volumeMounts = append(volumeMounts, VolumeMount{
 			Volume:    workdirVolume,
 			MountPath: spec.Dir,
@@ -564,6 +582,8 @@ func (p *containerProvider) createGardenContainer(
 			return nil, err
 		}
 
+		start := p.clock.Now()
+
 		if found {
 			inputVolume, err = p.volumeClient.FindOrCreateCOWVolumeForContainer(
 				logger,
@@ -579,6 +599,8 @@ func (p *containerProvider) createGardenContainer(
 			if err != nil {
 				return nil, err
 			}
+
+			delegate.VolumeCreated(string(inputSource.Name()), p.clock.Since(start))
 		} else {
 			inputVolume, err = p.volumeClient.FindOrCreateVolumeForContainer(
 				logger,
@@ -594,7 +616,15 @@ func (p *containerProvider) createGardenContainer(
 				return nil, err
 			}
 
-			err = inputSource.Source().StreamTo(inputVolume)
+			// streaming the input in is reported separately
+			delegate.VolumeCreated(string(inputSource.Name()), p.clock.Since(start))
+
+			var destination ArtifactDestination = inputVolume
+			if p.p2pStreamer != nil || p.volumeStreamer != nil {
+				destination = VolumeDestination(inputVolume, logger, p.p2pStreamer, p.volumeStreamer)
+			}
+
+			err = inputSource.Source().StreamTo(destination)
 			if err != nil {
 				return nil, err
 			}
@@ -606,7 +636,9 @@ func (p *containerProvider) createGardenContainer(
 		})
 	}
 
-	for _, outputPath := range spec.Outputs {
+	for outputName, outputPath := range spec.Outputs {
+		start := p.clock.Now()
+
 		outVolume, volumeErr := p.volumeClient.FindOrCreateVolumeForContainer(
 			logger,
 			VolumeSpec{
@@ -621,6 +653,8 @@ func (p *containerProvider) createGardenContainer(
 			return nil, volumeErr
 		}
 
+		delegate.VolumeCreated(outputName, p.clock.Since(start))
+
 		volumeMounts = append(volumeMounts, VolumeMount{
 			Volume:    outVolume,
 			MountPath: outputPath,
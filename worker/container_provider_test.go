@@ -47,6 +47,10 @@ var _ = Describe("ContainerProvider", func() {
 		fakeLockDB                  *workerfakes.FakeLockDB
 		fakeWorker                  *workerfakes.FakeWorker
 		fakeClock                   *fakeclock.FakeClock
+		fakeP2PStreamer             *workerfakes.FakeP2PStreamer
+		p2pStreamer                 P2PStreamer
+		fakeVolumeStreamer          *workerfakes.FakeVolumeStreamer
+		volumeStreamer              VolumeStreamer
 
 		containerProvider        ContainerProvider
 		containerProviderFactory ContainerProviderFactory
@@ -100,6 +104,10 @@ var _ = Describe("ContainerProvider", func() {
 		fakeDBTeamFactory.GetByIDReturns(fakeDBTeam)
 		fakeDBVolumeFactory = new(dbngfakes.FakeVolumeFactory)
 		fakeClock = fakeclock.NewFakeClock(time.Unix(0, 123))
+		fakeP2PStreamer = new(workerfakes.FakeP2PStreamer)
+		p2pStreamer = nil
+		fakeVolumeStreamer = new(workerfakes.FakeVolumeStreamer)
+		volumeStreamer = nil
 		fakeDBResourceCacheFactory = new(dbngfakes.FakeResourceCacheFactory)
 		fakeDBResourceConfigFactory = new(dbngfakes.FakeResourceConfigFactory)
 		fakeGardenContainer = new(gardenfakes.FakeContainer)
@@ -235,6 +243,8 @@ var _ = Describe("ContainerProvider", func() {
 			"http://noproxy.com",
 			certificatesPath,
 			symlinkedCertificatesPaths,
+			p2pStreamer,
+			volumeStreamer,
 			fakeClock,
 		)
 
@@ -389,6 +399,16 @@ var _ = Describe("ContainerProvider", func() {
 			Expect(actualContainer).To(Equal(fakeCreatingContainer))
 		})
 
+		It("reports creating each of the container's volumes to the delegate", func() {
+			var names []string
+			for i := 0; i < fakeImageFetchingDelegate.VolumeCreatedCallCount(); i++ {
+				name, _ := fakeImageFetchingDelegate.VolumeCreatedArgsForCall(i)
+				names = append(names, name)
+			}
+
+			Expect(names).To(ConsistOf("workdir", "local-input", "remote-input", "some-output"))
+		})
+
 		It("creates container in database", func() {
 			Expect(createDatabaseCallCountFunc()).To(Equal(1))
 		})
@@ -461,6 +481,59 @@ var _ = Describe("ContainerProvider", func() {
 			Expect(ioutil.ReadAll(from)).To(Equal([]byte("some-stream")))
 		})
 
+		It("does not offer to pull remote inputs directly", func() {
+			_, ok := fakeRemoteInputAS.StreamToArgsForCall(0).(P2PArtifactDestination)
+			Expect(ok).To(BeFalse())
+		})
+
+		Context("when p2p streaming is configured", func() {
+			BeforeEach(func() {
+				p2pStreamer = fakeP2PStreamer
+				fakeP2PStreamer.StreamP2PReturns(1024, nil)
+			})
+
+			It("lets remote inputs be pulled directly into the container volume", func() {
+				Expect(fakeRemoteInputAS.StreamToCallCount()).To(Equal(1))
+				ad, ok := fakeRemoteInputAS.StreamToArgsForCall(0).(P2PArtifactDestination)
+				Expect(ok).To(BeTrue())
+
+				sourceVolume := new(workerfakes.FakeVolume)
+
+				bytes, err := ad.StreamInFrom(".", sourceVolume, "some/path")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bytes).To(Equal(int64(1024)))
+
+				Expect(fakeP2PStreamer.StreamP2PCallCount()).To(Equal(1))
+				_, source, sourcePath, destination, destinationPath := fakeP2PStreamer.StreamP2PArgsForCall(0)
+				Expect(source).To(Equal(sourceVolume))
+				Expect(sourcePath).To(Equal("some/path"))
+				Expect(destination).To(Equal(fakeRemoteInputContainerVolume))
+				Expect(destinationPath).To(Equal("."))
+			})
+		})
+
+		Context("when a volume streamer is configured", func() {
+			BeforeEach(func() {
+				volumeStreamer = fakeVolumeStreamer
+				fakeVolumeStreamer.StreamVolumeReturns(512, nil)
+			})
+
+			It("lets remote inputs be relayed compressed into the container volume", func() {
+				ad, ok := fakeRemoteInputAS.StreamToArgsForCall(0).(EncodedArtifactDestination)
+				Expect(ok).To(BeTrue())
+
+				sourceVolume := new(workerfakes.FakeVolume)
+
+				bytes, err := ad.StreamInEncodedFrom(".", sourceVolume, ".")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bytes).To(Equal(int64(512)))
+
+				_, source, _, destination, _ := fakeVolumeStreamer.StreamVolumeArgsForCall(0)
+				Expect(source).To(Equal(sourceVolume))
+				Expect(destination).To(Equal(fakeRemoteInputContainerVolume))
+			})
+		})
+
 		It("marks container as created", func() {
 			Expect(fakeCreatingContainer.CreatedCallCount()).To(Equal(1))
 		})
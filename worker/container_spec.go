@@ -12,6 +12,13 @@ type WorkerSpec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// Optional label selectors and preferences narrowing down the workers.
+	Placement *atc.WorkerPlacement
+
+	// Workers to pass over if any others satisfy the spec, e.g. ones on which
+	// an earlier attempt of the step failed.
+	AvoidWorkers []string
 }
 
 type ContainerSpec struct {
@@ -21,6 +28,12 @@ type ContainerSpec struct {
 	ImageSpec ImageSpec
 	Env       []string
 
+	// Optional label selectors and preferences narrowing down the workers.
+	Placement *atc.WorkerPlacement
+
+	// Workers to pass over if any others satisfy the spec.
+	AvoidWorkers []string
+
 	// Working directory for processes run in the container.
 	Dir string
 
@@ -57,7 +70,28 @@ func (spec ContainerSpec) WorkerSpec() WorkerSpec {
 		Platform:     spec.Platform,
 		Tags:         spec.Tags,
 		TeamID:       spec.TeamID,
+		Placement:    spec.Placement,
+		AvoidWorkers: spec.AvoidWorkers,
+	}
+}
+
+// Selectors returns the label selectors every chosen worker must match.
+func (spec WorkerSpec) Selectors() []atc.WorkerSelector {
+	if spec.Placement == nil {
+		return nil
 	}
+
+	return spec.Placement.Selectors
+}
+
+// Preferences returns the label selectors which chosen workers should match
+// where possible.
+func (spec WorkerSpec) Preferences() []atc.WorkerSelector {
+	if spec.Placement == nil {
+		return nil
+	}
+
+	return spec.Placement.Preferences
 }
 
 func (spec WorkerSpec) Description() string {
@@ -75,5 +109,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	for _, selector := range spec.Selectors() {
+		attrs = append(attrs, fmt.Sprintf("label '%s'", selector))
+	}
+
 	return strings.Join(attrs, ", ")
 }
@@ -36,6 +36,8 @@ type dbWorkerProvider struct {
 	dbVolumeFactory                 dbng.VolumeFactory
 	dbTeamFactory                   dbng.TeamFactory
 	dbWorkerFactory                 dbng.WorkerFactory
+	p2pStreamer                     P2PStreamer
+	volumeStreamer                  VolumeStreamer
 }
 
 func NewDBWorkerProvider(
@@ -49,6 +51,8 @@ func NewDBWorkerProvider(
 	dbVolumeFactory dbng.VolumeFactory,
 	dbTeamFactory dbng.TeamFactory,
 	workerFactory dbng.WorkerFactory,
+	p2pStreamer P2PStreamer,
+	volumeStreamer VolumeStreamer,
 ) WorkerProvider {
 	return &dbWorkerProvider{
 		logger:                          logger,
@@ -61,6 +65,8 @@ func NewDBWorkerProvider(
 		dbVolumeFactory:                 dbVolumeFactory,
 		dbTeamFactory:                   dbTeamFactory,
 		dbWorkerFactory:                 workerFactory,
+		p2pStreamer:                     p2pStreamer,
+		volumeStreamer:                  volumeStreamer,
 	}
 }
 
@@ -213,6 +219,8 @@ func (provider *dbWorkerProvider) newGardenWorker(tikTok clock.Clock, savedWorke
 		savedWorker.NoProxy(),
 		savedWorker.CertificatesPath(),
 		savedWorker.CertificatesSymlinkedPaths(),
+		provider.p2pStreamer,
+		provider.volumeStreamer,
 		clock.NewClock(),
 	)
 
@@ -226,6 +234,7 @@ func (provider *dbWorkerProvider) newGardenWorker(tikTok clock.Clock, savedWorke
 		savedWorker.ResourceTypes(),
 		savedWorker.Platform(),
 		savedWorker.Tags(),
+		savedWorker.Labels(),
 		savedWorker.TeamID(),
 		savedWorker.Name(),
 		savedWorker.StartTime(),
@@ -136,6 +136,8 @@ var _ = Describe("DBProvider", func() {
 			fakeDBVolumeFactory,
 			fakeDBTeamFactory,
 			fakeDBWorkerFactory,
+			nil,
+			nil,
 		)
 		baggageclaimURL = baggageclaimServer.URL()
 	})
@@ -173,7 +175,7 @@ var _ = Describe("DBProvider", func() {
 				Expect(workers).To(HaveLen(2))
 			})
 
-			Context("when some of the workers returned are stalled or landing", func() {
+			Context("when some of the workers returned are stalled, landing or quarantined", func() {
 				BeforeEach(func() {
 
 					landingWorker := new(dbngfakes.FakeWorker)
@@ -194,11 +196,21 @@ var _ = Describe("DBProvider", func() {
 					stalledWorker.ResourceTypesReturns([]atc.WorkerResourceType{
 						{Type: "some-resource-b", Image: "some-image-b"}})
 
+					quarantinedWorker := new(dbngfakes.FakeWorker)
+					quarantinedWorker.NameReturns("quarantined-worker")
+					quarantinedWorker.GardenAddrReturns(&gardenAddr)
+					quarantinedWorker.BaggageclaimURLReturns(&baggageclaimURL)
+					quarantinedWorker.StateReturns(dbng.WorkerStateQuarantined)
+					quarantinedWorker.ActiveContainersReturns(2)
+					quarantinedWorker.ResourceTypesReturns([]atc.WorkerResourceType{
+						{Type: "some-resource-b", Image: "some-image-b"}})
+
 					fakeDBWorkerFactory.WorkersReturns(
 						[]dbng.Worker{
 							fakeWorker1,
 							stalledWorker,
 							landingWorker,
+							quarantinedWorker,
 						}, nil)
 				})
 
@@ -366,6 +378,7 @@ var _ = Describe("DBProvider", func() {
 			Entry("landed", dbng.WorkerStateLanded, false),
 			Entry("stalled", dbng.WorkerStateStalled, false),
 			Entry("retiring", dbng.WorkerStateRetiring, true),
+			Entry("quarantined", dbng.WorkerStateQuarantined, true),
 		)
 	})
 
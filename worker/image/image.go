@@ -4,6 +4,7 @@ import (
 	"io"
 	"net/url"
 	"path"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
@@ -14,17 +15,23 @@ import (
 
 const RawRootFSScheme = "raw"
 
+// imageVolumeName is what the creation of an image's volume is reported as.
+const imageVolumeName = "image"
+
 type imageProvidedByPreviousStepOnSameWorker struct {
 	artifactVolume worker.Volume
 	imageSpec      worker.ImageSpec
 	teamID         int
 	volumeClient   worker.VolumeClient
+	delegate       worker.ImageFetchingDelegate
 }
 
 func (i *imageProvidedByPreviousStepOnSameWorker) FetchForContainer(
 	logger lager.Logger,
 	container dbng.CreatingContainer,
 ) (worker.FetchedImage, error) {
+	start := time.Now()
+
 	imageVolume, err := i.volumeClient.FindOrCreateCOWVolumeForContainer(
 		logger,
 		worker.VolumeSpec{
@@ -41,6 +48,8 @@ func (i *imageProvidedByPreviousStepOnSameWorker) FetchForContainer(
 		return worker.FetchedImage{}, err
 	}
 
+	i.delegate.VolumeCreated(imageVolumeName, time.Since(start))
+
 	imageMetadataReader, err := i.imageSpec.ImageArtifactSource.StreamFile(ImageMetadataFile)
 	if err != nil {
 		logger.Error("failed-to-stream-metadata-file", err)
@@ -64,15 +73,19 @@ func (i *imageProvidedByPreviousStepOnSameWorker) FetchForContainer(
 }
 
 type imageProvidedByPreviousStepOnDifferentWorker struct {
-	imageSpec    worker.ImageSpec
-	teamID       int
-	volumeClient worker.VolumeClient
+	imageSpec      worker.ImageSpec
+	teamID         int
+	volumeClient   worker.VolumeClient
+	volumeStreamer worker.VolumeStreamer
+	delegate       worker.ImageFetchingDelegate
 }
 
 func (i *imageProvidedByPreviousStepOnDifferentWorker) FetchForContainer(
 	logger lager.Logger,
 	container dbng.CreatingContainer,
 ) (worker.FetchedImage, error) {
+	createStart := time.Now()
+
 	imageVolume, err := i.volumeClient.FindOrCreateVolumeForContainer(
 		logger,
 		worker.VolumeSpec{
@@ -88,16 +101,25 @@ func (i *imageProvidedByPreviousStepOnDifferentWorker) FetchForContainer(
 		return worker.FetchedImage{}, nil
 	}
 
-	dest := artifactDestination{
-		destination: imageVolume,
+	i.delegate.VolumeCreated(imageVolumeName, time.Since(createStart))
+
+	dest := &worker.CountingDestination{
+		Destination: worker.VolumeDestination(imageVolume, logger, nil, i.volumeStreamer),
 	}
 
-	err = i.imageSpec.ImageArtifactSource.StreamTo(&dest)
+	start := time.Now()
+
+	err = i.imageSpec.ImageArtifactSource.StreamTo(dest)
 	if err != nil {
 		logger.Error("failed-to-stream-image-artifact-source", err)
 		return worker.FetchedImage{}, nil
 	}
 
+	i.delegate.ImageFetched(worker.StreamStats{
+		Duration: time.Since(start),
+		Bytes:    dest.Bytes(),
+	})
+
 	imageMetadataReader, err := i.imageSpec.ImageArtifactSource.StreamFile(ImageMetadataFile)
 	if err != nil {
 		logger.Error("failed-to-stream-metadata-file", err)
@@ -127,12 +149,15 @@ type imageFromResource struct {
 	imageSpec           worker.ImageSpec
 	teamID              int
 	volumeClient        worker.VolumeClient
+	delegate            worker.ImageFetchingDelegate
 }
 
 func (i *imageFromResource) FetchForContainer(
 	logger lager.Logger,
 	container dbng.CreatingContainer,
 ) (worker.FetchedImage, error) {
+	start := time.Now()
+
 	imageVolume, err := i.volumeClient.FindOrCreateCOWVolumeForContainer(
 		logger.Session("create-cow-volume"),
 		worker.VolumeSpec{
@@ -149,6 +174,8 @@ func (i *imageFromResource) FetchForContainer(
 		return worker.FetchedImage{}, err
 	}
 
+	i.delegate.VolumeCreated(imageVolumeName, time.Since(start))
+
 	metadata, err := loadMetadata(i.imageMetadataReader)
 	if err != nil {
 		return worker.FetchedImage{}, err
@@ -171,6 +198,7 @@ type imageFromBaseResourceType struct {
 	resourceTypeName string
 	teamID           int
 	volumeClient     worker.VolumeClient
+	delegate         worker.ImageFetchingDelegate
 }
 
 func (i *imageFromBaseResourceType) FetchForContainer(
@@ -179,6 +207,8 @@ func (i *imageFromBaseResourceType) FetchForContainer(
 ) (worker.FetchedImage, error) {
 	for _, t := range i.worker.ResourceTypes() {
 		if t.Type == i.resourceTypeName {
+			start := time.Now()
+
 			importVolume, err := i.volumeClient.FindOrCreateVolumeForBaseResourceType(
 				logger,
 				worker.VolumeSpec{
@@ -207,6 +237,8 @@ func (i *imageFromBaseResourceType) FetchForContainer(
 				return worker.FetchedImage{}, err
 			}
 
+			i.delegate.VolumeCreated(imageVolumeName, time.Since(start))
+
 			rootFSURL := url.URL{
 				Scheme: RawRootFSScheme,
 				Path:   cowVolume.Path(),
@@ -235,11 +267,3 @@ func (i *imageInTask) FetchForContainer(
 		URL: i.url,
 	}, nil
 }
-
-type artifactDestination struct {
-	destination worker.Volume
-}
-
-func (wad *artifactDestination) StreamIn(path string, tarStream io.Reader) error {
-	return wad.destination.StreamIn(path, tarStream)
-}
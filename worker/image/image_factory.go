@@ -3,6 +3,7 @@ package image
 import (
 	"errors"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
@@ -14,13 +15,16 @@ var ErrUnsupportedResourceType = errors.New("unsupported resource type")
 
 type imageFactory struct {
 	imageResourceFetcherFactory ImageResourceFetcherFactory
+	volumeStreamer              worker.VolumeStreamer
 }
 
 func NewImageFactory(
 	imageResourceFetcherFactory ImageResourceFetcherFactory,
+	volumeStreamer worker.VolumeStreamer,
 ) worker.ImageFactory {
 	return &imageFactory{
 		imageResourceFetcherFactory: imageResourceFetcherFactory,
+		volumeStreamer:              volumeStreamer,
 	}
 }
 
@@ -48,13 +52,16 @@ func (f *imageFactory) GetImage(
 				imageSpec:      imageSpec,
 				teamID:         teamID,
 				volumeClient:   volumeClient,
+				delegate:       delegate,
 			}, nil
 		}
 
 		return &imageProvidedByPreviousStepOnDifferentWorker{
-			imageSpec:    imageSpec,
-			teamID:       teamID,
-			volumeClient: volumeClient,
+			imageSpec:      imageSpec,
+			teamID:         teamID,
+			volumeClient:   volumeClient,
+			volumeStreamer: f.volumeStreamer,
+			delegate:       delegate,
 		}, nil
 	}
 
@@ -71,6 +78,9 @@ func (f *imageFactory) GetImage(
 
 	if imageResource != nil {
 		imageResourceFetcher := f.imageResourceFetcherFactory.ImageResourceFetcherFor(worker)
+
+		start := time.Now()
+
 		imageParentVolume, imageMetadataReader, version, err := imageResourceFetcher.Fetch(
 			logger.Session("image"),
 			cancel,
@@ -88,6 +98,18 @@ func (f *imageFactory) GetImage(
 			return nil, err
 		}
 
+		duration := time.Since(start)
+
+		size, err := imageParentVolume.SizeInBytes()
+		if err != nil {
+			logger.Error("failed-to-get-image-size", err)
+		}
+
+		delegate.ImageFetched(worker.StreamStats{
+			Duration: duration,
+			Bytes:    size,
+		})
+
 		return &imageFromResource{
 			imageParentVolume:   imageParentVolume,
 			version:             version,
@@ -95,6 +117,7 @@ func (f *imageFactory) GetImage(
 			imageSpec:           imageSpec,
 			teamID:              teamID,
 			volumeClient:        volumeClient,
+			delegate:            delegate,
 		}, nil
 	}
 
@@ -104,6 +127,7 @@ func (f *imageFactory) GetImage(
 			resourceTypeName: imageSpec.ResourceType,
 			teamID:           teamID,
 			volumeClient:     volumeClient,
+			delegate:         delegate,
 		}, nil
 	}
 
@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
 	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/metric"
 	"github.com/concourse/atc/resource"
 	"github.com/concourse/atc/worker"
 )
@@ -50,11 +51,12 @@ type ImageResourceFetcher interface {
 }
 
 type imageResourceFetcherFactory struct {
-	resourceFetcherFactory  resource.FetcherFactory
-	resourceFactoryFactory  resource.ResourceFactoryFactory
-	dbResourceCacheFactory  dbng.ResourceCacheFactory
-	dbResourceConfigFactory dbng.ResourceConfigFactory
-	clock                   clock.Clock
+	resourceFetcherFactory      resource.FetcherFactory
+	resourceFactoryFactory      resource.ResourceFactoryFactory
+	dbResourceCacheFactory      dbng.ResourceCacheFactory
+	dbResourceConfigFactory     dbng.ResourceConfigFactory
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory
+	clock                       clock.Clock
 }
 
 func NewImageResourceFetcherFactory(
@@ -62,33 +64,39 @@ func NewImageResourceFetcherFactory(
 	resourceFactoryFactory resource.ResourceFactoryFactory,
 	dbResourceCacheFactory dbng.ResourceCacheFactory,
 	dbResourceConfigFactory dbng.ResourceConfigFactory,
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory,
 	clock clock.Clock,
 ) ImageResourceFetcherFactory {
 	return &imageResourceFetcherFactory{
-		resourceFetcherFactory:  resourceFetcherFactory,
-		resourceFactoryFactory:  resourceFactoryFactory,
-		dbResourceCacheFactory:  dbResourceCacheFactory,
-		dbResourceConfigFactory: dbResourceConfigFactory,
+		resourceFetcherFactory:      resourceFetcherFactory,
+		resourceFactoryFactory:      resourceFactoryFactory,
+		dbResourceCacheFactory:      dbResourceCacheFactory,
+		dbResourceConfigFactory:     dbResourceConfigFactory,
+		dbImageResourceFetchFactory: dbImageResourceFetchFactory,
 		clock: clock,
 	}
 }
 
 func (f *imageResourceFetcherFactory) ImageResourceFetcherFor(worker worker.Worker) ImageResourceFetcher {
 	return &imageResourceFetcher{
-		resourceFetcher:         f.resourceFetcherFactory.FetcherFor(worker),
-		resourceFactory:         f.resourceFactoryFactory.FactoryFor(worker),
-		dbResourceCacheFactory:  f.dbResourceCacheFactory,
-		dbResourceConfigFactory: f.dbResourceConfigFactory,
+		worker:                      worker,
+		resourceFetcher:             f.resourceFetcherFactory.FetcherFor(worker),
+		resourceFactory:             f.resourceFactoryFactory.FactoryFor(worker),
+		dbResourceCacheFactory:      f.dbResourceCacheFactory,
+		dbResourceConfigFactory:     f.dbResourceConfigFactory,
+		dbImageResourceFetchFactory: f.dbImageResourceFetchFactory,
 		clock: f.clock,
 	}
 }
 
 type imageResourceFetcher struct {
-	resourceFetcher         resource.Fetcher
-	resourceFactory         resource.ResourceFactory
-	dbResourceCacheFactory  dbng.ResourceCacheFactory
-	dbResourceConfigFactory dbng.ResourceConfigFactory
-	clock                   clock.Clock
+	worker                      worker.Worker
+	resourceFetcher             resource.Fetcher
+	resourceFactory             resource.ResourceFactory
+	dbResourceCacheFactory      dbng.ResourceCacheFactory
+	dbResourceConfigFactory     dbng.ResourceConfigFactory
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory
+	clock                       clock.Clock
 }
 
 func (i *imageResourceFetcher) Fetch(
@@ -126,6 +134,12 @@ func (i *imageResourceFetcher) Fetch(
 		return nil, nil, nil, err
 	}
 
+	_, hit, err := resourceInstance.FindInitializedOn(logger, i.worker)
+	if err != nil {
+		logger.Error("failed-to-find-initialized-image", err)
+		return nil, nil, nil, err
+	}
+
 	getSess := resource.Session{
 		Metadata: dbng.ContainerMetadata{
 			Type: dbng.ContainerTypeGet,
@@ -146,6 +160,8 @@ func (i *imageResourceFetcher) Fetch(
 		logger.Session("init-image"),
 		getSess,
 		tags,
+		nil,
+		nil,
 		teamID,
 		customTypes,
 		resourceInstance,
@@ -166,6 +182,14 @@ func (i *imageResourceFetcher) Fetch(
 		return nil, nil, nil, ErrImageGetDidNotProduceVolume
 	}
 
+	if hit {
+		metric.ImageCacheHits.Inc()
+	} else {
+		metric.ImageCacheMisses.Inc()
+	}
+
+	i.recordFetch(logger, resourceInstance, resourceUser, imageResourceType, version, tags, teamID, volume, hit)
+
 	reader, err := versionedSource.StreamOut(ImageMetadataFile)
 	if err != nil {
 		return nil, nil, nil, err
@@ -262,6 +286,44 @@ func (i *imageResourceFetcher) getLatestVersion(
 	return versions[0], nil
 }
 
+// recordFetch tracks the fetch so that the most used images can be pre-warmed
+// on workers. Failing to record it does not fail the fetch.
+func (i *imageResourceFetcher) recordFetch(
+	logger lager.Logger,
+	resourceInstance resource.ResourceInstance,
+	resourceUser dbng.ResourceUser,
+	imageResourceType string,
+	version atc.Version,
+	tags atc.Tags,
+	teamID int,
+	volume worker.Volume,
+	hit bool,
+) {
+	resourceCache, err := resourceInstance.FindOrCreateResourceCache(logger)
+	if err != nil {
+		logger.Error("failed-to-find-image-resource-cache", err)
+		return
+	}
+
+	size, err := volume.SizeInBytes()
+	if err != nil {
+		logger.Error("failed-to-get-image-size", err)
+	}
+
+	err = i.dbImageResourceFetchFactory.RecordFetch(dbng.ImageResourceFetch{
+		ResourceCacheID: resourceCache.ID,
+		TeamID:          teamID,
+		User:            resourceUser,
+		ResourceType:    imageResourceType,
+		Version:         version,
+		Tags:            tags,
+		SizeInBytes:     size,
+	}, hit)
+	if err != nil {
+		logger.Error("failed-to-record-image-fetch", err)
+	}
+}
+
 type leaseID struct {
 	Type       resource.ResourceType `json:"type"`
 	Version    atc.Version           `json:"version"`
@@ -37,6 +37,7 @@ var _ = Describe("Image", func() {
 	var fakeResourceFactoryFactory *rfakes.FakeResourceFactoryFactory
 	var fakeResourceCacheFactory *dbngfakes.FakeResourceCacheFactory
 	var fakeResourceConfigFactory *dbngfakes.FakeResourceConfigFactory
+	var fakeImageResourceFetchFactory *dbngfakes.FakeImageResourceFetchFactory
 
 	var imageResourceFetcher image.ImageResourceFetcher
 
@@ -100,12 +101,16 @@ var _ = Describe("Image", func() {
 		}
 
 		fakeResourceCacheFactory = new(dbngfakes.FakeResourceCacheFactory)
+		fakeResourceCacheFactory.FindOrCreateResourceCacheReturns(&dbng.UsedResourceCache{ID: 7}, nil)
+
+		fakeImageResourceFetchFactory = new(dbngfakes.FakeImageResourceFetchFactory)
 
 		imageResourceFetcher = image.NewImageResourceFetcherFactory(
 			fakeResourceFetcherFactory,
 			fakeResourceFactoryFactory,
 			fakeResourceCacheFactory,
 			fakeResourceConfigFactory,
+			fakeImageResourceFetchFactory,
 			fakeClock,
 		).ImageResourceFetcherFor(fakeWorker)
 	})
@@ -307,7 +312,7 @@ var _ = Describe("Image", func() {
 
 							It("fetches resource with correct session", func() {
 								Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(1))
-								_, session, tags, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+								_, session, tags, placement, _, actualTeamID, actualCustomTypes, resourceInstance, metadata, delegate, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
 								Expect(metadata).To(Equal(resource.EmptyMetadata{}))
 								Expect(session).To(Equal(resource.Session{
 									Metadata: dbng.ContainerMetadata{
@@ -315,6 +320,7 @@ var _ = Describe("Image", func() {
 									},
 								}))
 								Expect(tags).To(Equal(atc.Tags{"worker", "tags"}))
+								Expect(placement).To(BeNil())
 								Expect(actualTeamID).To(Equal(teamID))
 								Expect(resourceInstance).To(Equal(resource.NewResourceInstance(
 									"docker",
@@ -340,6 +346,64 @@ var _ = Describe("Image", func() {
 								Expect(fakeVersionedSource.VolumeCallCount()).To(Equal(1))
 							})
 
+							Context("when the worker did not already have the image", func() {
+								BeforeEach(func() {
+									fakeWorker.FindInitializedVolumeForResourceCacheReturns(nil, false, nil)
+									fakeVolume.SizeInBytesReturns(1024, nil)
+								})
+
+								It("records the fetch as a miss, with the size of the image but not its source", func() {
+									Expect(fakeImageResourceFetchFactory.RecordFetchCallCount()).To(Equal(1))
+									fetch, hit := fakeImageResourceFetchFactory.RecordFetchArgsForCall(0)
+									Expect(hit).To(BeFalse())
+									Expect(fetch).To(Equal(dbng.ImageResourceFetch{
+										ResourceCacheID: 7,
+										TeamID:          teamID,
+										User:            dbng.ForBuild(42),
+										ResourceType:    "docker",
+										Version:         atc.Version{"v": "1"},
+										Tags:            atc.Tags{"worker", "tags"},
+										SizeInBytes:     1024,
+									}))
+								})
+							})
+
+							Context("when the worker already had the image", func() {
+								BeforeEach(func() {
+									fakeWorker.FindInitializedVolumeForResourceCacheReturns(fakeVolume, true, nil)
+								})
+
+								It("records the fetch as a hit", func() {
+									Expect(fakeImageResourceFetchFactory.RecordFetchCallCount()).To(Equal(1))
+									_, hit := fakeImageResourceFetchFactory.RecordFetchArgsForCall(0)
+									Expect(hit).To(BeTrue())
+								})
+							})
+
+							Context("when recording the fetch fails", func() {
+								BeforeEach(func() {
+									fakeImageResourceFetchFactory.RecordFetchReturns(errors.New("nope"))
+								})
+
+								It("still succeeds", func() {
+									Expect(fetchErr).NotTo(HaveOccurred())
+									Expect(fetchedVolume).To(Equal(fakeVolume))
+								})
+							})
+
+							Context("when looking for the image on the worker fails", func() {
+								disaster := errors.New("nope")
+
+								BeforeEach(func() {
+									fakeWorker.FindInitializedVolumeForResourceCacheReturns(nil, false, disaster)
+								})
+
+								It("returns the error without fetching", func() {
+									Expect(fetchErr).To(Equal(disaster))
+									Expect(fakeResourceFetcher.FetchCallCount()).To(BeZero())
+								})
+							})
+
 							Context("when streaming the metadata out fails", func() {
 								disaster := errors.New("nope")
 
@@ -1,6 +1,7 @@
 package image_test
 
 import (
+	"io"
 	"io/ioutil"
 	"strings"
 
@@ -42,7 +43,7 @@ var _ = Describe("RootFsUri", func() {
 		fakeImageResourceFetcherFactory = new(imagefakes.FakeImageResourceFetcherFactory)
 		fakeImageResourceFetcher = new(imagefakes.FakeImageResourceFetcher)
 		fakeImageResourceFetcherFactory.ImageResourceFetcherForReturns(fakeImageResourceFetcher)
-		imageFactory = image.NewImageFactory(fakeImageResourceFetcherFactory)
+		imageFactory = image.NewImageFactory(fakeImageResourceFetcherFactory, nil)
 	})
 
 	Describe("imageProvidedByPreviousStepOnSameWorker", func() {
@@ -85,6 +86,15 @@ var _ = Describe("RootFsUri", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("reports creating the image's volume to the delegate", func() {
+			_, err := img.FetchForContainer(logger, fakeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeImageFetchingDelegate.VolumeCreatedCallCount()).To(Equal(1))
+			name, _ := fakeImageFetchingDelegate.VolumeCreatedArgsForCall(0)
+			Expect(name).To(Equal("image"))
+		})
+
 		It("finds or creates cow volume", func() {
 			_, err := img.FetchForContainer(logger, fakeContainer)
 			Expect(err).NotTo(HaveOccurred())
@@ -153,6 +163,15 @@ var _ = Describe("RootFsUri", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("reports creating the image's volume to the delegate", func() {
+			_, err := img.FetchForContainer(logger, fakeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeImageFetchingDelegate.VolumeCreatedCallCount()).To(Equal(1))
+			name, _ := fakeImageFetchingDelegate.VolumeCreatedArgsForCall(0)
+			Expect(name).To(Equal("image"))
+		})
+
 		It("finds or creates volume", func() {
 			_, err := img.FetchForContainer(logger, fakeContainer)
 			Expect(err).NotTo(HaveOccurred())
@@ -178,6 +197,24 @@ var _ = Describe("RootFsUri", func() {
 			Expect(fakeContainerRootfsVolume.StreamInCallCount()).To(Equal(1))
 		})
 
+		It("reports the size of the streamed image to the delegate", func() {
+			fakeContainerRootfsVolume.StreamInStub = func(path string, tarStream io.Reader) error {
+				_, err := ioutil.ReadAll(tarStream)
+				return err
+			}
+
+			fakeImageArtifactSource.StreamToStub = func(dest worker.ArtifactDestination) error {
+				return dest.StreamIn(".", strings.NewReader("fake-tar-stream"))
+			}
+
+			_, err := img.FetchForContainer(logger, fakeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeImageFetchingDelegate.ImageFetchedCallCount()).To(Equal(1))
+			stats := fakeImageFetchingDelegate.ImageFetchedArgsForCall(0)
+			Expect(stats.Bytes).To(Equal(int64(len("fake-tar-stream"))))
+		})
+
 		It("returns fetched image", func() {
 			fetchedImage, err := img.FetchForContainer(logger, fakeContainer)
 			Expect(err).NotTo(HaveOccurred())
@@ -207,6 +244,7 @@ var _ = Describe("RootFsUri", func() {
 				Parent: new(baggageclaimfakes.FakeVolume),
 			}
 			fakeResourceImageVolume.COWStrategyReturns(cowStrategy)
+			fakeResourceImageVolume.SizeInBytesReturns(2048, nil)
 
 			fakeContainerRootfsVolume = new(workerfakes.FakeVolume)
 			fakeContainerRootfsVolume.PathReturns("some-path")
@@ -240,6 +278,21 @@ var _ = Describe("RootFsUri", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("reports the image fetch to the delegate with the size of the image", func() {
+			Expect(fakeImageFetchingDelegate.ImageFetchedCallCount()).To(Equal(1))
+			stats := fakeImageFetchingDelegate.ImageFetchedArgsForCall(0)
+			Expect(stats.Bytes).To(Equal(int64(2048)))
+		})
+
+		It("reports creating the image's volume to the delegate", func() {
+			_, err := img.FetchForContainer(logger, fakeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeImageFetchingDelegate.VolumeCreatedCallCount()).To(Equal(1))
+			name, _ := fakeImageFetchingDelegate.VolumeCreatedArgsForCall(0)
+			Expect(name).To(Equal("image"))
+		})
+
 		It("finds or creates cow volume", func() {
 			_, err := img.FetchForContainer(logger, fakeContainer)
 			Expect(err).NotTo(HaveOccurred())
@@ -330,6 +383,15 @@ var _ = Describe("RootFsUri", func() {
 			Expect(resourceTypeName).To(Equal("some-base-resource-type"))
 		})
 
+		It("reports creating the image's volume to the delegate", func() {
+			_, err := img.FetchForContainer(logger, fakeContainer)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeImageFetchingDelegate.VolumeCreatedCallCount()).To(Equal(1))
+			name, _ := fakeImageFetchingDelegate.VolumeCreatedArgsForCall(0)
+			Expect(name).To(Equal("image"))
+		})
+
 		It("finds or creates cow volume", func() {
 			_, err := img.FetchForContainer(logger, fakeContainer)
 			Expect(err).NotTo(HaveOccurred())
@@ -0,0 +1,213 @@
+package image
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/metric"
+	"github.com/concourse/atc/resource"
+	"github.com/concourse/atc/worker"
+)
+
+// prewarmCandidatesPerImage is how many of the most fetched images are
+// considered for each image in the budget, as a worker may not be able to run
+// every one of them.
+const prewarmCandidatesPerImage = 4
+
+// PrewarmBudget bounds the images pre-warmed on each worker. Images that the
+// worker already has count towards it. A Disk of 0 does not bound disk usage.
+type PrewarmBudget struct {
+	Images int
+	Disk   int64
+}
+
+//go:generate counterfeiter . Prewarmer
+
+// Prewarmer fetches the images used most across the cluster onto workers that
+// have recently registered or are idle, so that the first builds to run on
+// them do not wait for their images to be fetched.
+type Prewarmer interface {
+	Run() error
+}
+
+type prewarmer struct {
+	logger                      lager.Logger
+	workerClient                worker.Client
+	resourceFetcherFactory      resource.FetcherFactory
+	dbResourceCacheFactory      dbng.ResourceCacheFactory
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory
+	clock                       clock.Clock
+
+	budget          PrewarmBudget
+	newWorkerWindow time.Duration
+	idleContainers  int
+}
+
+// NewPrewarmer constructs a Prewarmer which pre-warms workers that registered
+// within newWorkerWindow, or have no more than idleContainers containers.
+func NewPrewarmer(
+	logger lager.Logger,
+	workerClient worker.Client,
+	resourceFetcherFactory resource.FetcherFactory,
+	dbResourceCacheFactory dbng.ResourceCacheFactory,
+	dbImageResourceFetchFactory dbng.ImageResourceFetchFactory,
+	clock clock.Clock,
+	budget PrewarmBudget,
+	newWorkerWindow time.Duration,
+	idleContainers int,
+) Prewarmer {
+	return &prewarmer{
+		logger:                      logger,
+		workerClient:                workerClient,
+		resourceFetcherFactory:      resourceFetcherFactory,
+		dbResourceCacheFactory:      dbResourceCacheFactory,
+		dbImageResourceFetchFactory: dbImageResourceFetchFactory,
+		clock:                       clock,
+
+		budget:          budget,
+		newWorkerWindow: newWorkerWindow,
+		idleContainers:  idleContainers,
+	}
+}
+
+func (p *prewarmer) Run() error {
+	logger := p.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	workers, err := p.workerClient.RunningWorkers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return err
+	}
+
+	candidates, err := p.dbImageResourceFetchFactory.MostFetched(0, p.budget.Images*prewarmCandidatesPerImage)
+	if err != nil {
+		logger.Error("failed-to-get-most-fetched-images", err)
+		return err
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	wg := new(sync.WaitGroup)
+	for _, w := range workers {
+		if w.Uptime() > p.newWorkerWindow && w.ActiveContainers() > p.idleContainers {
+			continue
+		}
+
+		wg.Add(1)
+		go func(w worker.Worker) {
+			defer wg.Done()
+			p.prewarmWorker(logger.Session("prewarm", lager.Data{"worker": w.Name()}), w, candidates)
+		}(w)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (p *prewarmer) prewarmWorker(logger lager.Logger, w worker.Worker, candidates []dbng.ImageResourceFetch) {
+	images := 0
+	var disk int64
+
+	for _, candidate := range candidates {
+		if images >= p.budget.Images {
+			break
+		}
+
+		if p.budget.Disk > 0 && disk+candidate.SizeInBytes > p.budget.Disk {
+			// a smaller image may still fit
+			continue
+		}
+
+		_, err := w.Satisfying(worker.WorkerSpec{
+			ResourceType: candidate.ResourceType,
+			Tags:         candidate.Tags,
+			TeamID:       candidate.TeamID,
+		}, candidate.ResourceTypes)
+		if err != nil {
+			continue
+		}
+
+		err = p.prewarm(logger.Session("image", lager.Data{"resource-cache": candidate.ResourceCacheID}), w, candidate)
+		if err != nil {
+			continue
+		}
+
+		images++
+		disk += candidate.SizeInBytes
+	}
+}
+
+func (p *prewarmer) prewarm(logger lager.Logger, w worker.Worker, candidate dbng.ImageResourceFetch) error {
+	resourceInstance := resource.NewResourceInstance(
+		resource.ResourceType(candidate.ResourceType),
+		candidate.Version,
+		candidate.Source,
+		atc.Params{},
+		candidate.User,
+		candidate.ResourceTypes,
+		p.dbResourceCacheFactory,
+	)
+
+	_, found, err := resourceInstance.FindInitializedOn(logger, w)
+	if err != nil {
+		logger.Error("failed-to-find-initialized-image", err)
+		return err
+	}
+
+	if found {
+		logger.Debug("already-present")
+		return nil
+	}
+
+	delegate := worker.NoopImageFetchingDelegate{}
+
+	start := p.clock.Now()
+
+	_, err = p.resourceFetcherFactory.FetcherFor(w).Fetch(
+		logger,
+		resource.Session{
+			Metadata: dbng.ContainerMetadata{
+				Type: dbng.ContainerTypeGet,
+			},
+		},
+		candidate.Tags,
+		nil,
+		nil,
+		candidate.TeamID,
+		candidate.ResourceTypes,
+		resourceInstance,
+		resource.EmptyMetadata{},
+		delegate,
+		&imageResourceOptions{
+			imageFetchingDelegate: delegate,
+			source:                candidate.Source,
+			version:               candidate.Version,
+			resourceType:          resource.ResourceType(candidate.ResourceType),
+		},
+		make(chan os.Signal),
+		make(chan struct{}),
+	)
+	if err != nil {
+		logger.Error("failed-to-prewarm-image", err)
+		return err
+	}
+
+	metric.ImagePrewarmed{
+		WorkerName:   w.Name(),
+		ResourceType: candidate.ResourceType,
+		Duration:     p.clock.Since(start),
+	}.Emit(logger)
+
+	return nil
+}
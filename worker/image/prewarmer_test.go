@@ -0,0 +1,236 @@
+package image_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/resource"
+	rfakes "github.com/concourse/atc/resource/resourcefakes"
+	"github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/image"
+	wfakes "github.com/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prewarmer", func() {
+	var (
+		fakeWorkerClient              *wfakes.FakeClient
+		fakeWorker                    *wfakes.FakeWorker
+		fakeResourceFetcherFactory    *rfakes.FakeFetcherFactory
+		fakeResourceFetcher           *rfakes.FakeFetcher
+		fakeResourceCacheFactory      *dbngfakes.FakeResourceCacheFactory
+		fakeImageResourceFetchFactory *dbngfakes.FakeImageResourceFetchFactory
+
+		budget image.PrewarmBudget
+
+		prewarmer image.Prewarmer
+		runErr    error
+	)
+
+	candidate := func(id int, size int64) dbng.ImageResourceFetch {
+		return dbng.ImageResourceFetch{
+			ResourceCacheID: id,
+			TeamID:          1,
+			User:            dbng.ForBuild(42),
+			ResourceType:    "docker-image",
+			Source:          atc.Source{"repository": "some-image"},
+			Version:         atc.Version{"digest": fmt.Sprintf("digest-%d", id)},
+			Tags:            atc.Tags{"some-tag"},
+			SizeInBytes:     size,
+			Fetches:         10,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeWorker = new(wfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeWorker.UptimeReturns(time.Minute)
+		fakeWorker.ActiveContainersReturns(20)
+		fakeWorker.SatisfyingReturns(fakeWorker, nil)
+		fakeWorker.FindInitializedVolumeForResourceCacheReturns(nil, false, nil)
+
+		fakeWorkerClient = new(wfakes.FakeClient)
+		fakeWorkerClient.RunningWorkersReturns([]worker.Worker{fakeWorker}, nil)
+
+		fakeResourceFetcher = new(rfakes.FakeFetcher)
+		fakeResourceFetcherFactory = new(rfakes.FakeFetcherFactory)
+		fakeResourceFetcherFactory.FetcherForReturns(fakeResourceFetcher)
+
+		fakeResourceCacheFactory = new(dbngfakes.FakeResourceCacheFactory)
+		fakeResourceCacheFactory.FindOrCreateResourceCacheReturns(&dbng.UsedResourceCache{ID: 7}, nil)
+
+		fakeImageResourceFetchFactory = new(dbngfakes.FakeImageResourceFetchFactory)
+		fakeImageResourceFetchFactory.MostFetchedReturns([]dbng.ImageResourceFetch{
+			candidate(1, 100),
+			candidate(2, 100),
+			candidate(3, 100),
+		}, nil)
+
+		budget = image.PrewarmBudget{Images: 2}
+	})
+
+	JustBeforeEach(func() {
+		prewarmer = image.NewPrewarmer(
+			lagertest.NewTestLogger("test"),
+			fakeWorkerClient,
+			fakeResourceFetcherFactory,
+			fakeResourceCacheFactory,
+			fakeImageResourceFetchFactory,
+			fakeclock.NewFakeClock(time.Now()),
+			budget,
+			10*time.Minute,
+			0,
+		)
+
+		runErr = prewarmer.Run()
+	})
+
+	It("considers more of the most fetched images than the budget allows", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(fakeImageResourceFetchFactory.MostFetchedCallCount()).To(Equal(1))
+		teamID, limit := fakeImageResourceFetchFactory.MostFetchedArgsForCall(0)
+		Expect(teamID).To(Equal(0))
+		Expect(limit).To(BeNumerically(">", 2))
+	})
+
+	Context("when a worker has recently registered", func() {
+		It("fetches the most fetched images onto it, up to the budget", func() {
+			Expect(fakeResourceFetcherFactory.FetcherForArgsForCall(0)).To(Equal(fakeWorker))
+			Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(2))
+
+			_, session, tags, _, _, teamID, _, resourceInstance, _, _, resourceOptions, _, _ := fakeResourceFetcher.FetchArgsForCall(0)
+			Expect(session).To(Equal(resource.Session{
+				Metadata: dbng.ContainerMetadata{
+					Type: dbng.ContainerTypeGet,
+				},
+			}))
+			Expect(tags).To(Equal(atc.Tags{"some-tag"}))
+			Expect(teamID).To(Equal(1))
+			Expect(resourceInstance.ResourceUser()).To(Equal(dbng.ForBuild(42)))
+			Expect(resourceOptions.Version()).To(Equal(atc.Version{"digest": "digest-1"}))
+			Expect(resourceOptions.Source()).To(Equal(atc.Source{"repository": "some-image"}))
+		})
+
+		It("only fetches images onto the worker which it can run", func() {
+			Expect(fakeWorker.SatisfyingCallCount()).To(Equal(2))
+			spec, _ := fakeWorker.SatisfyingArgsForCall(0)
+			Expect(spec).To(Equal(worker.WorkerSpec{
+				ResourceType: "docker-image",
+				Tags:         atc.Tags{"some-tag"},
+				TeamID:       1,
+			}))
+		})
+
+		Context("when the worker cannot run an image", func() {
+			BeforeEach(func() {
+				calls := 0
+				fakeWorker.SatisfyingStub = func(worker.WorkerSpec, atc.VersionedResourceTypes) (worker.Worker, error) {
+					calls++
+					if calls == 1 {
+						return nil, errors.New("nope")
+					}
+
+					return fakeWorker, nil
+				}
+			})
+
+			It("moves on to the next image", func() {
+				Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the worker already has the images", func() {
+			BeforeEach(func() {
+				fakeWorker.FindInitializedVolumeForResourceCacheReturns(new(wfakes.FakeVolume), true, nil)
+			})
+
+			It("counts them towards the budget without fetching them", func() {
+				Expect(fakeResourceFetcher.FetchCallCount()).To(BeZero())
+				Expect(fakeWorker.FindInitializedVolumeForResourceCacheCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the images would exceed the disk budget", func() {
+			BeforeEach(func() {
+				fakeImageResourceFetchFactory.MostFetchedReturns([]dbng.ImageResourceFetch{
+					candidate(1, 100),
+					candidate(2, 500),
+					candidate(3, 50),
+				}, nil)
+
+				budget.Disk = 200
+			})
+
+			It("skips the images that do not fit", func() {
+				Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(2))
+				Expect(fakeResourceCacheFactory.FindOrCreateResourceCacheCallCount()).To(Equal(2))
+
+				_, _, _, version, _, _, _ := fakeResourceCacheFactory.FindOrCreateResourceCacheArgsForCall(1)
+				Expect(version).To(Equal(atc.Version{"digest": "digest-3"}))
+			})
+		})
+
+		Context("when fetching an image fails", func() {
+			BeforeEach(func() {
+				fakeResourceFetcher.FetchReturns(nil, errors.New("nope"))
+			})
+
+			It("does not count it towards the budget", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(3))
+			})
+		})
+	})
+
+	Context("when a worker is idle", func() {
+		BeforeEach(func() {
+			fakeWorker.UptimeReturns(time.Hour)
+			fakeWorker.ActiveContainersReturns(0)
+		})
+
+		It("fetches images onto it", func() {
+			Expect(fakeResourceFetcher.FetchCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when a worker is neither new nor idle", func() {
+		BeforeEach(func() {
+			fakeWorker.UptimeReturns(time.Hour)
+		})
+
+		It("leaves it alone", func() {
+			Expect(fakeResourceFetcher.FetchCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when no images have been fetched", func() {
+		BeforeEach(func() {
+			fakeImageResourceFetchFactory.MostFetchedReturns([]dbng.ImageResourceFetch{}, nil)
+		})
+
+		It("does nothing", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeResourceFetcher.FetchCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the most fetched images fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeImageResourceFetchFactory.MostFetchedReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
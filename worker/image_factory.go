@@ -4,6 +4,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/atc"
@@ -46,6 +47,11 @@ type Image interface {
 type ImageFetchingDelegate interface {
 	Stderr() io.Writer
 	ImageVersionDetermined(ResourceCacheIdentifier) error
+	ImageFetched(StreamStats)
+
+	// VolumeCreated is called with how long it took to create each of the
+	// container's volumes, named after what is mounted in them.
+	VolumeCreated(name string, duration time.Duration)
 }
 
 type ImageMetadata struct {
@@ -57,3 +63,5 @@ type NoopImageFetchingDelegate struct{}
 
 func (NoopImageFetchingDelegate) Stderr() io.Writer                                    { return ioutil.Discard }
 func (NoopImageFetchingDelegate) ImageVersionDetermined(ResourceCacheIdentifier) error { return nil }
+func (NoopImageFetchingDelegate) ImageFetched(StreamStats)                             {}
+func (NoopImageFetchingDelegate) VolumeCreated(string, time.Duration)                  {}
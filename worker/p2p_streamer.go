@@ -0,0 +1,236 @@
+package worker
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/worker/transport"
+)
+
+// ErrP2PStreamingUnsupported is returned when a volume cannot be streamed
+// directly between workers, either because the destination cannot pull
+// volumes or because its Baggageclaim does not support it.
+var ErrP2PStreamingUnsupported = errors.New("p2p streaming is not supported")
+
+// P2PStreamError is returned when the destination's Baggageclaim fails to
+// pull a volume from the source worker.
+type P2PStreamError struct {
+	WorkerName string
+	Status     int
+}
+
+func (err P2PStreamError) Error() string {
+	return fmt.Sprintf("worker '%s' failed to stream volume (status %d)", err.WorkerName, err.Status)
+}
+
+//go:generate counterfeiter . P2PStreamer
+
+// P2PStreamer streams volumes directly from one worker's Baggageclaim to
+// another's, so that the bytes do not flow through the ATC.
+type P2PStreamer interface {
+	// StreamP2P has the Baggageclaim of the destination volume pull the path
+	// from the source volume's Baggageclaim, returning the number of bytes
+	// streamed.
+	StreamP2P(
+		logger lager.Logger,
+		source Volume,
+		sourcePath string,
+		destination Volume,
+		destinationPath string,
+	) (int64, error)
+}
+
+type p2pStreamer struct {
+	db    transport.TransportDB
+	key   []byte
+	ttl   time.Duration
+	clock clock.Clock
+
+	httpClient *http.Client
+}
+
+// NewP2PStreamer constructs a P2PStreamer which hands the destination a URL
+// for the source volume signed with the given key, valid for the given ttl.
+// Workers must be configured with the same key to verify it.
+func NewP2PStreamer(
+	db transport.TransportDB,
+	key []byte,
+	ttl time.Duration,
+	clock clock.Clock,
+) P2PStreamer {
+	return &p2pStreamer{
+		db:    db,
+		key:   key,
+		ttl:   ttl,
+		clock: clock,
+
+		httpClient: &http.Client{
+			Transport: &http.Transport{DisableKeepAlives: true},
+		},
+	}
+}
+
+type p2pStreamInRequest struct {
+	URL string `json:"url"`
+}
+
+type p2pStreamInResponse struct {
+	Bytes int64 `json:"bytes"`
+}
+
+func (streamer *p2pStreamer) StreamP2P(
+	logger lager.Logger,
+	source Volume,
+	sourcePath string,
+	destination Volume,
+	destinationPath string,
+) (int64, error) {
+	logger = logger.Session("stream-p2p", lager.Data{
+		"source":      source.Handle(),
+		"destination": destination.Handle(),
+	})
+
+	sourceWorker, err := reachableWorker(streamer.db, source.WorkerName())
+	if err != nil {
+		return 0, err
+	}
+
+	destinationWorker, err := reachableWorker(streamer.db, destination.WorkerName())
+	if err != nil {
+		return 0, err
+	}
+
+	streamURL := SignStreamOutURL(
+		*sourceWorker.BaggageclaimURL(),
+		source.Handle(),
+		sourcePath,
+		streamer.clock.Now().Add(streamer.ttl),
+		streamer.key,
+	)
+
+	payload, err := json.Marshal(p2pStreamInRequest{URL: streamURL})
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf(
+			"%s/volumes/%s/stream-p2p-in?path=%s",
+			*destinationWorker.BaggageclaimURL(),
+			destination.Handle(),
+			url.QueryEscape(destinationPath),
+		),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := streamer.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		io.Copy(ioutil.Discard, response.Body)
+		return 0, ErrP2PStreamingUnsupported
+	default:
+		io.Copy(ioutil.Discard, response.Body)
+		return 0, P2PStreamError{
+			WorkerName: destination.WorkerName(),
+			Status:     response.StatusCode,
+		}
+	}
+
+	var result p2pStreamInResponse
+	err = json.NewDecoder(response.Body).Decode(&result)
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Debug("streamed", lager.Data{"bytes": result.Bytes})
+
+	return result.Bytes, nil
+}
+
+// reachableWorker looks up a worker whose Baggageclaim can be reached.
+func reachableWorker(db transport.TransportDB, workerName string) (dbng.Worker, error) {
+	savedWorker, found, err := db.GetWorker(workerName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, transport.WorkerMissingError{WorkerName: workerName}
+	}
+
+	if savedWorker.BaggageclaimURL() == nil {
+		return nil, transport.WorkerUnreachableError{
+			WorkerName:  workerName,
+			WorkerState: string(savedWorker.State()),
+		}
+	}
+
+	return savedWorker, nil
+}
+
+// SignStreamOutURL returns the URL of the given path of a volume on the
+// Baggageclaim at baggageclaimURL, which may be streamed out without further
+// authorization until it expires.
+//
+// The signature is the hex-encoded HMAC-SHA256 of the handle, path, and
+// expiry (as Unix seconds), separated by newlines.
+func SignStreamOutURL(baggageclaimURL string, handle string, path string, expires time.Time, key []byte) string {
+	expiry := strconv.FormatInt(expires.Unix(), 10)
+
+	query := url.Values{}
+	query.Set("path", path)
+	query.Set("expires", expiry)
+	query.Set("signature", StreamOutSignature(handle, path, expiry, key))
+
+	return fmt.Sprintf(
+		"%s/volumes/%s/stream-out?%s",
+		baggageclaimURL,
+		handle,
+		query.Encode(),
+	)
+}
+
+// StreamOutSignature computes the signature of a stream-out URL, as used by
+// SignStreamOutURL.
+func StreamOutSignature(handle string, path string, expiry string, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(handle + "\n" + path + "\n" + expiry))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// P2PArtifactDestination is an ArtifactDestination that can pull an artifact
+// directly from the worker that its volume lives on.
+type P2PArtifactDestination interface {
+	ArtifactDestination
+
+	// StreamInFrom pulls the path of the source volume into the destination
+	// directory, returning the number of bytes streamed.
+	StreamInFrom(path string, source Volume, sourcePath string) (int64, error)
+}
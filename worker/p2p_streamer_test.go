@@ -0,0 +1,163 @@
+package worker_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	. "github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/transport"
+	"github.com/concourse/atc/worker/transport/transportfakes"
+	"github.com/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("P2PStreamer", func() {
+	var (
+		logger          *lagertest.TestLogger
+		fakeTransportDB *transportfakes.FakeTransportDB
+		fakeClock       *fakeclock.FakeClock
+
+		destinationServer *ghttp.Server
+
+		sourceVolume      *workerfakes.FakeVolume
+		destinationVolume *workerfakes.FakeVolume
+
+		streamer P2PStreamer
+
+		streamedBytes int64
+		streamErr     error
+	)
+
+	key := []byte("some-key")
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+
+		destinationServer = ghttp.NewServer()
+
+		sourceURL := "http://source-baggageclaim"
+		destinationURL := destinationServer.URL()
+
+		fakeTransportDB = new(transportfakes.FakeTransportDB)
+		fakeTransportDB.GetWorkerStub = func(name string) (dbng.Worker, bool, error) {
+			worker := new(dbngfakes.FakeWorker)
+			worker.NameReturns(name)
+			worker.StateReturns(dbng.WorkerStateRunning)
+
+			switch name {
+			case "source-worker":
+				worker.BaggageclaimURLReturns(&sourceURL)
+			case "destination-worker":
+				worker.BaggageclaimURLReturns(&destinationURL)
+			default:
+				return nil, false, nil
+			}
+
+			return worker, true, nil
+		}
+
+		sourceVolume = new(workerfakes.FakeVolume)
+		sourceVolume.HandleReturns("source-handle")
+		sourceVolume.WorkerNameReturns("source-worker")
+
+		destinationVolume = new(workerfakes.FakeVolume)
+		destinationVolume.HandleReturns("destination-handle")
+		destinationVolume.WorkerNameReturns("destination-worker")
+
+		streamer = NewP2PStreamer(fakeTransportDB, key, 5*time.Minute, fakeClock)
+	})
+
+	AfterEach(func() {
+		destinationServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		streamedBytes, streamErr = streamer.StreamP2P(logger, sourceVolume, "some/path", destinationVolume, ".")
+	})
+
+	Context("when the destination pulls the volume", func() {
+		var requestedURL string
+
+		BeforeEach(func() {
+			destinationServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/volumes/destination-handle/stream-p2p-in", "path=."),
+					func(w http.ResponseWriter, r *http.Request) {
+						var request struct {
+							URL string `json:"url"`
+						}
+
+						Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+						requestedURL = request.URL
+					},
+					ghttp.RespondWith(http.StatusOK, `{"bytes":1024}`),
+				),
+			)
+		})
+
+		It("returns the number of bytes streamed", func() {
+			Expect(streamErr).ToNot(HaveOccurred())
+			Expect(streamedBytes).To(Equal(int64(1024)))
+		})
+
+		It("hands it a signed URL of the source volume which expires after the ttl", func() {
+			streamURL, err := url.Parse(requestedURL)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(streamURL.Host).To(Equal("source-baggageclaim"))
+			Expect(streamURL.Path).To(Equal("/volumes/source-handle/stream-out"))
+
+			query := streamURL.Query()
+			Expect(query.Get("path")).To(Equal("some/path"))
+			Expect(query.Get("expires")).To(Equal("1300"))
+			Expect(query.Get("signature")).To(Equal(StreamOutSignature("source-handle", "some/path", "1300", key)))
+		})
+	})
+
+	Context("when the destination does not support pulling volumes", func() {
+		BeforeEach(func() {
+			destinationServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusNotFound, ""),
+			)
+		})
+
+		It("returns ErrP2PStreamingUnsupported", func() {
+			Expect(streamErr).To(Equal(ErrP2PStreamingUnsupported))
+		})
+	})
+
+	Context("when the destination fails to pull the volume", func() {
+		BeforeEach(func() {
+			destinationServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusBadGateway, ""),
+			)
+		})
+
+		It("returns an error", func() {
+			Expect(streamErr).To(Equal(P2PStreamError{
+				WorkerName: "destination-worker",
+				Status:     http.StatusBadGateway,
+			}))
+		})
+	})
+
+	Context("when the source worker has gone away", func() {
+		BeforeEach(func() {
+			sourceVolume.WorkerNameReturns("bogus-worker")
+		})
+
+		It("returns an error without contacting the destination", func() {
+			Expect(streamErr).To(Equal(transport.WorkerMissingError{WorkerName: "bogus-worker"}))
+			Expect(destinationServer.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+})
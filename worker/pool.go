@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -58,6 +59,39 @@ func (err NoCompatibleWorkersError) Error() string {
 		availableWorkers += "\n  - " + worker.Description()
 	}
 
+	unmatchedSelectors := ""
+	for _, selector := range err.UnmatchedSelectors() {
+		unmatchedSelectors += "\n  - " + selector.String()
+	}
+
+	if unmatchedSelectors != "" {
+		return fmt.Sprintf(
+			"no workers satisfying: %s\n\nno workers match label selectors: %s\n\navailable workers: %s",
+			err.Spec.Description(),
+			unmatchedSelectors,
+			availableWorkers,
+		)
+	}
+
+	closestWorkers := ""
+	for _, mismatch := range err.ClosestWorkers() {
+		failing := []string{}
+		for _, selector := range mismatch.Selectors {
+			failing = append(failing, selector.String())
+		}
+
+		closestWorkers += "\n  - " + mismatch.Worker.Name() + ": " + strings.Join(failing, ", ")
+	}
+
+	if closestWorkers != "" {
+		return fmt.Sprintf(
+			"no workers satisfying: %s\n\nno single worker matches all label selectors; closest workers fail: %s\n\navailable workers: %s",
+			err.Spec.Description(),
+			closestWorkers,
+			availableWorkers,
+		)
+	}
+
 	return fmt.Sprintf(
 		"no workers satisfying: %s\n\navailable workers: %s",
 		err.Spec.Description(),
@@ -65,6 +99,73 @@ func (err NoCompatibleWorkersError) Error() string {
 	)
 }
 
+// UnmatchedSelectors returns the spec's label selectors which none of the
+// available workers match.
+func (err NoCompatibleWorkersError) UnmatchedSelectors() []atc.WorkerSelector {
+	unmatched := []atc.WorkerSelector{}
+
+selectors:
+	for _, selector := range err.Spec.Selectors() {
+		for _, worker := range err.Workers {
+			if selector.Matches(worker.Labels()) {
+				continue selectors
+			}
+		}
+
+		unmatched = append(unmatched, selector)
+	}
+
+	return unmatched
+}
+
+// WorkerSelectorMismatch is a worker along with the label selectors it fails
+// to match.
+type WorkerSelectorMismatch struct {
+	Worker    Worker
+	Selectors []atc.WorkerSelector
+}
+
+// ClosestWorkers returns the workers failing the fewest of the spec's label
+// selectors, along with the selectors each of them fails. It is only useful
+// when every selector is matched by some worker but no single worker matches
+// all of them, so it returns nothing if any worker matches every selector.
+func (err NoCompatibleWorkersError) ClosestWorkers() []WorkerSelectorMismatch {
+	selectors := err.Spec.Selectors()
+	if len(selectors) == 0 {
+		return nil
+	}
+
+	closest := []WorkerSelectorMismatch{}
+	fewest := len(selectors) + 1
+
+	for _, worker := range err.Workers {
+		failing := []atc.WorkerSelector{}
+		for _, selector := range selectors {
+			if !selector.Matches(worker.Labels()) {
+				failing = append(failing, selector)
+			}
+		}
+
+		if len(failing) == 0 {
+			return nil
+		}
+
+		if len(failing) < fewest {
+			fewest = len(failing)
+			closest = closest[:0]
+		}
+
+		if len(failing) == fewest {
+			closest = append(closest, WorkerSelectorMismatch{
+				Worker:    worker,
+				Selectors: failing,
+			})
+		}
+	}
+
+	return closest
+}
+
 type pool struct {
 	provider WorkerProvider
 
@@ -119,11 +220,11 @@ func (pool *pool) AllSatisfying(spec WorkerSpec, resourceTypes atc.VersionedReso
 	}
 
 	if len(compatibleTeamWorkers) != 0 {
-		return compatibleTeamWorkers, nil
+		return preferredWorkers(unavoidedWorkers(compatibleTeamWorkers, spec.AvoidWorkers), spec.Preferences()), nil
 	}
 
 	if len(compatibleGeneralWorkers) != 0 {
-		return compatibleGeneralWorkers, nil
+		return preferredWorkers(unavoidedWorkers(compatibleGeneralWorkers, spec.AvoidWorkers), spec.Preferences()), nil
 	}
 
 	return nil, NoCompatibleWorkersError{
@@ -132,6 +233,63 @@ func (pool *pool) AllSatisfying(spec WorkerSpec, resourceTypes atc.VersionedReso
 	}
 }
 
+// unavoidedWorkers leaves out the workers which are to be avoided, unless
+// that would leave none at all.
+func unavoidedWorkers(workers []Worker, avoid []string) []Worker {
+	if len(avoid) == 0 {
+		return workers
+	}
+
+	avoided := map[string]bool{}
+	for _, name := range avoid {
+		avoided[name] = true
+	}
+
+	unavoided := []Worker{}
+	for _, worker := range workers {
+		if !avoided[worker.Name()] {
+			unavoided = append(unavoided, worker)
+		}
+	}
+
+	if len(unavoided) == 0 {
+		return workers
+	}
+
+	return unavoided
+}
+
+// preferredWorkers narrows the workers down to those matching the most
+// preferences. If none of them match any preference, all of them are
+// returned.
+func preferredWorkers(workers []Worker, preferences []atc.WorkerSelector) []Worker {
+	if len(preferences) == 0 {
+		return workers
+	}
+
+	preferred := []Worker{}
+	highestScore := 0
+	for _, worker := range workers {
+		score := 0
+		for _, preference := range preferences {
+			if preference.Matches(worker.Labels()) {
+				score++
+			}
+		}
+
+		if score > highestScore {
+			highestScore = score
+			preferred = []Worker{}
+		}
+
+		if score == highestScore {
+			preferred = append(preferred, worker)
+		}
+	}
+
+	return preferred
+}
+
 func (pool *pool) Satisfying(spec WorkerSpec, resourceTypes atc.VersionedResourceTypes) (Worker, error) {
 	compatibleWorkers, err := pool.AllSatisfying(spec, resourceTypes)
 	if err != nil {
@@ -283,6 +283,152 @@ var _ = Describe("Pool", func() {
 						Workers: []Worker{workerA, workerB, workerC},
 					}))
 				})
+
+				Context("when the spec has label selectors no worker matches", func() {
+					BeforeEach(func() {
+						spec.Placement = &atc.WorkerPlacement{
+							Selectors: []atc.WorkerSelector{
+								{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+								{Key: "gpu", Operator: atc.WorkerSelectorExists},
+							},
+						}
+
+						workerA.LabelsReturns(map[string]string{"zone": "a"})
+						workerB.LabelsReturns(map[string]string{"zone": "b"})
+					})
+
+					It("lists the unmatched selectors in the error", func() {
+						noCompatibleErr, ok := satisfyingErr.(NoCompatibleWorkersError)
+						Expect(ok).To(BeTrue())
+						Expect(noCompatibleErr.UnmatchedSelectors()).To(Equal([]atc.WorkerSelector{
+							{Key: "gpu", Operator: atc.WorkerSelectorExists},
+						}))
+						Expect(noCompatibleErr.Error()).To(ContainSubstring("no workers match label selectors: \n  - gpu exists"))
+					})
+				})
+
+				Context("when every label selector is matched by some worker but none match them all", func() {
+					BeforeEach(func() {
+						spec.Placement = &atc.WorkerPlacement{
+							Selectors: []atc.WorkerSelector{
+								{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+								{Key: "gpu", Operator: atc.WorkerSelectorExists},
+							},
+						}
+
+						workerA.NameReturns("worker-a")
+						workerA.LabelsReturns(map[string]string{"zone": "a"})
+						workerB.NameReturns("worker-b")
+						workerB.LabelsReturns(map[string]string{"zone": "b", "gpu": "true"})
+						workerC.NameReturns("worker-c")
+						workerC.LabelsReturns(map[string]string{})
+					})
+
+					It("has no unmatched selectors", func() {
+						noCompatibleErr, ok := satisfyingErr.(NoCompatibleWorkersError)
+						Expect(ok).To(BeTrue())
+						Expect(noCompatibleErr.UnmatchedSelectors()).To(BeEmpty())
+					})
+
+					It("lists the selectors failed by the closest workers in the error", func() {
+						noCompatibleErr, ok := satisfyingErr.(NoCompatibleWorkersError)
+						Expect(ok).To(BeTrue())
+						Expect(noCompatibleErr.ClosestWorkers()).To(Equal([]WorkerSelectorMismatch{
+							{
+								Worker:    workerA,
+								Selectors: []atc.WorkerSelector{{Key: "gpu", Operator: atc.WorkerSelectorExists}},
+							},
+							{
+								Worker:    workerB,
+								Selectors: []atc.WorkerSelector{{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}}},
+							},
+						}))
+						Expect(noCompatibleErr.Error()).To(ContainSubstring("closest workers fail: \n  - worker-a: gpu exists\n  - worker-b: zone in (a)"))
+					})
+				})
+
+				Context("when some worker matches every label selector", func() {
+					BeforeEach(func() {
+						spec.Placement = &atc.WorkerPlacement{
+							Selectors: []atc.WorkerSelector{
+								{Key: "gpu", Operator: atc.WorkerSelectorExists},
+							},
+						}
+
+						workerA.LabelsReturns(map[string]string{"gpu": "true"})
+					})
+
+					It("does not blame the label selectors", func() {
+						noCompatibleErr, ok := satisfyingErr.(NoCompatibleWorkersError)
+						Expect(ok).To(BeTrue())
+						Expect(noCompatibleErr.ClosestWorkers()).To(BeEmpty())
+						Expect(noCompatibleErr.Error()).NotTo(ContainSubstring("label selectors"))
+					})
+				})
+			})
+
+			Context("when the spec has preferences", func() {
+				BeforeEach(func() {
+					spec.Placement = &atc.WorkerPlacement{
+						Preferences: []atc.WorkerSelector{
+							{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+							{Key: "disk", Operator: atc.WorkerSelectorIn, Values: []string{"ssd"}},
+						},
+					}
+				})
+
+				Context("when some workers match more preferences than others", func() {
+					BeforeEach(func() {
+						workerA.LabelsReturns(map[string]string{"zone": "a"})
+						workerB.LabelsReturns(map[string]string{"zone": "a", "disk": "ssd"})
+					})
+
+					It("returns only the workers matching the most preferences", func() {
+						Expect(satisfyingErr).NotTo(HaveOccurred())
+						Expect(satisfyingWorkers).To(ConsistOf(workerB))
+					})
+				})
+
+				Context("when no workers match any preference", func() {
+					BeforeEach(func() {
+						workerA.LabelsReturns(map[string]string{"zone": "b"})
+						workerB.LabelsReturns(nil)
+					})
+
+					It("returns all workers satisfying the spec", func() {
+						Expect(satisfyingErr).NotTo(HaveOccurred())
+						Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
+					})
+				})
+			})
+
+			Context("when the spec avoids some workers", func() {
+				BeforeEach(func() {
+					workerA.NameReturns("worker-a")
+					workerB.NameReturns("worker-b")
+				})
+
+				Context("when other workers satisfy the spec", func() {
+					BeforeEach(func() {
+						spec.AvoidWorkers = []string{"worker-a"}
+					})
+
+					It("leaves out the avoided workers", func() {
+						Expect(satisfyingErr).NotTo(HaveOccurred())
+						Expect(satisfyingWorkers).To(ConsistOf(workerB))
+					})
+				})
+
+				Context("when every satisfying worker is avoided", func() {
+					BeforeEach(func() {
+						spec.AvoidWorkers = []string{"worker-a", "worker-b"}
+					})
+
+					It("returns them anyway", func() {
+						Expect(satisfyingErr).NotTo(HaveOccurred())
+						Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
+					})
+				})
 			})
 		})
 
@@ -12,6 +12,7 @@ import (
 type Volume interface {
 	Handle() string
 	Path() string
+	WorkerName() string
 
 	SetProperty(key string, value string) error
 	Properties() (baggageclaim.VolumeProperties, error)
@@ -19,6 +20,8 @@ type Volume interface {
 	StreamIn(path string, tarStream io.Reader) error
 	StreamOut(path string) (io.ReadCloser, error)
 
+	SizeInBytes() (int64, error)
+
 	COWStrategy() baggageclaim.COWStrategy
 
 	IsInitialized() (bool, error)
@@ -53,6 +56,8 @@ func (v *volume) Handle() string { return v.bcVolume.Handle() }
 
 func (v *volume) Path() string { return v.bcVolume.Path() }
 
+func (v *volume) WorkerName() string { return v.dbVolume.Worker().Name() }
+
 func (v *volume) SetProperty(key string, value string) error {
 	return v.bcVolume.SetProperty(key, value)
 }
@@ -65,6 +70,10 @@ func (v *volume) StreamOut(path string) (io.ReadCloser, error) {
 	return v.bcVolume.StreamOut(path)
 }
 
+func (v *volume) SizeInBytes() (int64, error) {
+	return v.bcVolume.SizeInBytes()
+}
+
 func (v *volume) Properties() (baggageclaim.VolumeProperties, error) {
 	return v.bcVolume.Properties()
 }
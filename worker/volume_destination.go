@@ -0,0 +1,93 @@
+package worker
+
+import "code.cloudfoundry.org/lager"
+
+// EncodedArtifactDestination is an ArtifactDestination that can have a
+// volume's contents relayed into it compressed, rather than as a plain tar
+// stream.
+type EncodedArtifactDestination interface {
+	ArtifactDestination
+
+	// StreamInEncodedFrom relays the path of the source volume into the
+	// destination directory, returning the number of compressed bytes relayed.
+	StreamInEncodedFrom(path string, source Volume, sourcePath string) (int64, error)
+}
+
+type volumeDestination struct {
+	Volume
+
+	logger         lager.Logger
+	p2pStreamer    P2PStreamer
+	volumeStreamer VolumeStreamer
+}
+
+// VolumeDestination wraps a volume as an ArtifactDestination which pulls
+// volumes directly from other workers using the P2PStreamer, and has them
+// relayed compressed using the VolumeStreamer. Either may be nil, in which
+// case it is not used.
+func VolumeDestination(
+	volume Volume,
+	logger lager.Logger,
+	p2pStreamer P2PStreamer,
+	volumeStreamer VolumeStreamer,
+) ArtifactDestination {
+	return volumeDestination{
+		Volume:         volume,
+		logger:         logger,
+		p2pStreamer:    p2pStreamer,
+		volumeStreamer: volumeStreamer,
+	}
+}
+
+func (dest volumeDestination) StreamInFrom(path string, source Volume, sourcePath string) (int64, error) {
+	if dest.p2pStreamer == nil {
+		return 0, ErrP2PStreamingUnsupported
+	}
+
+	return dest.p2pStreamer.StreamP2P(dest.logger, source, sourcePath, dest.Volume, path)
+}
+
+func (dest volumeDestination) StreamInEncodedFrom(path string, source Volume, sourcePath string) (int64, error) {
+	if dest.volumeStreamer == nil {
+		return 0, ErrEncodedStreamingUnsupported
+	}
+
+	return dest.volumeStreamer.StreamVolume(dest.logger, source, sourcePath, dest.Volume, path)
+}
+
+// StreamVolumeTo streams the contents of a volume into the destination. If
+// the destination can pull the contents directly from the volume's worker it
+// does so; otherwise, if it can have them relayed compressed, they are.
+// Failing both, they are streamed through the ATC as a plain tar stream.
+func StreamVolumeTo(logger lager.Logger, volume Volume, destination ArtifactDestination) error {
+	if p2p, ok := destination.(P2PArtifactDestination); ok {
+		_, err := p2p.StreamInFrom(".", volume, ".")
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrP2PStreamingUnsupported {
+			logger.Error("failed-to-stream-p2p", err)
+		}
+	}
+
+	if encoded, ok := destination.(EncodedArtifactDestination); ok {
+		_, err := encoded.StreamInEncodedFrom(".", volume, ".")
+		if err == nil {
+			return nil
+		}
+
+		if err != ErrEncodedStreamingUnsupported {
+			logger.Error("failed-to-stream-encoded", err)
+		}
+	}
+
+	out, err := volume.StreamOut(".")
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	return destination.StreamIn(".", out)
+}
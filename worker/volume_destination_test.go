@@ -0,0 +1,170 @@
+package worker_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StreamVolumeTo", func() {
+	var (
+		logger             *lagertest.TestLogger
+		volume             *workerfakes.FakeVolume
+		destination        *workerfakes.FakeArtifactDestination
+		fakeP2PStreamer    *workerfakes.FakeP2PStreamer
+		fakeVolumeStreamer *workerfakes.FakeVolumeStreamer
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		volume = new(workerfakes.FakeVolume)
+		volume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-stream")), nil)
+
+		destination = new(workerfakes.FakeArtifactDestination)
+		fakeP2PStreamer = new(workerfakes.FakeP2PStreamer)
+		fakeVolumeStreamer = new(workerfakes.FakeVolumeStreamer)
+	})
+
+	It("streams the volume through the ATC", func() {
+		Expect(StreamVolumeTo(logger, volume, destination)).To(Succeed())
+
+		Expect(volume.StreamOutArgsForCall(0)).To(Equal("."))
+
+		dst, from := destination.StreamInArgsForCall(0)
+		Expect(dst).To(Equal("."))
+		Expect(ioutil.ReadAll(from)).To(Equal([]byte("some-stream")))
+	})
+
+	Context("when the destination is a volume", func() {
+		var (
+			destinationVolume *workerfakes.FakeVolume
+
+			p2pStreamer    P2PStreamer
+			volumeStreamer VolumeStreamer
+
+			counter *CountingDestination
+		)
+
+		BeforeEach(func() {
+			destinationVolume = new(workerfakes.FakeVolume)
+			destinationVolume.StreamInStub = func(path string, tarStream io.Reader) error {
+				_, err := ioutil.ReadAll(tarStream)
+				return err
+			}
+
+			p2pStreamer = nil
+			volumeStreamer = nil
+		})
+
+		JustBeforeEach(func() {
+			counter = &CountingDestination{
+				Destination: VolumeDestination(destinationVolume, logger, p2pStreamer, volumeStreamer),
+			}
+
+			Expect(StreamVolumeTo(logger, volume, counter)).To(Succeed())
+		})
+
+		Context("without any streamers", func() {
+			It("streams the volume through the ATC", func() {
+				Expect(volume.StreamOutCallCount()).To(Equal(1))
+				Expect(counter.Bytes()).To(Equal(int64(len("some-stream"))))
+				Expect(counter.P2P()).To(BeFalse())
+			})
+		})
+
+		Context("with a p2p streamer", func() {
+			BeforeEach(func() {
+				p2pStreamer = fakeP2PStreamer
+			})
+
+			Context("when pulling succeeds", func() {
+				BeforeEach(func() {
+					fakeP2PStreamer.StreamP2PReturns(1024, nil)
+				})
+
+				It("does not stream the volume through the ATC", func() {
+					Expect(fakeP2PStreamer.StreamP2PCallCount()).To(Equal(1))
+					_, source, sourcePath, dest, destPath := fakeP2PStreamer.StreamP2PArgsForCall(0)
+					Expect(source).To(Equal(volume))
+					Expect(sourcePath).To(Equal("."))
+					Expect(dest).To(Equal(destinationVolume))
+					Expect(destPath).To(Equal("."))
+
+					Expect(volume.StreamOutCallCount()).To(BeZero())
+
+					Expect(counter.Bytes()).To(Equal(int64(1024)))
+					Expect(counter.P2P()).To(BeTrue())
+				})
+			})
+
+			Context("when pulling fails", func() {
+				BeforeEach(func() {
+					fakeP2PStreamer.StreamP2PReturns(0, errors.New("nope"))
+				})
+
+				It("falls back to streaming the volume through the ATC", func() {
+					Expect(volume.StreamOutCallCount()).To(Equal(1))
+					Expect(counter.Bytes()).To(Equal(int64(len("some-stream"))))
+					Expect(counter.P2P()).To(BeFalse())
+				})
+			})
+		})
+
+		Context("with a volume streamer", func() {
+			BeforeEach(func() {
+				volumeStreamer = fakeVolumeStreamer
+			})
+
+			Context("when relaying succeeds", func() {
+				BeforeEach(func() {
+					fakeVolumeStreamer.StreamVolumeReturns(512, nil)
+				})
+
+				It("relays the volume compressed rather than as a plain tar stream", func() {
+					Expect(fakeVolumeStreamer.StreamVolumeCallCount()).To(Equal(1))
+					_, source, sourcePath, dest, destPath := fakeVolumeStreamer.StreamVolumeArgsForCall(0)
+					Expect(source).To(Equal(volume))
+					Expect(sourcePath).To(Equal("."))
+					Expect(dest).To(Equal(destinationVolume))
+					Expect(destPath).To(Equal("."))
+
+					Expect(volume.StreamOutCallCount()).To(BeZero())
+
+					Expect(counter.Bytes()).To(Equal(int64(512)))
+					Expect(counter.P2P()).To(BeFalse())
+				})
+			})
+
+			Context("when relaying fails", func() {
+				BeforeEach(func() {
+					fakeVolumeStreamer.StreamVolumeReturns(0, errors.New("nope"))
+				})
+
+				It("falls back to streaming a plain tar stream", func() {
+					Expect(volume.StreamOutCallCount()).To(Equal(1))
+					Expect(counter.Bytes()).To(Equal(int64(len("some-stream"))))
+				})
+			})
+
+			Context("and a p2p streamer which succeeds", func() {
+				BeforeEach(func() {
+					p2pStreamer = fakeP2PStreamer
+					fakeP2PStreamer.StreamP2PReturns(1024, nil)
+				})
+
+				It("does not relay the volume", func() {
+					Expect(fakeVolumeStreamer.StreamVolumeCallCount()).To(BeZero())
+					Expect(counter.P2P()).To(BeTrue())
+				})
+			})
+		})
+	})
+})
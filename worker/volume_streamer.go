@@ -0,0 +1,230 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/worker/transport"
+)
+
+// StreamEncoding is a compression encoding in which Baggageclaim streams
+// volumes.
+type StreamEncoding string
+
+const (
+	GzipEncoding StreamEncoding = "gzip"
+	ZstdEncoding StreamEncoding = "zstd"
+)
+
+// DefaultStreamEncodings are the encodings used for streaming volumes, in
+// order of preference.
+var DefaultStreamEncodings = []StreamEncoding{ZstdEncoding, GzipEncoding}
+
+// ErrEncodedStreamingUnsupported is returned when a destination cannot have
+// volumes relayed into it in an encoding negotiated with their workers.
+var ErrEncodedStreamingUnsupported = errors.New("encoded streaming is not supported")
+
+// NegotiateStreamEncoding returns the first of the preferred encodings that
+// both workers advertise. Every worker supports gzip whether or not it
+// advertises it, so gzip is returned if they have nothing else in common.
+func NegotiateStreamEncoding(preferred []StreamEncoding, source []string, destination []string) StreamEncoding {
+	for _, encoding := range preferred {
+		if encoding == GzipEncoding {
+			break
+		}
+
+		if hasEncoding(source, encoding) && hasEncoding(destination, encoding) {
+			return encoding
+		}
+	}
+
+	return GzipEncoding
+}
+
+func hasEncoding(encodings []string, encoding StreamEncoding) bool {
+	for _, e := range encodings {
+		if StreamEncoding(e) == encoding {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VolumeStreamError is returned when a worker's Baggageclaim fails to stream
+// a volume out or in.
+type VolumeStreamError struct {
+	WorkerName string
+	Action     string
+	Status     int
+}
+
+func (err VolumeStreamError) Error() string {
+	return fmt.Sprintf("worker '%s' failed to %s volume (status %d)", err.WorkerName, err.Action, err.Status)
+}
+
+// StreamEncodingMismatchError is returned when a worker streams a volume out
+// in an encoding other than the one that was negotiated.
+type StreamEncodingMismatchError struct {
+	WorkerName string
+	Expected   StreamEncoding
+	Actual     StreamEncoding
+}
+
+func (err StreamEncodingMismatchError) Error() string {
+	return fmt.Sprintf("worker '%s' streamed volume as %s rather than %s", err.WorkerName, err.Actual, err.Expected)
+}
+
+//go:generate counterfeiter . VolumeStreamer
+
+// VolumeStreamer relays volumes from one worker's Baggageclaim to another's
+// through the ATC, compressed in an encoding negotiated with both workers.
+// The compressed stream is passed through as-is, so the ATC spends no time
+// decompressing and recompressing it.
+type VolumeStreamer interface {
+	// StreamVolume streams the path of the source volume into the destination
+	// volume, returning the number of compressed bytes relayed.
+	StreamVolume(
+		logger lager.Logger,
+		source Volume,
+		sourcePath string,
+		destination Volume,
+		destinationPath string,
+	) (int64, error)
+}
+
+type volumeStreamer struct {
+	db        transport.TransportDB
+	encodings []StreamEncoding
+
+	httpClient *http.Client
+}
+
+// NewVolumeStreamer constructs a VolumeStreamer which uses the first of the
+// given encodings that both workers support.
+func NewVolumeStreamer(db transport.TransportDB, encodings []StreamEncoding) VolumeStreamer {
+	return &volumeStreamer{
+		db:        db,
+		encodings: encodings,
+
+		httpClient: &http.Client{
+			Transport: &http.Transport{DisableKeepAlives: true},
+		},
+	}
+}
+
+func (streamer *volumeStreamer) StreamVolume(
+	logger lager.Logger,
+	source Volume,
+	sourcePath string,
+	destination Volume,
+	destinationPath string,
+) (int64, error) {
+	sourceWorker, err := reachableWorker(streamer.db, source.WorkerName())
+	if err != nil {
+		return 0, err
+	}
+
+	destinationWorker, err := reachableWorker(streamer.db, destination.WorkerName())
+	if err != nil {
+		return 0, err
+	}
+
+	encoding := NegotiateStreamEncoding(
+		streamer.encodings,
+		sourceWorker.StreamEncodings(),
+		destinationWorker.StreamEncodings(),
+	)
+
+	logger = logger.Session("stream-volume", lager.Data{
+		"source":      source.Handle(),
+		"destination": destination.Handle(),
+		"encoding":    encoding,
+	})
+
+	outRequest, err := http.NewRequest(
+		"GET",
+		fmt.Sprintf("%s/volumes/%s/stream-out?path=%s", *sourceWorker.BaggageclaimURL(), source.Handle(), url.QueryEscape(sourcePath)),
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	outRequest.Header.Set("Accept-Encoding", string(encoding))
+
+	outResponse, err := streamer.httpClient.Do(outRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	defer outResponse.Body.Close()
+
+	if outResponse.StatusCode != http.StatusOK {
+		io.Copy(ioutil.Discard, outResponse.Body)
+		return 0, VolumeStreamError{
+			WorkerName: source.WorkerName(),
+			Action:     "stream out",
+			Status:     outResponse.StatusCode,
+		}
+	}
+
+	// workers that predate negotiation stream gzip without saying so
+	actual := StreamEncoding(outResponse.Header.Get("Content-Encoding"))
+	if actual == "" {
+		actual = GzipEncoding
+	}
+
+	if actual != encoding {
+		return 0, StreamEncodingMismatchError{
+			WorkerName: source.WorkerName(),
+			Expected:   encoding,
+			Actual:     actual,
+		}
+	}
+
+	var relayed int64
+
+	inRequest, err := http.NewRequest(
+		"PUT",
+		fmt.Sprintf("%s/volumes/%s/stream-in?path=%s", *destinationWorker.BaggageclaimURL(), destination.Handle(), url.QueryEscape(destinationPath)),
+		&countingReader{
+			reader: outResponse.Body,
+			count:  &relayed,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	inRequest.Header.Set("Content-Encoding", string(encoding))
+
+	inResponse, err := streamer.httpClient.Do(inRequest)
+	if err != nil {
+		return 0, err
+	}
+
+	defer inResponse.Body.Close()
+
+	io.Copy(ioutil.Discard, inResponse.Body)
+
+	if inResponse.StatusCode != http.StatusOK && inResponse.StatusCode != http.StatusNoContent {
+		return 0, VolumeStreamError{
+			WorkerName: destination.WorkerName(),
+			Action:     "stream in",
+			Status:     inResponse.StatusCode,
+		}
+	}
+
+	bytes := atomic.LoadInt64(&relayed)
+
+	logger.Debug("streamed", lager.Data{"bytes": bytes})
+
+	return bytes, nil
+}
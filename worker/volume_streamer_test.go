@@ -0,0 +1,219 @@
+package worker_test
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	. "github.com/concourse/atc/worker"
+	"github.com/concourse/atc/worker/transport/transportfakes"
+	"github.com/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("NegotiateStreamEncoding", func() {
+	It("picks the first preferred encoding that both workers support", func() {
+		Expect(NegotiateStreamEncoding(
+			DefaultStreamEncodings,
+			[]string{"zstd"},
+			[]string{"gzip", "zstd"},
+		)).To(Equal(ZstdEncoding))
+	})
+
+	It("falls back to gzip if only one worker supports anything else", func() {
+		Expect(NegotiateStreamEncoding(
+			DefaultStreamEncodings,
+			[]string{"zstd"},
+			nil,
+		)).To(Equal(GzipEncoding))
+	})
+
+	It("respects a preference for gzip", func() {
+		Expect(NegotiateStreamEncoding(
+			[]StreamEncoding{GzipEncoding, ZstdEncoding},
+			[]string{"zstd"},
+			[]string{"zstd"},
+		)).To(Equal(GzipEncoding))
+	})
+})
+
+var _ = Describe("VolumeStreamer", func() {
+	var (
+		logger          *lagertest.TestLogger
+		fakeTransportDB *transportfakes.FakeTransportDB
+
+		sourceServer      *ghttp.Server
+		destinationServer *ghttp.Server
+
+		sourceEncodings      []string
+		destinationEncodings []string
+
+		sourceVolume      *workerfakes.FakeVolume
+		destinationVolume *workerfakes.FakeVolume
+
+		streamer VolumeStreamer
+
+		streamedBytes int64
+		streamErr     error
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		sourceServer = ghttp.NewServer()
+		destinationServer = ghttp.NewServer()
+
+		sourceEncodings = []string{"zstd"}
+		destinationEncodings = []string{"zstd"}
+
+		fakeTransportDB = new(transportfakes.FakeTransportDB)
+		fakeTransportDB.GetWorkerStub = func(name string) (dbng.Worker, bool, error) {
+			worker := new(dbngfakes.FakeWorker)
+			worker.NameReturns(name)
+
+			switch name {
+			case "source-worker":
+				url := sourceServer.URL()
+				worker.BaggageclaimURLReturns(&url)
+				worker.StreamEncodingsReturns(sourceEncodings)
+			case "destination-worker":
+				url := destinationServer.URL()
+				worker.BaggageclaimURLReturns(&url)
+				worker.StreamEncodingsReturns(destinationEncodings)
+			default:
+				return nil, false, nil
+			}
+
+			return worker, true, nil
+		}
+
+		sourceVolume = new(workerfakes.FakeVolume)
+		sourceVolume.HandleReturns("source-handle")
+		sourceVolume.WorkerNameReturns("source-worker")
+
+		destinationVolume = new(workerfakes.FakeVolume)
+		destinationVolume.HandleReturns("destination-handle")
+		destinationVolume.WorkerNameReturns("destination-worker")
+
+		streamer = NewVolumeStreamer(fakeTransportDB, DefaultStreamEncodings)
+	})
+
+	AfterEach(func() {
+		sourceServer.Close()
+		destinationServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		streamedBytes, streamErr = streamer.StreamVolume(logger, sourceVolume, "some/path", destinationVolume, ".")
+	})
+
+	expectRelayed := func(encoding string) {
+		sourceServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/volumes/source-handle/stream-out", "path=some%2Fpath"),
+				ghttp.VerifyHeaderKV("Accept-Encoding", encoding),
+				ghttp.RespondWith(http.StatusOK, "compressed-stream", http.Header{
+					"Content-Encoding": []string{encoding},
+				}),
+			),
+		)
+
+		destinationServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/volumes/destination-handle/stream-in", "path=."),
+				ghttp.VerifyHeaderKV("Content-Encoding", encoding),
+				func(w http.ResponseWriter, r *http.Request) {
+					Expect(ioutil.ReadAll(r.Body)).To(Equal([]byte("compressed-stream")))
+				},
+				ghttp.RespondWith(http.StatusNoContent, ""),
+			),
+		)
+	}
+
+	Context("when both workers support zstd", func() {
+		BeforeEach(func() {
+			expectRelayed("zstd")
+		})
+
+		It("relays the zstd stream as-is", func() {
+			Expect(streamErr).ToNot(HaveOccurred())
+			Expect(sourceServer.ReceivedRequests()).To(HaveLen(1))
+			Expect(destinationServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns the number of compressed bytes relayed", func() {
+			Expect(streamedBytes).To(Equal(int64(len("compressed-stream"))))
+		})
+	})
+
+	Context("when the destination does not advertise zstd", func() {
+		BeforeEach(func() {
+			destinationEncodings = nil
+			expectRelayed("gzip")
+		})
+
+		It("relays gzip", func() {
+			Expect(streamErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when the source does not stream the negotiated encoding", func() {
+		BeforeEach(func() {
+			sourceServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, "gzipped-stream"),
+			)
+		})
+
+		It("returns an error without streaming to the destination", func() {
+			Expect(streamErr).To(Equal(StreamEncodingMismatchError{
+				WorkerName: "source-worker",
+				Expected:   ZstdEncoding,
+				Actual:     GzipEncoding,
+			}))
+
+			Expect(destinationServer.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("when the source fails to stream out", func() {
+		BeforeEach(func() {
+			sourceServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+		})
+
+		It("returns an error", func() {
+			Expect(streamErr).To(Equal(VolumeStreamError{
+				WorkerName: "source-worker",
+				Action:     "stream out",
+				Status:     http.StatusInternalServerError,
+			}))
+		})
+	})
+
+	Context("when the destination fails to stream in", func() {
+		BeforeEach(func() {
+			sourceServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, "compressed-stream", http.Header{
+					"Content-Encoding": []string{"zstd"},
+				}),
+			)
+
+			destinationServer.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+		})
+
+		It("returns an error", func() {
+			Expect(streamErr).To(Equal(VolumeStreamError{
+				WorkerName: "destination-worker",
+				Action:     "stream in",
+				Status:     http.StatusInternalServerError,
+			}))
+		})
+	})
+})
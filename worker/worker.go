@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,14 @@ func (err MalformedMetadataError) Error() string {
 	return fmt.Sprintf("malformed image metadata: %s", err.UnmarshalError)
 }
 
+type MismatchedSelectorError struct {
+	Selector atc.WorkerSelector
+}
+
+func (err MismatchedSelectorError) Error() string {
+	return fmt.Sprintf("mismatched label selector: %s", err.Selector)
+}
+
 const ephemeralPropertyName = "concourse:ephemeral"
 const volumePropertyName = "concourse:volumes"
 const volumeMountsPropertyName = "concourse:volume-mounts"
@@ -47,6 +56,7 @@ type Worker interface {
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() map[string]string
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 }
@@ -65,6 +75,7 @@ type gardenWorker struct {
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             atc.Tags
+	labels           map[string]string
 	teamID           int
 	name             string
 	startTime        int64
@@ -80,6 +91,7 @@ func NewGardenWorker(
 	resourceTypes []atc.WorkerResourceType,
 	platform string,
 	tags atc.Tags,
+	labels map[string]string,
 	teamID int,
 	name string,
 	startTime int64,
@@ -96,6 +108,7 @@ func NewGardenWorker(
 		resourceTypes:    resourceTypes,
 		platform:         platform,
 		tags:             tags,
+		labels:           labels,
 		teamID:           teamID,
 		name:             name,
 		startTime:        startTime,
@@ -243,6 +256,12 @@ func (worker *gardenWorker) Satisfying(spec WorkerSpec, resourceTypes atc.Versio
 		return nil, ErrMismatchedTags
 	}
 
+	for _, selector := range spec.Selectors() {
+		if !selector.Matches(worker.labels) {
+			return nil, MismatchedSelectorError{Selector: selector}
+		}
+	}
+
 	return worker, nil
 }
 
@@ -282,6 +301,17 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	labelKeys := []string{}
+	for key := range worker.labels {
+		labelKeys = append(labelKeys, key)
+	}
+
+	sort.Strings(labelKeys)
+
+	for _, key := range labelKeys {
+		messages = append(messages, fmt.Sprintf("label '%s=%s'", key, worker.labels[key]))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -297,6 +327,10 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.tags
 }
 
+func (worker *gardenWorker) Labels() map[string]string {
+	return worker.labels
+}
+
 func (worker *gardenWorker) IsOwnedByTeam() bool {
 	return worker.teamID != 0
 }
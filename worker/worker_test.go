@@ -32,6 +32,7 @@ var _ = Describe("Worker", func() {
 		resourceTypes                []atc.WorkerResourceType
 		platform                     string
 		tags                         atc.Tags
+		labels                       map[string]string
 		teamID                       int
 		workerName                   string
 		workerStartTime              int64
@@ -55,6 +56,7 @@ var _ = Describe("Worker", func() {
 		}
 		platform = "some-platform"
 		tags = atc.Tags{"some", "tags"}
+		labels = map[string]string{"zone": "a", "disk": "ssd"}
 		teamID = 17
 		workerName = "some-worker"
 		workerStartTime = fakeClock.Now().Unix()
@@ -79,6 +81,7 @@ var _ = Describe("Worker", func() {
 			resourceTypes,
 			platform,
 			tags,
+			labels,
 			teamID,
 			workerName,
 			workerStartTime,
@@ -482,5 +485,54 @@ var _ = Describe("Worker", func() {
 				})
 			})
 		})
+
+		Context("when the spec has label selectors", func() {
+			BeforeEach(func() {
+				spec.Placement = &atc.WorkerPlacement{}
+			})
+
+			Context("when every selector matches the worker's labels", func() {
+				BeforeEach(func() {
+					spec.Placement.Selectors = []atc.WorkerSelector{
+						{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a", "b"}},
+						{Key: "disk", Operator: atc.WorkerSelectorExists},
+						{Key: "gpu", Operator: atc.WorkerSelectorNotIn, Values: []string{"yes"}},
+					}
+				})
+
+				It("returns the worker", func() {
+					Expect(satisfyingErr).NotTo(HaveOccurred())
+					Expect(satisfyingWorker).To(Equal(gardenWorker))
+				})
+			})
+
+			Context("when a selector does not match the worker's labels", func() {
+				BeforeEach(func() {
+					spec.Placement.Selectors = []atc.WorkerSelector{
+						{Key: "zone", Operator: atc.WorkerSelectorIn, Values: []string{"a"}},
+						{Key: "disk", Operator: atc.WorkerSelectorNotIn, Values: []string{"ssd"}},
+					}
+				})
+
+				It("returns a MismatchedSelectorError for the selector", func() {
+					Expect(satisfyingErr).To(Equal(MismatchedSelectorError{
+						Selector: atc.WorkerSelector{Key: "disk", Operator: atc.WorkerSelectorNotIn, Values: []string{"ssd"}},
+					}))
+				})
+			})
+
+			Context("when only preferences do not match the worker's labels", func() {
+				BeforeEach(func() {
+					spec.Placement.Preferences = []atc.WorkerSelector{
+						{Key: "gpu", Operator: atc.WorkerSelectorExists},
+					}
+				})
+
+				It("returns the worker", func() {
+					Expect(satisfyingErr).NotTo(HaveOccurred())
+					Expect(satisfyingWorker).To(Equal(gardenWorker))
+				})
+			})
+		})
 	})
 })
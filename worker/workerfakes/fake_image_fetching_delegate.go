@@ -4,6 +4,7 @@ package workerfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"github.com/concourse/atc/worker"
 )
@@ -29,6 +30,17 @@ type FakeImageFetchingDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ImageFetchedStub        func(worker.StreamStats)
+	imageFetchedMutex       sync.RWMutex
+	imageFetchedArgsForCall []struct {
+		arg1 worker.StreamStats
+	}
+	VolumeCreatedStub        func(name string, duration time.Duration)
+	volumeCreatedMutex       sync.RWMutex
+	volumeCreatedArgsForCall []struct {
+		name     string
+		duration time.Duration
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -121,6 +133,55 @@ func (fake *FakeImageFetchingDelegate) ImageVersionDeterminedReturnsOnCall(i int
 	}{result1}
 }
 
+func (fake *FakeImageFetchingDelegate) ImageFetched(arg1 worker.StreamStats) {
+	fake.imageFetchedMutex.Lock()
+	fake.imageFetchedArgsForCall = append(fake.imageFetchedArgsForCall, struct {
+		arg1 worker.StreamStats
+	}{arg1})
+	fake.recordInvocation("ImageFetched", []interface{}{arg1})
+	fake.imageFetchedMutex.Unlock()
+	if fake.ImageFetchedStub != nil {
+		fake.ImageFetchedStub(arg1)
+	}
+}
+
+func (fake *FakeImageFetchingDelegate) ImageFetchedCallCount() int {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return len(fake.imageFetchedArgsForCall)
+}
+
+func (fake *FakeImageFetchingDelegate) ImageFetchedArgsForCall(i int) worker.StreamStats {
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	return fake.imageFetchedArgsForCall[i].arg1
+}
+
+func (fake *FakeImageFetchingDelegate) VolumeCreated(name string, duration time.Duration) {
+	fake.volumeCreatedMutex.Lock()
+	fake.volumeCreatedArgsForCall = append(fake.volumeCreatedArgsForCall, struct {
+		name     string
+		duration time.Duration
+	}{name, duration})
+	fake.recordInvocation("VolumeCreated", []interface{}{name, duration})
+	fake.volumeCreatedMutex.Unlock()
+	if fake.VolumeCreatedStub != nil {
+		fake.VolumeCreatedStub(name, duration)
+	}
+}
+
+func (fake *FakeImageFetchingDelegate) VolumeCreatedCallCount() int {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return len(fake.volumeCreatedArgsForCall)
+}
+
+func (fake *FakeImageFetchingDelegate) VolumeCreatedArgsForCall(i int) (string, time.Duration) {
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
+	return fake.volumeCreatedArgsForCall[i].name, fake.volumeCreatedArgsForCall[i].duration
+}
+
 func (fake *FakeImageFetchingDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -128,6 +189,10 @@ func (fake *FakeImageFetchingDelegate) Invocations() map[string][][]interface{}
 	defer fake.stderrMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.imageFetchedMutex.RLock()
+	defer fake.imageFetchedMutex.RUnlock()
+	fake.volumeCreatedMutex.RLock()
+	defer fake.volumeCreatedMutex.RUnlock()
 	return fake.invocations
 }
 
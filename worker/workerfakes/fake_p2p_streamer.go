@@ -0,0 +1,108 @@
+// This file was generated by counterfeiter
+package workerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/worker"
+)
+
+type FakeP2PStreamer struct {
+	StreamP2PStub        func(logger lager.Logger, source worker.Volume, sourcePath string, destination worker.Volume, destinationPath string) (int64, error)
+	streamP2PMutex       sync.RWMutex
+	streamP2PArgsForCall []struct {
+		logger          lager.Logger
+		source          worker.Volume
+		sourcePath      string
+		destination     worker.Volume
+		destinationPath string
+	}
+	streamP2PReturns struct {
+		result1 int64
+		result2 error
+	}
+	streamP2PReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeP2PStreamer) StreamP2P(logger lager.Logger, source worker.Volume, sourcePath string, destination worker.Volume, destinationPath string) (int64, error) {
+	fake.streamP2PMutex.Lock()
+	ret, specificReturn := fake.streamP2PReturnsOnCall[len(fake.streamP2PArgsForCall)]
+	fake.streamP2PArgsForCall = append(fake.streamP2PArgsForCall, struct {
+		logger          lager.Logger
+		source          worker.Volume
+		sourcePath      string
+		destination     worker.Volume
+		destinationPath string
+	}{logger, source, sourcePath, destination, destinationPath})
+	fake.recordInvocation("StreamP2P", []interface{}{logger, source, sourcePath, destination, destinationPath})
+	fake.streamP2PMutex.Unlock()
+	if fake.StreamP2PStub != nil {
+		return fake.StreamP2PStub(logger, source, sourcePath, destination, destinationPath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.streamP2PReturns.result1, fake.streamP2PReturns.result2
+}
+
+func (fake *FakeP2PStreamer) StreamP2PCallCount() int {
+	fake.streamP2PMutex.RLock()
+	defer fake.streamP2PMutex.RUnlock()
+	return len(fake.streamP2PArgsForCall)
+}
+
+func (fake *FakeP2PStreamer) StreamP2PArgsForCall(i int) (lager.Logger, worker.Volume, string, worker.Volume, string) {
+	fake.streamP2PMutex.RLock()
+	defer fake.streamP2PMutex.RUnlock()
+	return fake.streamP2PArgsForCall[i].logger, fake.streamP2PArgsForCall[i].source, fake.streamP2PArgsForCall[i].sourcePath, fake.streamP2PArgsForCall[i].destination, fake.streamP2PArgsForCall[i].destinationPath
+}
+
+func (fake *FakeP2PStreamer) StreamP2PReturns(result1 int64, result2 error) {
+	fake.StreamP2PStub = nil
+	fake.streamP2PReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeP2PStreamer) StreamP2PReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.StreamP2PStub = nil
+	if fake.streamP2PReturnsOnCall == nil {
+		fake.streamP2PReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.streamP2PReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeP2PStreamer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.streamP2PMutex.RLock()
+	defer fake.streamP2PMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeP2PStreamer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ worker.P2PStreamer = new(FakeP2PStreamer)
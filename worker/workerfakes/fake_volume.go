@@ -29,6 +29,15 @@ type FakeVolume struct {
 	pathReturnsOnCall map[int]struct {
 		result1 string
 	}
+	WorkerNameStub        func() string
+	workerNameMutex       sync.RWMutex
+	workerNameArgsForCall []struct{}
+	workerNameReturns     struct {
+		result1 string
+	}
+	workerNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	SetPropertyStub        func(key string, value string) error
 	setPropertyMutex       sync.RWMutex
 	setPropertyArgsForCall []struct {
@@ -77,6 +86,17 @@ type FakeVolume struct {
 		result1 io.ReadCloser
 		result2 error
 	}
+	SizeInBytesStub        func() (int64, error)
+	sizeInBytesMutex       sync.RWMutex
+	sizeInBytesArgsForCall []struct{}
+	sizeInBytesReturns     struct {
+		result1 int64
+		result2 error
+	}
+	sizeInBytesReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	COWStrategyStub        func() baggageclaim.COWStrategy
 	cOWStrategyMutex       sync.RWMutex
 	cOWStrategyArgsForCall []struct{}
@@ -213,6 +233,46 @@ func (fake *FakeVolume) PathReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeVolume) WorkerName() string {
+	fake.workerNameMutex.Lock()
+	ret, specificReturn := fake.workerNameReturnsOnCall[len(fake.workerNameArgsForCall)]
+	fake.workerNameArgsForCall = append(fake.workerNameArgsForCall, struct{}{})
+	fake.recordInvocation("WorkerName", []interface{}{})
+	fake.workerNameMutex.Unlock()
+	if fake.WorkerNameStub != nil {
+		return fake.WorkerNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.workerNameReturns.result1
+}
+
+func (fake *FakeVolume) WorkerNameCallCount() int {
+	fake.workerNameMutex.RLock()
+	defer fake.workerNameMutex.RUnlock()
+	return len(fake.workerNameArgsForCall)
+}
+
+func (fake *FakeVolume) WorkerNameReturns(result1 string) {
+	fake.WorkerNameStub = nil
+	fake.workerNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeVolume) WorkerNameReturnsOnCall(i int, result1 string) {
+	fake.WorkerNameStub = nil
+	if fake.workerNameReturnsOnCall == nil {
+		fake.workerNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.workerNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeVolume) SetProperty(key string, value string) error {
 	fake.setPropertyMutex.Lock()
 	ret, specificReturn := fake.setPropertyReturnsOnCall[len(fake.setPropertyArgsForCall)]
@@ -405,6 +465,49 @@ func (fake *FakeVolume) StreamOutReturnsOnCall(i int, result1 io.ReadCloser, res
 	}{result1, result2}
 }
 
+func (fake *FakeVolume) SizeInBytes() (int64, error) {
+	fake.sizeInBytesMutex.Lock()
+	ret, specificReturn := fake.sizeInBytesReturnsOnCall[len(fake.sizeInBytesArgsForCall)]
+	fake.sizeInBytesArgsForCall = append(fake.sizeInBytesArgsForCall, struct{}{})
+	fake.recordInvocation("SizeInBytes", []interface{}{})
+	fake.sizeInBytesMutex.Unlock()
+	if fake.SizeInBytesStub != nil {
+		return fake.SizeInBytesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.sizeInBytesReturns.result1, fake.sizeInBytesReturns.result2
+}
+
+func (fake *FakeVolume) SizeInBytesCallCount() int {
+	fake.sizeInBytesMutex.RLock()
+	defer fake.sizeInBytesMutex.RUnlock()
+	return len(fake.sizeInBytesArgsForCall)
+}
+
+func (fake *FakeVolume) SizeInBytesReturns(result1 int64, result2 error) {
+	fake.SizeInBytesStub = nil
+	fake.sizeInBytesReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolume) SizeInBytesReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.SizeInBytesStub = nil
+	if fake.sizeInBytesReturnsOnCall == nil {
+		fake.sizeInBytesReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.sizeInBytesReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVolume) COWStrategy() baggageclaim.COWStrategy {
 	fake.cOWStrategyMutex.Lock()
 	ret, specificReturn := fake.cOWStrategyReturnsOnCall[len(fake.cOWStrategyArgsForCall)]
@@ -627,6 +730,8 @@ func (fake *FakeVolume) Invocations() map[string][][]interface{} {
 	defer fake.handleMutex.RUnlock()
 	fake.pathMutex.RLock()
 	defer fake.pathMutex.RUnlock()
+	fake.workerNameMutex.RLock()
+	defer fake.workerNameMutex.RUnlock()
 	fake.setPropertyMutex.RLock()
 	defer fake.setPropertyMutex.RUnlock()
 	fake.propertiesMutex.RLock()
@@ -635,6 +740,8 @@ func (fake *FakeVolume) Invocations() map[string][][]interface{} {
 	defer fake.streamInMutex.RUnlock()
 	fake.streamOutMutex.RLock()
 	defer fake.streamOutMutex.RUnlock()
+	fake.sizeInBytesMutex.RLock()
+	defer fake.sizeInBytesMutex.RUnlock()
 	fake.cOWStrategyMutex.RLock()
 	defer fake.cOWStrategyMutex.RUnlock()
 	fake.isInitializedMutex.RLock()
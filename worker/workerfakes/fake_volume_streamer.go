@@ -0,0 +1,108 @@
+// This file was generated by counterfeiter
+package workerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/worker"
+)
+
+type FakeVolumeStreamer struct {
+	StreamVolumeStub        func(logger lager.Logger, source worker.Volume, sourcePath string, destination worker.Volume, destinationPath string) (int64, error)
+	streamVolumeMutex       sync.RWMutex
+	streamVolumeArgsForCall []struct {
+		logger          lager.Logger
+		source          worker.Volume
+		sourcePath      string
+		destination     worker.Volume
+		destinationPath string
+	}
+	streamVolumeReturns struct {
+		result1 int64
+		result2 error
+	}
+	streamVolumeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeVolumeStreamer) StreamVolume(logger lager.Logger, source worker.Volume, sourcePath string, destination worker.Volume, destinationPath string) (int64, error) {
+	fake.streamVolumeMutex.Lock()
+	ret, specificReturn := fake.streamVolumeReturnsOnCall[len(fake.streamVolumeArgsForCall)]
+	fake.streamVolumeArgsForCall = append(fake.streamVolumeArgsForCall, struct {
+		logger          lager.Logger
+		source          worker.Volume
+		sourcePath      string
+		destination     worker.Volume
+		destinationPath string
+	}{logger, source, sourcePath, destination, destinationPath})
+	fake.recordInvocation("StreamVolume", []interface{}{logger, source, sourcePath, destination, destinationPath})
+	fake.streamVolumeMutex.Unlock()
+	if fake.StreamVolumeStub != nil {
+		return fake.StreamVolumeStub(logger, source, sourcePath, destination, destinationPath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.streamVolumeReturns.result1, fake.streamVolumeReturns.result2
+}
+
+func (fake *FakeVolumeStreamer) StreamVolumeCallCount() int {
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	return len(fake.streamVolumeArgsForCall)
+}
+
+func (fake *FakeVolumeStreamer) StreamVolumeArgsForCall(i int) (lager.Logger, worker.Volume, string, worker.Volume, string) {
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	return fake.streamVolumeArgsForCall[i].logger, fake.streamVolumeArgsForCall[i].source, fake.streamVolumeArgsForCall[i].sourcePath, fake.streamVolumeArgsForCall[i].destination, fake.streamVolumeArgsForCall[i].destinationPath
+}
+
+func (fake *FakeVolumeStreamer) StreamVolumeReturns(result1 int64, result2 error) {
+	fake.StreamVolumeStub = nil
+	fake.streamVolumeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeStreamer) StreamVolumeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.StreamVolumeStub = nil
+	if fake.streamVolumeReturnsOnCall == nil {
+		fake.streamVolumeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.streamVolumeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVolumeStreamer) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeVolumeStreamer) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ worker.VolumeStreamer = new(FakeVolumeStreamer)
@@ -0,0 +1,65 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+type WorkerSelectorOperator string
+
+const (
+	WorkerSelectorIn     WorkerSelectorOperator = "in"
+	WorkerSelectorNotIn  WorkerSelectorOperator = "notin"
+	WorkerSelectorExists WorkerSelectorOperator = "exists"
+)
+
+// WorkerSelector matches workers by one of the labels they registered with.
+type WorkerSelector struct {
+	Key      string                 `yaml:"key" json:"key" mapstructure:"key"`
+	Operator WorkerSelectorOperator `yaml:"operator" json:"operator" mapstructure:"operator"`
+	Values   []string               `yaml:"values,omitempty" json:"values,omitempty" mapstructure:"values"`
+}
+
+func (selector WorkerSelector) Matches(labels map[string]string) bool {
+	value, found := labels[selector.Key]
+
+	switch selector.Operator {
+	case WorkerSelectorIn:
+		return found && selector.hasValue(value)
+	case WorkerSelectorNotIn:
+		return !found || !selector.hasValue(value)
+	case WorkerSelectorExists:
+		return found
+	default:
+		return false
+	}
+}
+
+func (selector WorkerSelector) String() string {
+	if selector.Operator == WorkerSelectorExists {
+		return fmt.Sprintf("%s exists", selector.Key)
+	}
+
+	return fmt.Sprintf("%s %s (%s)", selector.Key, selector.Operator, strings.Join(selector.Values, ", "))
+}
+
+func (selector WorkerSelector) hasValue(value string) bool {
+	for _, v := range selector.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WorkerPlacement narrows down the workers a step can run on, on top of its
+// tags.
+type WorkerPlacement struct {
+	// every selector must match for a worker to be eligible
+	Selectors []WorkerSelector `yaml:"selectors,omitempty" json:"selectors,omitempty" mapstructure:"selectors"`
+
+	// eligible workers matching the most preferences are chosen, but no
+	// preference has to match
+	Preferences []WorkerSelector `yaml:"preferences,omitempty" json:"preferences,omitempty" mapstructure:"preferences"`
+}
@@ -0,0 +1,124 @@
+package workerdrain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/concourse/atc"
+)
+
+// attemptsRemain reports whether failing the given attempt of the named step
+// leaves one of the retry steps around it with another attempt to run. The
+// attempt is a container's, numbering the attempt of each enclosing retry
+// step from the outermost in, e.g. "2.1".
+func attemptsRemain(plan atc.Plan, stepName string, attempt string) bool {
+	attempts := []int{}
+	for _, a := range strings.Split(attempt, ".") {
+		n, err := strconv.Atoi(a)
+		if err != nil {
+			return false
+		}
+
+		attempts = append(attempts, n)
+	}
+
+	found, remain := findAttempt(plan, stepName, attempts, nil, nil)
+	return found && remain
+}
+
+// findAttempt walks the plan looking for the steps with the given name and
+// attempts, returning whether any were found and whether all of them have
+// attempts left in an enclosing retry step. path and counts track the attempt
+// and number of attempts of each retry step enclosing the current plan.
+func findAttempt(plan atc.Plan, stepName string, attempts []int, path []int, counts []int) (bool, bool) {
+	if plan.Retry != nil {
+		found, remain := false, true
+		for i, inner := range *plan.Retry {
+			innerPath := append(append([]int{}, path...), i+1)
+			innerCounts := append(append([]int{}, counts...), len(*plan.Retry))
+
+			f, r := findAttempt(inner, stepName, attempts, innerPath, innerCounts)
+			if f {
+				found = true
+				remain = remain && r
+			}
+		}
+
+		return found, found && remain
+	}
+
+	if planStepName(plan) == stepName && samePath(path, attempts) {
+		for i := range path {
+			if path[i] < counts[i] {
+				return true, true
+			}
+		}
+
+		return true, false
+	}
+
+	found, remain := false, true
+	for _, child := range childPlans(plan) {
+		f, r := findAttempt(child, stepName, attempts, path, counts)
+		if f {
+			found = true
+			remain = remain && r
+		}
+	}
+
+	return found, found && remain
+}
+
+func planStepName(plan atc.Plan) string {
+	switch {
+	case plan.Get != nil:
+		return plan.Get.Name
+	case plan.Put != nil:
+		return plan.Put.Name
+	case plan.Task != nil:
+		return plan.Task.Name
+	case plan.DependentGet != nil:
+		return plan.DependentGet.Name
+	default:
+		return ""
+	}
+}
+
+func childPlans(plan atc.Plan) []atc.Plan {
+	switch {
+	case plan.Aggregate != nil:
+		return *plan.Aggregate
+	case plan.Do != nil:
+		return *plan.Do
+	case plan.InParallel != nil:
+		return plan.InParallel.Steps
+	case plan.Ensure != nil:
+		return []atc.Plan{plan.Ensure.Step, plan.Ensure.Next}
+	case plan.OnSuccess != nil:
+		return []atc.Plan{plan.OnSuccess.Step, plan.OnSuccess.Next}
+	case plan.OnFailure != nil:
+		return []atc.Plan{plan.OnFailure.Step, plan.OnFailure.Next}
+	case plan.Try != nil:
+		return []atc.Plan{plan.Try.Step}
+	case plan.Timeout != nil:
+		return []atc.Plan{plan.Timeout.Step}
+	case plan.Conditional != nil:
+		return []atc.Plan{plan.Conditional.Step}
+	default:
+		return nil
+	}
+}
+
+func samePath(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
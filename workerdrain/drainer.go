@@ -0,0 +1,190 @@
+package workerdrain
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/engine"
+	"github.com/concourse/atc/gcng"
+)
+
+//go:generate counterfeiter . Drainer
+
+// Drainer enforces the deadlines of workers being drained, clearing out the
+// builds which are still running on a worker once its deadline has passed.
+type Drainer interface {
+	Run() error
+}
+
+type drainer struct {
+	logger              lager.Logger
+	workerFactory       dbng.WorkerFactory
+	buildFactory        dbng.BuildFactory
+	engine              engine.Engine
+	gardenClientFactory gcng.GardenClientFactory
+	clock               clock.Clock
+}
+
+// NewDrainer constructs a Drainer. Builds whose containers on an overdue
+// worker all belong to attempts of a retry step with attempts left have those
+// containers destroyed, failing the attempt so that the next one runs on
+// another worker; any other build is aborted.
+func NewDrainer(
+	logger lager.Logger,
+	workerFactory dbng.WorkerFactory,
+	buildFactory dbng.BuildFactory,
+	engine engine.Engine,
+	gardenClientFactory gcng.GardenClientFactory,
+	clock clock.Clock,
+) Drainer {
+	return &drainer{
+		logger:              logger,
+		workerFactory:       workerFactory,
+		buildFactory:        buildFactory,
+		engine:              engine,
+		gardenClientFactory: gardenClientFactory,
+		clock:               clock,
+	}
+}
+
+func (d *drainer) Run() error {
+	logger := d.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	workers, err := d.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return err
+	}
+
+	for _, worker := range workers {
+		if worker.State() != dbng.WorkerStateLanding && worker.State() != dbng.WorkerStateRetiring {
+			continue
+		}
+
+		deadline := worker.DrainDeadline()
+		if deadline == nil || d.clock.Now().Before(*deadline) {
+			continue
+		}
+
+		d.drainWorker(logger.Session("drain", lager.Data{"worker": worker.Name()}), worker)
+	}
+
+	return nil
+}
+
+func (d *drainer) drainWorker(logger lager.Logger, worker dbng.Worker) {
+	containers, err := worker.ActiveBuildContainers()
+	if err != nil {
+		logger.Error("failed-to-get-active-build-containers", err)
+		return
+	}
+
+	buildIDs := []int{}
+	containersByBuild := map[int][]dbng.CreatedContainer{}
+	for _, container := range containers {
+		buildID := container.Metadata().BuildID
+		if _, found := containersByBuild[buildID]; !found {
+			buildIDs = append(buildIDs, buildID)
+		}
+
+		containersByBuild[buildID] = append(containersByBuild[buildID], container)
+	}
+
+	for _, buildID := range buildIDs {
+		buildLogger := logger.Session("build", lager.Data{"build": buildID})
+
+		build, found, err := d.buildFactory.Build(buildID)
+		if err != nil {
+			buildLogger.Error("failed-to-get-build", err)
+			continue
+		}
+
+		if !found {
+			buildLogger.Debug("build-not-found")
+			continue
+		}
+
+		if d.retryable(buildLogger, build, containersByBuild[buildID]) {
+			d.destroyContainers(buildLogger, worker, containersByBuild[buildID])
+		} else {
+			d.abortBuild(buildLogger, build)
+		}
+	}
+}
+
+func (d *drainer) abortBuild(logger lager.Logger, build dbng.Build) {
+	engineBuild, err := d.engine.LookupBuild(logger, build)
+	if err != nil {
+		logger.Error("failed-to-lookup-build", err)
+		return
+	}
+
+	err = engineBuild.Abort(logger)
+	if err != nil {
+		logger.Error("failed-to-abort-build", err)
+		return
+	}
+
+	logger.Info("aborted-build")
+}
+
+func (d *drainer) destroyContainers(logger lager.Logger, worker dbng.Worker, containers []dbng.CreatedContainer) {
+	gardenClient, err := d.gardenClientFactory(worker, logger)
+	if err != nil {
+		logger.Error("failed-to-get-garden-client-for-worker", err)
+		return
+	}
+
+	for _, container := range containers {
+		cLog := logger.Session("destroy-container", lager.Data{"container": container.Handle()})
+
+		err := gardenClient.Destroy(container.Handle())
+		if err != nil {
+			if _, ok := err.(garden.ContainerNotFoundError); !ok {
+				cLog.Error("failed-to-destroy-container", err)
+				continue
+			}
+
+			cLog.Debug("container-no-longer-present-in-garden")
+		}
+
+		_, err = container.Destroying()
+		if err != nil {
+			cLog.Error("failed-to-mark-container-as-destroying", err)
+			continue
+		}
+
+		cLog.Info("destroyed-container-for-retry")
+	}
+}
+
+// retryable reports whether every container belongs to an attempt of a retry
+// step which is not the last, in which case destroying them fails the attempt
+// rather than the build.
+func (d *drainer) retryable(logger lager.Logger, build dbng.Build, containers []dbng.CreatedContainer) bool {
+	for _, container := range containers {
+		if container.Metadata().Attempt == "" {
+			return false
+		}
+	}
+
+	plan, err := engine.BuildPlan(build)
+	if err != nil {
+		logger.Error("failed-to-get-build-plan", err)
+		return false
+	}
+
+	for _, container := range containers {
+		metadata := container.Metadata()
+		if !attemptsRemain(plan, metadata.StepName, metadata.Attempt) {
+			logger.Debug("on-last-attempt", lager.Data{"step": metadata.StepName, "attempt": metadata.Attempt})
+			return false
+		}
+	}
+
+	return true
+}
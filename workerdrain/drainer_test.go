@@ -0,0 +1,269 @@
+package workerdrain_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/engine/enginefakes"
+	"github.com/concourse/atc/workerdrain"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drainer", func() {
+	var (
+		fakeWorkerFactory *dbngfakes.FakeWorkerFactory
+		fakeBuildFactory  *dbngfakes.FakeBuildFactory
+		fakeEngine        *enginefakes.FakeEngine
+		fakeEngineBuild   *enginefakes.FakeBuild
+		fakeGardenClient  *gardenfakes.FakeClient
+		fakeClock         *fakeclock.FakeClock
+
+		fakeWorker    *dbngfakes.FakeWorker
+		fakeBuild     *dbngfakes.FakeBuild
+		drainDeadline time.Time
+
+		drainer workerdrain.Drainer
+		runErr  error
+	)
+
+	newContainer := func(handle string, buildID int, attempt string) *dbngfakes.FakeCreatedContainer {
+		container := new(dbngfakes.FakeCreatedContainer)
+		container.HandleReturns(handle)
+		container.MetadataReturns(dbng.ContainerMetadata{
+			BuildID:  buildID,
+			StepName: "some-task",
+			Attempt:  attempt,
+		})
+		return container
+	}
+
+	BeforeEach(func() {
+		fakeWorkerFactory = new(dbngfakes.FakeWorkerFactory)
+		fakeBuildFactory = new(dbngfakes.FakeBuildFactory)
+
+		fakeEngine = new(enginefakes.FakeEngine)
+		fakeEngineBuild = new(enginefakes.FakeBuild)
+		fakeEngine.LookupBuildReturns(fakeEngineBuild, nil)
+
+		fakeGardenClient = new(gardenfakes.FakeClient)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+		drainDeadline = time.Unix(100, 0)
+
+		fakeWorker = new(dbngfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeWorker.StateReturns(dbng.WorkerStateLanding)
+		fakeWorker.DrainDeadlineReturns(&drainDeadline)
+		fakeWorkerFactory.WorkersReturns([]dbng.Worker{fakeWorker}, nil)
+
+		task := atc.Plan{Task: &atc.TaskPlan{Name: "some-task"}}
+		engineMetadata, err := json.Marshal(map[string]atc.Plan{
+			"Plan": {Retry: &atc.RetryPlan{task, task}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeBuild = new(dbngfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EngineReturns("exec.v2")
+		fakeBuild.EngineMetadataReturns(string(engineMetadata))
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		drainer = workerdrain.NewDrainer(
+			lagertest.NewTestLogger("test"),
+			fakeWorkerFactory,
+			fakeBuildFactory,
+			fakeEngine,
+			func(dbng.Worker, lager.Logger) (garden.Client, error) {
+				return fakeGardenClient, nil
+			},
+			fakeClock,
+		)
+	})
+
+	JustBeforeEach(func() {
+		runErr = drainer.Run()
+	})
+
+	Context("when a build without retries is running on an overdue worker", func() {
+		BeforeEach(func() {
+			fakeWorker.ActiveBuildContainersReturns([]dbng.CreatedContainer{
+				newContainer("some-handle", 42, ""),
+				newContainer("some-other-handle", 42, "1"),
+			}, nil)
+		})
+
+		It("succeeds", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+		})
+
+		It("aborts the build", func() {
+			Expect(fakeBuildFactory.BuildCallCount()).To(Equal(1))
+			Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(42))
+
+			Expect(fakeEngine.LookupBuildCallCount()).To(Equal(1))
+			_, build := fakeEngine.LookupBuildArgsForCall(0)
+			Expect(build).To(Equal(fakeBuild))
+
+			Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+		})
+
+		It("does not destroy any containers", func() {
+			Expect(fakeGardenClient.DestroyCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when a build is running on an overdue worker inside a retry", func() {
+		var container *dbngfakes.FakeCreatedContainer
+
+		BeforeEach(func() {
+			container = newContainer("some-handle", 42, "1")
+			fakeWorker.ActiveBuildContainersReturns([]dbng.CreatedContainer{container}, nil)
+		})
+
+		It("destroys the container so that the next attempt runs elsewhere", func() {
+			Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+			Expect(fakeGardenClient.DestroyArgsForCall(0)).To(Equal("some-handle"))
+			Expect(container.DestroyingCallCount()).To(Equal(1))
+		})
+
+		It("does not abort the build", func() {
+			Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+		})
+
+		Context("when the container is already gone from garden", func() {
+			BeforeEach(func() {
+				fakeGardenClient.DestroyReturns(garden.ContainerNotFoundError{Handle: "some-handle"})
+			})
+
+			It("still marks it as destroying", func() {
+				Expect(container.DestroyingCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when destroying the container fails", func() {
+			BeforeEach(func() {
+				fakeGardenClient.DestroyReturns(errors.New("disaster"))
+			})
+
+			It("does not mark it as destroying", func() {
+				Expect(container.DestroyingCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the container belongs to the last attempt", func() {
+			BeforeEach(func() {
+				container = newContainer("some-handle", 42, "2")
+				fakeWorker.ActiveBuildContainersReturns([]dbng.CreatedContainer{container}, nil)
+			})
+
+			It("aborts the build instead of destroying the container", func() {
+				Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.DestroyCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the build's plan cannot be determined", func() {
+			BeforeEach(func() {
+				fakeBuild.EngineReturns("some-other-engine")
+			})
+
+			It("aborts the build instead of destroying the container", func() {
+				Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.DestroyCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when a build is running on an overdue worker inside nested retries", func() {
+		var container *dbngfakes.FakeCreatedContainer
+
+		BeforeEach(func() {
+			task := atc.Plan{Task: &atc.TaskPlan{Name: "some-task"}}
+			inner := atc.Plan{Retry: &atc.RetryPlan{task, task}}
+			engineMetadata, err := json.Marshal(map[string]atc.Plan{
+				"Plan": {Do: &atc.DoPlan{{Retry: &atc.RetryPlan{inner, inner}}}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeBuild.EngineMetadataReturns(string(engineMetadata))
+		})
+
+		Context("when the inner retry is on its last attempt but the outer one is not", func() {
+			BeforeEach(func() {
+				container = newContainer("some-handle", 42, "1.2")
+				fakeWorker.ActiveBuildContainersReturns([]dbng.CreatedContainer{container}, nil)
+			})
+
+			It("destroys the container", func() {
+				Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+				Expect(fakeEngineBuild.AbortCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when both retries are on their last attempt", func() {
+			BeforeEach(func() {
+				container = newContainer("some-handle", 42, "2.2")
+				fakeWorker.ActiveBuildContainersReturns([]dbng.CreatedContainer{container}, nil)
+			})
+
+			It("aborts the build", func() {
+				Expect(fakeEngineBuild.AbortCallCount()).To(Equal(1))
+				Expect(fakeGardenClient.DestroyCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the worker's deadline has not passed", func() {
+		BeforeEach(func() {
+			drainDeadline = time.Unix(200, 0)
+		})
+
+		It("leaves the worker alone", func() {
+			Expect(fakeWorker.ActiveBuildContainersCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the worker is not being drained", func() {
+		BeforeEach(func() {
+			fakeWorker.DrainDeadlineReturns(nil)
+		})
+
+		It("leaves the worker alone", func() {
+			Expect(fakeWorker.ActiveBuildContainersCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the worker has already landed", func() {
+		BeforeEach(func() {
+			fakeWorker.StateReturns(dbng.WorkerStateLanded)
+		})
+
+		It("leaves the worker alone", func() {
+			Expect(fakeWorker.ActiveBuildContainersCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the workers fails", func() {
+		disaster := errors.New("disaster")
+
+		BeforeEach(func() {
+			fakeWorkerFactory.WorkersReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(Equal(disaster))
+		})
+	})
+})
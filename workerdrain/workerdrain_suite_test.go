@@ -0,0 +1,13 @@
+package workerdrain_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestWorkerdrain(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Workerdrain Suite")
+}
@@ -0,0 +1,310 @@
+package workerhealth
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/gcng"
+	"github.com/concourse/atc/metric"
+	"github.com/concourse/baggageclaim"
+	bclient "github.com/concourse/baggageclaim/client"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// ProbeGraceTime bounds how long a probe container can outlive a prober that
+// failed to destroy it.
+const ProbeGraceTime = 5 * time.Minute
+
+// ErrProbeTimedOut is recorded as the error of a probe that did not complete
+// in time. A worker that hangs is no more usable than one that fails.
+var ErrProbeTimedOut = errors.New("probe timed out")
+
+//go:generate counterfeiter . Prober
+
+// Prober checks that each worker's Garden and Baggageclaim actually work,
+// quarantining workers which keep failing and releasing them once they
+// recover.
+type Prober interface {
+	Run() error
+}
+
+type prober struct {
+	logger                    lager.Logger
+	workerFactory             dbng.WorkerFactory
+	gardenClientFactory       gcng.GardenClientFactory
+	baggageclaimClientFactory gcng.BaggageclaimClientFactory
+	clock                     clock.Clock
+
+	timeout   time.Duration
+	window    int
+	threshold float64
+
+	// handles of probe volumes that failed to be destroyed, by worker name,
+	// so that destroying them is retried on the worker's next probe
+	leakedVolumes     map[string][]string
+	leakedVolumesLock *sync.Mutex
+}
+
+// NewProber constructs a Prober which keeps the last window probes for each
+// worker. A running worker is quarantined once the window is full and the
+// proportion of failed probes in it reaches threshold; a quarantined worker
+// is released once every probe in the window has succeeded. A probe which
+// takes longer than timeout counts as failed.
+func NewProber(
+	logger lager.Logger,
+	workerFactory dbng.WorkerFactory,
+	gardenClientFactory gcng.GardenClientFactory,
+	baggageclaimClientFactory gcng.BaggageclaimClientFactory,
+	clock clock.Clock,
+	timeout time.Duration,
+	window int,
+	threshold float64,
+) Prober {
+	return &prober{
+		logger:                    logger,
+		workerFactory:             workerFactory,
+		gardenClientFactory:       gardenClientFactory,
+		baggageclaimClientFactory: baggageclaimClientFactory,
+		clock:                     clock,
+		timeout:                   timeout,
+		window:                    window,
+		threshold:                 threshold,
+
+		leakedVolumes:     map[string][]string{},
+		leakedVolumesLock: new(sync.Mutex),
+	}
+}
+
+func (p *prober) Run() error {
+	logger := p.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	workers, err := p.workerFactory.Workers()
+	if err != nil {
+		logger.Error("failed-to-get-workers", err)
+		return err
+	}
+
+	wg := new(sync.WaitGroup)
+	for _, worker := range workers {
+		if worker.State() != dbng.WorkerStateRunning && worker.State() != dbng.WorkerStateQuarantined {
+			continue
+		}
+
+		if worker.GardenAddr() == nil || worker.BaggageclaimURL() == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func(worker dbng.Worker) {
+			defer wg.Done()
+			p.probeWorker(logger.Session("probe", lager.Data{"worker": worker.Name()}), worker)
+		}(worker)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (p *prober) probeWorker(logger lager.Logger, worker dbng.Worker) {
+	probe := dbng.WorkerProbe{
+		Succeeded: true,
+		Time:      p.clock.Now(),
+	}
+
+	err := p.probe(logger, worker)
+	if err != nil {
+		logger.Info("probe-failed", lager.Data{"error": err.Error()})
+		probe.Succeeded = false
+		probe.Error = err.Error()
+	}
+
+	err = worker.SaveProbe(probe, p.window)
+	if err != nil {
+		logger.Error("failed-to-save-probe", err)
+		return
+	}
+
+	probes := worker.Probes()
+
+	failures := 0
+	for _, probe := range probes {
+		if !probe.Succeeded {
+			failures++
+		}
+	}
+
+	failureRate := float64(failures) / float64(len(probes))
+
+	metric.WorkerProbeFailureRate{
+		WorkerName:  worker.Name(),
+		FailureRate: failureRate,
+	}.Emit(logger)
+
+	if len(probes) < p.window {
+		return
+	}
+
+	switch worker.State() {
+	case dbng.WorkerStateRunning:
+		if failureRate >= p.threshold {
+			p.transition(logger, worker, worker.Quarantine, dbng.WorkerStateRunning, dbng.WorkerStateQuarantined)
+		}
+
+	case dbng.WorkerStateQuarantined:
+		if failures == 0 {
+			p.transition(logger, worker, worker.Unquarantine, dbng.WorkerStateQuarantined, dbng.WorkerStateRunning)
+		}
+	}
+}
+
+func (p *prober) transition(logger lager.Logger, worker dbng.Worker, apply func() error, from dbng.WorkerState, to dbng.WorkerState) {
+	err := apply()
+	if err != nil {
+		if err == dbng.ErrWorkerNotQuarantinable || err == dbng.ErrWorkerNotPresent {
+			// the worker landed, retired or went away in the meantime
+			logger.Debug("worker-changed-state", lager.Data{"error": err.Error()})
+			return
+		}
+
+		logger.Error("failed-to-transition-worker", err, lager.Data{"to": string(to)})
+		return
+	}
+
+	logger.Info("transitioned-worker", lager.Data{
+		"from": string(from),
+		"to":   string(to),
+	})
+
+	metric.WorkerStateTransition{
+		WorkerName: worker.Name(),
+		FromState:  string(from),
+		ToState:    string(to),
+	}.Emit(logger)
+}
+
+func (p *prober) probe(logger lager.Logger, worker dbng.Worker) error {
+	timer := p.clock.NewTimer(p.timeout)
+	defer timer.Stop()
+
+	// buffered so that a probe finishing after the timeout does not leak
+	probed := make(chan error, 1)
+
+	go func() {
+		probed <- p.probeGardenAndBaggageclaim(logger, worker)
+	}()
+
+	select {
+	case err := <-probed:
+		return err
+	case <-timer.C():
+		return ErrProbeTimedOut
+	}
+}
+
+func (p *prober) probeGardenAndBaggageclaim(logger lager.Logger, worker dbng.Worker) error {
+	err := p.probeGarden(logger.Session("garden"), worker)
+	if err != nil {
+		return err
+	}
+
+	return p.probeBaggageclaim(logger.Session("baggageclaim"), worker)
+}
+
+func (p *prober) probeGarden(logger lager.Logger, worker dbng.Worker) error {
+	gardenClient, err := p.gardenClientFactory(worker, logger)
+	if err != nil {
+		return err
+	}
+
+	var rootFSPath string
+	if resourceTypes := worker.ResourceTypes(); len(resourceTypes) > 0 {
+		rootFSPath = "raw://" + resourceTypes[0].Image
+	}
+
+	container, err := gardenClient.Create(garden.ContainerSpec{
+		RootFSPath: rootFSPath,
+		GraceTime:  ProbeGraceTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return gardenClient.Destroy(container.Handle())
+}
+
+// probeBaggageclaim creates and destroys a volume. Baggageclaim has no way to
+// expire a volume, and nothing else knows about probe volumes, so one that
+// fails to be destroyed is remembered and destroyed on a later probe.
+func (p *prober) probeBaggageclaim(logger lager.Logger, worker dbng.Worker) error {
+	baggageclaimClient := p.baggageclaimClientFactory.NewClient(*worker.BaggageclaimURL(), worker.Name())
+
+	p.destroyLeakedVolumes(logger, worker.Name(), baggageclaimClient)
+
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+
+	volume, err := baggageclaimClient.CreateVolume(logger, handle.String(), baggageclaim.VolumeSpec{
+		Strategy: baggageclaim.EmptyStrategy{},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = volume.Destroy()
+	if err != nil {
+		logger.Error("failed-to-destroy-probe-volume", err, lager.Data{"handle": handle.String()})
+		p.recordLeakedVolume(worker.Name(), handle.String())
+		return err
+	}
+
+	return nil
+}
+
+func (p *prober) recordLeakedVolume(workerName string, handle string) {
+	p.leakedVolumesLock.Lock()
+	p.leakedVolumes[workerName] = append(p.leakedVolumes[workerName], handle)
+	p.leakedVolumesLock.Unlock()
+}
+
+func (p *prober) destroyLeakedVolumes(logger lager.Logger, workerName string, baggageclaimClient bclient.Client) {
+	p.leakedVolumesLock.Lock()
+	handles := p.leakedVolumes[workerName]
+	delete(p.leakedVolumes, workerName)
+	p.leakedVolumesLock.Unlock()
+
+	for _, handle := range handles {
+		logger := logger.WithData(lager.Data{"handle": handle})
+
+		volume, found, err := baggageclaimClient.LookupVolume(logger, handle)
+		if err != nil {
+			logger.Error("failed-to-lookup-leaked-probe-volume", err)
+			p.recordLeakedVolume(workerName, handle)
+			continue
+		}
+
+		if !found {
+			logger.Debug("leaked-probe-volume-already-gone")
+			continue
+		}
+
+		err = volume.Destroy()
+		if err != nil {
+			logger.Error("failed-to-destroy-leaked-probe-volume", err)
+			p.recordLeakedVolume(workerName, handle)
+			continue
+		}
+
+		logger.Info("destroyed-leaked-probe-volume")
+	}
+}
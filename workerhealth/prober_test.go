@@ -0,0 +1,387 @@
+package workerhealth_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/atc"
+	"github.com/concourse/atc/dbng"
+	"github.com/concourse/atc/dbng/dbngfakes"
+	"github.com/concourse/atc/gcng/gcngfakes"
+	"github.com/concourse/atc/workerhealth"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Prober", func() {
+	var (
+		fakeWorkerFactory             *dbngfakes.FakeWorkerFactory
+		fakeGardenClient              *gardenfakes.FakeClient
+		fakeBaggageclaimClientFactory *gcngfakes.FakeBaggageclaimClientFactory
+		fakeBaggageclaimClient        *baggageclaimfakes.FakeClient
+		fakeBCVolume                  *baggageclaimfakes.FakeVolume
+		fakeContainer                 *gardenfakes.FakeContainer
+		fakeClock                     *fakeclock.FakeClock
+
+		fakeWorker *dbngfakes.FakeWorker
+		probes     []dbng.WorkerProbe
+
+		gardenAddr      string
+		baggageclaimURL string
+
+		prober workerhealth.Prober
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeWorkerFactory = new(dbngfakes.FakeWorkerFactory)
+
+		fakeGardenClient = new(gardenfakes.FakeClient)
+		fakeContainer = new(gardenfakes.FakeContainer)
+		fakeContainer.HandleReturns("some-probe-handle")
+		fakeGardenClient.CreateReturns(fakeContainer, nil)
+
+		fakeBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+		fakeBCVolume = new(baggageclaimfakes.FakeVolume)
+		fakeBaggageclaimClient.CreateVolumeReturns(fakeBCVolume, nil)
+
+		fakeBaggageclaimClientFactory = new(gcngfakes.FakeBaggageclaimClientFactory)
+		fakeBaggageclaimClientFactory.NewClientReturns(fakeBaggageclaimClient)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+		gardenAddr = "1.2.3.4:7777"
+		baggageclaimURL = "http://1.2.3.4:7788"
+
+		probes = nil
+
+		fakeWorker = new(dbngfakes.FakeWorker)
+		fakeWorker.NameReturns("some-worker")
+		fakeWorker.StateReturns(dbng.WorkerStateRunning)
+		fakeWorker.GardenAddrReturns(&gardenAddr)
+		fakeWorker.BaggageclaimURLReturns(&baggageclaimURL)
+		fakeWorker.ResourceTypesReturns([]atc.WorkerResourceType{
+			{Type: "some-type", Image: "/path/to/some-image"},
+		})
+		fakeWorker.SaveProbeStub = func(probe dbng.WorkerProbe, keep int) error {
+			probes = append([]dbng.WorkerProbe{probe}, probes...)
+			if len(probes) > keep {
+				probes = probes[:keep]
+			}
+			return nil
+		}
+		fakeWorker.ProbesStub = func() []dbng.WorkerProbe {
+			return probes
+		}
+
+		fakeWorkerFactory.WorkersReturns([]dbng.Worker{fakeWorker}, nil)
+
+		prober = workerhealth.NewProber(
+			lagertest.NewTestLogger("test"),
+			fakeWorkerFactory,
+			func(worker dbng.Worker, logger lager.Logger) (garden.Client, error) {
+				return fakeGardenClient, nil
+			},
+			fakeBaggageclaimClientFactory,
+			fakeClock,
+			time.Minute,
+			3,
+			0.6,
+		)
+	})
+
+	JustBeforeEach(func() {
+		runErr = prober.Run()
+	})
+
+	It("creates and destroys a container on the worker", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+
+		Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+		spec := fakeGardenClient.CreateArgsForCall(0)
+		Expect(spec.RootFSPath).To(Equal("raw:///path/to/some-image"))
+		Expect(spec.GraceTime).To(Equal(workerhealth.ProbeGraceTime))
+
+		Expect(fakeGardenClient.DestroyCallCount()).To(Equal(1))
+		Expect(fakeGardenClient.DestroyArgsForCall(0)).To(Equal("some-probe-handle"))
+	})
+
+	It("creates and destroys a volume on the worker", func() {
+		Expect(fakeBaggageclaimClientFactory.NewClientCallCount()).To(Equal(1))
+		apiURL, workerName := fakeBaggageclaimClientFactory.NewClientArgsForCall(0)
+		Expect(apiURL).To(Equal(baggageclaimURL))
+		Expect(workerName).To(Equal("some-worker"))
+
+		Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(Equal(1))
+		_, handle, spec := fakeBaggageclaimClient.CreateVolumeArgsForCall(0)
+		Expect(handle).NotTo(BeEmpty())
+		Expect(spec.Strategy).To(Equal(baggageclaim.EmptyStrategy{}))
+
+		Expect(fakeBCVolume.DestroyCallCount()).To(Equal(1))
+	})
+
+	It("saves a successful probe, keeping the window", func() {
+		Expect(fakeWorker.SaveProbeCallCount()).To(Equal(1))
+		probe, keep := fakeWorker.SaveProbeArgsForCall(0)
+		Expect(probe).To(Equal(dbng.WorkerProbe{
+			Succeeded: true,
+			Time:      time.Unix(123, 0),
+		}))
+		Expect(keep).To(Equal(3))
+	})
+
+	Context("when creating the container fails", func() {
+		BeforeEach(func() {
+			fakeGardenClient.CreateReturns(nil, errors.New("disaster"))
+		})
+
+		It("saves a failed probe without probing baggageclaim", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			probe, _ := fakeWorker.SaveProbeArgsForCall(0)
+			Expect(probe.Succeeded).To(BeFalse())
+			Expect(probe.Error).To(Equal("disaster"))
+
+			Expect(fakeBaggageclaimClient.CreateVolumeCallCount()).To(BeZero())
+		})
+
+		Context("when the window is not yet full", func() {
+			It("does not quarantine the worker", func() {
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the failure rate over the window reaches the threshold", func() {
+			BeforeEach(func() {
+				probes = []dbng.WorkerProbe{
+					{Succeeded: false},
+					{Succeeded: true},
+				}
+			})
+
+			It("quarantines the worker", func() {
+				Expect(fakeWorker.QuarantineCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the failure rate over the window is below the threshold", func() {
+			BeforeEach(func() {
+				probes = []dbng.WorkerProbe{
+					{Succeeded: true},
+					{Succeeded: true},
+				}
+			})
+
+			It("does not quarantine the worker", func() {
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the worker is already quarantined", func() {
+			BeforeEach(func() {
+				fakeWorker.StateReturns(dbng.WorkerStateQuarantined)
+				probes = []dbng.WorkerProbe{
+					{Succeeded: true},
+					{Succeeded: true},
+				}
+			})
+
+			It("keeps the worker quarantined", func() {
+				Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+				Expect(fakeWorker.UnquarantineCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when creating the container hangs", func() {
+		var unblock chan struct{}
+
+		BeforeEach(func() {
+			unblock = make(chan struct{})
+			fakeGardenClient.CreateStub = func(garden.ContainerSpec) (garden.Container, error) {
+				<-unblock
+				return fakeContainer, nil
+			}
+
+			go func() {
+				defer GinkgoRecover()
+				fakeClock.WaitForWatcherAndIncrement(time.Minute)
+			}()
+		})
+
+		AfterEach(func() {
+			close(unblock)
+		})
+
+		It("saves a failed probe once the timeout elapses", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(probes).To(HaveLen(1))
+			Expect(probes[0].Succeeded).To(BeFalse())
+			Expect(probes[0].Error).To(Equal(workerhealth.ErrProbeTimedOut.Error()))
+		})
+	})
+
+	Context("when destroying the volume fails", func() {
+		BeforeEach(func() {
+			fakeBCVolume.DestroyReturns(errors.New("disaster"))
+		})
+
+		It("saves a failed probe", func() {
+			probe, _ := fakeWorker.SaveProbeArgsForCall(0)
+			Expect(probe.Succeeded).To(BeFalse())
+			Expect(probe.Error).To(Equal("disaster"))
+		})
+
+		Context("when the worker is probed again", func() {
+			var leakedHandle string
+			var leakedVolume *baggageclaimfakes.FakeVolume
+
+			JustBeforeEach(func() {
+				_, leakedHandle, _ = fakeBaggageclaimClient.CreateVolumeArgsForCall(0)
+
+				fakeBCVolume.DestroyReturns(nil)
+
+				runErr = prober.Run()
+			})
+
+			Context("when the leaked volume is still there", func() {
+				BeforeEach(func() {
+					leakedVolume = new(baggageclaimfakes.FakeVolume)
+					fakeBaggageclaimClient.LookupVolumeReturns(leakedVolume, true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(fakeBaggageclaimClient.LookupVolumeCallCount()).To(Equal(1))
+					_, handle := fakeBaggageclaimClient.LookupVolumeArgsForCall(0)
+					Expect(handle).To(Equal(leakedHandle))
+
+					Expect(leakedVolume.DestroyCallCount()).To(Equal(1))
+				})
+
+				It("does not try again once it is destroyed", func() {
+					runErr = prober.Run()
+					Expect(fakeBaggageclaimClient.LookupVolumeCallCount()).To(Equal(1))
+				})
+
+				Context("when destroying it fails again", func() {
+					BeforeEach(func() {
+						leakedVolume.DestroyReturns(errors.New("still failing"))
+					})
+
+					It("tries again on the next probe", func() {
+						runErr = prober.Run()
+						Expect(leakedVolume.DestroyCallCount()).To(Equal(2))
+					})
+				})
+			})
+
+			Context("when the leaked volume has gone", func() {
+				BeforeEach(func() {
+					fakeBaggageclaimClient.LookupVolumeReturns(nil, false, nil)
+				})
+
+				It("forgets about it", func() {
+					runErr = prober.Run()
+					Expect(fakeBaggageclaimClient.LookupVolumeCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Context("when a quarantined worker has succeeded every probe in the window", func() {
+		BeforeEach(func() {
+			fakeWorker.StateReturns(dbng.WorkerStateQuarantined)
+			probes = []dbng.WorkerProbe{
+				{Succeeded: true},
+				{Succeeded: true},
+			}
+		})
+
+		It("releases the worker", func() {
+			Expect(fakeWorker.UnquarantineCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a quarantined worker has recently failed a probe", func() {
+		BeforeEach(func() {
+			fakeWorker.StateReturns(dbng.WorkerStateQuarantined)
+			probes = []dbng.WorkerProbe{
+				{Succeeded: true},
+				{Succeeded: false},
+			}
+		})
+
+		It("keeps the worker quarantined", func() {
+			Expect(fakeWorker.UnquarantineCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when quarantining the worker fails because its state changed", func() {
+		BeforeEach(func() {
+			fakeGardenClient.CreateReturns(nil, errors.New("disaster"))
+			fakeWorker.QuarantineReturns(dbng.ErrWorkerNotQuarantinable)
+			probes = []dbng.WorkerProbe{
+				{Succeeded: false},
+				{Succeeded: false},
+			}
+		})
+
+		It("does not return an error", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeWorker.QuarantineCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when saving the probe fails", func() {
+		BeforeEach(func() {
+			fakeWorker.SaveProbeStub = nil
+			fakeWorker.SaveProbeReturns(errors.New("disaster"))
+		})
+
+		It("does not change the worker's state", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeWorker.QuarantineCallCount()).To(BeZero())
+			Expect(fakeWorker.UnquarantineCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the worker is not running or quarantined", func() {
+		BeforeEach(func() {
+			fakeWorker.StateReturns(dbng.WorkerStateLanding)
+		})
+
+		It("does not probe it", func() {
+			Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+			Expect(fakeWorker.SaveProbeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the worker has no addresses", func() {
+		BeforeEach(func() {
+			fakeWorker.GardenAddrReturns(nil)
+		})
+
+		It("does not probe it", func() {
+			Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+			Expect(fakeWorker.SaveProbeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when getting the workers fails", func() {
+		BeforeEach(func() {
+			fakeWorkerFactory.WorkersReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
@@ -54,6 +54,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListAllPipelines,
 			atc.ListPipelines,
 			atc.ListBuilds,
+			atc.SearchBuildLogs,
 			atc.MainJobBadge:
 
 		// pipeline is public or authorized
@@ -64,17 +65,23 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
-			atc.BuildEvents:
+			atc.BuildEvents,
+			atc.ListBuildArtifacts,
+			atc.DownloadBuildArtifact,
+			atc.ListBuildAnnotations:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 		// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.ApproveBuildStep,
+			atc.ListBuildNotifications:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
 		case atc.PruneWorker,
 			atc.LandWorker,
-			atc.RetireWorker:
+			atc.RetireWorker,
+			atc.DrainWorker:
 			newHandler = wrappa.checkWorkerTeamAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// pipeline is public or authorized
@@ -91,6 +98,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListResourceVersions:
 			newHandler = wrappa.checkPipelineAccessHandlerFactory.HandlerFor(handler, rejector)
 
+		// pipeline and job are public or authorized
+		case atc.ListJobAnnotations:
+			newHandler = wrappa.checkPipelineAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
+
 		// authenticated
 		case atc.GetAuthToken,
 			atc.CreateBuild,
@@ -111,7 +122,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		case atc.GetLogLevel,
-			atc.SetLogLevel:
+			atc.SetLogLevel,
+			atc.SetTeamScheduling:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -121,8 +133,16 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigDiff,
+			atc.RollbackPipelineConfig,
+			atc.ListPipelineTemplates,
+			atc.GetPipelineTemplate,
+			atc.SavePipelineTemplate,
+			atc.SavePipelineTemplateInstance,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.TeamEvents,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
@@ -133,7 +153,12 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpauseResource,
 			atc.ExposePipeline,
 			atc.HidePipeline,
-			atc.SaveConfig:
+			atc.SaveConfig,
+			atc.GetTeamNotifications,
+			atc.SetTeamNotifications,
+			atc.GetTeamRedactionRules,
+			atc.SetTeamRedactionRules,
+			atc.GetTeamScheduling:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
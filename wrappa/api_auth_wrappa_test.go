@@ -131,6 +131,21 @@ var _ = Describe("APIAuthWrappa", func() {
 		)
 	}
 
+	openForPublicJobOrAuthorized := func(handler http.Handler) http.Handler {
+		return auth.CSRFValidationHandler(
+			auth.WrapHandler(
+				fakeCheckPipelineAccessHandlerFactory.CheckIfPrivateJobHandler(
+					handler,
+					rejector,
+				),
+				fakeAuthValidator,
+				fakeUserContextReader,
+			),
+			rejector,
+			fakeUserContextReader,
+		)
+	}
+
 	doesNotCheckIfPrivateJob := func(handler http.Handler) http.Handler {
 		return auth.CSRFValidationHandler(
 			auth.WrapHandler(
@@ -216,6 +231,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListBuilds:           unauthenticated(inputHandlers[atc.ListBuilds]),
 				atc.ListPipelines:        unauthenticated(inputHandlers[atc.ListPipelines]),
 				atc.ListTeams:            unauthenticated(inputHandlers[atc.ListTeams]),
+				atc.SearchBuildLogs:      unauthenticated(inputHandlers[atc.SearchBuildLogs]),
 				atc.MainJobBadge:         unauthenticated(inputHandlers[atc.MainJobBadge]),
 
 				// authorized or public pipeline
@@ -224,16 +240,22 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetBuildPlan:   doesNotCheckIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
 				// authorized or public pipeline and public job
-				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
-				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
+				atc.BuildEvents:           checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
+				atc.GetBuildPreparation:   checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
+				atc.ListBuildArtifacts:    checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
+				atc.DownloadBuildArtifact: checksIfPrivateJob(inputHandlers[atc.DownloadBuildArtifact]),
+				atc.ListBuildAnnotations:  checksIfPrivateJob(inputHandlers[atc.ListBuildAnnotations]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:             checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.ApproveBuildStep:       checkWritePermissionForBuild(inputHandlers[atc.ApproveBuildStep]),
+				atc.ListBuildNotifications: checkWritePermissionForBuild(inputHandlers[atc.ListBuildNotifications]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:  checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
 				atc.LandWorker:   checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
 				atc.RetireWorker: checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
+				atc.DrainWorker:  checkTeamAccessForWorker(inputHandlers[atc.DrainWorker]),
 
 				// belongs to public pipeline or authorized
 				atc.GetPipeline:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipeline]),
@@ -248,6 +270,9 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListResources:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResources]),
 				atc.ListResourceVersions:          openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceVersions]),
 
+				// belongs to public job of public pipeline or authorized
+				atc.ListJobAnnotations: openForPublicJobOrAuthorized(inputHandlers[atc.ListJobAnnotations]),
+
 				// authenticated
 				atc.CreateBuild:     authenticated(inputHandlers[atc.CreateBuild]),
 				atc.CreatePipe:      authenticated(inputHandlers[atc.CreatePipe]),
@@ -271,6 +296,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetLogLevel: authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
 				atc.SetLogLevel: authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
 
+				atc.SetTeamScheduling: authenticatedAndAdmin(inputHandlers[atc.SetTeamScheduling]),
+
 				// authorized (requested team matches resource team)
 				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
 				atc.CreateJobBuild:         authorized(inputHandlers[atc.CreateJobBuild]),
@@ -280,17 +307,34 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetConfig:              authorized(inputHandlers[atc.GetConfig]),
 				atc.GetVersionsDB:          authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:          authorized(inputHandlers[atc.ListJobInputs]),
+				atc.TeamEvents:             authorized(inputHandlers[atc.TeamEvents]),
 				atc.OrderPipelines:         authorized(inputHandlers[atc.OrderPipelines]),
 				atc.PauseJob:               authorized(inputHandlers[atc.PauseJob]),
 				atc.PausePipeline:          authorized(inputHandlers[atc.PausePipeline]),
 				atc.PauseResource:          authorized(inputHandlers[atc.PauseResource]),
 				atc.RenamePipeline:         authorized(inputHandlers[atc.RenamePipeline]),
 				atc.SaveConfig:             authorized(inputHandlers[atc.SaveConfig]),
+				atc.GetTeamNotifications:   authorized(inputHandlers[atc.GetTeamNotifications]),
+				atc.SetTeamNotifications:   authorized(inputHandlers[atc.SetTeamNotifications]),
 				atc.UnpauseJob:             authorized(inputHandlers[atc.UnpauseJob]),
 				atc.UnpausePipeline:        authorized(inputHandlers[atc.UnpausePipeline]),
 				atc.UnpauseResource:        authorized(inputHandlers[atc.UnpauseResource]),
 				atc.ExposePipeline:         authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:           authorized(inputHandlers[atc.HidePipeline]),
+
+				atc.ListPipelineConfigVersions: authorized(inputHandlers[atc.ListPipelineConfigVersions]),
+				atc.GetPipelineConfigDiff:      authorized(inputHandlers[atc.GetPipelineConfigDiff]),
+				atc.RollbackPipelineConfig:     authorized(inputHandlers[atc.RollbackPipelineConfig]),
+
+				atc.ListPipelineTemplates:        authorized(inputHandlers[atc.ListPipelineTemplates]),
+				atc.GetPipelineTemplate:          authorized(inputHandlers[atc.GetPipelineTemplate]),
+				atc.SavePipelineTemplate:         authorized(inputHandlers[atc.SavePipelineTemplate]),
+				atc.SavePipelineTemplateInstance: authorized(inputHandlers[atc.SavePipelineTemplateInstance]),
+
+				atc.GetTeamRedactionRules: authorized(inputHandlers[atc.GetTeamRedactionRules]),
+				atc.SetTeamRedactionRules: authorized(inputHandlers[atc.SetTeamRedactionRules]),
+
+				atc.GetTeamScheduling: authorized(inputHandlers[atc.GetTeamScheduling]),
 			}
 		})
 